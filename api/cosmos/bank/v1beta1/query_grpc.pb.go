@@ -42,6 +42,16 @@ type QueryClient interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByDisplay queries the client metadata of the coin
+	// denominations with the given display denomination.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataByDisplay(ctx context.Context, in *QueryDenomsMetadataByDisplayRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByDisplayResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of the coin
+	// denominations with the given symbol.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -137,6 +147,24 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DenomsMetadataByDisplay(ctx context.Context, in *QueryDenomsMetadataByDisplayRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByDisplayResponse, error) {
+	out := new(QueryDenomsMetadataByDisplayResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsMetadataByDisplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error) {
+	out := new(QueryDenomsMetadataBySymbolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error) {
 	out := new(QueryDenomOwnersResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomOwners", in, out, opts...)
@@ -179,6 +207,16 @@ type QueryServer interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByDisplay queries the client metadata of the coin
+	// denominations with the given display denomination.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataByDisplay(context.Context, *QueryDenomsMetadataByDisplayRequest) (*QueryDenomsMetadataByDisplayResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of the coin
+	// denominations with the given symbol.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataBySymbol(context.Context, *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -223,6 +261,12 @@ func (UnimplementedQueryServer) DenomMetadata(context.Context, *QueryDenomMetada
 func (UnimplementedQueryServer) DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (UnimplementedQueryServer) DenomsMetadataByDisplay(context.Context, *QueryDenomsMetadataByDisplayRequest) (*QueryDenomsMetadataByDisplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataByDisplay not implemented")
+}
+func (UnimplementedQueryServer) DenomsMetadataBySymbol(context.Context, *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataBySymbol not implemented")
+}
 func (UnimplementedQueryServer) DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
@@ -386,6 +430,42 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomsMetadataByDisplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataByDisplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataByDisplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsMetadataByDisplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataByDisplay(ctx, req.(*QueryDenomsMetadataByDisplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsMetadataBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataBySymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, req.(*QueryDenomsMetadataBySymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDenomOwnersRequest)
 	if err := dec(in); err != nil {
@@ -461,6 +541,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DenomsMetadataByDisplay",
+			Handler:    _Query_DenomsMetadataByDisplay_Handler,
+		},
+		{
+			MethodName: "DenomsMetadataBySymbol",
+			Handler:    _Query_DenomsMetadataBySymbol_Handler,
+		},
 		{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
@@ -41,6 +41,20 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// ContinuousFunds queries all the continuous funds of the community pool.
+	//
+	// Since: cosmos-sdk 0.47
+	ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error)
+	// DelegatorAutoRestake queries whether the rewards of a delegator are
+	// automatically re-delegated.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegatorAutoRestake(ctx context.Context, in *QueryDelegatorAutoRestakeRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakeResponse, error)
+	// DelegatorWithdrawAddressWeights queries the weighted withdraw addresses
+	// of a delegator.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegatorWithdrawAddressWeights(ctx context.Context, in *QueryDelegatorWithdrawAddressWeightsRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressWeightsResponse, error)
 }
 
 type queryClient struct {
@@ -132,6 +146,33 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) ContinuousFunds(ctx context.Context, in *QueryContinuousFundsRequest, opts ...grpc.CallOption) (*QueryContinuousFundsResponse, error) {
+	out := new(QueryContinuousFundsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/ContinuousFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorAutoRestake(ctx context.Context, in *QueryDelegatorAutoRestakeRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoRestakeResponse, error) {
+	out := new(QueryDelegatorAutoRestakeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoRestake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DelegatorWithdrawAddressWeights(ctx context.Context, in *QueryDelegatorWithdrawAddressWeightsRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressWeightsResponse, error) {
+	out := new(QueryDelegatorWithdrawAddressWeightsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddressWeights", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -155,6 +196,20 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// ContinuousFunds queries all the continuous funds of the community pool.
+	//
+	// Since: cosmos-sdk 0.47
+	ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error)
+	// DelegatorAutoRestake queries whether the rewards of a delegator are
+	// automatically re-delegated.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegatorAutoRestake(context.Context, *QueryDelegatorAutoRestakeRequest) (*QueryDelegatorAutoRestakeResponse, error)
+	// DelegatorWithdrawAddressWeights queries the weighted withdraw addresses
+	// of a delegator.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegatorWithdrawAddressWeights(context.Context, *QueryDelegatorWithdrawAddressWeightsRequest) (*QueryDelegatorWithdrawAddressWeightsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -189,6 +244,15 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) ContinuousFunds(context.Context, *QueryContinuousFundsRequest) (*QueryContinuousFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContinuousFunds not implemented")
+}
+func (UnimplementedQueryServer) DelegatorAutoRestake(context.Context, *QueryDelegatorAutoRestakeRequest) (*QueryDelegatorAutoRestakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoRestake not implemented")
+}
+func (UnimplementedQueryServer) DelegatorWithdrawAddressWeights(context.Context, *QueryDelegatorWithdrawAddressWeightsRequest) (*QueryDelegatorWithdrawAddressWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorWithdrawAddressWeights not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -364,6 +428,60 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContinuousFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContinuousFundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContinuousFunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/ContinuousFunds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContinuousFunds(ctx, req.(*QueryContinuousFundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorAutoRestake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoRestakeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoRestake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoRestake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoRestake(ctx, req.(*QueryDelegatorAutoRestakeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DelegatorWithdrawAddressWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorWithdrawAddressWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorWithdrawAddressWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddressWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorWithdrawAddressWeights(ctx, req.(*QueryDelegatorWithdrawAddressWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -407,6 +525,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "ContinuousFunds",
+			Handler:    _Query_ContinuousFunds_Handler,
+		},
+		{
+			MethodName: "DelegatorAutoRestake",
+			Handler:    _Query_DelegatorAutoRestake_Handler,
+		},
+		{
+			MethodName: "DelegatorWithdrawAddressWeights",
+			Handler:    _Query_DelegatorWithdrawAddressWeights_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -28,6 +28,20 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// Infractions queries the infraction history of given cons address
+	//
+	// Since: cosmos-sdk 0.47
+	Infractions(ctx context.Context, in *QueryInfractionsRequest, opts ...grpc.CallOption) (*QueryInfractionsResponse, error)
+	// ValidatorUptime queries the uptime history of given cons address within a
+	// range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error)
+	// WorstUptimeValidators queries the validators with the lowest uptime within
+	// a range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	WorstUptimeValidators(ctx context.Context, in *QueryWorstUptimeValidatorsRequest, opts ...grpc.CallOption) (*QueryWorstUptimeValidatorsResponse, error)
 }
 
 type queryClient struct {
@@ -65,6 +79,33 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) Infractions(ctx context.Context, in *QueryInfractionsRequest, opts ...grpc.CallOption) (*QueryInfractionsResponse, error) {
+	out := new(QueryInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/Infractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error) {
+	out := new(QueryValidatorUptimeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorstUptimeValidators(ctx context.Context, in *QueryWorstUptimeValidatorsRequest, opts ...grpc.CallOption) (*QueryWorstUptimeValidatorsResponse, error) {
+	out := new(QueryWorstUptimeValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/WorstUptimeValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -75,6 +116,20 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// Infractions queries the infraction history of given cons address
+	//
+	// Since: cosmos-sdk 0.47
+	Infractions(context.Context, *QueryInfractionsRequest) (*QueryInfractionsResponse, error)
+	// ValidatorUptime queries the uptime history of given cons address within a
+	// range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error)
+	// WorstUptimeValidators queries the validators with the lowest uptime within
+	// a range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	WorstUptimeValidators(context.Context, *QueryWorstUptimeValidatorsRequest) (*QueryWorstUptimeValidatorsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -91,6 +146,15 @@ func (UnimplementedQueryServer) SigningInfo(context.Context, *QuerySigningInfoRe
 func (UnimplementedQueryServer) SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (UnimplementedQueryServer) Infractions(context.Context, *QueryInfractionsRequest) (*QueryInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Infractions not implemented")
+}
+func (UnimplementedQueryServer) ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUptime not implemented")
+}
+func (UnimplementedQueryServer) WorstUptimeValidators(context.Context, *QueryWorstUptimeValidatorsRequest) (*QueryWorstUptimeValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorstUptimeValidators not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +222,60 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Infractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Infractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/Infractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Infractions(ctx, req.(*QueryInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUptime(ctx, req.(*QueryValidatorUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorstUptimeValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorstUptimeValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorstUptimeValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/WorstUptimeValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorstUptimeValidators(ctx, req.(*QueryWorstUptimeValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +295,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "Infractions",
+			Handler:    _Query_Infractions_Handler,
+		},
+		{
+			MethodName: "ValidatorUptime",
+			Handler:    _Query_ValidatorUptime_Handler,
+		},
+		{
+			MethodName: "WorstUptimeValidators",
+			Handler:    _Query_WorstUptimeValidators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
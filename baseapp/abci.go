@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -297,6 +298,88 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx
 	}
 }
 
+// PrepareProposal implements the PrepareProposal ABCI++ method. It is called by
+// the block proposer to build the list of transactions of the next block,
+// starting from the transactions provided by the consensus engine and the
+// application's mempool. The txs are verified against a branch of the latest
+// committed state which is discarded on Commit.
+//
+// Note: the version of Tendermint currently used by the SDK does not invoke
+// this method, so it must be called explicitly, e.g. by a consensus engine
+// adapter or in tests.
+func (app *BaseApp) PrepareProposal(req sdk.RequestPrepareProposal) (resp sdk.ResponsePrepareProposal) {
+	if app.prepareProposal == nil {
+		panic("PrepareProposal handler not set")
+	}
+
+	app.setProposalState(runTxPrepareProposal, app.proposalHeader(req.Height, req.Time, req.ProposerAddress))
+
+	ctx := app.prepareProposalState.ctx
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	app.prepareProposalState.ctx = ctx
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error(
+				"panic recovered in PrepareProposal",
+				"height", req.Height,
+				"time", req.Time,
+				"panic", r,
+			)
+
+			resp = sdk.ResponsePrepareProposal{Txs: req.Txs}
+		}
+	}()
+
+	return app.prepareProposal(ctx, req)
+}
+
+// ProcessProposal implements the ProcessProposal ABCI++ method. It is called by
+// every validator receiving a block proposal to decide whether the proposal is
+// valid. The txs are verified against a branch of the latest committed state
+// which is discarded on Commit.
+//
+// Note: the version of Tendermint currently used by the SDK does not invoke
+// this method, so it must be called explicitly, e.g. by a consensus engine
+// adapter or in tests.
+func (app *BaseApp) ProcessProposal(req sdk.RequestProcessProposal) (resp sdk.ResponseProcessProposal) {
+	if app.processProposal == nil {
+		panic("ProcessProposal handler not set")
+	}
+
+	app.setProposalState(runTxProcessProposal, app.proposalHeader(req.Height, req.Time, req.ProposerAddress))
+
+	ctx := app.processProposalState.ctx.WithHeaderHash(req.Hash)
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+	app.processProposalState.ctx = ctx
+
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error(
+				"panic recovered in ProcessProposal",
+				"height", req.Height,
+				"time", req.Time,
+				"hash", fmt.Sprintf("%X", req.Hash),
+				"panic", r,
+			)
+
+			resp = sdk.ResponseProcessProposal{Status: sdk.ProposalStatusReject}
+		}
+	}()
+
+	return app.processProposal(ctx, req)
+}
+
+// proposalHeader returns the header used to verify the txs of a block proposal.
+func (app *BaseApp) proposalHeader(height int64, t time.Time, proposer []byte) tmproto.Header {
+	return tmproto.Header{
+		ChainID:         app.checkState.ctx.ChainID(),
+		Height:          height,
+		Time:            t,
+		ProposerAddress: proposer,
+	}
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
@@ -324,6 +407,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	// reset the proposal states, they are set again for the next height
+	app.prepareProposalState = nil
+	app.processProposalState = nil
+
 	var halt bool
 
 	switch {
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -18,14 +19,17 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
 const (
-	runTxModeCheck    runTxMode = iota // Check a transaction
-	runTxModeReCheck                   // Recheck a (pending) transaction after a commit
-	runTxModeSimulate                  // Simulate a transaction
-	runTxModeDeliver                   // Deliver a transaction
+	runTxModeCheck       runTxMode = iota // Check a transaction
+	runTxModeReCheck                      // Recheck a (pending) transaction after a commit
+	runTxModeSimulate                     // Simulate a transaction
+	runTxModeDeliver                      // Deliver a transaction
+	runTxPrepareProposal                  // Verify a transaction included in a block proposal being built
+	runTxProcessProposal                  // Verify a transaction included in a received block proposal
 )
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -54,16 +58,20 @@ type BaseApp struct { // nolint: maligned
 	grpcQueryRouter   *GRPCQueryRouter     // router for redirecting gRPC query calls
 	msgServiceRouter  *MsgServiceRouter    // router for redirecting Msg service messages
 	interfaceRegistry codectypes.InterfaceRegistry
-	txDecoder         sdk.TxDecoder // unmarshal []byte into sdk.Tx
-
-	anteHandler    sdk.AnteHandler  // ante handler for fee and auth
-	postHandler    sdk.AnteHandler  // post handler, optional, e.g. for tips
-	initChainer    sdk.InitChainer  // initialize state with validators and state blob
-	beginBlocker   sdk.BeginBlocker // logic to run before any txs
-	endBlocker     sdk.EndBlocker   // logic to run after all txs, and to determine valset changes
-	addrPeerFilter sdk.PeerFilter   // filter peers by address and port
-	idPeerFilter   sdk.PeerFilter   // filter peers by node ID
-	fauxMerkleMode bool             // if true, IAVL MountStores uses MountStoresDB for simulation speed.
+	txDecoder         sdk.TxDecoder   // unmarshal []byte into sdk.Tx
+	txEncoder         sdk.TxEncoder   // marshal sdk.Tx into []byte
+	mempool           mempool.Mempool // application side mempool
+
+	anteHandler     sdk.AnteHandler            // ante handler for fee and auth
+	postHandler     sdk.AnteHandler            // post handler, optional, e.g. for tips
+	initChainer     sdk.InitChainer            // initialize state with validators and state blob
+	beginBlocker    sdk.BeginBlocker           // logic to run before any txs
+	endBlocker      sdk.EndBlocker             // logic to run after all txs, and to determine valset changes
+	prepareProposal sdk.PrepareProposalHandler // logic to build a block proposal from the mempool
+	processProposal sdk.ProcessProposalHandler // logic to verify a received block proposal
+	addrPeerFilter  sdk.PeerFilter             // filter peers by address and port
+	idPeerFilter    sdk.PeerFilter             // filter peers by node ID
+	fauxMerkleMode  bool                       // if true, IAVL MountStores uses MountStoresDB for simulation speed.
 
 	// manages snapshots, i.e. dumps of app state at certain intervals
 	snapshotManager *snapshots.Manager
@@ -72,8 +80,12 @@ type BaseApp struct { // nolint: maligned
 	//
 	// checkState is set on InitChain and reset on Commit
 	// deliverState is set on InitChain and BeginBlock and set to nil on Commit
-	checkState   *state // for CheckTx
-	deliverState *state // for DeliverTx
+	// prepareProposalState is set on PrepareProposal and set to nil on Commit
+	// processProposalState is set on ProcessProposal and set to nil on Commit
+	checkState           *state // for CheckTx
+	deliverState         *state // for DeliverTx
+	prepareProposalState *state // for PrepareProposal
+	processProposalState *state // for ProcessProposal
 
 	// an inter-block write-through cache provided to the context during deliverState
 	interBlockCache sdk.MultiStorePersistentCache
@@ -161,6 +173,24 @@ func NewBaseApp(
 		option(app)
 	}
 
+	if app.mempool == nil {
+		app.SetMempool(mempool.NoOpMempool{})
+	}
+
+	// The default proposal handlers resolve the mempool when they run so that
+	// a mempool set with SetMempool after construction is honored.
+	if app.prepareProposal == nil {
+		app.SetPrepareProposal(func(ctx sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+			return NewDefaultProposalHandler(app.mempool, app).PrepareProposalHandler()(ctx, req)
+		})
+	}
+
+	if app.processProposal == nil {
+		app.SetProcessProposal(func(ctx sdk.Context, req sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+			return NewDefaultProposalHandler(app.mempool, app).ProcessProposalHandler()(ctx, req)
+		})
+	}
+
 	if app.interBlockCache != nil {
 		app.cms.SetInterBlockCache(app.interBlockCache)
 	}
@@ -195,6 +225,11 @@ func (app *BaseApp) Trace() bool {
 	return app.trace
 }
 
+// Mempool returns the Mempool of the app.
+func (app *BaseApp) Mempool() mempool.Mempool {
+	return app.mempool
+}
+
 // MsgServiceRouter returns the MsgServiceRouter of a BaseApp.
 func (app *BaseApp) MsgServiceRouter() *MsgServiceRouter { return app.msgServiceRouter }
 
@@ -414,6 +449,25 @@ func (app *BaseApp) setDeliverState(header tmproto.Header) {
 	}
 }
 
+// setProposalState sets the state used to verify the txs of a block proposal
+// with a branched multi-store (i.e. a CacheMultiStore) of the latest committed
+// state and a new Context with the provided header. It is set on
+// PrepareProposal and ProcessProposal respectively and set to nil on Commit.
+func (app *BaseApp) setProposalState(mode runTxMode, header tmproto.Header) {
+	ms := app.cms.CacheMultiStore()
+	st := &state{
+		ms: ms,
+		ctx: sdk.NewContext(ms, header, false, app.logger).
+			WithBlockGasMeter(sdk.NewInfiniteGasMeter()),
+	}
+
+	if mode == runTxPrepareProposal {
+		app.prepareProposalState = st
+	} else {
+		app.processProposalState = st
+	}
+}
+
 // GetConsensusParams returns the current consensus parameters from the BaseApp's
 // ParamStore. If the BaseApp has no ParamStore defined, nil is returned.
 func (app *BaseApp) GetConsensusParams(ctx sdk.Context) *tmproto.ConsensusParams {
@@ -537,14 +591,23 @@ func validateBasicTxMsgs(msgs []sdk.Msg) error {
 	return nil
 }
 
-// Returns the applications's deliverState if app is in runTxModeDeliver,
-// otherwise it returns the application's checkstate.
+// Returns the applications's deliverState if app is in runTxModeDeliver, the
+// respective proposal state if app is verifying a block proposal, otherwise it
+// returns the application's checkstate.
 func (app *BaseApp) getState(mode runTxMode) *state {
-	if mode == runTxModeDeliver {
+	switch mode {
+	case runTxModeDeliver:
 		return app.deliverState
-	}
 
-	return app.checkState
+	case runTxPrepareProposal:
+		return app.prepareProposalState
+
+	case runTxProcessProposal:
+		return app.processProposalState
+
+	default:
+		return app.checkState
+	}
 }
 
 // retrieve the context for the tx w/ txBytes and other memoized values.
@@ -679,6 +742,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		gasWanted = ctx.GasMeter().Limit()
 
 		if err != nil {
+			if mode == runTxModeReCheck {
+				// The tx is no longer valid against the latest committed state,
+				// so it must not be proposed anymore.
+				if rmErr := app.mempool.Remove(tx); rmErr != nil && !errors.Is(rmErr, mempool.ErrTxNotFound) {
+					app.logger.Error("failed to remove tx from mempool", "err", rmErr)
+				}
+			}
+
 			return gInfo, nil, nil, 0, err
 		}
 
@@ -687,6 +758,16 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 		anteEvents = events.ToABCIEvents()
 	}
 
+	if mode == runTxModeCheck {
+		if err := app.mempool.Insert(ctx, tx); err != nil {
+			return gInfo, nil, anteEvents, priority, err
+		}
+	} else if mode == runTxModeDeliver {
+		if err := app.mempool.Remove(tx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+			return gInfo, nil, anteEvents, priority, fmt.Errorf("failed to remove tx from mempool: %w", err)
+		}
+	}
+
 	// Create a new Context based off of the existing Context with a MultiStore branch
 	// in case message processing fails. At this point, the MultiStore
 	// is a branch of a branch.
@@ -737,8 +818,9 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 
 	// NOTE: GasWanted is determined by the AnteHandler and GasUsed by the GasMeter.
 	for i, msg := range msgs {
-		// skip actual execution for (Re)CheckTx mode
-		if mode == runTxModeCheck || mode == runTxModeReCheck {
+		// skip actual execution for (Re)CheckTx mode and proposal verification
+		if mode == runTxModeCheck || mode == runTxModeReCheck ||
+			mode == runTxPrepareProposal || mode == runTxProcessProposal {
 			break
 		}
 
@@ -818,3 +900,162 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (*s
 func makeABCIData(msgResponses []*codectypes.Any) ([]byte, error) {
 	return proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
 }
+
+// PrepareProposalVerifyTx performs transaction verification when a proposer is
+// creating a block proposal during PrepareProposal. Any state committed to the
+// PrepareProposal state internally will be discarded. <nil, err> will be
+// returned if the transaction cannot be encoded. <bz, nil> will be returned if
+// the transaction is valid, otherwise <bz, err> will be returned.
+func (app *BaseApp) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	if app.txEncoder == nil {
+		return nil, errors.New("no tx encoder set on BaseApp")
+	}
+
+	bz, err := app.txEncoder(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, _, _, err = app.runTx(runTxPrepareProposal, bz)
+	if err != nil {
+		return bz, err
+	}
+
+	return bz, nil
+}
+
+// ProcessProposalVerifyTx performs transaction verification when receiving a
+// block proposal during ProcessProposal. Any state committed to the
+// ProcessProposal state internally will be discarded. <nil, err> will be
+// returned if the transaction cannot be decoded. <Tx, nil> will be returned if
+// the transaction is valid, otherwise <Tx, err> will be returned.
+func (app *BaseApp) ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error) {
+	tx, err := app.txDecoder(txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, _, _, err = app.runTx(runTxProcessProposal, txBz)
+	if err != nil {
+		return tx, err
+	}
+
+	return tx, nil
+}
+
+type (
+	// ProposalTxVerifier defines the interface that is implemented by BaseApp,
+	// that any custom ABCI PrepareProposal and ProcessProposal handler can use
+	// to verify a transaction.
+	ProposalTxVerifier interface {
+		PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error)
+		ProcessProposalVerifyTx(txBz []byte) (sdk.Tx, error)
+	}
+
+	// DefaultProposalHandler defines the default ABCI PrepareProposal and
+	// ProcessProposal handlers.
+	DefaultProposalHandler struct {
+		mempool    mempool.Mempool
+		txVerifier ProposalTxVerifier
+	}
+)
+
+// NewDefaultProposalHandler returns a DefaultProposalHandler selecting txs
+// from the provided mempool and verifying them with txVerifier.
+func NewDefaultProposalHandler(mp mempool.Mempool, txVerifier ProposalTxVerifier) DefaultProposalHandler {
+	return DefaultProposalHandler{
+		mempool:    mp,
+		txVerifier: txVerifier,
+	}
+}
+
+// PrepareProposalHandler returns the default implementation for processing an
+// ABCI proposal. The application's mempool is enumerated and all valid
+// transactions are added to the proposal. Transactions are valid if they:
+//
+// 1) Successfully encode to bytes.
+// 2) Are valid (i.e. pass runTx, AnteHandler only).
+//
+// Enumeration is halted once RequestPrepareProposal.MaxTxBytes of transactions
+// is reached. Transactions failing verification are removed from the mempool.
+//
+// If the app uses a no-op mempool, the transactions provided by the consensus
+// engine are returned as is, up to MaxTxBytes.
+func (h DefaultProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+		var (
+			selectedTxs  [][]byte
+			totalTxBytes int64
+		)
+
+		if _, ok := h.mempool.(mempool.NoOpMempool); ok {
+			for _, txBz := range req.Txs {
+				totalTxBytes += int64(len(txBz))
+				if totalTxBytes > req.MaxTxBytes {
+					break
+				}
+
+				selectedTxs = append(selectedTxs, txBz)
+			}
+
+			return sdk.ResponsePrepareProposal{Txs: selectedTxs}
+		}
+
+		for iterator := h.mempool.Select(ctx, req.Txs); iterator != nil; iterator = iterator.Next() {
+			memTx := iterator.Tx()
+
+			bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+			if err != nil {
+				if err := h.mempool.Remove(memTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					ctx.Logger().Error("failed to remove tx from mempool", "err", err)
+				}
+
+				continue
+			}
+
+			totalTxBytes += int64(len(bz))
+			if totalTxBytes > req.MaxTxBytes {
+				break
+			}
+
+			selectedTxs = append(selectedTxs, bz)
+		}
+
+		return sdk.ResponsePrepareProposal{Txs: selectedTxs}
+	}
+}
+
+// ProcessProposalHandler returns the default implementation for processing an
+// ABCI proposal. Every transaction in the proposal must pass 2 conditions:
+//
+// 1. The transaction bytes must decode to a valid transaction.
+// 2. The transaction must be valid (i.e. pass runTx, AnteHandler only)
+//
+// If any transaction fails to pass either condition, the proposal is rejected.
+func (h DefaultProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+		for _, txBytes := range req.Txs {
+			if _, err := h.txVerifier.ProcessProposalVerifyTx(txBytes); err != nil {
+				return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusReject}
+			}
+		}
+
+		return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusAccept}
+	}
+}
+
+// NoOpPrepareProposal defines a no-op PrepareProposal handler. It will always
+// return the transactions sent by the client's request.
+func NoOpPrepareProposal() sdk.PrepareProposalHandler {
+	return func(_ sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+		return sdk.ResponsePrepareProposal{Txs: req.Txs}
+	}
+}
+
+// NoOpProcessProposal defines a no-op ProcessProposal handler. It will always
+// return ACCEPT.
+func NoOpProcessProposal() sdk.ProcessProposalHandler {
+	return func(_ sdk.Context, _ sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+		return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusAccept}
+	}
+}
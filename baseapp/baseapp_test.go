@@ -30,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 )
 
@@ -541,6 +542,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetRouter(NewRouter())
 	})
+	require.Panics(t, func() {
+		app.SetMempool(mempool.NoOpMempool{})
+	})
 }
 
 func TestSetMinGasPrices(t *testing.T) {
@@ -1013,6 +1017,235 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// testMempool is a mempool tracking txTest transactions in insertion order.
+type testMempool struct {
+	txs        []txTest
+	priorities map[int64]int64 // counter -> priority
+}
+
+func newTestMempool() *testMempool {
+	return &testMempool{priorities: make(map[int64]int64)}
+}
+
+func (mp *testMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	mp.txs = append(mp.txs, tx.(txTest))
+	mp.priorities[tx.(txTest).Counter] = ctx.Priority()
+	return nil
+}
+
+func (mp *testMempool) Select(sdk.Context, [][]byte) mempool.Iterator {
+	if len(mp.txs) == 0 {
+		return nil
+	}
+
+	txs := make([]txTest, len(mp.txs))
+	copy(txs, mp.txs)
+	return &testMempoolIterator{txs: txs}
+}
+
+func (mp *testMempool) CountTx() int { return len(mp.txs) }
+
+func (mp *testMempool) Remove(tx sdk.Tx) error {
+	for i, memTx := range mp.txs {
+		if memTx.Counter == tx.(txTest).Counter {
+			mp.txs = append(mp.txs[:i], mp.txs[i+1:]...)
+			delete(mp.priorities, memTx.Counter)
+			return nil
+		}
+	}
+
+	return mempool.ErrTxNotFound
+}
+
+type testMempoolIterator struct {
+	txs []txTest
+}
+
+func (it *testMempoolIterator) Next() mempool.Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+
+	it.txs = it.txs[1:]
+	return it
+}
+
+func (it *testMempoolIterator) Tx() sdk.Tx { return it.txs[0] }
+
+// Test that CheckTx inserts txs passing the AnteHandler into the app-side
+// mempool and that DeliverTx removes them.
+func TestMempoolCheckDeliverTx(t *testing.T) {
+	counterKey := []byte("counter-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, counterKey)) }
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, []byte("deliver-key"))))
+	}
+
+	mp := newTestMempool()
+	app := setupBaseApp(t, anteOpt, routerOpt, SetMempool(mp))
+	require.Equal(t, mp, app.Mempool())
+
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nTxs := int64(3)
+	txs := make([][]byte, nTxs)
+	for i := int64(0); i < nTxs; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+		txs[i] = txBytes
+
+		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+	}
+
+	// a tx failing the AnteHandler must not be inserted
+	failTx := newTxCounter(nTxs, nTxs)
+	failTx.setFailOnAnte(true)
+	txBytes, err := codec.Marshal(failTx)
+	require.NoError(t, err)
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+
+	require.Equal(t, int(nTxs), mp.CountTx())
+	for i := int64(0); i < nTxs; i++ {
+		require.Equal(t, testTxPriority, mp.priorities[i])
+	}
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	for _, txBytes := range txs {
+		res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	require.Zero(t, mp.CountTx())
+}
+
+func TestPrepareProposal(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	encoderOpt := func(bapp *BaseApp) { bapp.SetTxEncoder(aminoTxEncoder()) }
+
+	mp := newTestMempool()
+	app := setupBaseApp(t, anteOpt, encoderOpt, SetMempool(mp))
+	app.InitChain(abci.RequestInitChain{})
+
+	txEncoder := aminoTxEncoder()
+	ctx := app.checkState.ctx
+	var txSizes []int64
+	for i := int64(0); i < 4; i++ {
+		tx := newTxCounter(i, i)
+		bz, err := txEncoder(*tx)
+		require.NoError(t, err)
+		txSizes = append(txSizes, int64(len(bz)))
+		require.NoError(t, mp.Insert(ctx, *tx))
+	}
+
+	// a tx failing the AnteHandler is dropped from the proposal and the mempool
+	failTx := newTxCounter(4, 4)
+	failTx.setFailOnAnte(true)
+	require.NoError(t, mp.Insert(ctx, *failTx))
+
+	req := sdk.RequestPrepareProposal{Height: 1, MaxTxBytes: 1 << 20}
+	res := app.PrepareProposal(req)
+	require.Len(t, res.Txs, 4)
+	require.Equal(t, 4, mp.CountTx())
+
+	// the proposal is bounded by MaxTxBytes
+	req.MaxTxBytes = txSizes[0] + txSizes[1]
+	res = app.PrepareProposal(req)
+	require.Len(t, res.Txs, 2)
+
+	// proposal verification does not alter the check state
+	require.Zero(t, getIntFromStore(app.checkState.ctx.KVStore(capKey1), []byte("ante-key")))
+}
+
+func TestPrepareProposalMempoolSetAfterConstruction(t *testing.T) {
+	encoderOpt := func(bapp *BaseApp) { bapp.SetTxEncoder(aminoTxEncoder()) }
+	app := newBaseApp(t.Name(), encoderOpt)
+	app.MountStores(capKey1, capKey2)
+	app.SetParamStore(&paramStore{db: dbm.NewMemDB()})
+
+	// the mempool is set after NewBaseApp installed the default handlers
+	mp := newTestMempool()
+	app.SetMempool(mp)
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	require.NoError(t, mp.Insert(app.checkState.ctx, *newTxCounter(0, 0)))
+
+	res := app.PrepareProposal(sdk.RequestPrepareProposal{Height: 1, MaxTxBytes: 1 << 20})
+	require.Len(t, res.Txs, 1)
+}
+
+func TestPrepareProposalNoOpMempool(t *testing.T) {
+	app := setupBaseApp(t)
+	app.InitChain(abci.RequestInitChain{})
+
+	txs := [][]byte{[]byte("tx1"), []byte("tx2"), []byte("tx3")}
+	res := app.PrepareProposal(sdk.RequestPrepareProposal{Txs: txs, Height: 1, MaxTxBytes: 6})
+	require.Equal(t, txs[:2], res.Txs)
+}
+
+func TestProcessProposal(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, []byte("ante-key"))) }
+	app := setupBaseApp(t, anteOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	var txs [][]byte
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, i))
+		require.NoError(t, err)
+		txs = append(txs, txBytes)
+	}
+
+	res := app.ProcessProposal(sdk.RequestProcessProposal{Txs: txs, Height: 1})
+	require.True(t, res.IsAccepted())
+
+	failTx := newTxCounter(3, 3)
+	failTx.setFailOnAnte(true)
+	txBytes, err := codec.Marshal(failTx)
+	require.NoError(t, err)
+
+	res = app.ProcessProposal(sdk.RequestProcessProposal{Txs: append(txs, txBytes), Height: 1})
+	require.Equal(t, sdk.ProposalStatusReject, res.Status)
+
+	res = app.ProcessProposal(sdk.RequestProcessProposal{Txs: [][]byte{[]byte("invalid")}, Height: 1})
+	require.Equal(t, sdk.ProposalStatusReject, res.Status)
+}
+
+func TestCustomProposalHandlers(t *testing.T) {
+	injected := []byte("injected")
+	prepareOpt := SetPrepareProposal(func(ctx sdk.Context, req sdk.RequestPrepareProposal) sdk.ResponsePrepareProposal {
+		return sdk.ResponsePrepareProposal{Txs: append([][]byte{injected}, req.Txs...)}
+	})
+	processOpt := SetProcessProposal(func(ctx sdk.Context, req sdk.RequestProcessProposal) sdk.ResponseProcessProposal {
+		if len(req.Txs) == 0 || !bytes.Equal(req.Txs[0], injected) {
+			return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusReject}
+		}
+
+		return sdk.ResponseProcessProposal{Status: sdk.ProposalStatusAccept}
+	})
+
+	app := setupBaseApp(t, prepareOpt, processOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	prepareRes := app.PrepareProposal(sdk.RequestPrepareProposal{Txs: [][]byte{[]byte("tx")}, Height: 1})
+	require.Equal(t, [][]byte{injected, []byte("tx")}, prepareRes.Txs)
+
+	require.True(t, app.ProcessProposal(sdk.RequestProcessProposal{Txs: prepareRes.Txs, Height: 1}).IsAccepted())
+	require.False(t, app.ProcessProposal(sdk.RequestProcessProposal{Txs: [][]byte{[]byte("tx")}, Height: 1}).IsAccepted())
+
+	require.Panics(t, func() { app.SetPrepareProposal(NoOpPrepareProposal()) })
+	require.Panics(t, func() { app.SetProcessProposal(NoOpProcessProposal()) })
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
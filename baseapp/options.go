@@ -12,6 +12,7 @@ import (
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 // File for storing in-package BaseApp optional functions,
@@ -75,6 +76,21 @@ func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOpti
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
 }
 
+// SetMempool sets the mempool on BaseApp.
+func SetMempool(mempool mempool.Mempool) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetMempool(mempool) }
+}
+
+// SetPrepareProposal sets the PrepareProposal handler on BaseApp.
+func SetPrepareProposal(handler sdk.PrepareProposalHandler) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetPrepareProposal(handler) }
+}
+
+// SetProcessProposal sets the ProcessProposal handler on BaseApp.
+func SetProcessProposal(handler sdk.ProcessProposalHandler) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetProcessProposal(handler) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -161,6 +177,26 @@ func (app *BaseApp) SetPostHandler(ph sdk.AnteHandler) {
 	app.postHandler = ph
 }
 
+// SetPrepareProposal sets the handler called by the block proposer to build a
+// block proposal.
+func (app *BaseApp) SetPrepareProposal(handler sdk.PrepareProposalHandler) {
+	if app.sealed {
+		panic("SetPrepareProposal() on sealed BaseApp")
+	}
+
+	app.prepareProposal = handler
+}
+
+// SetProcessProposal sets the handler called by validators to verify a block
+// proposal.
+func (app *BaseApp) SetProcessProposal(handler sdk.ProcessProposalHandler) {
+	if app.sealed {
+		panic("SetProcessProposal() on sealed BaseApp")
+	}
+
+	app.processProposal = handler
+}
+
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
@@ -243,3 +279,19 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 func (app *BaseApp) SetTxDecoder(txDecoder sdk.TxDecoder) {
 	app.txDecoder = txDecoder
 }
+
+// SetTxEncoder sets the TxEncoder, which is used to encode the txs selected
+// from the mempool when building a block proposal.
+func (app *BaseApp) SetTxEncoder(txEncoder sdk.TxEncoder) {
+	app.txEncoder = txEncoder
+}
+
+// SetMempool sets the mempool for the BaseApp and is required for the app to
+// start up.
+func (app *BaseApp) SetMempool(mempool mempool.Mempool) {
+	if app.sealed {
+		panic("SetMempool() on sealed BaseApp")
+	}
+
+	app.mempool = mempool
+}
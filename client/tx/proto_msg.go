@@ -0,0 +1,75 @@
+package tx
+
+import (
+	"fmt"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetFromAddress returns the bech32 address of the account given by the --from
+// flag, which may be either a key name or an address. It can be used as the
+// signer resolver of commands generated by the client/v2 CLI builder.
+func GetFromAddress(cmd *cobra.Command) (string, error) {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return "", err
+	}
+
+	from := clientCtx.GetFromAddress()
+	if from.Empty() {
+		return "", fmt.Errorf("the signer must be specified with the --from flag")
+	}
+
+	return from.String(), nil
+}
+
+// GenerateOrBroadcastProtoMsg converts a message generated with
+// google.golang.org/protobuf (e.g. from cosmossdk.io/api) into the sdk.Msg
+// registered for the same type in the interface registry, and either generates
+// or signs and broadcasts a transaction containing it. It can be used to
+// broadcast the messages of commands generated by the client/v2 CLI builder.
+func GenerateOrBroadcastProtoMsg(cmd *cobra.Command, msg proto.Message) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg, err := protoMsgToSDKMsg(clientCtx.InterfaceRegistry, msg)
+	if err != nil {
+		return err
+	}
+
+	return GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), sdkMsg)
+}
+
+// protoMsgToSDKMsg converts msg to the registered sdk.Msg by its type URL,
+// using the binary encoding which is shared by both representations.
+func protoMsgToSDKMsg(registry codectypes.InterfaceRegistry, msg proto.Message) (sdk.Msg, error) {
+	typeURL := "/" + string(msg.ProtoReflect().Descriptor().FullName())
+	resolved, err := registry.Resolve(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkMsg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a sdk.Msg", typeURL)
+	}
+
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := gogoproto.Unmarshal(bz, sdkMsg); err != nil {
+		return nil, err
+	}
+
+	return sdkMsg, nil
+}
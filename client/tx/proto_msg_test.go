@@ -0,0 +1,73 @@
+package tx_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGenerateOrBroadcastProtoMsg(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	_, _, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+
+	out := &bytes.Buffer{}
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithChainID("test-chain").
+		WithOutput(out)
+
+	var msg *bankv1beta1.MsgSend
+	cmd := &cobra.Command{
+		Use:          "send",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			signer, err := tx.GetFromAddress(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg.FromAddress = signer
+			return tx.GenerateOrBroadcastProtoMsg(cmd, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	run := func(args ...string) error {
+		out.Reset()
+		cmdCtx := clientCtx
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &cmdCtx)
+		cmd.SetArgs(args)
+		return cmd.ExecuteContext(ctx)
+	}
+
+	msg = &bankv1beta1.MsgSend{
+		ToAddress: to.String(),
+		Amount:    []*basev1beta1.Coin{{Denom: "stake", Amount: "10"}},
+	}
+	require.NoError(t, run("--from", from.String(), "--generate-only"))
+
+	stdTx, err := encCfg.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))}, stdTx.GetMsgs())
+
+	// the converted msg is validated before generating the tx
+	msg.Amount = nil
+	require.Error(t, run("--from", from.String(), "--generate-only"))
+}
@@ -3,7 +3,9 @@ package cli
 import (
 	"context"
 
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
 )
@@ -16,4 +18,19 @@ type Builder struct {
 	// GetClientConn specifies how CLI commands will resolve a grpc.ClientConnInterface
 	// from a given context.
 	GetClientConn func(context.Context) grpc.ClientConnInterface
+
+	// AddTxConnFlags adds the flags needed to sign and broadcast transactions,
+	// such as --from, --chain-id or --fees, to tx commands. If it is nil, only
+	// a --from flag is added.
+	AddTxConnFlags func(*cobra.Command)
+
+	// GetSignerAddress specifies how tx commands will resolve the address of
+	// the signer from the --from flag, which may be a key name or an address.
+	// If it is nil, the value of the --from flag is used as is.
+	GetSignerAddress func(*cobra.Command) (string, error)
+
+	// BroadcastMsg specifies how tx commands will sign and broadcast (or
+	// generate) a transaction containing the message built from the command
+	// arguments and flags. If it is nil, tx commands return an error.
+	BroadcastMsg func(*cobra.Command, proto.Message) error
 }
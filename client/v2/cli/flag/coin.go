@@ -0,0 +1,153 @@
+package flag
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+const coinMessageName = "cosmos.base.v1beta1.Coin"
+
+// coinRegex matches a coin in the `<amount><denom>` format, e.g. `10stake`.
+var coinRegex = regexp.MustCompile(`^([0-9]+)\s*([a-zA-Z][a-zA-Z0-9/:._-]{2,127})$`)
+
+type coinType struct {
+	messageDesc protoreflect.MessageDescriptor
+}
+
+func (c coinType) NewValue(_ context.Context, builder *Builder) pflag.Value {
+	return &coinValue{
+		messageType:          util.ResolveMessageType(builder.TypeResolver, c.messageDesc),
+		jsonUnmarshalOptions: protojson.UnmarshalOptions{Resolver: builder.TypeResolver},
+	}
+}
+
+func (c coinType) DefaultValue() string {
+	return ""
+}
+
+type coinValue struct {
+	jsonUnmarshalOptions protojson.UnmarshalOptions
+	messageType          protoreflect.MessageType
+	value                protoreflect.Message
+}
+
+func (c coinValue) Get() protoreflect.Value {
+	if c.value == nil {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(c.value)
+}
+
+func (c coinValue) String() string {
+	if c.value == nil {
+		return ""
+	}
+	return coinString(c.value)
+}
+
+func (c *coinValue) Set(s string) error {
+	coin, err := parseCoin(c.messageType, c.jsonUnmarshalOptions, s)
+	if err != nil {
+		return err
+	}
+
+	c.value = coin
+	return nil
+}
+
+func (c coinValue) Type() string {
+	return "coin"
+}
+
+// coinsType is the flag type of repeated coin fields. Unlike other repeated
+// flags, coins may also be passed as a comma separated list, e.g. `10stake,5atom`.
+type coinsType struct {
+	messageDesc protoreflect.MessageDescriptor
+}
+
+func (c coinsType) NewValue(_ context.Context, builder *Builder) pflag.Value {
+	return &coinsValue{
+		messageType:          util.ResolveMessageType(builder.TypeResolver, c.messageDesc),
+		jsonUnmarshalOptions: protojson.UnmarshalOptions{Resolver: builder.TypeResolver},
+	}
+}
+
+func (c coinsType) DefaultValue() string {
+	return ""
+}
+
+type coinsValue struct {
+	jsonUnmarshalOptions protojson.UnmarshalOptions
+	messageType          protoreflect.MessageType
+	values               []protoreflect.Message
+}
+
+func (c coinsValue) AppendTo(list protoreflect.List) {
+	for _, coin := range c.values {
+		list.Append(protoreflect.ValueOfMessage(coin))
+	}
+}
+
+func (c coinsValue) String() string {
+	coins := make([]string, len(c.values))
+	for i, coin := range c.values {
+		coins[i] = coinString(coin)
+	}
+	return strings.Join(coins, ",")
+}
+
+func (c *coinsValue) Set(s string) error {
+	// a single coin may be passed as JSON, which can itself contain commas
+	parts := []string{s}
+	if !strings.HasPrefix(strings.TrimSpace(s), "{") {
+		parts = strings.Split(s, ",")
+	}
+
+	for _, part := range parts {
+		coin, err := parseCoin(c.messageType, c.jsonUnmarshalOptions, part)
+		if err != nil {
+			return err
+		}
+		c.values = append(c.values, coin)
+	}
+	return nil
+}
+
+func (c coinsValue) Type() string {
+	return "coins"
+}
+
+// parseCoin parses a coin either in the `<amount><denom>` format or as JSON.
+func parseCoin(messageType protoreflect.MessageType, jsonUnmarshalOptions protojson.UnmarshalOptions, s string) (protoreflect.Message, error) {
+	s = strings.TrimSpace(s)
+	coin := messageType.New()
+	if strings.HasPrefix(s, "{") {
+		if err := jsonUnmarshalOptions.Unmarshal([]byte(s), coin.Interface()); err != nil {
+			return nil, err
+		}
+		return coin, nil
+	}
+
+	matches := coinRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, fmt.Errorf("invalid coin %q, expected format <amount><denom>", s)
+	}
+
+	fields := coin.Descriptor().Fields()
+	coin.Set(fields.ByName("amount"), protoreflect.ValueOfString(matches[1]))
+	coin.Set(fields.ByName("denom"), protoreflect.ValueOfString(matches[2]))
+	return coin, nil
+}
+
+func coinString(coin protoreflect.Message) string {
+	fields := coin.Descriptor().Fields()
+	return coin.Get(fields.ByName("amount")).String() + coin.Get(fields.ByName("denom")).String()
+}
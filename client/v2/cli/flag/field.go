@@ -70,6 +70,10 @@ func (b *Builder) AddFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, fiel
 func (b *Builder) resolveFlagType(field protoreflect.FieldDescriptor) Type {
 	typ := b.resolveFlagTypeBasic(field)
 	if field.IsList() {
+		if _, ok := typ.(coinType); ok {
+			return coinsType{messageDesc: field.Message()}
+		}
+
 		if typ != nil {
 			return compositeListType{simpleType: typ}
 		}
@@ -98,6 +102,10 @@ func (b *Builder) resolveFlagTypeBasic(field protoreflect.FieldDescriptor) Type
 			return flagType
 		}
 
+		if field.Message().FullName() == coinMessageName {
+			return coinType{messageDesc: field.Message()}
+		}
+
 		return jsonMessageFlagType{
 			messageDesc: field.Message(),
 		}
@@ -124,5 +132,5 @@ type listValueBinder struct {
 }
 
 func (s listValueBinder) Bind(message protoreflect.Message, field protoreflect.FieldDescriptor) {
-	s.AppendTo(message.NewField(field).List())
+	s.AppendTo(message.Mutable(field).List())
 }
@@ -0,0 +1,218 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+const (
+	flagFrom = "from"
+
+	// signerExtensionName is the name of the message option which specifies
+	// the signer fields of a Msg.
+	signerExtensionName = "cosmos.msg.v1.signer"
+)
+
+// MsgMethodOptions specifies options for the command generated for a Msg
+// service method.
+type MsgMethodOptions struct {
+	// PositionalArgs are the names of the message fields which are set from
+	// positional arguments, in order. If the last one is a repeated field, it
+	// takes all the remaining arguments. All other fields are set from flags.
+	PositionalArgs []protoreflect.Name
+}
+
+// AddMsgServiceCommands adds a sub-command to the provided command for each
+// method in the specified Msg service and returns the command. Options for
+// specific methods can be provided by method name.
+func (b *Builder) AddMsgServiceCommands(command *cobra.Command, serviceName protoreflect.FullName, methodOptions map[protoreflect.Name]MsgMethodOptions) *cobra.Command {
+	resolver := b.FileResolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalFiles
+	}
+	descriptor, err := resolver.FindDescriptorByName(serviceName)
+	if err != nil {
+		panic(err)
+	}
+
+	service := descriptor.(protoreflect.ServiceDescriptor)
+	methods := service.Methods()
+	n := methods.Len()
+	for i := 0; i < n; i++ {
+		method := methods.Get(i)
+		cmd := b.CreateMsgMethodCommand(method, methodOptions[method.Name()])
+		command.AddCommand(cmd)
+	}
+	return command
+}
+
+// CreateMsgMethodCommand creates a tx command for the given Msg service method.
+// The signer field of the message, as specified by the cosmos.msg.v1.signer
+// option, is filled from the --from flag, and the resulting message is passed
+// to BroadcastMsg.
+func (b *Builder) CreateMsgMethodCommand(descriptor protoreflect.MethodDescriptor, options MsgMethodOptions) *cobra.Command {
+	docs := util.DescriptorDocs(descriptor)
+	inputDesc := descriptor.Input()
+	inputType := util.ResolveMessageType(b.TypeResolver, inputDesc)
+	fields := inputDesc.Fields()
+	signerField := b.resolveSignerField(inputDesc)
+
+	cmd := &cobra.Command{
+		Use:  protoNameToCliName(descriptor.Name()),
+		Long: docs,
+		Args: cobra.ExactArgs(len(options.PositionalArgs)),
+	}
+
+	// positional arguments are parsed through a separate flag set, so that
+	// they support the same types as flags
+	argSet := pflag.NewFlagSet("args", pflag.ContinueOnError)
+	var (
+		argNames []string
+		binders  []fieldBinder
+	)
+	for i, name := range options.PositionalArgs {
+		field := fields.ByName(name)
+		if field == nil {
+			panic(fmt.Errorf("can't find field %s on %s", name, inputDesc.FullName()))
+		}
+
+		binder := b.AddFieldFlag(cmd.Context(), argSet, field, flag.Options{})
+		if binder == nil {
+			panic(fmt.Errorf("can't bind field %s to a positional argument", field.FullName()))
+		}
+
+		argName := util.DescriptorKebabName(field)
+		argNames = append(argNames, argName)
+		binders = append(binders, fieldBinder{binder: binder, field: field})
+
+		use := fmt.Sprintf("[%s]", argName)
+		if field.IsList() {
+			if i != len(options.PositionalArgs)-1 {
+				panic(fmt.Errorf("repeated field %s must be the last positional argument", field.FullName()))
+			}
+			cmd.Args = cobra.MinimumNArgs(len(options.PositionalArgs))
+			use += "..."
+		}
+		cmd.Use += " " + use
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if field == signerField || isPositional(field, options.PositionalArgs) {
+			continue
+		}
+
+		// fields which can't be bound to a flag are left unset
+		binder := b.AddFieldFlag(cmd.Context(), cmd.Flags(), field, flag.Options{})
+		if binder == nil {
+			continue
+		}
+		binders = append(binders, fieldBinder{binder: binder, field: field})
+	}
+
+	if b.AddTxConnFlags != nil {
+		b.AddTxConnFlags(cmd)
+	} else {
+		cmd.Flags().String(flagFrom, "", "Name or address of private key with which to sign")
+	}
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if b.BroadcastMsg == nil {
+			return fmt.Errorf("can't broadcast %s: BroadcastMsg isn't set on the builder", inputDesc.FullName())
+		}
+
+		for i, arg := range args {
+			// a trailing repeated field takes all the remaining arguments
+			name := argNames[len(argNames)-1]
+			if i < len(argNames) {
+				name = argNames[i]
+			}
+
+			if err := argSet.Set(name, arg); err != nil {
+				return fmt.Errorf("invalid argument %q for %s: %w", arg, name, err)
+			}
+		}
+
+		msg := inputType.New()
+		for _, binder := range binders {
+			binder.binder.Bind(msg, binder.field)
+		}
+
+		if signerField != nil {
+			signer, err := b.getSignerAddress(cmd)
+			if err != nil {
+				return err
+			}
+			msg.Set(signerField, protoreflect.ValueOfString(signer))
+		}
+
+		return b.BroadcastMsg(cmd, msg.Interface())
+	}
+
+	return cmd
+}
+
+// resolveSignerField returns the field of the message which holds the address
+// of its signer, or nil if the message doesn't specify a single signer field.
+func (b *Builder) resolveSignerField(descriptor protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	var resolver protoregistry.ExtensionTypeResolver = protoregistry.GlobalTypes
+	if b.TypeResolver != nil {
+		resolver = b.TypeResolver
+	}
+
+	extType, err := resolver.FindExtensionByName(signerExtensionName)
+	if err != nil {
+		return nil
+	}
+
+	signers, ok := proto.GetExtension(descriptor.Options(), extType).([]string)
+	if !ok || len(signers) != 1 {
+		return nil
+	}
+
+	field := descriptor.Fields().ByName(protoreflect.Name(signers[0]))
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return nil
+	}
+
+	return field
+}
+
+func (b *Builder) getSignerAddress(cmd *cobra.Command) (string, error) {
+	if b.GetSignerAddress != nil {
+		return b.GetSignerAddress(cmd)
+	}
+
+	from, err := cmd.Flags().GetString(flagFrom)
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(from) == "" {
+		return "", fmt.Errorf("--%s flag is required", flagFrom)
+	}
+
+	return from, nil
+}
+
+func isPositional(field protoreflect.FieldDescriptor, positionalArgs []protoreflect.Name) bool {
+	for _, name := range positionalArgs {
+		if field.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+type fieldBinder struct {
+	binder flag.FieldValueBinder
+	field  protoreflect.FieldDescriptor
+}
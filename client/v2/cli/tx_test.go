@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gotest.tools/v3/assert"
+	"gotest.tools/v3/golden"
+
+	basev1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/testpb"
+)
+
+type testBroadcaster struct {
+	lastMsg proto.Message
+	out     *bytes.Buffer
+}
+
+func testTxExec(t *testing.T, b *Builder, args ...string) (*testBroadcaster, error) {
+	broadcaster := &testBroadcaster{out: &bytes.Buffer{}}
+	b.BroadcastMsg = func(_ *cobra.Command, msg proto.Message) error {
+		broadcaster.lastMsg = msg
+		return nil
+	}
+
+	cmd := b.AddMsgServiceCommands(&cobra.Command{Use: "test"}, protoreflect.FullName(testpb.Msg_ServiceDesc.ServiceName), map[protoreflect.Name]MsgMethodOptions{
+		"Send": {PositionalArgs: []protoreflect.Name{"recipient", "amount"}},
+	})
+	cmd.SetArgs(args)
+	cmd.SetOut(broadcaster.out)
+	cmd.SetErr(broadcaster.out)
+	return broadcaster, cmd.Execute()
+}
+
+func TestMsgCommand(t *testing.T) {
+	broadcaster, err := testTxExec(t, &Builder{},
+		"send",
+		"cosmos1recipient",
+		"10stake,5atom",
+		"7foo",
+		"--from", "cosmos1sender",
+		"--duration", "1h",
+		"--a-message", `{"bar":"abc", "baz":-3}`,
+		"--u-64", "3",
+		"--memos", "a,b",
+		"--memos", "c",
+	)
+	assert.NilError(t, err)
+
+	expected := &testpb.MsgSend{
+		Sender:    "cosmos1sender",
+		Recipient: "cosmos1recipient",
+		Amount: []*basev1beta1.Coin{
+			{Denom: "stake", Amount: "10"},
+			{Denom: "atom", Amount: "5"},
+			{Denom: "foo", Amount: "7"},
+		},
+		Duration: durationpb.New(3600 * 1e9),
+		AMessage: &testpb.AMessage{Bar: "abc", Baz: -3},
+		U64:      3,
+		Memos:    []string{"a", "b", "c"},
+	}
+	assert.DeepEqual(t, expected, broadcaster.lastMsg, protocmp.Transform())
+}
+
+func TestMsgCommandSigner(t *testing.T) {
+	_, err := testTxExec(t, &Builder{}, "send", "cosmos1recipient", "10stake")
+	assert.ErrorContains(t, err, "--from flag is required")
+
+	_, err = testTxExec(t, &Builder{}, "send", "cosmos1recipient", "10", "--from", "cosmos1sender")
+	assert.ErrorContains(t, err, "invalid coin")
+
+	_, err = testTxExec(t, &Builder{}, "send", "cosmos1recipient", "--from", "cosmos1sender")
+	assert.ErrorContains(t, err, "requires at least 2 arg(s)")
+
+	b := &Builder{
+		GetSignerAddress: func(cmd *cobra.Command) (string, error) {
+			from, err := cmd.Flags().GetString("from")
+			return "cosmos1" + from, err
+		},
+	}
+	broadcaster, err := testTxExec(t, b, "send", "cosmos1recipient", "10stake", "--from", "alice")
+	assert.NilError(t, err)
+	assert.Equal(t, "cosmos1alice", broadcaster.lastMsg.(*testpb.MsgSend).Sender)
+}
+
+func TestMsgCommandNoBroadcaster(t *testing.T) {
+	cmd := (&Builder{}).AddMsgServiceCommands(&cobra.Command{Use: "test"}, protoreflect.FullName(testpb.Msg_ServiceDesc.ServiceName), map[protoreflect.Name]MsgMethodOptions{
+		"Send": {PositionalArgs: []protoreflect.Name{"recipient", "amount"}},
+	})
+	cmd.SetArgs([]string{"send", "cosmos1recipient", "10stake", "--from", "cosmos1sender"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	assert.ErrorContains(t, cmd.Execute(), "BroadcastMsg isn't set")
+}
+
+func TestMsgCommandHelp(t *testing.T) {
+	broadcaster, err := testTxExec(t, &Builder{}, "send", "-h")
+	assert.NilError(t, err)
+	golden.Assert(t, broadcaster.out.String(), "tx_help.golden")
+}
+
+func TestMsgCommandUnsupportedField(t *testing.T) {
+	// floating point fields can't be bound to a flag
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("unsupported.proto"),
+		Package: proto.String("testpb.unsupported"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{
+				Name: proto.String("MsgSet"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					{Name: proto.String("key"), JsonName: proto.String("key"), Number: proto.Int32(1), Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+					{Name: proto.String("weight"), JsonName: proto.String("weight"), Number: proto.Int32(2), Type: descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()},
+				},
+			},
+			{Name: proto.String("MsgSetResponse")},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{
+				Name: proto.String("Msg"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Set"), InputType: proto.String(".testpb.unsupported.MsgSet"), OutputType: proto.String(".testpb.unsupported.MsgSetResponse")},
+				},
+			},
+		},
+	}, protoregistry.GlobalFiles)
+	assert.NilError(t, err)
+
+	var lastMsg proto.Message
+	b := &Builder{BroadcastMsg: func(_ *cobra.Command, msg proto.Message) error {
+		lastMsg = msg
+		return nil
+	}}
+	cmd := b.CreateMsgMethodCommand(file.Services().Get(0).Methods().Get(0), MsgMethodOptions{})
+	assert.Assert(t, cmd.Flags().Lookup("key") != nil)
+	assert.Assert(t, cmd.Flags().Lookup("weight") == nil)
+
+	cmd.SetArgs([]string{"--key", "abc"})
+	assert.NilError(t, cmd.Execute())
+
+	msg := lastMsg.ProtoReflect()
+	assert.Equal(t, "abc", msg.Get(msg.Descriptor().Fields().ByName("key")).String())
+	assert.Assert(t, !msg.Has(msg.Descriptor().Fields().ByName("weight")))
+}
@@ -160,6 +160,11 @@ func (a *App) Configurator() module.Configurator {
 	return a.configurator
 }
 
+// GetStoreKeys returns all the store keys registered with the App.
+func (a *App) GetStoreKeys() []storetypes.StoreKey {
+	return a.storeKeys
+}
+
 // UnsafeFindStoreKey fetches a registered StoreKey from the App in linear time.
 //
 // NOTE: This should only be used in testing.
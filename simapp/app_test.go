@@ -13,10 +13,13 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/tests/mocks"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
@@ -33,6 +36,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	tokenfactorymodule "github.com/cosmos/cosmos-sdk/x/tokenfactory/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -190,6 +194,7 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"tokenfactory": tokenfactorymodule.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -268,3 +273,32 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 
 	require.NotNil(t, app.UpgradeKeeper.GetVersionSetter())
 }
+
+func TestTextualSignModeRendersBankMetadata(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	app.BankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		Name:    "Atom",
+		Symbol:  "ATOM",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	})
+
+	handler, ok := app.TxConfig().SignModeHandler().(authsigning.SignModeHandlerWithContext)
+	require.True(t, ok)
+	require.Contains(t, handler.Modes(), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+
+	_, pubKey, addr := testdata.KeyTestPubAddr()
+	txBuilder := app.TxConfig().NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))))
+	signerData := authsigning.SignerData{Address: addr.String(), ChainID: "test-chain", PubKey: pubKey}
+
+	// the ante handlers render the coins with the metadata stored in the bank module
+	signBytes, err := handler.GetSignBytesWithContext(sdk.WrapSDKContext(ctx), signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.Contains(t, string(signBytes), "Amount: 1.5 atom")
+}
@@ -4,15 +4,21 @@ package params
 const (
 	DefaultWeightMsgSend                      int = 100
 	DefaultWeightMsgMultiSend                 int = 10
+	DefaultWeightMsgBatchSend                 int = 10
 	DefaultWeightMsgDeposit                   int = 100
 	DefaultWeightMsgVote                      int = 67
 	DefaultWeightMsgVoteWeighted              int = 33
+	DefaultWeightMsgCancelProposal            int = 5
 	DefaultWeightMsgCreateValidator           int = 100
 	DefaultWeightMsgEditValidator             int = 5
 	DefaultWeightMsgDelegate                  int = 100
 	DefaultWeightMsgUndelegate                int = 100
 	DefaultWeightMsgBeginRedelegate           int = 100
 	DefaultWeightMsgCancelUnbondingDelegation int = 100
+	DefaultWeightMsgTokenizeShares            int = 25
+	DefaultWeightMsgRedeemTokensForShares     int = 25
+	DefaultWeightMsgValidatorBond             int = 25
+	DefaultWeightMsgUpdateParams              int = 5
 
 	// feegrant
 	DefaultWeightGrantAllowance  int = 100
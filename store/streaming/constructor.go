@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
+	gogogrpc "github.com/gogo/protobuf/grpc"
 	"github.com/spf13/cast"
 )
 
@@ -51,8 +52,30 @@ var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
 	File: NewFileStreamingService,
 }
 
+// registeredConstructors is a mapping of the names of the streaming services registered with
+// RegisterServiceConstructor to their streaming.ServiceConstructors
+var registeredConstructors = map[string]ServiceConstructor{}
+
+// RegisterServiceConstructor registers the streaming.ServiceConstructor of a streaming service implemented
+// outside of this package, e.g. by a module, under the provided name so that it can be loaded by
+// LoadStreamingServices. It is meant to be called by the app before loading the streaming services, and
+// panics if the name is already used.
+func RegisterServiceConstructor(name string, constructor ServiceConstructor) {
+	name = strings.ToLower(name)
+	if ServiceTypeFromString(name) != Unknown {
+		panic(fmt.Sprintf("streaming service %s is already defined", name))
+	}
+	if _, ok := registeredConstructors[name]; ok {
+		panic(fmt.Sprintf("streaming service %s is already registered", name))
+	}
+	registeredConstructors[name] = constructor
+}
+
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
 func NewServiceConstructor(name string) (ServiceConstructor, error) {
+	if constructor, ok := registeredConstructors[strings.ToLower(name)]; ok {
+		return constructor, nil
+	}
 	ssType := ServiceTypeFromString(name)
 	if ssType == Unknown {
 		return nil, fmt.Errorf("unrecognized streaming service name %s", name)
@@ -70,6 +93,11 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// grpcServiceRegistrar is implemented by the streaming services exposing a gRPC query service
+type grpcServiceRegistrar interface {
+	RegisterGRPCServer(server gogogrpc.Server)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
@@ -118,6 +146,10 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 		}
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
+		// register the query service of the streaming service, if any
+		if registrar, ok := streamingService.(grpcServiceRegistrar); ok {
+			registrar.RegisterGRPCServer(bApp.GRPCQueryRouter())
+		}
 		// kick off the background streaming service loop
 		streamingService.Stream(wg)
 		// add to the list of active streamers
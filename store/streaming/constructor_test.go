@@ -49,6 +49,24 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestRegisterServiceConstructor(t *testing.T) {
+	serv, err := file.NewStreamingService("", "", mockKeys, testMarshaller)
+	require.NoError(t, err)
+	streaming.RegisterServiceConstructor("Custom", func(serverTypes.AppOptions, []types.StoreKey, codec.BinaryCodec) (baseapp.StreamingService, error) {
+		return serv, nil
+	})
+
+	constructor, err := streaming.NewServiceConstructor("custom")
+	require.NoError(t, err)
+	res, err := constructor(mockOptions, mockKeys, testMarshaller)
+	require.NoError(t, err)
+	require.Equal(t, serv, res)
+
+	// the names cannot be registered twice or shadow the services of this package
+	require.Panics(t, func() { streaming.RegisterServiceConstructor("custom", constructor) })
+	require.Panics(t, func() { streaming.RegisterServiceConstructor("file", constructor) })
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -17,7 +17,9 @@ func KVStoreReversePrefixIterator(kvs KVStore, prefix []byte) Iterator {
 }
 
 // DiffKVStores compares two KVstores and returns all the key/value pairs
-// that differ from one another. It also skips value comparison for a set of provided prefixes.
+// that differ from one another. Keys matching any of the provided prefixes are
+// skipped on each side independently, so the remaining keys are still compared
+// pairwise when the stores hold a different number of keys under a skipped prefix.
 func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []kv.Pair) {
 	iterA := a.Iterator(nil, nil)
 
@@ -28,6 +30,11 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 	defer iterB.Close()
 
 	for {
+		// Skip over prefixed keys independently on each side, so that a different
+		// number of skipped entries does not misalign the remaining keys.
+		skipPrefixedKeys(iterA, prefixesToSkip)
+		skipPrefixedKeys(iterB, prefixesToSkip)
+
 		if !iterA.Valid() && !iterB.Valid() {
 			return kvAs, kvBs
 		}
@@ -45,23 +52,29 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 			iterB.Next()
 		}
 
-		compareValue := true
-
-		for _, prefix := range prefixesToSkip {
-			// Skip value comparison if we matched a prefix
-			if bytes.HasPrefix(kvA.Key, prefix) || bytes.HasPrefix(kvB.Key, prefix) {
-				compareValue = false
-				break
-			}
-		}
-
-		if compareValue && (!bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value)) {
+		if !bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value) {
 			kvAs = append(kvAs, kvA)
 			kvBs = append(kvBs, kvB)
 		}
 	}
 }
 
+// skipPrefixedKeys advances the iterator past any keys matching one of the given prefixes.
+func skipPrefixedKeys(iter Iterator, prefixesToSkip [][]byte) {
+	for iter.Valid() && hasAnyPrefix(iter.Key(), prefixesToSkip) {
+		iter.Next()
+	}
+}
+
+func hasAnyPrefix(key []byte, prefixes [][]byte) bool {
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // PrefixEndBytes returns the []byte that would end a
 // range query for all []byte with a certain prefix
 // Deals with last byte of prefix being FF without overflowing
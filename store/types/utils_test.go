@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
 func initTestStores(t *testing.T) (sdk.KVStore, sdk.KVStore) {
@@ -71,6 +72,65 @@ func TestDiffKVStores(t *testing.T) {
 	require.Equal(t, len(kvAs), len(kvBs))
 }
 
+func TestDiffKVStoresSkippedPrefixes(t *testing.T) {
+	t.Parallel()
+	prefix := []byte("prefix:")
+	prefixed := func(k string) []byte { return append(append([]byte{}, prefix...), k...) }
+
+	testCases := []struct {
+		name    string
+		kvsA    []kv.Pair
+		kvsB    []kv.Pair
+		expKeys [][]byte
+	}{
+		{
+			"more skipped keys in the first store",
+			[]kv.Pair{{Key: prefixed("k1"), Value: []byte("v1")}, {Key: prefixed("k2"), Value: []byte("v2")}, {Key: []byte("z3"), Value: []byte("v3")}},
+			[]kv.Pair{{Key: prefixed("k1"), Value: []byte("v1")}, {Key: []byte("z3"), Value: []byte("v3")}},
+			nil,
+		},
+		{
+			"more skipped keys in the second store",
+			[]kv.Pair{{Key: []byte("z3"), Value: []byte("v3")}},
+			[]kv.Pair{{Key: prefixed("k1"), Value: []byte("v1")}, {Key: prefixed("k2"), Value: []byte("v2")}, {Key: []byte("z3"), Value: []byte("v3")}},
+			nil,
+		},
+		{
+			"trailing skipped keys in one store only",
+			[]kv.Pair{{Key: []byte("a1"), Value: []byte("v1")}},
+			[]kv.Pair{{Key: []byte("a1"), Value: []byte("v1")}, {Key: prefixed("k1"), Value: []byte("v1")}},
+			nil,
+		},
+		{
+			"mismatch after skipped keys",
+			[]kv.Pair{{Key: prefixed("k1"), Value: []byte("v1")}, {Key: prefixed("k2"), Value: []byte("v2")}, {Key: []byte("z3"), Value: []byte("v3")}},
+			[]kv.Pair{{Key: prefixed("k1"), Value: []byte("v1")}, {Key: []byte("z3"), Value: []byte("v4")}},
+			[][]byte{[]byte("z3")},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			store1, store2 := initTestStores(t)
+			for _, p := range tc.kvsA {
+				store1.Set(p.Key, p.Value)
+			}
+			for _, p := range tc.kvsB {
+				store2.Set(p.Key, p.Value)
+			}
+
+			kvAs, kvBs := sdk.DiffKVStores(store1, store2, [][]byte{prefix})
+			require.Len(t, kvAs, len(tc.expKeys))
+			require.Len(t, kvBs, len(tc.expKeys))
+			for i, key := range tc.expKeys {
+				require.Equal(t, key, kvAs[i].Key)
+				require.Equal(t, key, kvBs[i].Key)
+			}
+		})
+	}
+}
+
 func TestPrefixEndBytes(t *testing.T) {
 	t.Parallel()
 	bs1 := []byte{0x23, 0xA5, 0x06}
@@ -4,13 +4,18 @@ package sims
 const (
 	DefaultWeightMsgSend                        int = 100
 	DefaultWeightMsgMultiSend                   int = 10
+	DefaultWeightMsgBatchSend                   int = 10
 	DefaultWeightMsgSetWithdrawAddress          int = 50
 	DefaultWeightMsgWithdrawDelegationReward    int = 50
 	DefaultWeightMsgWithdrawValidatorCommission int = 50
 	DefaultWeightMsgFundCommunityPool           int = 50
+	DefaultWeightMsgWithdrawAllDelegatorRewards int = 50
+	DefaultWeightMsgSetAutoRestake              int = 20
+	DefaultWeightMsgSetWithdrawAddressWeights   int = 20
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
 	DefaultWeightMsgVoteWeighted                int = 33
+	DefaultWeightMsgCancelProposal              int = 5
 	DefaultWeightMsgUnjail                      int = 100
 	DefaultWeightMsgCreateValidator             int = 100
 	DefaultWeightMsgEditValidator               int = 5
@@ -18,6 +23,10 @@ const (
 	DefaultWeightMsgUndelegate                  int = 100
 	DefaultWeightMsgBeginRedelegate             int = 100
 	DefaultWeightMsgCancelUnbondingDelegation   int = 100
+	DefaultWeightMsgTokenizeShares              int = 25
+	DefaultWeightMsgRedeemTokensForShares       int = 25
+	DefaultWeightMsgValidatorBond               int = 25
+	DefaultWeightMsgUpdateParams                int = 5
 
 	DefaultWeightCommunitySpendProposal int = 5
 	DefaultWeightTextProposal           int = 5
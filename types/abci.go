@@ -1,6 +1,10 @@
 package types
 
-import abci "github.com/tendermint/tendermint/abci/types"
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
 
 // InitChainer initializes application state at genesis
 type InitChainer func(ctx Context, req abci.RequestInitChain) abci.ResponseInitChain
@@ -19,3 +23,61 @@ type EndBlocker func(ctx Context, req abci.RequestEndBlock) abci.ResponseEndBloc
 
 // PeerFilter responds to p2p filtering queries from Tendermint
 type PeerFilter func(info string) abci.ResponseQuery
+
+// RequestPrepareProposal is passed to the PrepareProposalHandler of the block
+// proposer before a new block is proposed. Txs contains the raw transactions
+// reaped from the consensus engine's mempool and MaxTxBytes is the upper bound
+// on the total size of the transactions that may be returned.
+type RequestPrepareProposal struct {
+	Txs             [][]byte
+	MaxTxBytes      int64
+	Height          int64
+	Time            time.Time
+	ProposerAddress []byte
+}
+
+// ResponsePrepareProposal contains the ordered list of raw transactions to be
+// included in the block proposal.
+type ResponsePrepareProposal struct {
+	Txs [][]byte
+}
+
+// RequestProcessProposal is passed to the ProcessProposalHandler of every
+// validator receiving a block proposal.
+type RequestProcessProposal struct {
+	Txs             [][]byte
+	Hash            []byte
+	Height          int64
+	Time            time.Time
+	ProposerAddress []byte
+}
+
+// ProposalStatus defines the outcome of processing a block proposal.
+type ProposalStatus int32
+
+const (
+	// ProposalStatusUnknown is the zero value of ProposalStatus.
+	ProposalStatusUnknown ProposalStatus = iota
+	// ProposalStatusAccept means the proposal is valid and can be voted on.
+	ProposalStatusAccept
+	// ProposalStatusReject means the proposal is invalid.
+	ProposalStatusReject
+)
+
+// ResponseProcessProposal contains the verdict of a ProcessProposalHandler.
+type ResponseProcessProposal struct {
+	Status ProposalStatus
+}
+
+// IsAccepted returns true if the proposal was accepted.
+func (r ResponseProcessProposal) IsAccepted() bool {
+	return r.Status == ProposalStatusAccept
+}
+
+// PrepareProposalHandler defines a function type alias for preparing a block
+// proposal.
+type PrepareProposalHandler func(ctx Context, req RequestPrepareProposal) ResponsePrepareProposal
+
+// ProcessProposalHandler defines a function type alias for processing a block
+// proposal.
+type ProcessProposalHandler func(ctx Context, req RequestProcessProposal) ResponseProcessProposal
@@ -0,0 +1,19 @@
+package mempool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Mempool = (*NoOpMempool)(nil)
+
+// NoOpMempool defines a no-op mempool. Transactions are completely discarded
+// and ignored when BaseApp interacts with the mempool.
+//
+// Note: When this mempool is used, it is assumed that an application relies
+// on Tendermint's transaction ordering, which is FIFO-ordered by default.
+type NoOpMempool struct{}
+
+func (NoOpMempool) Insert(sdk.Context, sdk.Tx) error      { return nil }
+func (NoOpMempool) Select(sdk.Context, [][]byte) Iterator { return nil }
+func (NoOpMempool) CountTx() int                          { return 0 }
+func (NoOpMempool) Remove(sdk.Tx) error                   { return nil }
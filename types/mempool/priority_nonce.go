@@ -0,0 +1,243 @@
+package mempool
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var (
+	_ Mempool  = (*PriorityNonceMempool)(nil)
+	_ Iterator = (*PriorityNonceIterator)(nil)
+)
+
+// PriorityNonceMempool is a mempool implementation that stores txs in a
+// partially ordered set by 2 dimensions: priority, and sender-nonce (sequence
+// number). Internally it keeps, for every sender, the list of its txs sorted
+// by nonce. Selection always picks, among the lowest-nonce pending tx of every
+// sender, the one with the highest priority, so that a sender's txs are never
+// returned out of sequence order.
+//
+// Ties in priority are broken deterministically by sender address, which makes
+// the iteration order a pure function of the mempool's contents.
+type PriorityNonceMempool struct {
+	senders map[string][]*txMeta
+	count   int
+	maxTx   int
+}
+
+// PriorityNonceMempoolOption defines a function that configures a
+// PriorityNonceMempool.
+type PriorityNonceMempoolOption func(*PriorityNonceMempool)
+
+// PriorityNonceWithMaxTx sets the maximum number of transactions allowed in
+// the mempool. A value <= 0 means the mempool is unbounded.
+func PriorityNonceWithMaxTx(maxTx int) PriorityNonceMempoolOption {
+	return func(mp *PriorityNonceMempool) {
+		mp.maxTx = maxTx
+	}
+}
+
+// txMeta stores the mempool metadata of a transaction.
+type txMeta struct {
+	tx       sdk.Tx
+	sender   string
+	nonce    uint64
+	priority int64
+}
+
+// NewPriorityMempool returns a PriorityNonceMempool configured with the
+// provided options.
+func NewPriorityMempool(opts ...PriorityNonceMempoolOption) *PriorityNonceMempool {
+	mp := &PriorityNonceMempool{
+		senders: make(map[string][]*txMeta),
+	}
+
+	for _, opt := range opts {
+		opt(mp)
+	}
+
+	return mp
+}
+
+// Insert attempts to insert a Tx into the mempool. The priority is read from
+// the provided context, which is expected to be the context returned by the
+// AnteHandler. If a tx with the same sender and nonce already exists, it is
+// replaced.
+func (mp *PriorityNonceMempool) Insert(ctx sdk.Context, tx sdk.Tx) error {
+	sender, nonce, err := senderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	meta := &txMeta{
+		tx:       tx,
+		sender:   sender,
+		nonce:    nonce,
+		priority: ctx.Priority(),
+	}
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+
+	// Slices are never mutated in place so that outstanding iterators keep a
+	// consistent view of the mempool.
+	if i < len(txs) && txs[i].nonce == nonce {
+		updated := make([]*txMeta, len(txs))
+		copy(updated, txs)
+		updated[i] = meta
+		mp.senders[sender] = updated
+
+		return nil
+	}
+
+	if mp.maxTx > 0 && mp.count >= mp.maxTx {
+		return ErrMempoolTxMaxCapacity
+	}
+
+	updated := make([]*txMeta, 0, len(txs)+1)
+	updated = append(updated, txs[:i]...)
+	updated = append(updated, meta)
+	updated = append(updated, txs[i:]...)
+	mp.senders[sender] = updated
+	mp.count++
+
+	return nil
+}
+
+// Select returns an iterator over the mempool txs ordered by priority while
+// respecting the nonce order of every sender. The txs argument is ignored.
+// It returns nil if the mempool is empty.
+func (mp *PriorityNonceMempool) Select(_ sdk.Context, _ [][]byte) Iterator {
+	if mp.count == 0 {
+		return nil
+	}
+
+	it := &PriorityNonceIterator{
+		senders: make(map[string][]*txMeta, len(mp.senders)),
+	}
+
+	for sender, txs := range mp.senders {
+		it.senders[sender] = txs
+		it.heads = append(it.heads, txs[0])
+	}
+
+	heap.Init(&it.heads)
+
+	return it.Next()
+}
+
+// CountTx returns the number of transactions in the mempool.
+func (mp *PriorityNonceMempool) CountTx() int {
+	return mp.count
+}
+
+// Remove removes a transaction from the mempool. It returns ErrTxNotFound if
+// the transaction's sender and nonce are not tracked by the mempool.
+func (mp *PriorityNonceMempool) Remove(tx sdk.Tx) error {
+	sender, nonce, err := senderNonce(tx)
+	if err != nil {
+		return err
+	}
+
+	txs := mp.senders[sender]
+	i := sort.Search(len(txs), func(i int) bool { return txs[i].nonce >= nonce })
+	if i == len(txs) || txs[i].nonce != nonce {
+		return ErrTxNotFound
+	}
+
+	if len(txs) == 1 {
+		delete(mp.senders, sender)
+	} else {
+		updated := make([]*txMeta, 0, len(txs)-1)
+		updated = append(updated, txs[:i]...)
+		updated = append(updated, txs[i+1:]...)
+		mp.senders[sender] = updated
+	}
+
+	mp.count--
+
+	return nil
+}
+
+// PriorityNonceIterator defines an iterator over a snapshot of a
+// PriorityNonceMempool.
+type PriorityNonceIterator struct {
+	senders map[string][]*txMeta
+	heads   txHeap
+	current *txMeta
+}
+
+// Next advances the iterator, returning nil once all txs have been visited.
+func (it *PriorityNonceIterator) Next() Iterator {
+	if it.heads.Len() == 0 {
+		return nil
+	}
+
+	it.current = heap.Pop(&it.heads).(*txMeta)
+
+	txs := it.senders[it.current.sender][1:]
+	it.senders[it.current.sender] = txs
+	if len(txs) > 0 {
+		heap.Push(&it.heads, txs[0])
+	}
+
+	return it
+}
+
+// Tx returns the transaction at the current position of the iterator.
+func (it *PriorityNonceIterator) Tx() sdk.Tx {
+	return it.current.tx
+}
+
+// txHeap is a max-heap of the lowest-nonce tx of every sender, ordered by
+// priority and then by sender address.
+type txHeap []*txMeta
+
+func (h txHeap) Len() int { return len(h) }
+
+func (h txHeap) Less(i, j int) bool {
+	if h[i].priority != h[j].priority {
+		return h[i].priority > h[j].priority
+	}
+
+	return h[i].sender < h[j].sender
+}
+
+func (h txHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txHeap) Push(x interface{}) { *h = append(*h, x.(*txMeta)) }
+
+func (h *txHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+
+	return item
+}
+
+// senderNonce returns the address of the first signer of a tx along with the
+// sequence number of its signature.
+func senderNonce(tx sdk.Tx) (string, uint64, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return "", 0, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", 0, err
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return "", 0, fmt.Errorf("tx must have at least one signer")
+	}
+
+	return signers[0].String(), sigs[0].Sequence, nil
+}
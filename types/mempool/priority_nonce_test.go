@@ -0,0 +1,202 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+var _ authsigning.SigVerifiableTx = testTx{}
+
+// testTx is a tx signed by a single account with a given sequence.
+type testTx struct {
+	id       int
+	priority int64
+	nonce    uint64
+	address  sdk.AccAddress
+}
+
+func (tx testTx) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{tx.address} }
+
+func (tx testTx) GetPubKeys() ([]cryptotypes.PubKey, error) { return nil, nil }
+
+func (tx testTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	return []signing.SignatureV2{{Sequence: tx.nonce}}, nil
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg { return nil }
+
+func (tx testTx) ValidateBasic() error { return nil }
+
+// noSigTx is a tx which does not implement SigVerifiableTx.
+type noSigTx struct{}
+
+func (noSigTx) GetMsgs() []sdk.Msg { return nil }
+
+func (noSigTx) ValidateBasic() error { return nil }
+
+func newTestCtx() sdk.Context {
+	return sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+}
+
+func selectIDs(mp mempool.Mempool) []int {
+	var ids []int
+	for it := mp.Select(newTestCtx(), nil); it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+
+	return ids
+}
+
+func TestPriorityNonceMempool_Select(t *testing.T) {
+	_, _, sa := testdata.KeyTestPubAddr()
+	_, _, sb := testdata.KeyTestPubAddr()
+	_, _, sc := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		name string
+		txs  []testTx
+		// expected order of tx ids
+		order []int
+	}{
+		{
+			name: "priority ordering across senders",
+			txs: []testTx{
+				{id: 0, priority: 5, nonce: 0, address: sa},
+				{id: 1, priority: 20, nonce: 0, address: sb},
+				{id: 2, priority: 10, nonce: 0, address: sc},
+			},
+			order: []int{1, 2, 0},
+		},
+		{
+			name: "nonce ordering within a sender",
+			txs: []testTx{
+				{id: 0, priority: 20, nonce: 2, address: sa},
+				{id: 1, priority: 10, nonce: 1, address: sa},
+				{id: 2, priority: 5, nonce: 0, address: sa},
+			},
+			order: []int{2, 1, 0},
+		},
+		{
+			name: "low priority nonce gates higher priority txs of the same sender",
+			txs: []testTx{
+				{id: 0, priority: 1, nonce: 0, address: sa},
+				{id: 1, priority: 30, nonce: 1, address: sa},
+				{id: 2, priority: 10, nonce: 0, address: sb},
+				{id: 3, priority: 5, nonce: 1, address: sb},
+			},
+			order: []int{2, 3, 0, 1},
+		},
+		{
+			name: "replacing a tx with the same sender and nonce",
+			txs: []testTx{
+				{id: 0, priority: 1, nonce: 0, address: sa},
+				{id: 1, priority: 10, nonce: 0, address: sb},
+				{id: 2, priority: 20, nonce: 0, address: sa},
+			},
+			order: []int{2, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := mempool.NewPriorityMempool()
+			for _, tx := range tc.txs {
+				require.NoError(t, mp.Insert(newTestCtx().WithPriority(tx.priority), tx))
+			}
+
+			require.Equal(t, len(tc.order), mp.CountTx())
+			require.Equal(t, tc.order, selectIDs(mp))
+		})
+	}
+}
+
+func TestPriorityNonceMempool_Deterministic(t *testing.T) {
+	accounts := make([]sdk.AccAddress, 5)
+	for i := range accounts {
+		_, _, accounts[i] = testdata.KeyTestPubAddr()
+	}
+
+	var txs []testTx
+	for i := 0; i < 50; i++ {
+		txs = append(txs, testTx{id: i, priority: int64(i % 3), nonce: uint64(i / 5), address: accounts[i%5]})
+	}
+
+	var expected []int
+	for i := 0; i < 5; i++ {
+		mp := mempool.NewPriorityMempool()
+		for _, tx := range txs {
+			require.NoError(t, mp.Insert(newTestCtx().WithPriority(tx.priority), tx))
+		}
+
+		ids := selectIDs(mp)
+		require.Len(t, ids, len(txs))
+		if expected == nil {
+			expected = ids
+		}
+		require.Equal(t, expected, ids)
+	}
+}
+
+func TestPriorityNonceMempool_Remove(t *testing.T) {
+	_, _, sa := testdata.KeyTestPubAddr()
+	_, _, sb := testdata.KeyTestPubAddr()
+
+	mp := mempool.NewPriorityMempool()
+	txs := []testTx{
+		{id: 0, priority: 10, nonce: 0, address: sa},
+		{id: 1, priority: 10, nonce: 1, address: sa},
+		{id: 2, priority: 5, nonce: 0, address: sb},
+	}
+	for _, tx := range txs {
+		require.NoError(t, mp.Insert(newTestCtx().WithPriority(tx.priority), tx))
+	}
+
+	// an outstanding iterator is not affected by removals
+	it := mp.Select(newTestCtx(), nil)
+
+	require.NoError(t, mp.Remove(txs[0]))
+	require.Equal(t, 2, mp.CountTx())
+	require.Equal(t, []int{1, 2}, selectIDs(mp))
+
+	require.ErrorIs(t, mp.Remove(txs[0]), mempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Remove(testTx{nonce: 7, address: sb}), mempool.ErrTxNotFound)
+
+	var ids []int
+	for ; it != nil; it = it.Next() {
+		ids = append(ids, it.Tx().(testTx).id)
+	}
+	require.Equal(t, []int{0, 1, 2}, ids)
+
+	require.NoError(t, mp.Remove(txs[1]))
+	require.NoError(t, mp.Remove(txs[2]))
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(newTestCtx(), nil))
+}
+
+func TestPriorityNonceMempool_MaxTx(t *testing.T) {
+	_, _, sa := testdata.KeyTestPubAddr()
+
+	mp := mempool.NewPriorityMempool(mempool.PriorityNonceWithMaxTx(1))
+	require.NoError(t, mp.Insert(newTestCtx(), testTx{id: 0, nonce: 0, address: sa}))
+	require.ErrorIs(t, mp.Insert(newTestCtx(), testTx{id: 1, nonce: 1, address: sa}), mempool.ErrMempoolTxMaxCapacity)
+
+	// replacing an existing tx does not grow the mempool
+	require.NoError(t, mp.Insert(newTestCtx(), testTx{id: 2, nonce: 0, address: sa}))
+	require.Equal(t, []int{2}, selectIDs(mp))
+}
+
+func TestPriorityNonceMempool_InvalidTx(t *testing.T) {
+	mp := mempool.NewPriorityMempool()
+	require.Error(t, mp.Insert(newTestCtx(), noSigTx{}))
+	require.Zero(t, mp.CountTx())
+}
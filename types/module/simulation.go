@@ -31,6 +31,14 @@ type AppModuleSimulation interface {
 	WeightedOperations(simState SimulationState) []simulation.WeightedOperation
 }
 
+// HasProposalMsgs defines the messages that can be used to simulate governance
+// proposals, it is implemented by the modules whose messages are executed by
+// governance, such as MsgUpdateParams.
+type HasProposalMsgs interface {
+	// msg functions used to simulate governance proposals
+	ProposalMsgs(simState SimulationState) []simulation.WeightedProposalMsg
+}
+
 // SimulationManager defines a simulation manager that provides the high level utility
 // for managing and executing simulation functionalities for a group of modules
 type SimulationManager struct {
@@ -91,6 +99,19 @@ func (sm *SimulationManager) GetProposalContents(simState SimulationState) []sim
 	return wContents
 }
 
+// GetProposalMsgs returns the proposal msg generator functions of the modules
+// implementing HasProposalMsgs with their default operation weight and key.
+func (sm *SimulationManager) GetProposalMsgs(simState SimulationState) []simulation.WeightedProposalMsg {
+	wMsgs := make([]simulation.WeightedProposalMsg, 0, len(sm.Modules))
+	for _, module := range sm.Modules {
+		if module, ok := module.(HasProposalMsgs); ok {
+			wMsgs = append(wMsgs, module.ProposalMsgs(simState)...)
+		}
+	}
+
+	return wMsgs
+}
+
 // RegisterStoreDecoders registers each of the modules' store decoders into a map
 func (sm *SimulationManager) RegisterStoreDecoders() {
 	for _, module := range sm.Modules {
@@ -142,4 +163,5 @@ type SimulationState struct {
 	UnbondTime   time.Duration                        // staking unbond time stored to use it as the slashing maximum evidence duration
 	ParamChanges []simulation.ParamChange             // simulated parameter changes from modules
 	Contents     []simulation.WeightedProposalContent // proposal content generator functions with their default weight and app sim key
+	ProposalMsgs []simulation.WeightedProposalMsg     // proposal msg generator functions with their default weight and app sim key
 }
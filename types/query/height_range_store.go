@@ -0,0 +1,62 @@
+package query
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// heightRangeStore restricts the iterators of a store keyed by big endian
+// block heights to the keys of a range of heights, so that paginated queries
+// never visit the entries out of the range. A nil end leaves the range
+// unbounded.
+type heightRangeStore struct {
+	types.KVStore
+
+	start, end []byte
+}
+
+// NewHeightRangeStore returns a store whose iterators only visit the keys
+// starting with a big endian block height between fromHeight and toHeight,
+// both inclusive, which can be passed to Paginate. No upper bound is applied
+// when toHeight is zero.
+func NewHeightRangeStore(store types.KVStore, fromHeight, toHeight int64) types.KVStore {
+	s := heightRangeStore{KVStore: store, start: heightKey(fromHeight)}
+	if toHeight > 0 {
+		s.end = heightKey(toHeight + 1)
+	}
+
+	return s
+}
+
+func (s heightRangeStore) Iterator(start, end []byte) types.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s heightRangeStore) ReverseIterator(start, end []byte) types.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// clamp restricts the given iteration bounds to the range of the store.
+func (s heightRangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if s.end != nil && (end == nil || bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+
+	return start, end
+}
+
+func heightKey(height int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(height))
+	return key
+}
@@ -19,6 +19,14 @@ type WeightedProposalContent interface {
 
 type ContentSimulatorFn func(r *rand.Rand, ctx sdk.Context, accs []Account) Content
 
+type WeightedProposalMsg interface {
+	AppParamsKey() string           // key used to retrieve the value of the weight from the simulation application params
+	DefaultWeight() int             // default weight
+	MsgSimulatorFn() MsgSimulatorFn // msg simulator function
+}
+
+type MsgSimulatorFn func(r *rand.Rand, ctx sdk.Context, accs []Account) sdk.Msg
+
 type Content interface {
 	GetTitle() string
 	GetDescription() string
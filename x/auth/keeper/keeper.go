@@ -13,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // AccountKeeperI is the interface contract that x/auth's keeper implements.
@@ -55,14 +54,17 @@ type AccountKeeperI interface {
 // AccountKeeper encodes/decodes accounts using the go-amino (binary)
 // encoding/decoding library.
 type AccountKeeper struct {
-	key           storetypes.StoreKey
-	cdc           codec.BinaryCodec
-	paramSubspace paramtypes.Subspace
-	permAddrs     map[string]types.PermissionsForAddress
+	key       storetypes.StoreKey
+	cdc       codec.BinaryCodec
+	permAddrs map[string]types.PermissionsForAddress
 
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -74,14 +76,9 @@ var _ AccountKeeperI = &AccountKeeper{}
 // and don't have to fit into any predefined structure. This auth module does not use account permissions internally, though other modules
 // may use auth.Keeper to access the accounts permissions map.
 func NewAccountKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string, bech32Prefix string,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, proto func() types.AccountI,
+	maccPerms map[string][]string, bech32Prefix string, authority string,
 ) AccountKeeper {
-	// set KeyTable if it has not already been set
-	if !paramstore.HasKeyTable() {
-		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
-	}
-
 	permAddrs := make(map[string]types.PermissionsForAddress)
 	for name, perms := range maccPerms {
 		permAddrs[name] = types.NewPermissionsForAddress(name, perms)
@@ -90,15 +87,20 @@ func NewAccountKeeper(
 	bech32Codec := newBech32Codec(bech32Prefix)
 
 	return AccountKeeper{
-		key:           key,
-		proto:         proto,
-		cdc:           cdc,
-		paramSubspace: paramstore,
-		permAddrs:     permAddrs,
-		addressCdc:    bech32Codec,
+		key:        key,
+		proto:      proto,
+		cdc:        cdc,
+		permAddrs:  permAddrs,
+		addressCdc: bech32Codec,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the x/auth module's authority.
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // Logger returns a module-specific logger.
 func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -131,8 +131,8 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	keeper := keeper.NewAccountKeeper(
-		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		cdc, app.GetKey(types.StoreKey), types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		types.NewModuleAddress("gov").String(),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -5,7 +5,9 @@ import (
 
 	v043 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/auth/migrations/v047"
 
+	"github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,13 +15,14 @@ import (
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper      AccountKeeper
-	queryServer grpc.Server
+	keeper         AccountKeeper
+	queryServer    grpc.Server
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper AccountKeeper, queryServer grpc.Server) Migrator {
-	return Migrator{keeper: keeper, queryServer: queryServer}
+func NewMigrator(keeper AccountKeeper, queryServer grpc.Server, ss exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, queryServer: queryServer, legacySubspace: ss}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
@@ -50,6 +53,14 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.key, m.keeper.cdc)
 }
 
+// Migrate3to4 migrates the x/auth module state from the consensus version 3 to
+// version 4. Specifically, it takes the parameters that are currently stored
+// and managed by the x/params modules and stores them directly into the x/auth
+// module state.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.key, m.legacySubspace, m.keeper.cdc)
+}
+
 // V45_SetAccount implements V45_SetAccount
 // set the account without map to accAddr to accNumber.
 //
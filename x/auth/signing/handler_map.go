@@ -1,6 +1,7 @@
 package signing
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
@@ -16,7 +17,7 @@ type SignModeHandlerMap struct {
 	signModeHandlers map[signing.SignMode]SignModeHandler
 }
 
-var _ SignModeHandler = SignModeHandlerMap{}
+var _ SignModeHandlerWithContext = SignModeHandlerMap{}
 
 // NewSignModeHandlerMap returns a new SignModeHandlerMap with the provided defaultMode and handlers
 func NewSignModeHandlerMap(defaultMode signing.SignMode, handlers []SignModeHandler) SignModeHandlerMap {
@@ -58,3 +59,25 @@ func (h SignModeHandlerMap) GetSignBytes(mode signing.SignMode, data SignerData,
 	}
 	return handler.GetSignBytes(mode, data, tx)
 }
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h SignModeHandlerMap) GetSignBytesWithContext(ctx context.Context, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	handler, found := h.signModeHandlers[mode]
+	if !found {
+		return nil, fmt.Errorf("can't verify sign mode %s", mode.String())
+	}
+
+	return GetSignBytesAdapter(ctx, handler, mode, data, tx)
+}
+
+// GetSignBytesAdapter returns the sign bytes for a given transaction and sign
+// mode. It accepts the arguments expected by SignModeHandlerWithContext, and
+// calls GetSignBytesWithContext if the handler implements it, falling back to
+// GetSignBytes otherwise.
+func GetSignBytesAdapter(ctx context.Context, handler SignModeHandler, mode signing.SignMode, data SignerData, tx sdk.Tx) ([]byte, error) {
+	if handlerWithContext, ok := handler.(SignModeHandlerWithContext); ok {
+		return handlerWithContext.GetSignBytesWithContext(ctx, mode, data, tx)
+	}
+
+	return handler.GetSignBytes(mode, data, tx)
+}
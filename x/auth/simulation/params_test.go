@@ -1,37 +0,0 @@
-package simulation_test
-
-import (
-	"math/rand"
-	"testing"
-
-	"github.com/stretchr/testify/require"
-
-	"github.com/cosmos/cosmos-sdk/x/auth/simulation"
-)
-
-func TestParamChanges(t *testing.T) {
-	s := rand.NewSource(1)
-	r := rand.New(s)
-
-	expected := []struct {
-		composedKey string
-		key         string
-		simValue    string
-		subspace    string
-	}{
-		{"auth/MaxMemoCharacters", "MaxMemoCharacters", "\"181\"", "auth"},
-		{"auth/TxSigLimit", "TxSigLimit", "\"7\"", "auth"},
-		{"auth/TxSizeCostPerByte", "TxSizeCostPerByte", "\"12\"", "auth"},
-	}
-
-	paramChanges := simulation.ParamChanges(r)
-
-	require.Len(t, paramChanges, 3)
-
-	for i, p := range paramChanges {
-		require.Equal(t, expected[i].composedKey, p.ComposedKey())
-		require.Equal(t, expected[i].key, p.Key())
-		require.Equal(t, expected[i].simValue, p.SimValue()(r))
-		require.Equal(t, expected[i].subspace, p.Subspace())
-	}
-}
@@ -0,0 +1,38 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+const OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(ak keeper.AccountKeeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(ak),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams with random auth params.
+func SimulateMsgUpdateParams(ak keeper.AccountKeeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := types.NewParams(
+			GenMaxMemoChars(r), GenTxSigLimit(r), GenTxSizeCostPerByte(r),
+			GenSigVerifyCostED25519(r), GenSigVerifyCostSECP256K1(r),
+		)
+
+		return &types.MsgUpdateParams{Authority: ak.GetAuthority(), Params: params}
+	}
+}
@@ -0,0 +1,76 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/valuerenderer"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankKeeper defines the bank keeper method used by SIGN_MODE_TEXTUAL to
+// query coin metadata.
+type BankKeeper interface {
+	DenomMetadata(context.Context, *banktypes.QueryDenomMetadataRequest) (*banktypes.QueryDenomMetadataResponse, error)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a coin metadata query function for
+// SIGN_MODE_TEXTUAL reading the metadata from the bank keeper. It must be
+// called with a context wrapping an sdk.Context, as the ante handlers do, and
+// returns an error otherwise.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) valuerenderer.CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		if _, ok := ctx.Value(sdk.SdkContextKey).(sdk.Context); !ok {
+			return nil, fmt.Errorf("no sdk.Context to query the metadata of %s from the bank keeper", denom)
+		}
+
+		res, err := bk.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		return toMetadataV2(res, err)
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a coin metadata query function for
+// SIGN_MODE_TEXTUAL querying the metadata from the bank module of a node, e.g.
+// through a client.Context.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) valuerenderer.CoinMetadataQueryFn {
+	queryClient := banktypes.NewQueryClient(conn)
+	return func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		res, err := queryClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		return toMetadataV2(res, err)
+	}
+}
+
+// toMetadataV2 converts the response of a denom metadata query to the metadata
+// type used by SIGN_MODE_TEXTUAL. A denom without metadata has nil metadata,
+// its coins are rendered in the base denom.
+func toMetadataV2(res *banktypes.QueryDenomMetadataResponse, err error) (*bankv1beta1.Metadata, error) {
+	// the status code of the bank query is lost when it is run through ABCI
+	if status.Code(err) == codes.NotFound || errors.Is(err, sdkerrors.ErrKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := res.Metadata.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &bankv1beta1.Metadata{}
+	if err := proto.Unmarshal(bz, metadata); err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/valuerenderer"
 )
 
 type config struct {
@@ -26,7 +27,23 @@ type config struct {
 // NOTE: Use NewTxConfigWithHandler to provide a custom signing handler in case the sign mode
 // is not supported by default (eg: SignMode_SIGN_MODE_EIP_191).
 func NewTxConfig(protoCodec codec.ProtoCodecMarshaler, enabledSignModes []signingtypes.SignMode) client.TxConfig {
-	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(enabledSignModes))
+	return NewTxConfigWithOptions(protoCodec, ConfigOptions{EnabledSignModes: enabledSignModes})
+}
+
+// ConfigOptions defines the options used to build a protobuf TxConfig.
+type ConfigOptions struct {
+	// EnabledSignModes are the sign modes supported by the TxConfig. The
+	// first enabled sign mode will become the default sign mode.
+	EnabledSignModes []signingtypes.SignMode
+
+	// TextualCoinMetadataQueryFn is the function used by SIGN_MODE_TEXTUAL to
+	// query coin metadata. It is required if SIGN_MODE_TEXTUAL is enabled.
+	TextualCoinMetadataQueryFn valuerenderer.CoinMetadataQueryFn
+}
+
+// NewTxConfigWithOptions returns a new protobuf TxConfig using the provided ProtoCodec and options.
+func NewTxConfigWithOptions(protoCodec codec.ProtoCodecMarshaler, opts ConfigOptions) client.TxConfig {
+	return NewTxConfigWithHandler(protoCodec, makeSignModeHandler(opts.EnabledSignModes, opts.TextualCoinMetadataQueryFn))
 }
 
 // NewTxConfig returns a new protobuf TxConfig using the provided ProtoCodec and signing handler.
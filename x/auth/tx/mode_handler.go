@@ -5,6 +5,7 @@ import (
 
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/valuerenderer"
 )
 
 // DefaultSignModes are the default sign modes enabled for protobuf transactions.
@@ -15,8 +16,10 @@ var DefaultSignModes = []signingtypes.SignMode{
 }
 
 // makeSignModeHandler returns the default protobuf SignModeHandler supporting
-// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX and SIGN_MODE_LEGACY_AMINO_JSON.
-func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler {
+// SIGN_MODE_DIRECT, SIGN_MODE_DIRECT_AUX, SIGN_MODE_LEGACY_AMINO_JSON and
+// SIGN_MODE_TEXTUAL. SIGN_MODE_TEXTUAL can only be enabled if a coin metadata
+// query function is provided.
+func makeSignModeHandler(modes []signingtypes.SignMode, coinMetadataQueryFn valuerenderer.CoinMetadataQueryFn) signing.SignModeHandler {
 	if len(modes) < 1 {
 		panic(fmt.Errorf("no sign modes enabled"))
 	}
@@ -31,6 +34,11 @@ func makeSignModeHandler(modes []signingtypes.SignMode) signing.SignModeHandler
 			handlers[i] = signModeLegacyAminoJSONHandler{}
 		case signingtypes.SignMode_SIGN_MODE_DIRECT_AUX:
 			handlers[i] = signModeDirectAuxHandler{}
+		case signingtypes.SignMode_SIGN_MODE_TEXTUAL:
+			if coinMetadataQueryFn == nil {
+				panic(fmt.Errorf("cannot enable %s without a coin metadata query function", mode))
+			}
+			handlers[i] = signModeTextualHandler{t: valuerenderer.NewTextual(coinMetadataQueryFn)}
 		default:
 			panic(fmt.Errorf("unsupported sign mode %+v", mode))
 		}
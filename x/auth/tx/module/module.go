@@ -11,6 +11,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/depinject"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
@@ -43,7 +44,18 @@ type txOutputs struct {
 }
 
 func provideModule(in txInputs) txOutputs {
-	txConfig := tx.NewTxConfig(in.ProtoCodecMarshaler, tx.DefaultSignModes)
+	txConfigOpts := tx.ConfigOptions{EnabledSignModes: tx.DefaultSignModes}
+
+	// SIGN_MODE_TEXTUAL renders coins with the denom metadata of the bank module
+	if bk, ok := in.BankKeeper.(tx.BankKeeper); ok {
+		txConfigOpts.EnabledSignModes = append(
+			append([]signingtypes.SignMode{}, tx.DefaultSignModes...),
+			signingtypes.SignMode_SIGN_MODE_TEXTUAL,
+		)
+		txConfigOpts.TextualCoinMetadataQueryFn = tx.NewBankKeeperCoinMetadataQueryFn(bk)
+	}
+
+	txConfig := tx.NewTxConfigWithOptions(in.ProtoCodecMarshaler, txConfigOpts)
 
 	baseAppOption := func(app *baseapp.BaseApp) {
 
@@ -80,8 +92,9 @@ func provideModule(in txInputs) txOutputs {
 			app.SetPostHandler(postHandler)
 		}
 
-		// TxDecoder
+		// TxDecoder/TxEncoder
 		app.SetTxDecoder(txConfig.TxDecoder())
+		app.SetTxEncoder(txConfig.TxEncoder())
 	}
 
 	return txOutputs{TxConfig: txConfig, BaseAppOption: baseAppOption}
@@ -0,0 +1,188 @@
+package tx
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/valuerenderer"
+)
+
+// signModeTextualHandler defines the SIGN_MODE_TEXTUAL SignModeHandler.
+// It renders the transaction into a list of human-readable screens, and signs
+// over the CBOR encoding of these screens.
+type signModeTextualHandler struct {
+	t valuerenderer.Textual
+}
+
+var _ signing.SignModeHandlerWithContext = signModeTextualHandler{}
+
+// NewSignModeTextualHandler returns a SIGN_MODE_TEXTUAL SignModeHandler using
+// the provided Textual value renderers.
+func NewSignModeTextualHandler(t valuerenderer.Textual) signing.SignModeHandlerWithContext {
+	return signModeTextualHandler{t: t}
+}
+
+// DefaultMode implements SignModeHandler.DefaultMode
+func (signModeTextualHandler) DefaultMode() signingtypes.SignMode {
+	return signingtypes.SignMode_SIGN_MODE_TEXTUAL
+}
+
+// Modes implements SignModeHandler.Modes
+func (signModeTextualHandler) Modes() []signingtypes.SignMode {
+	return []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+}
+
+// GetSignBytes implements SignModeHandler.GetSignBytes. Textual rendering
+// queries the coin metadata with the context of the caller, so it always
+// returns an error: callers must use GetSignBytesWithContext instead.
+func (h signModeTextualHandler) GetSignBytes(mode signingtypes.SignMode, _ signing.SignerData, _ sdk.Tx) ([]byte, error) {
+	return nil, fmt.Errorf("%s requires a context to query the coin metadata, use GetSignBytesWithContext", mode)
+}
+
+// GetSignBytesWithContext implements SignModeHandlerWithContext.GetSignBytesWithContext
+func (h signModeTextualHandler) GetSignBytesWithContext(ctx context.Context, mode signingtypes.SignMode, data signing.SignerData, tx sdk.Tx) ([]byte, error) {
+	if mode != signingtypes.SignMode_SIGN_MODE_TEXTUAL {
+		return nil, fmt.Errorf("expected %s, got %s", signingtypes.SignMode_SIGN_MODE_TEXTUAL, mode)
+	}
+
+	protoTx, ok := tx.(*wrapper)
+	if !ok {
+		return nil, fmt.Errorf("can only handle a protobuf Tx, got %T", tx)
+	}
+
+	screens, err := h.textualScreens(ctx, protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return valuerenderer.EncodeScreens(screens)
+}
+
+// textualScreens renders the transaction envelope, i.e. the signer data, the
+// body and the auth info, into a list of screens.
+func (h signModeTextualHandler) textualScreens(ctx context.Context, bodyBz, authInfoBz []byte, data signing.SignerData) ([]valuerenderer.Screen, error) {
+	body := &txv1beta1.TxBody{}
+	if err := proto.Unmarshal(bodyBz, body); err != nil {
+		return nil, err
+	}
+
+	authInfo := &txv1beta1.AuthInfo{}
+	if err := proto.Unmarshal(authInfoBz, authInfo); err != nil {
+		return nil, err
+	}
+
+	screens := []valuerenderer.Screen{
+		{Text: fmt.Sprintf("Chain ID: %s", data.ChainID)},
+		{Text: fmt.Sprintf("Account number: %d", data.AccountNumber)},
+		{Text: fmt.Sprintf("Sequence: %d", data.Sequence)},
+		{Text: fmt.Sprintf("Address: %s", data.Address)},
+	}
+
+	if data.PubKey != nil {
+		screens = append(screens, valuerenderer.Screen{
+			Text:   fmt.Sprintf("Public key: /%s", gogoproto.MessageName(data.PubKey)),
+			Expert: true,
+		}, valuerenderer.Screen{
+			Text:   fmt.Sprintf("Key: %s", strings.ToUpper(hex.EncodeToString(data.PubKey.Bytes()))),
+			Indent: 1,
+			Expert: true,
+		})
+	}
+
+	msgScreens, err := h.messagesScreens(ctx, body.Messages)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, msgScreens...)
+
+	if body.Memo != "" {
+		screens = append(screens, valuerenderer.Screen{Text: fmt.Sprintf("Memo: %s", body.Memo)})
+	}
+
+	if fee := authInfo.Fee; fee != nil {
+		feeScreens, err := h.feeScreens(ctx, fee)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, feeScreens...)
+	}
+
+	if body.TimeoutHeight > 0 {
+		screens = append(screens, valuerenderer.Screen{
+			Text:   fmt.Sprintf("Timeout height: %d", body.TimeoutHeight),
+			Expert: true,
+		})
+	}
+
+	// The hash of the raw bytes ensures that the signature commits to every
+	// field of the transaction, including those which are not rendered.
+	hash := sha256.Sum256(append(append([]byte{}, bodyBz...), authInfoBz...))
+	screens = append(screens,
+		valuerenderer.Screen{Text: fmt.Sprintf("Hash of raw bytes: %X", hash), Expert: true},
+		valuerenderer.Screen{Text: "End of transaction"},
+	)
+
+	return screens, nil
+}
+
+func (h signModeTextualHandler) messagesScreens(ctx context.Context, msgs []*anypb.Any) ([]valuerenderer.Screen, error) {
+	anyRenderer, err := h.t.GetMessageValueRenderer((&anypb.Any{}).ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := "s"
+	if len(msgs) == 1 {
+		suffix = ""
+	}
+
+	screens := []valuerenderer.Screen{{Text: fmt.Sprintf("This transaction has %d message%s", len(msgs), suffix)}}
+	for i, msg := range msgs {
+		subscreens, err := anyRenderer.Format(ctx, protoreflect.ValueOfMessage(msg.ProtoReflect()))
+		if err != nil {
+			return nil, err
+		}
+
+		subscreens[0].Text = fmt.Sprintf("Message (%d/%d): %s", i+1, len(msgs), subscreens[0].Text)
+		screens = append(screens, subscreens...)
+	}
+
+	return append(screens, valuerenderer.Screen{Text: "End of Message"}), nil
+}
+
+func (h signModeTextualHandler) feeScreens(ctx context.Context, fee *txv1beta1.Fee) ([]valuerenderer.Screen, error) {
+	amountField := fee.ProtoReflect().Descriptor().Fields().ByName("amount")
+	vr, err := h.t.GetValueRenderer(amountField)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := vr.Format(ctx, fee.ProtoReflect().Get(amountField))
+	if err != nil {
+		return nil, err
+	}
+
+	screens := []valuerenderer.Screen{{Text: fmt.Sprintf("Fees: %s", amount[0].Text)}}
+
+	if fee.Payer != "" {
+		screens = append(screens, valuerenderer.Screen{Text: fmt.Sprintf("Fee payer: %s", fee.Payer), Expert: true})
+	}
+	if fee.Granter != "" {
+		screens = append(screens, valuerenderer.Screen{Text: fmt.Sprintf("Fee granter: %s", fee.Granter), Expert: true})
+	}
+
+	return append(screens, valuerenderer.Screen{Text: fmt.Sprintf("Gas limit: %d", fee.GasLimit), Expert: true}), nil
+}
@@ -0,0 +1,88 @@
+package valuerenderer
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// CBOR major types, see RFC 8949 section 3.1.
+const (
+	cborMajorUint   byte = 0
+	cborMajorString byte = 3
+	cborMajorArray  byte = 4
+	cborMajorMap    byte = 5
+	cborSimpleTrue  byte = 0xf5
+)
+
+// Keys of the CBOR map encoding a Screen.
+const (
+	screenTextKey   = 1
+	screenIndentKey = 2
+	screenExpertKey = 3
+)
+
+// EncodeScreens encodes a list of screens in deterministic CBOR. Each screen
+// is encoded as a map with the integer keys 1 (text), 2 (indent) and 3
+// (expert), where keys holding their default value are omitted. The result
+// is the payload signed in SIGN_MODE_TEXTUAL.
+func EncodeScreens(screens []Screen) ([]byte, error) {
+	var buf bytes.Buffer
+	writeHead(&buf, cborMajorArray, uint64(len(screens)))
+
+	for _, s := range screens {
+		if s.Indent < 0 {
+			return nil, fmt.Errorf("invalid negative indent %d", s.Indent)
+		}
+
+		n := uint64(0)
+		if s.Text != "" {
+			n++
+		}
+		if s.Indent > 0 {
+			n++
+		}
+		if s.Expert {
+			n++
+		}
+
+		writeHead(&buf, cborMajorMap, n)
+		if s.Text != "" {
+			writeHead(&buf, cborMajorUint, screenTextKey)
+			writeHead(&buf, cborMajorString, uint64(len(s.Text)))
+			buf.WriteString(s.Text)
+		}
+		if s.Indent > 0 {
+			writeHead(&buf, cborMajorUint, screenIndentKey)
+			writeHead(&buf, cborMajorUint, uint64(s.Indent))
+		}
+		if s.Expert {
+			writeHead(&buf, cborMajorUint, screenExpertKey)
+			buf.WriteByte(cborSimpleTrue)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeHead writes the shortest head for the given major type and argument.
+func writeHead(buf *bytes.Buffer, major byte, arg uint64) {
+	major <<= 5
+
+	switch {
+	case arg < 24:
+		buf.WriteByte(major | byte(arg))
+	case arg <= 0xff:
+		buf.WriteByte(major | 24)
+		buf.WriteByte(byte(arg))
+	case arg <= 0xffff:
+		buf.WriteByte(major | 25)
+		_ = binary.Write(buf, binary.BigEndian, uint16(arg))
+	case arg <= 0xffffffff:
+		buf.WriteByte(major | 26)
+		_ = binary.Write(buf, binary.BigEndian, uint32(arg))
+	default:
+		buf.WriteByte(major | 27)
+		_ = binary.Write(buf, binary.BigEndian, arg)
+	}
+}
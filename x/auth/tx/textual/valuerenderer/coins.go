@@ -0,0 +1,185 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+)
+
+type coinValueRenderer struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+}
+
+var _ ValueRenderer = coinValueRenderer{}
+
+func newCoinValueRenderer(q CoinMetadataQueryFn) ValueRenderer {
+	return coinValueRenderer{coinMetadataQuerier: q}
+}
+
+// Format renders a single coin in its display denom, e.g. `1.5 atom`.
+func (vr coinValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	coin, ok := v.Message().Interface().(*basev1beta1.Coin)
+	if !ok {
+		return nil, fmt.Errorf("expected Coin, got %T", v.Message().Interface())
+	}
+
+	metadata, err := queryMetadata(ctx, vr.coinMetadataQuerier, coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	formatted, err := formatCoin(coin, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatted}}, nil
+}
+
+type coinsValueRenderer struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+}
+
+var _ ValueRenderer = coinsValueRenderer{}
+
+func newCoinsValueRenderer(q CoinMetadataQueryFn) ValueRenderer {
+	return coinsValueRenderer{coinMetadataQuerier: q}
+}
+
+// Format renders a list of coins on a single screen, as a comma-separated
+// list sorted by display denom, e.g. `1.5 atom, 2 regen`.
+func (vr coinsValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	list := v.List()
+	if list.Len() == 0 {
+		return []Screen{{Text: "zero"}}, nil
+	}
+
+	type displayCoin struct {
+		denom string
+		text  string
+	}
+
+	coins := make([]displayCoin, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		coin, ok := list.Get(i).Message().Interface().(*basev1beta1.Coin)
+		if !ok {
+			return nil, fmt.Errorf("expected Coin, got %T", list.Get(i).Message().Interface())
+		}
+
+		metadata, err := queryMetadata(ctx, vr.coinMetadataQuerier, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		formatted, err := formatCoin(coin, metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		denom := coin.Denom
+		if metadata != nil && metadata.Display != "" {
+			denom = metadata.Display
+		}
+		coins[i] = displayCoin{denom: denom, text: formatted}
+	}
+
+	sort.SliceStable(coins, func(i, j int) bool { return coins[i].denom < coins[j].denom })
+
+	texts := make([]string, len(coins))
+	for i, c := range coins {
+		texts[i] = c.text
+	}
+
+	return []Screen{{Text: strings.Join(texts, ", ")}}, nil
+}
+
+func queryMetadata(ctx context.Context, q CoinMetadataQueryFn, denom string) (*bankv1beta1.Metadata, error) {
+	if q == nil {
+		return nil, nil
+	}
+
+	return q(ctx, denom)
+}
+
+// formatCoin formats a coin using the display unit of its metadata. If the
+// metadata is nil or does not define the display unit, the coin is formatted
+// in its base denom.
+func formatCoin(coin *basev1beta1.Coin, metadata *bankv1beta1.Metadata) (string, error) {
+	if coin == nil {
+		return "", fmt.Errorf("got nil coin")
+	}
+
+	if metadata == nil || metadata.Display == "" || metadata.Display == coin.Denom {
+		amount, err := formatDecimal(coin.Amount)
+		if err != nil {
+			return "", err
+		}
+
+		return amount + " " + coin.Denom, nil
+	}
+
+	var coinExp, displayExp uint32
+	var foundCoin, foundDisplay bool
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == coin.Denom {
+			coinExp, foundCoin = unit.Exponent, true
+		}
+		if unit.Denom == metadata.Display {
+			displayExp, foundDisplay = unit.Exponent, true
+		}
+	}
+
+	// Fall back to the coin's own denom if the units cannot be converted.
+	if !foundCoin || !foundDisplay {
+		amount, err := formatDecimal(coin.Amount)
+		if err != nil {
+			return "", err
+		}
+
+		return amount + " " + coin.Denom, nil
+	}
+
+	amount, err := shiftDecimal(coin.Amount, int(coinExp)-int(displayExp))
+	if err != nil {
+		return "", err
+	}
+
+	amount, err = formatDecimal(amount)
+	if err != nil {
+		return "", err
+	}
+
+	return amount + " " + metadata.Display, nil
+}
+
+// shiftDecimal multiplies the non-negative decimal string v by 10^exp. It
+// operates on strings to avoid any loss of precision.
+func shiftDecimal(v string, exp int) (string, error) {
+	parts := strings.Split(v, ".")
+	if len(parts) > 2 || parts[0] == "" || strings.TrimLeft(v, "0123456789.") != "" {
+		return "", fmt.Errorf("invalid amount %q", v)
+	}
+
+	integral, fractional := parts[0], ""
+	if len(parts) == 2 {
+		fractional = parts[1]
+	}
+
+	digits := integral + fractional
+	point := len(integral) + exp
+
+	switch {
+	case point <= 0:
+		return "0." + strings.Repeat("0", -point) + digits, nil
+	case point >= len(digits):
+		return digits + strings.Repeat("0", point-len(digits)), nil
+	default:
+		return digits[:point] + "." + digits[point:], nil
+	}
+}
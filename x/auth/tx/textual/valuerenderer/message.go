@@ -0,0 +1,166 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+type messageValueRenderer struct {
+	tr Textual
+	md protoreflect.MessageDescriptor
+}
+
+var _ ValueRenderer = messageValueRenderer{}
+
+func newMessageValueRenderer(t Textual, md protoreflect.MessageDescriptor) ValueRenderer {
+	return messageValueRenderer{tr: t, md: md}
+}
+
+// Format renders a message as a header screen `<Name> object`, followed by
+// one or more screens for each of its set fields, in field number order.
+// Nested screens are indented by one level.
+func (mr messageValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	msg := v.Message()
+	if msg.Descriptor().FullName() != mr.md.FullName() {
+		return nil, fmt.Errorf("expected %s, got %s", mr.md.FullName(), msg.Descriptor().FullName())
+	}
+
+	screens := []Screen{{Text: fmt.Sprintf("%s object", mr.md.Name())}}
+
+	fields := mr.md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if !msg.Has(fd) {
+			continue
+		}
+
+		subscreens, err := mr.formatField(ctx, fd, msg.Get(fd))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range subscreens {
+			s.Indent++
+			screens = append(screens, s)
+		}
+	}
+
+	return screens, nil
+}
+
+func (mr messageValueRenderer) formatField(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value) ([]Screen, error) {
+	vr, err := mr.tr.GetValueRenderer(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	title := toSentenceCase(string(fd.Name()))
+
+	if fd.IsList() {
+		if _, isCoins := vr.(coinsValueRenderer); !isCoins {
+			return formatList(ctx, vr, fd, title, v.List())
+		}
+	}
+
+	subscreens, err := vr.Format(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+	if len(subscreens) == 0 {
+		return nil, fmt.Errorf("empty rendering for field %s", fd.FullName())
+	}
+
+	return titled(title, subscreens), nil
+}
+
+// formatList renders a repeated field as a header screen with the number of
+// elements, followed by each element prefixed by its position, and a footer.
+func formatList(ctx context.Context, vr ValueRenderer, fd protoreflect.FieldDescriptor, title string, list protoreflect.List) ([]Screen, error) {
+	elemType := fd.Kind().String()
+	if fd.Kind() == protoreflect.MessageKind {
+		elemType = string(fd.Message().Name())
+	}
+
+	screens := []Screen{{Text: fmt.Sprintf("%s: %d %s", title, list.Len(), elemType)}}
+	for i := 0; i < list.Len(); i++ {
+		subscreens, err := vr.Format(ctx, list.Get(i))
+		if err != nil {
+			return nil, err
+		}
+		if len(subscreens) == 0 {
+			return nil, fmt.Errorf("empty rendering for element %d of field %s", i, fd.FullName())
+		}
+
+		screens = append(screens, titled(fmt.Sprintf("%s (%d/%d)", title, i+1, list.Len()), subscreens)...)
+	}
+
+	return append(screens, Screen{Text: fmt.Sprintf("End of %s", title)}), nil
+}
+
+// titled prefixes the first screen with the given title.
+func titled(title string, screens []Screen) []Screen {
+	res := make([]Screen, len(screens))
+	copy(res, screens)
+	res[0].Text = fmt.Sprintf("%s: %s", title, res[0].Text)
+
+	return res
+}
+
+// toSentenceCase converts a proto field name to a human readable title, e.g.
+// `from_address` to `From address`.
+func toSentenceCase(name string) string {
+	name = strings.ReplaceAll(name, "_", " ")
+	if name == "" {
+		return name
+	}
+
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+type anyValueRenderer struct {
+	tr Textual
+}
+
+var _ ValueRenderer = anyValueRenderer{}
+
+// Format renders an Any as its type URL, followed by the screens of the
+// packed message indented by one level.
+func (ar anyValueRenderer) Format(ctx context.Context, v protoreflect.Value) ([]Screen, error) {
+	anyMsg, ok := v.Message().Interface().(*anypb.Any)
+	if !ok {
+		return nil, fmt.Errorf("expected Any, got %T", v.Message().Interface())
+	}
+
+	typ, err := ar.tr.typeResolver.FindMessageByURL(anyMsg.TypeUrl)
+	if err != nil {
+		return nil, fmt.Errorf("cannot resolve type %s: %w", anyMsg.TypeUrl, err)
+	}
+
+	internal := typ.New().Interface()
+	if err := proto.Unmarshal(anyMsg.Value, internal); err != nil {
+		return nil, err
+	}
+
+	vr, err := ar.tr.GetMessageValueRenderer(internal.ProtoReflect().Descriptor())
+	if err != nil {
+		return nil, err
+	}
+
+	subscreens, err := vr.Format(ctx, protoreflect.ValueOfMessage(internal.ProtoReflect()))
+	if err != nil {
+		return nil, err
+	}
+
+	screens := []Screen{{Text: anyMsg.TypeUrl}}
+	for _, s := range subscreens {
+		s.Indent++
+		screens = append(screens, s)
+	}
+
+	return screens, nil
+}
@@ -0,0 +1,150 @@
+package valuerenderer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const thousandSeparator = "'"
+
+type intValueRenderer struct{}
+
+var _ ValueRenderer = intValueRenderer{}
+
+// Format renders an integer, or the string representation of a cosmos.Int,
+// with thousand separators, e.g. `1'000'000`.
+func (vr intValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	formatted, err := formatInteger(v.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatted}}, nil
+}
+
+type decValueRenderer struct{}
+
+var _ ValueRenderer = decValueRenderer{}
+
+// Format renders the string representation of a cosmos.Dec with thousand
+// separators and without trailing zeros, e.g. `1'000.5`.
+func (vr decValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	formatted, err := formatDecimal(v.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatted}}, nil
+}
+
+type stringValueRenderer struct{}
+
+var _ ValueRenderer = stringValueRenderer{}
+
+// Format renders a string as is.
+func (vr stringValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: v.String()}}, nil
+}
+
+type bytesValueRenderer struct{}
+
+var _ ValueRenderer = bytesValueRenderer{}
+
+// Format renders bytes as an uppercase hex string.
+func (vr bytesValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	return []Screen{{Text: strings.ToUpper(hex.EncodeToString(v.Bytes()))}}, nil
+}
+
+type boolValueRenderer struct{}
+
+var _ ValueRenderer = boolValueRenderer{}
+
+// Format renders a bool as `True` or `False`.
+func (vr boolValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	if v.Bool() {
+		return []Screen{{Text: "True"}}, nil
+	}
+
+	return []Screen{{Text: "False"}}, nil
+}
+
+type enumValueRenderer struct {
+	ed protoreflect.EnumDescriptor
+}
+
+var _ ValueRenderer = enumValueRenderer{}
+
+// Format renders an enum using the name of its value.
+func (vr enumValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	evd := vr.ed.Values().ByNumber(v.Enum())
+	if evd == nil {
+		return nil, fmt.Errorf("cannot find value %d of enum %s", v.Enum(), vr.ed.FullName())
+	}
+
+	return []Screen{{Text: string(evd.Name())}}, nil
+}
+
+// formatInteger formats an integer string by adding thousand separators.
+func formatInteger(v string) (string, error) {
+	sign := ""
+	if strings.HasPrefix(v, "-") {
+		sign, v = "-", v[1:]
+	}
+
+	if len(v) == 0 || strings.TrimLeft(v, "0123456789") != "" {
+		return "", fmt.Errorf("invalid integer %q", sign+v)
+	}
+
+	v = strings.TrimLeft(v, "0")
+	if v == "" {
+		return "0", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sign)
+	for i, c := range v {
+		if i > 0 && (len(v)-i)%3 == 0 {
+			sb.WriteString(thousandSeparator)
+		}
+		sb.WriteRune(c)
+	}
+
+	return sb.String(), nil
+}
+
+// formatDecimal formats a decimal string by adding thousand separators to its
+// integral part and removing the trailing zeros of its fractional part.
+func formatDecimal(v string) (string, error) {
+	parts := strings.Split(v, ".")
+	if len(parts) > 2 {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	integral, err := formatInteger(parts[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	if len(parts) == 1 {
+		return integral, nil
+	}
+
+	if strings.TrimLeft(parts[1], "0123456789") != "" {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	fractional := strings.TrimRight(parts[1], "0")
+	if fractional == "" {
+		if integral == "-0" {
+			return "0", nil
+		}
+
+		return integral, nil
+	}
+
+	return integral + "." + fractional, nil
+}
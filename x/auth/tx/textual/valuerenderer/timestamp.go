@@ -0,0 +1,86 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type timestampValueRenderer struct{}
+
+var _ ValueRenderer = timestampValueRenderer{}
+
+// Format renders a timestamp in RFC 3339 format, in UTC.
+func (vr timestampValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	ts, ok := v.Message().Interface().(*timestamppb.Timestamp)
+	if !ok {
+		return nil, fmt.Errorf("expected Timestamp, got %T", v.Message().Interface())
+	}
+
+	if err := ts.CheckValid(); err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: ts.AsTime().UTC().Format(time.RFC3339Nano)}}, nil
+}
+
+type durationValueRenderer struct{}
+
+var _ ValueRenderer = durationValueRenderer{}
+
+// Format renders a duration as a list of its components, e.g.
+// `1 day, 2 hours, 0 minutes, 3.5 seconds`.
+func (vr durationValueRenderer) Format(_ context.Context, v protoreflect.Value) ([]Screen, error) {
+	d, ok := v.Message().Interface().(*durationpb.Duration)
+	if !ok {
+		return nil, fmt.Errorf("expected Duration, got %T", v.Message().Interface())
+	}
+
+	if err := d.CheckValid(); err != nil {
+		return nil, err
+	}
+
+	return []Screen{{Text: formatDuration(d.Seconds, d.Nanos)}}, nil
+}
+
+func formatDuration(seconds int64, nanos int32) string {
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign, seconds, nanos = "-", -seconds, -nanos
+	}
+
+	days := seconds / 86400
+	hours := seconds % 86400 / 3600
+	minutes := seconds % 3600 / 60
+	secs := fmt.Sprintf("%d", seconds%60)
+	if nanos > 0 {
+		secs += strings.TrimRight(fmt.Sprintf(".%09d", nanos), "0")
+	}
+
+	var parts []string
+	if days > 0 {
+		parts = append(parts, pluralize(fmt.Sprintf("%d", days), "day"))
+	}
+	if days > 0 || hours > 0 {
+		parts = append(parts, pluralize(fmt.Sprintf("%d", hours), "hour"))
+	}
+	if days > 0 || hours > 0 || minutes > 0 {
+		parts = append(parts, pluralize(fmt.Sprintf("%d", minutes), "minute"))
+	}
+	parts = append(parts, pluralize(secs, "second"))
+
+	return sign + strings.Join(parts, ", ")
+}
+
+func pluralize(amount, unit string) string {
+	if amount == "1" {
+		return amount + " " + unit
+	}
+
+	return amount + " " + unit + "s"
+}
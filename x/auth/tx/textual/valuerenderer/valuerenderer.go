@@ -0,0 +1,203 @@
+package valuerenderer
+
+import (
+	"context"
+	"fmt"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+)
+
+// Screen is the abstract unit of Textual rendering. A list of screens is what
+// is shown to the user on a signing device, one screen at a time.
+type Screen struct {
+	// Text is the text to display on the screen.
+	Text string
+
+	// Indent is the indentation level of the screen, used to represent nested
+	// structures.
+	Indent int
+
+	// Expert indicates that the screen should only be displayed when the
+	// signing device is in expert mode.
+	Expert bool
+}
+
+// ValueRenderer defines an interface to produce formatted output for a
+// protobuf value.
+type ValueRenderer interface {
+	// Format renders the protobuf value to a list of Screens.
+	Format(context.Context, protoreflect.Value) ([]Screen, error)
+}
+
+// CoinMetadataQueryFn defines a function that queries state for the coin
+// denom metadata. It is meant to be passed as an argument into `NewTextual`.
+// It should return a nil Metadata and no error if the denom has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*bankv1beta1.Metadata, error)
+
+// Textual holds the configuration for dispatching to specific value renderers
+// for SIGN_MODE_TEXTUAL. Modules can extend it by defining renderers for
+// custom scalars or messages.
+type Textual struct {
+	// coinMetadataQuerier defines a function to query the coin metadata from
+	// state. It should use bank module's `DenomsMetadata` gRPC query to fetch
+	// each denom's associated metadata, either using the bank keeper (for
+	// server-side code) or a gRPC query client (for client-side code).
+	coinMetadataQuerier CoinMetadataQueryFn
+
+	// typeResolver is used to resolve the type URLs of Any values.
+	typeResolver protoregistry.MessageTypeResolver
+
+	// scalars defines a registry for Cosmos scalars, indexed by the
+	// `cosmos_proto.scalar` field option.
+	scalars map[string]ValueRenderer
+
+	// messages defines a registry for custom message renderers, indexed by
+	// the message's full name.
+	messages map[protoreflect.FullName]ValueRenderer
+}
+
+// NewTextual returns a new Textual which provides value renderers. The
+// resolver for Any values defaults to protoregistry.GlobalTypes, so the API
+// packages of all messages to be rendered must be imported by the app.
+func NewTextual(q CoinMetadataQueryFn) Textual {
+	t := Textual{
+		coinMetadataQuerier: q,
+		typeResolver:        protoregistry.GlobalTypes,
+	}
+	t.init()
+
+	return t
+}
+
+// init initializes Textual's internal registries with the default renderers.
+func (r *Textual) init() {
+	if r.scalars == nil {
+		r.scalars = map[string]ValueRenderer{
+			"cosmos.Int": intValueRenderer{},
+			"cosmos.Dec": decValueRenderer{},
+		}
+	}
+
+	if r.messages == nil {
+		r.messages = map[protoreflect.FullName]ValueRenderer{
+			(&basev1beta1.Coin{}).ProtoReflect().Descriptor().FullName():      newCoinValueRenderer(r.coinMetadataQuerier),
+			(&timestamppb.Timestamp{}).ProtoReflect().Descriptor().FullName(): timestampValueRenderer{},
+			(&durationpb.Duration{}).ProtoReflect().Descriptor().FullName():   durationValueRenderer{},
+		}
+	}
+}
+
+// SetTypeResolver sets the resolver used to decode the content of Any values.
+func (r *Textual) SetTypeResolver(resolver protoregistry.MessageTypeResolver) {
+	r.typeResolver = resolver
+}
+
+// DefineScalar adds a value renderer to the given Cosmos scalar, i.e. to all
+// fields annotated with this `cosmos_proto.scalar` option.
+func (r *Textual) DefineScalar(scalar string, vr ValueRenderer) {
+	r.init()
+	r.scalars[scalar] = vr
+}
+
+// DefineMessageRenderer adds a value renderer for the message with the given
+// full name, overriding the default message renderer.
+func (r *Textual) DefineMessageRenderer(name protoreflect.FullName, vr ValueRenderer) {
+	r.init()
+	r.messages[name] = vr
+}
+
+// GetValueRenderer returns the value renderer for the given FieldDescriptor.
+// For repeated fields, the returned renderer formats a single element, except
+// for repeated coins which are rendered on a single screen.
+func (r Textual) GetValueRenderer(fd protoreflect.FieldDescriptor) (ValueRenderer, error) {
+	if scalar := getScalar(fd); scalar != "" {
+		if vr, found := r.scalars[scalar]; found {
+			return vr, nil
+		}
+	}
+
+	switch fd.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind,
+		protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return intValueRenderer{}, nil
+
+	case protoreflect.StringKind:
+		return stringValueRenderer{}, nil
+
+	case protoreflect.BytesKind:
+		return bytesValueRenderer{}, nil
+
+	case protoreflect.BoolKind:
+		return boolValueRenderer{}, nil
+
+	case protoreflect.EnumKind:
+		return enumValueRenderer{ed: fd.Enum()}, nil
+
+	case protoreflect.MessageKind:
+		if fd.IsMap() {
+			return nil, fmt.Errorf("value renderers for map fields are not supported, got %s", fd.FullName())
+		}
+
+		md := fd.Message()
+		if fd.IsList() && md.FullName() == (&basev1beta1.Coin{}).ProtoReflect().Descriptor().FullName() {
+			return newCoinsValueRenderer(r.coinMetadataQuerier), nil
+		}
+
+		return r.GetMessageValueRenderer(md)
+
+	default:
+		return nil, fmt.Errorf("value renderers cannot format value of kind %s", fd.Kind())
+	}
+}
+
+// GetMessageValueRenderer returns the value renderer for a message of the
+// given descriptor.
+func (r Textual) GetMessageValueRenderer(md protoreflect.MessageDescriptor) (ValueRenderer, error) {
+	if vr, found := r.messages[md.FullName()]; found {
+		return vr, nil
+	}
+
+	if md.FullName() == (&anypb.Any{}).ProtoReflect().Descriptor().FullName() {
+		return anyValueRenderer{tr: r}, nil
+	}
+
+	return newMessageValueRenderer(r, md), nil
+}
+
+// FormatMessage renders a protobuf message using the value renderer
+// registered for its type.
+func (r Textual) FormatMessage(ctx context.Context, msg proto.Message) ([]Screen, error) {
+	m := msg.ProtoReflect()
+	vr, err := r.GetMessageValueRenderer(m.Descriptor())
+	if err != nil {
+		return nil, err
+	}
+
+	return vr.Format(ctx, protoreflect.ValueOfMessage(m))
+}
+
+// getScalar returns the value of the `cosmos_proto.scalar` option of a field,
+// or the empty string if it is not set.
+func getScalar(fd protoreflect.FieldDescriptor) string {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, cosmos_proto.E_Scalar) {
+		return ""
+	}
+
+	scalar, ok := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	if !ok {
+		return ""
+	}
+
+	return scalar
+}
@@ -0,0 +1,256 @@
+package valuerenderer_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	stakingv1beta1 "cosmossdk.io/api/cosmos/staking/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/valuerenderer"
+)
+
+func mockCoinMetadataQuerier(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+	switch denom {
+	case "uatom", "matom", "atom":
+		return &bankv1beta1.Metadata{
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*bankv1beta1.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "matom", Exponent: 3},
+				{Denom: "atom", Exponent: 6},
+			},
+		}, nil
+	case "ueth":
+		return &bankv1beta1.Metadata{
+			Base:    "ueth",
+			Display: "eth",
+			DenomUnits: []*bankv1beta1.DenomUnit{
+				{Denom: "ueth", Exponent: 0},
+				{Denom: "eth", Exponent: 6},
+			},
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func TestFormatScalars(t *testing.T) {
+	tr := valuerenderer.NewTextual(mockCoinMetadataQuerier)
+
+	// CommissionRates.rate is a cosmos.Dec.
+	rates := (&stakingv1beta1.CommissionRates{}).ProtoReflect().Descriptor()
+	decField := rates.Fields().ByName("rate")
+	// Validator.tokens is a cosmos.Int.
+	validator := (&stakingv1beta1.Validator{}).ProtoReflect().Descriptor()
+	intField := validator.Fields().ByName("tokens")
+	boolField := validator.Fields().ByName("jailed")
+	enumField := validator.Fields().ByName("status")
+	heightField := validator.Fields().ByName("unbonding_height")
+	stringField := validator.Fields().ByName("operator_address")
+
+	testCases := []struct {
+		name   string
+		fd     protoreflect.FieldDescriptor
+		value  protoreflect.Value
+		expRes string
+		expErr bool
+	}{
+		{"int zero", intField, protoreflect.ValueOfString("0"), "0", false},
+		{"int small", intField, protoreflect.ValueOfString("123"), "123", false},
+		{"int thousands", intField, protoreflect.ValueOfString("1234567"), "1'234'567", false},
+		{"int negative", intField, protoreflect.ValueOfString("-1000"), "-1'000", false},
+		{"int invalid", intField, protoreflect.ValueOfString("12a"), "", true},
+		{"dec trailing zeros", decField, protoreflect.ValueOfString("1000.500000000000000000"), "1'000.5", false},
+		{"dec integral", decField, protoreflect.ValueOfString("2.000000000000000000"), "2", false},
+		{"dec small", decField, protoreflect.ValueOfString("0.010000000000000000"), "0.01", false},
+		{"dec invalid", decField, protoreflect.ValueOfString("1.2.3"), "", true},
+		{"string", stringField, protoreflect.ValueOfString("cosmosvaloper1"), "cosmosvaloper1", false},
+		{"int64", heightField, protoreflect.ValueOfInt64(1000000), "1'000'000", false},
+		{"bool true", boolField, protoreflect.ValueOfBool(true), "True", false},
+		{"bool false", boolField, protoreflect.ValueOfBool(false), "False", false},
+		{"enum", enumField, protoreflect.ValueOfEnum(stakingv1beta1.BondStatus_BOND_STATUS_BONDED.Number()), "BOND_STATUS_BONDED", false},
+		{"enum unknown", enumField, protoreflect.ValueOfEnum(42), "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vr, err := tr.GetValueRenderer(tc.fd)
+			require.NoError(t, err)
+
+			screens, err := vr.Format(context.Background(), tc.value)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc.expRes}}, screens)
+		})
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	tr := valuerenderer.NewTextual(mockCoinMetadataQuerier)
+	fd := (&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().Fields().ByName("amount")
+
+	testCases := []struct {
+		name   string
+		coins  []*basev1beta1.Coin
+		expRes string
+	}{
+		{"base to display", []*basev1beta1.Coin{{Denom: "uatom", Amount: "1500000"}}, "1.5 atom"},
+		{"small amount", []*basev1beta1.Coin{{Denom: "uatom", Amount: "1"}}, "0.000001 atom"},
+		{"intermediate unit", []*basev1beta1.Coin{{Denom: "matom", Amount: "1000"}}, "1 atom"},
+		{"display unit", []*basev1beta1.Coin{{Denom: "atom", Amount: "1234"}}, "1'234 atom"},
+		{"no metadata", []*basev1beta1.Coin{{Denom: "stake", Amount: "1000"}}, "1'000 stake"},
+		{
+			"sorted by display denom",
+			[]*basev1beta1.Coin{{Denom: "ueth", Amount: "2000000"}, {Denom: "stake", Amount: "3"}, {Denom: "uatom", Amount: "10"}},
+			"0.00001 atom, 2 eth, 3 stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vr, err := tr.GetValueRenderer(fd)
+			require.NoError(t, err)
+
+			msg := &bankv1beta1.MsgSend{Amount: tc.coins}
+			screens, err := vr.Format(context.Background(), msg.ProtoReflect().Get(fd))
+			require.NoError(t, err)
+			require.Equal(t, []valuerenderer.Screen{{Text: tc.expRes}}, screens)
+		})
+	}
+}
+
+func TestFormatTimeAndDuration(t *testing.T) {
+	tr := valuerenderer.NewTextual(mockCoinMetadataQuerier)
+
+	ts := timestamppb.New(time.Date(2022, 10, 3, 12, 30, 0, 500000000, time.UTC))
+	vr, err := tr.GetMessageValueRenderer(ts.ProtoReflect().Descriptor())
+	require.NoError(t, err)
+	screens, err := vr.Format(context.Background(), protoreflect.ValueOfMessage(ts.ProtoReflect()))
+	require.NoError(t, err)
+	require.Equal(t, []valuerenderer.Screen{{Text: "2022-10-03T12:30:00.5Z"}}, screens)
+
+	testCases := []struct {
+		d      time.Duration
+		expRes string
+	}{
+		{0, "0 seconds"},
+		{time.Second, "1 second"},
+		{90 * time.Second, "1 minute, 30 seconds"},
+		{26*time.Hour + 1500*time.Millisecond, "1 day, 2 hours, 0 minutes, 1.5 seconds"},
+		{-3 * time.Hour, "-3 hours, 0 minutes, 0 seconds"},
+	}
+
+	for _, tc := range testCases {
+		d := durationpb.New(tc.d)
+		vr, err := tr.GetMessageValueRenderer(d.ProtoReflect().Descriptor())
+		require.NoError(t, err)
+		screens, err := vr.Format(context.Background(), protoreflect.ValueOfMessage(d.ProtoReflect()))
+		require.NoError(t, err)
+		require.Equal(t, []valuerenderer.Screen{{Text: tc.expRes}}, screens)
+	}
+}
+
+func TestFormatMessage(t *testing.T) {
+	tr := valuerenderer.NewTextual(mockCoinMetadataQuerier)
+
+	msg := &bankv1beta1.MsgMultiSend{
+		Inputs: []*bankv1beta1.Input{
+			{Address: "cosmos1input", Coins: []*basev1beta1.Coin{{Denom: "uatom", Amount: "2000000"}}},
+		},
+		Outputs: []*bankv1beta1.Output{
+			{Address: "cosmos1a", Coins: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000000"}}},
+			{Address: "cosmos1b", Coins: []*basev1beta1.Coin{{Denom: "uatom", Amount: "1000000"}}},
+		},
+	}
+
+	screens, err := tr.FormatMessage(context.Background(), msg)
+	require.NoError(t, err)
+	require.Equal(t, []valuerenderer.Screen{
+		{Text: "MsgMultiSend object"},
+		{Text: "Inputs: 1 Input", Indent: 1},
+		{Text: "Inputs (1/1): Input object", Indent: 1},
+		{Text: "Address: cosmos1input", Indent: 2},
+		{Text: "Coins: 2 atom", Indent: 2},
+		{Text: "End of Inputs", Indent: 1},
+		{Text: "Outputs: 2 Output", Indent: 1},
+		{Text: "Outputs (1/2): Output object", Indent: 1},
+		{Text: "Address: cosmos1a", Indent: 2},
+		{Text: "Coins: 1 atom", Indent: 2},
+		{Text: "Outputs (2/2): Output object", Indent: 1},
+		{Text: "Address: cosmos1b", Indent: 2},
+		{Text: "Coins: 1 atom", Indent: 2},
+		{Text: "End of Outputs", Indent: 1},
+	}, screens)
+
+	// Any values are rendered with their type URL.
+	bz, err := proto.Marshal(&bankv1beta1.MsgSend{FromAddress: "cosmos1from", ToAddress: "cosmos1to"})
+	require.NoError(t, err)
+	send := &anypb.Any{TypeUrl: "/cosmos.bank.v1beta1.MsgSend", Value: bz}
+	screens, err = tr.FormatMessage(context.Background(), send)
+	require.NoError(t, err)
+	require.Equal(t, []valuerenderer.Screen{
+		{Text: "/cosmos.bank.v1beta1.MsgSend"},
+		{Text: "MsgSend object", Indent: 1},
+		{Text: "From address: cosmos1from", Indent: 2},
+		{Text: "To address: cosmos1to", Indent: 2},
+	}, screens)
+}
+
+type constRenderer struct{ text string }
+
+func (r constRenderer) Format(context.Context, protoreflect.Value) ([]valuerenderer.Screen, error) {
+	return []valuerenderer.Screen{{Text: r.text}}, nil
+}
+
+func TestDefineRenderers(t *testing.T) {
+	tr := valuerenderer.NewTextual(mockCoinMetadataQuerier)
+	tr.DefineScalar("cosmos.Dec", constRenderer{"custom dec"})
+	tr.DefineMessageRenderer((&bankv1beta1.Input{}).ProtoReflect().Descriptor().FullName(), constRenderer{"custom input"})
+
+	vr, err := tr.GetValueRenderer((&stakingv1beta1.CommissionRates{}).ProtoReflect().Descriptor().Fields().ByName("rate"))
+	require.NoError(t, err)
+	screens, err := vr.Format(context.Background(), protoreflect.ValueOfString("1.0"))
+	require.NoError(t, err)
+	require.Equal(t, "custom dec", screens[0].Text)
+
+	screens, err = tr.FormatMessage(context.Background(), &bankv1beta1.Input{Address: "cosmos1input"})
+	require.NoError(t, err)
+	require.Equal(t, []valuerenderer.Screen{{Text: "custom input"}}, screens)
+}
+
+func TestEncodeScreens(t *testing.T) {
+	bz, err := valuerenderer.EncodeScreens([]valuerenderer.Screen{
+		{Text: "a"},
+		{Text: "b", Indent: 1, Expert: true},
+		{},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{
+		0x83,             // array(3)
+		0xa1, 0x01, 0x61, // map(1), 1: text(1)
+		'a',
+		0xa3, 0x01, 0x61, // map(3), 1: text(1)
+		'b',
+		0x02, 0x01, // 2: 1
+		0x03, 0xf5, // 3: true
+		0xa0, // map(0)
+	}, bz)
+
+	_, err = valuerenderer.EncodeScreens([]valuerenderer.Screen{{Indent: -1}})
+	require.Error(t, err)
+}
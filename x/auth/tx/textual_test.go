@@ -0,0 +1,212 @@
+package tx
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual/valuerenderer"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	_, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &banktypes.MsgSend{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	var queried []string
+	querier := func(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		queried = append(queried, denom)
+		return &bankv1beta1.Metadata{
+			Display: "atom",
+			DenomUnits: []*bankv1beta1.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+		}, nil
+	}
+
+	txConfig := NewTxConfigWithOptions(marshaler, ConfigOptions{
+		EnabledSignModes:           []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL},
+		TextualCoinMetadataQueryFn: querier,
+	})
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+
+	txBuilder := txConfig.NewTxBuilder()
+	msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000)))
+	require.NoError(t, txBuilder.SetMsgs(msg))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)))
+	txBuilder.SetGasLimit(20000)
+
+	signingData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      2,
+		PubKey:        pubkey,
+	}
+
+	handler, ok := modeHandler.(signing.SignModeHandlerWithContext)
+	require.True(t, ok)
+
+	signBytes, err := handler.GetSignBytesWithContext(context.Background(), signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEmpty(t, signBytes)
+	require.Equal(t, []string{"uatom", "uatom"}, queried)
+
+	// the sign bytes are the CBOR encoding of the rendered screens
+	protoTx := txBuilder.GetTx().(*wrapper)
+	screens, err := signModeTextualHandler{t: valuerenderer.NewTextual(querier)}.textualScreens(
+		context.Background(), protoTx.getBodyBytes(), protoTx.getAuthInfoBytes(), signingData,
+	)
+	require.NoError(t, err)
+
+	expBz, err := valuerenderer.EncodeScreens(screens)
+	require.NoError(t, err)
+	require.Equal(t, expBz, signBytes)
+
+	var texts []string
+	for _, s := range screens {
+		if !s.Expert {
+			texts = append(texts, s.Text)
+		}
+	}
+	require.Equal(t, []string{
+		"Chain ID: test-chain",
+		"Account number: 1",
+		"Sequence: 2",
+		"Address: " + addr.String(),
+		"This transaction has 1 message",
+		"Message (1/1): /cosmos.bank.v1beta1.MsgSend",
+		"MsgSend object",
+		"From address: " + addr.String(),
+		"To address: " + addr.String(),
+		"Amount: 1.5 atom",
+		"End of Message",
+		"Memo: sometestmemo",
+		"Fees: 0.002 atom",
+		"End of transaction",
+	}, texts)
+
+	// changing any field changes the sign bytes
+	txBuilder.SetMemo("othermemo")
+	otherBytes, err := handler.GetSignBytesWithContext(context.Background(), signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.NoError(t, err)
+	require.NotEqual(t, signBytes, otherBytes)
+
+	// expect error with wrong sign mode
+	_, err = handler.GetSignBytesWithContext(context.Background(), signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+
+	// expect error without a context to query the coin metadata
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_TEXTUAL, signingData, txBuilder.GetTx())
+	require.Error(t, err)
+}
+
+func TestTextualModeHandlerRequiresQuerier(t *testing.T) {
+	marshaler := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.Panics(t, func() {
+		NewTxConfig(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL})
+	})
+}
+
+type mockBankKeeper struct {
+	metadata map[string]banktypes.Metadata
+}
+
+func (bk mockBankKeeper) DenomMetadata(_ context.Context, req *banktypes.QueryDenomMetadataRequest) (*banktypes.QueryDenomMetadataResponse, error) {
+	metadata, ok := bk.metadata[req.Denom]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "client metadata for denom %s", req.Denom)
+	}
+
+	return &banktypes.QueryDenomMetadataResponse{Metadata: metadata}, nil
+}
+
+func TestBankKeeperCoinMetadataQueryFn(t *testing.T) {
+	querier := NewBankKeeperCoinMetadataQueryFn(mockBankKeeper{metadata: map[string]banktypes.Metadata{
+		"uatom": {
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+		},
+	}})
+
+	ctx := sdk.WrapSDKContext(sdk.Context{}.WithContext(context.Background()))
+	metadata, err := querier(ctx, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, "atom", metadata.Display)
+	require.Len(t, metadata.DenomUnits, 2)
+	require.Equal(t, uint32(6), metadata.DenomUnits[1].Exponent)
+
+	// coins without metadata are rendered in their base denom
+	metadata, err = querier(ctx, "stake")
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+
+	// the bank keeper can't be queried without an sdk.Context
+	_, err = querier(context.Background(), "uatom")
+	require.Error(t, err)
+}
+
+// mockBankQueryConn serves the bank DenomMetadata query from a bank keeper the
+// way a node does through ABCI, which converts the gRPC status of the errors.
+type mockBankQueryConn struct {
+	bk BankKeeper
+}
+
+func (c mockBankQueryConn) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	if method != "/cosmos.bank.v1beta1.Query/DenomMetadata" {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+
+	res, err := c.bk.DenomMetadata(ctx, args.(*banktypes.QueryDenomMetadataRequest))
+	if status.Code(err) == codes.NotFound {
+		return sdkerrors.Wrap(sdkerrors.ErrKeyNotFound, err.Error())
+	}
+	if err != nil {
+		return err
+	}
+
+	*reply.(*banktypes.QueryDenomMetadataResponse) = *res
+	return nil
+}
+
+func (mockBankQueryConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func TestGRPCCoinMetadataQueryFn(t *testing.T) {
+	querier := NewGRPCCoinMetadataQueryFn(mockBankQueryConn{bk: mockBankKeeper{metadata: map[string]banktypes.Metadata{
+		"uatom": {Base: "uatom", Display: "atom"},
+	}}})
+
+	metadata, err := querier(context.Background(), "uatom")
+	require.NoError(t, err)
+	require.Equal(t, "atom", metadata.Display)
+
+	// the not found error of a query run through ABCI is an sdk error
+	metadata, err = querier(context.Background(), "stake")
+	require.NoError(t, err)
+	require.Nil(t, metadata)
+}
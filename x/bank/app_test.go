@@ -337,3 +337,92 @@ func TestMsgMultiSendDependent(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgBatchSend(t *testing.T) {
+	acc1 := authtypes.NewBaseAccountWithAddress(addr1)
+	acc2 := authtypes.NewBaseAccountWithAddress(addr2)
+	require.NoError(t, acc2.SetAccountNumber(1))
+	acc4 := authtypes.NewBaseAccountWithAddress(addr4)
+	require.NoError(t, acc4.SetAccountNumber(2))
+
+	genAccs := []authtypes.GenesisAccount{acc1, acc2, acc4}
+	app := simapp.SetupWithGenesisAccounts(t, genAccs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 42))))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, addr4, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 42))))
+
+	app.Commit()
+
+	testCases := []appTestCase{
+		{
+			desc: "transfers from many senders to many recipients",
+			msgs: []sdk.Msg{types.NewMsgBatchSend([]types.Transfer{
+				types.NewTransfer(addr1, addr2, coins),
+				types.NewTransfer(addr4, addr3, coins),
+				types.NewTransfer(addr1, addr3, halfCoins),
+			})},
+			accNums:    []uint64{0, 2},
+			accSeqs:    []uint64{0, 0},
+			expSimPass: true,
+			expPass:    true,
+			privKeys:   []cryptotypes.PrivKey{priv1, priv4},
+			expectedBalances: []expectedBalance{
+				{addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 27)}},
+				{addr4, sdk.Coins{sdk.NewInt64Coin("foocoin", 32)}},
+				{addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}},
+				{addr3, sdk.Coins{sdk.NewInt64Coin("foocoin", 15)}},
+			},
+		},
+		{
+			desc: "no transfer is applied if one of them fails",
+			msgs: []sdk.Msg{types.NewMsgBatchSend([]types.Transfer{
+				types.NewTransfer(addr1, addr2, coins),
+				types.NewTransfer(addr4, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 100)}),
+			})},
+			accNums:    []uint64{0, 2},
+			accSeqs:    []uint64{1, 1},
+			expSimPass: false,
+			expPass:    false,
+			privKeys:   []cryptotypes.PrivKey{priv1, priv4},
+			expectedBalances: []expectedBalance{
+				{addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 27)}},
+				{addr4, sdk.Coins{sdk.NewInt64Coin("foocoin", 32)}},
+				{addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}},
+			},
+		},
+		{
+			desc: "every sender must sign",
+			msgs: []sdk.Msg{types.NewMsgBatchSend([]types.Transfer{
+				types.NewTransfer(addr1, addr2, coins),
+				types.NewTransfer(addr4, addr2, coins),
+			})},
+			accNums:    []uint64{0},
+			accSeqs:    []uint64{2},
+			expSimPass: false,
+			expPass:    false,
+			privKeys:   []cryptotypes.PrivKey{priv1},
+			expectedBalances: []expectedBalance{
+				{addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 27)}},
+				{addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			txGen := simapp.MakeTestEncodingConfig().TxConfig
+			_, _, err := simapp.SignCheckDeliver(t, txGen, app.BaseApp, header, tc.msgs, "", tc.accNums, tc.accSeqs, tc.expSimPass, tc.expPass, tc.privKeys...)
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+
+			for _, eb := range tc.expectedBalances {
+				simapp.CheckBalance(t, app, eb.addr, eb.coins)
+			}
+		})
+	}
+}
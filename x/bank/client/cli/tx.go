@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		NewSendTxCmd(),
 		NewMultiSendTxCmd(),
+		NewBatchSendTxCmd(),
 	)
 
 	return txCmd
@@ -143,3 +145,47 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 
 	return cmd
 }
+
+// NewBatchSendTxCmd returns a CLI command handler for creating a MsgBatchSend transaction.
+func NewBatchSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [batch_file]",
+		Short: "Execute a batch of transfers atomically.",
+		Long: `Execute a batch of transfers atomically: either all of them succeed or none is applied.
+The batch is read from a JSON file, for instance:
+
+{
+  "transfers": [
+    {"from_address": "cosmos1...", "to_address": "cosmos1...", "amount": [{"denom": "stake", "amount": "10"}]},
+    {"from_address": "cosmos1...", "to_address": "cosmos1...", "amount": [{"denom": "stake", "amount": "20"}]}
+  ]
+}
+
+Every sender of the batch must sign the transaction. When the batch has
+several senders, use '--generate-only' and have each of them sign it.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchSend{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("failed to parse batch file: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -11,6 +11,7 @@ import (
 	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -659,6 +660,61 @@ func (s *IntegrationTestSuite) TestNewMultiSendTxCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewBatchSendTxCmd() {
+	val := s.network.Validators[0]
+	testAddr := sdk.AccAddress("cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5")
+	amount := sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10)))
+
+	batch := fmt.Sprintf(`{"transfers": [
+		{"from_address": "%[1]s", "to_address": "%[2]s", "amount": [{"denom": "%[3]s", "amount": "10"}]},
+		{"from_address": "%[1]s", "to_address": "%[1]s", "amount": [{"denom": "%[3]s", "amount": "20"}]}
+	]}`, val.Address, testAddr, s.cfg.BondDenom)
+	batchFile := testutil.WriteToNewTempFile(s.T(), batch).Name()
+	invalidFile := testutil.WriteToNewTempFile(s.T(), `{"transfers": "invalid"}`).Name()
+
+	baseArgs := []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		batchFile    string
+		expectErr    bool
+		expectedCode uint32
+	}{
+		{"valid transaction", batchFile, false, 0},
+		{"invalid batch file", invalidFile, true, 0},
+		{"missing batch file", "missing.json", true, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			bz, err := MsgBatchSendExec(clientCtx, val.Address, tc.batchFile, baseArgs...)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var txResp sdk.TxResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bz.Bytes(), &txResp), bz.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, txResp.RawLog)
+
+			out, err := QueryBalancesExec(clientCtx, testAddr)
+			s.Require().NoError(err)
+			var balances types.QueryAllBalancesResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &balances))
+			s.Require().True(balances.Balances.IsAllGTE(amount))
+		})
+	}
+}
+
 func NewCoin(denom string, amount math.Int) *sdk.Coin {
 	coin := sdk.NewCoin(denom, amount)
 	return &coin
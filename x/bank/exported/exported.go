@@ -2,6 +2,7 @@ package exported
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // GenesisBalance defines a genesis balance interface that allows for account
@@ -10,3 +11,15 @@ type GenesisBalance interface {
 	GetAddress() sdk.AccAddress
 	GetCoins() sdk.Coins
 }
+
+type (
+	ParamSet = paramtypes.ParamSet
+
+	// Subspace defines an interface that implements the legacy x/params Subspace
+	// type.
+	//
+	// NOTE: This is used solely for migration of x/params managed parameters.
+	Subspace interface {
+		GetParamSet(ctx sdk.Context, ps ParamSet)
+	}
+)
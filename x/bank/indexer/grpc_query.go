@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/store/v2alpha1/dbadapter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+var _ QueryServer = &Indexer{}
+
+// Balance implements the Query/Balance gRPC method
+func (i *Indexer) Balance(_ context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, height, err := i.readerAt(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Discard()
+
+	amount, err := getAmount(r, balanceKey(addr, req.Denom))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryBalanceResponse{Balance: sdk.NewCoin(req.Denom, amount), Height: height}, nil
+}
+
+// AllBalances implements the Query/AllBalances gRPC method
+func (i *Indexer) AllBalances(_ context.Context, req *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	r, height, err := i.readerAt(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Discard()
+
+	balances := sdk.NewCoins()
+	balancesStore := prefix.NewStore(dbadapter.Store{DB: dbm.ReaderAsReadWriter(r)}, balanceKey(addr, ""))
+	pageRes, err := query.Paginate(balancesStore, req.Pagination, func(key, value []byte) error {
+		amount, err := unmarshalAmount(value)
+		if err != nil {
+			return err
+		}
+		balances = append(balances, sdk.NewCoin(string(key), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &QueryAllBalancesResponse{Balances: balances, Height: height, Pagination: pageRes}, nil
+}
+
+// SupplyOf implements the Query/SupplyOf gRPC method
+func (i *Indexer) SupplyOf(_ context.Context, req *QuerySupplyOfRequest) (*QuerySupplyOfResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	r, height, err := i.readerAt(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Discard()
+
+	amount, err := getAmount(r, supplyKey(req.Denom))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QuerySupplyOfResponse{Amount: sdk.NewCoin(req.Denom, amount), Height: height}, nil
+}
+
+// BalanceChanges implements the Query/BalanceChanges gRPC method
+func (i *Indexer) BalanceChanges(_ context.Context, req *QueryBalanceChangesRequest) (*QueryBalanceChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	r, latest, err := i.readerAt(0)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Discard()
+
+	toHeight := req.ToHeight
+	if toHeight == 0 || toHeight > latest {
+		toHeight = latest
+	}
+	if req.FromHeight > toHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height %d is greater than to height %d", req.FromHeight, toHeight)
+	}
+
+	changes := []BalanceChange{}
+	changesStore := prefix.NewStore(dbadapter.Store{DB: dbm.ReaderAsReadWriter(r)}, prefixBalanceChangesKey(addr))
+	pageRes, err := query.FilteredPaginate(changesStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var change BalanceChange
+		if err := i.cdc.Unmarshal(value, &change); err != nil {
+			return false, err
+		}
+
+		if change.Height < req.FromHeight || change.Height > toHeight {
+			return false, nil
+		}
+		if req.Denom != "" && change.Denom != req.Denom {
+			return false, nil
+		}
+
+		if accumulate {
+			changes = append(changes, change)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &QueryBalanceChangesResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+// IndexedHeights implements the Query/IndexedHeights gRPC method
+func (i *Indexer) IndexedHeights(_ context.Context, req *QueryIndexedHeightsRequest) (*QueryIndexedHeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	earliest, latest, err := i.indexedHeights()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &QueryIndexedHeightsResponse{EarliestHeight: earliest, LatestHeight: latest}, nil
+}
+
+// indexedHeights returns the first and last indexed heights, which are 0 if
+// nothing was indexed yet.
+func (i *Indexer) indexedHeights() (earliest int64, latest int64, err error) {
+	versions, err := i.db.Versions()
+	if err != nil {
+		return 0, 0, err
+	}
+	latest = int64(versions.Last())
+	if latest == 0 {
+		return 0, 0, nil
+	}
+
+	r, err := i.db.ReaderAt(uint64(latest))
+	if err != nil {
+		return 0, 0, err
+	}
+	defer r.Discard()
+
+	bz, err := r.Get(EarliestHeightKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int64(sdk.BigEndianToUint64(bz)), latest, nil
+}
+
+// readerAt opens a reader of the index at height, or at the latest indexed
+// height if height is 0. It returns the height of the reader.
+func (i *Indexer) readerAt(height int64) (dbm.Reader, int64, error) {
+	earliest, latest, err := i.indexedHeights()
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	if latest == 0 {
+		return nil, 0, status.Error(codes.Unavailable, "nothing is indexed yet")
+	}
+
+	if height == 0 {
+		height = latest
+	}
+	if height < earliest || height > latest {
+		return nil, 0, status.Errorf(codes.NotFound, "height %d is not indexed, indexed heights are %d to %d", height, earliest, latest)
+	}
+
+	r, err := i.db.ReaderAt(uint64(height))
+	if errors.Is(err, dbm.ErrVersionDoesNotExist) {
+		// some blocks in the range may have been missed
+		return nil, 0, status.Errorf(codes.NotFound, "height %d is not indexed", height)
+	}
+	if err != nil {
+		return nil, 0, status.Error(codes.Internal, err.Error())
+	}
+	return r, height, nil
+}
+
+// getAmount returns the amount stored at key, which is zero if the key is not set.
+func getAmount(r dbm.Reader, key []byte) (sdk.Int, error) {
+	bz, err := r.Get(key)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return unmarshalAmount(bz)
+}
@@ -0,0 +1,336 @@
+package indexer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// MemDBBackend keeps the index in memory, it is lost when the node stops.
+	MemDBBackend = "memdb"
+	// BadgerDBBackend persists the index in a badger database.
+	BadgerDBBackend = "badgerdb"
+)
+
+var _ baseapp.StreamingService = &Indexer{}
+
+// Indexer is a StreamingService which indexes the history of the x/bank
+// balances and supply into a versioned db, outside of the consensus state, so
+// that they can be queried at any indexed height regardless of the pruning of
+// the application state. It also records a log of the balance changes of
+// every account.
+//
+// The indexer listens to the writes on the bank store to learn which
+// balances and supplies changed. Since the writes of BeginBlock and EndBlock
+// are only flushed when the block is committed, it also learns about the
+// changes they make from the bank events. At the end of each block, it reads
+// the changed values from the block state and saves the index at the block
+// height.
+//
+// The first block indexed seeds the index with all the balances and supplies.
+// If some blocks are missed, for instance if the indexer was disabled for a
+// while, the next indexed block resynchronizes the whole index and records the
+// accumulated changes at its height.
+type Indexer struct {
+	db       dbm.Connection
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// touched holds the bank store keys changed since the last indexed block
+	touched    map[string]struct{}
+	touchedMtx sync.Mutex
+}
+
+// NewIndexer creates an Indexer of the bank store identified by storeKey, writing to db.
+func NewIndexer(db dbm.Connection, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) *Indexer {
+	return &Indexer{
+		db:       db,
+		storeKey: storeKey,
+		cdc:      cdc,
+		touched:  make(map[string]struct{}),
+	}
+}
+
+// NewDB opens the index db of the given backend. dir is only used by the
+// backends persisting the index to disk.
+func NewDB(backend, dir string) (dbm.Connection, error) {
+	switch backend {
+	case MemDBBackend, "":
+		return memdb.NewDB(), nil
+	case BadgerDBBackend:
+		if dir == "" {
+			return nil, errors.New("a directory is required by the badgerdb backend")
+		}
+		return badgerdb.NewDB(dir)
+	default:
+		return nil, fmt.Errorf("unknown bank indexer db backend %s", backend)
+	}
+}
+
+// RegisterGRPCServer registers the indexer Query service.
+func (i *Indexer) RegisterGRPCServer(server gogogrpc.Server) {
+	RegisterQueryServer(server, i)
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the listener of the bank store writes.
+func (i *Indexer) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{
+		i.storeKey: {i},
+	}
+}
+
+// OnWrite satisfies the storetypes.WriteListener interface
+// It records the balance and supply keys written to the bank store.
+func (i *Indexer) OnWrite(_ storetypes.StoreKey, key []byte, _ []byte, _ bool) error {
+	if bytes.HasPrefix(key, BalancesPrefix) || bytes.HasPrefix(key, SupplyPrefix) {
+		i.touch(key)
+	}
+	return nil
+}
+
+func (i *Indexer) touch(key []byte) {
+	i.touchedMtx.Lock()
+	defer i.touchedMtx.Unlock()
+	i.touched[string(key)] = struct{}{}
+}
+
+// touchEvents records the balance and supply keys changed according to the bank events.
+func (i *Indexer) touchEvents(events []abci.Event) {
+	for _, event := range events {
+		var addrKey string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			addrKey = banktypes.AttributeKeySpender
+		case banktypes.EventTypeCoinReceived:
+			addrKey = banktypes.AttributeKeyReceiver
+		case banktypes.EventTypeCoinMint, banktypes.EventTypeCoinBurn:
+			// only the supply changes, the balance changes have their own events
+		default:
+			continue
+		}
+
+		var addrStr, amountStr string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case addrKey:
+				addrStr = attr.Value
+			case sdk.AttributeKeyAmount:
+				amountStr = attr.Value
+			}
+		}
+
+		coins, err := sdk.ParseCoinsNormalized(amountStr)
+		if err != nil {
+			continue
+		}
+
+		if addrKey == "" {
+			for _, coin := range coins {
+				i.touch(supplyKey(coin.Denom))
+			}
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			continue
+		}
+		for _, coin := range coins {
+			i.touch(balanceKey(addr, coin.Denom))
+		}
+	}
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It records the changes made by BeginBlock from the bank events.
+func (i *Indexer) ListenBeginBlock(_ sdk.Context, _ abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	i.touchEvents(res.Events)
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// The changes of the transactions are recorded by the store listener.
+func (i *Indexer) ListenDeliverTx(_ sdk.Context, _ abci.RequestDeliverTx, _ abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It records the changes made by EndBlock from the bank events, then indexes the block.
+func (i *Indexer) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	i.touchEvents(res.Events)
+	return i.index(ctx, req.Height)
+}
+
+// index saves the touched balances and supplies at height, reading their
+// values from the block state.
+func (i *Indexer) index(ctx sdk.Context, height int64) (err error) {
+	i.touchedMtx.Lock()
+	touched := i.touched
+	i.touched = make(map[string]struct{})
+	i.touchedMtx.Unlock()
+
+	versions, err := i.db.Versions()
+	if err != nil {
+		return err
+	}
+	latest := int64(versions.Last())
+	// the block is already indexed, which happens when blocks are replayed
+	if latest >= height {
+		return nil
+	}
+
+	// the store is read directly from the multistore to not consume gas
+	store := ctx.MultiStore().GetKVStore(i.storeKey)
+	rw := i.db.ReadWriter()
+	defer func() {
+		if err != nil {
+			rw.Discard()
+		}
+	}()
+
+	seed := latest == 0
+	if seed {
+		if err = rw.Set(EarliestHeightKey, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+			return err
+		}
+	}
+	// first block, or some blocks were missed: every balance and supply must be checked
+	if seed || latest+1 < height {
+		if err = i.touchAll(store, rw, touched); err != nil {
+			return err
+		}
+	}
+
+	keys := make([]string, 0, len(touched))
+	for key := range touched {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err = i.update(store, rw, []byte(key), height, !seed); err != nil {
+			return err
+		}
+	}
+
+	if err = rw.Commit(); err != nil {
+		return err
+	}
+	return i.db.SaveVersion(uint64(height))
+}
+
+// touchAll adds all the balance and supply keys of the bank store and of the index to touched.
+func (i *Indexer) touchAll(store storetypes.KVStore, r dbm.Reader, touched map[string]struct{}) error {
+	for _, prefix := range [][]byte{SupplyPrefix, BalancesPrefix} {
+		storeIter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; storeIter.Valid(); storeIter.Next() {
+			touched[string(storeIter.Key())] = struct{}{}
+		}
+		storeIter.Close()
+
+		dbIter, err := r.Iterator(prefix, sdk.PrefixEndBytes(prefix))
+		if err != nil {
+			return err
+		}
+		for dbIter.Next() {
+			touched[string(dbIter.Key())] = struct{}{}
+		}
+		err = dbIter.Error()
+		dbIter.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// update copies the value of key from the bank store to the index, and
+// records the balance change if recordChange is true.
+func (i *Indexer) update(store storetypes.KVStore, rw dbm.ReadWriter, key []byte, height int64, recordChange bool) error {
+	newBz := store.Get(key)
+	oldBz, err := rw.Get(key)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(newBz, oldBz) {
+		return nil
+	}
+
+	if newBz == nil {
+		err = rw.Delete(key)
+	} else {
+		err = rw.Set(key, newBz)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !recordChange || !bytes.HasPrefix(key, BalancesPrefix) {
+		return nil
+	}
+
+	addr, denom, err := banktypes.AddressAndDenomFromBalancesStore(key[len(BalancesPrefix):])
+	if err != nil {
+		return err
+	}
+	newBalance, err := unmarshalAmount(newBz)
+	if err != nil {
+		return err
+	}
+	oldBalance, err := unmarshalAmount(oldBz)
+	if err != nil {
+		return err
+	}
+
+	change := BalanceChange{
+		Height:  height,
+		Denom:   denom,
+		Delta:   newBalance.Sub(oldBalance),
+		Balance: newBalance,
+	}
+	bz, err := i.cdc.Marshal(&change)
+	if err != nil {
+		return err
+	}
+	return rw.Set(balanceChangeKey(addr, height, denom), bz)
+}
+
+// unmarshalAmount decodes an amount stored in the bank store, which is zero if bz is nil.
+func unmarshalAmount(bz []byte) (sdk.Int, error) {
+	amount := sdk.ZeroInt()
+	if bz == nil {
+		return amount, nil
+	}
+
+	err := amount.Unmarshal(bz)
+	return amount, err
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// The indexer writes to its db while the blocks are processed, it has no
+// background loop.
+func (i *Indexer) Stream(_ *sync.WaitGroup) error {
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It closes the index db.
+func (i *Indexer) Close() error {
+	return i.db.Close()
+}
@@ -0,0 +1,282 @@
+package indexer_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmdb "github.com/tendermint/tm-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/bank/indexer"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const fooDenom = "foo"
+
+var (
+	addr1 = sdk.AccAddress("addr1_______________")
+	addr2 = sdk.AccAddress("addr2_______________")
+)
+
+type IndexerTestSuite struct {
+	suite.Suite
+
+	store   *rootmulti.Store
+	key     *storetypes.KVStoreKey
+	indexer *indexer.Indexer
+}
+
+func TestIndexerTestSuite(t *testing.T) {
+	suite.Run(t, new(IndexerTestSuite))
+}
+
+// SetupTest indexes the following blocks:
+//
+//	1: addr1 has 100foo, seeds the index
+//	2: addr1 sends 30foo to addr2 in a transaction
+//	3: 10foo are minted to addr2 in EndBlock
+//	4: addr1 loses its balance, the block is not indexed
+//	5: nothing happens, the index is resynchronized
+func (s *IndexerTestSuite) SetupTest() {
+	s.key = storetypes.NewKVStoreKey(banktypes.StoreKey)
+	s.store = rootmulti.NewStore(tmdb.NewMemDB(), log.NewNopLogger())
+	s.store.MountStoreWithDB(s.key, storetypes.StoreTypeIAVL, nil)
+	s.Require().NoError(s.store.LoadLatestVersion())
+
+	s.indexer = indexer.NewIndexer(memdb.NewDB(), s.key, codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+	for key, listeners := range s.indexer.Listeners() {
+		s.store.AddListeners(key, listeners)
+	}
+
+	s.commitBlock(1, true, nil, func(store storetypes.KVStore) {
+		setAmount(store, balanceKey(addr1, fooDenom), 100)
+		setAmount(store, supplyKey(fooDenom), 100)
+	})
+
+	s.commitBlock(2, true, func(store storetypes.KVStore) {
+		setAmount(store, balanceKey(addr1, fooDenom), 70)
+		setAmount(store, balanceKey(addr2, fooDenom), 30)
+	}, nil)
+
+	s.commitBlock(3, true, nil, func(store storetypes.KVStore) {
+		setAmount(store, balanceKey(addr2, fooDenom), 40)
+		setAmount(store, supplyKey(fooDenom), 110)
+	},
+		banktypes.NewCoinReceivedEvent(addr2, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10))),
+		banktypes.NewCoinMintEvent(addr2, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10))),
+	)
+
+	s.commitBlock(4, false, nil, func(store storetypes.KVStore) {
+		store.Delete(balanceKey(addr1, fooDenom))
+	})
+
+	s.commitBlock(5, true, nil, nil)
+}
+
+// commitBlock executes a block at height. txWrites are done in a transaction
+// and blockWrites directly in the block state, announced by events.
+func (s *IndexerTestSuite) commitBlock(height int64, index bool, txWrites, blockWrites func(store storetypes.KVStore), events ...sdk.Event) {
+	cms := s.store.CacheMultiStore()
+	ctx := sdk.NewContext(cms, tmproto.Header{Height: height}, false, log.NewNopLogger())
+
+	if txWrites != nil {
+		txCms := cms.CacheMultiStore()
+		txWrites(txCms.GetKVStore(s.key))
+		txCms.Write()
+	}
+	if blockWrites != nil {
+		blockWrites(cms.GetKVStore(s.key))
+	}
+
+	if index {
+		res := abci.ResponseEndBlock{Events: sdk.Events(events).ToABCIEvents()}
+		s.Require().NoError(s.indexer.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, res))
+	}
+
+	cms.Write()
+	s.store.Commit()
+}
+
+func (s *IndexerTestSuite) TestBalance() {
+	testCases := []struct {
+		msg        string
+		height     int64
+		address    sdk.AccAddress
+		expError   codes.Code
+		expBalance int64
+		expHeight  int64
+	}{
+		{"seeded balance", 1, addr1, codes.OK, 100, 1},
+		{"balance changed by a transaction", 2, addr1, codes.OK, 70, 2},
+		{"balance created by a transaction", 2, addr2, codes.OK, 30, 2},
+		{"no balance", 1, addr2, codes.OK, 0, 1},
+		{"balance changed in end block", 3, addr2, codes.OK, 40, 3},
+		{"balance changed in a missed block", 5, addr1, codes.OK, 0, 5},
+		{"latest height", 0, addr2, codes.OK, 40, 5},
+		{"missed height", 4, addr1, codes.NotFound, 0, 0},
+		{"future height", 6, addr1, codes.NotFound, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.indexer.Balance(gocontext.Background(), &indexer.QueryBalanceRequest{
+				Address: tc.address.String(),
+				Denom:   fooDenom,
+				Height:  tc.height,
+			})
+			if tc.expError != codes.OK {
+				s.Require().Equal(tc.expError, status.Code(err))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewInt64Coin(fooDenom, tc.expBalance), res.Balance)
+			s.Require().Equal(tc.expHeight, res.Height)
+		})
+	}
+
+	_, err := s.indexer.Balance(gocontext.Background(), &indexer.QueryBalanceRequest{Address: "invalid", Denom: fooDenom})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *IndexerTestSuite) TestAllBalances() {
+	res, err := s.indexer.AllBalances(gocontext.Background(), &indexer.QueryAllBalancesRequest{Address: addr2.String(), Height: 3})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 40)), res.Balances)
+	s.Require().Equal(uint64(1), res.Pagination.Total)
+
+	res, err = s.indexer.AllBalances(gocontext.Background(), &indexer.QueryAllBalancesRequest{Address: addr1.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.Balances.IsZero())
+}
+
+func (s *IndexerTestSuite) TestSupplyOf() {
+	for height, expSupply := range map[int64]int64{1: 100, 2: 100, 3: 110, 5: 110} {
+		res, err := s.indexer.SupplyOf(gocontext.Background(), &indexer.QuerySupplyOfRequest{Denom: fooDenom, Height: height})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(fooDenom, expSupply), res.Amount)
+	}
+}
+
+func (s *IndexerTestSuite) TestBalanceChanges() {
+	testCases := []struct {
+		msg        string
+		req        *indexer.QueryBalanceChangesRequest
+		expError   codes.Code
+		expChanges []indexer.BalanceChange
+	}{
+		{
+			"all changes",
+			&indexer.QueryBalanceChangesRequest{Address: addr1.String()},
+			codes.OK,
+			[]indexer.BalanceChange{newChange(2, -30, 70), newChange(5, -70, 0)},
+		},
+		{
+			"changes from height",
+			&indexer.QueryBalanceChangesRequest{Address: addr2.String(), FromHeight: 3},
+			codes.OK,
+			[]indexer.BalanceChange{newChange(3, 10, 40)},
+		},
+		{
+			"changes to height",
+			&indexer.QueryBalanceChangesRequest{Address: addr2.String(), ToHeight: 2},
+			codes.OK,
+			[]indexer.BalanceChange{newChange(2, 30, 30)},
+		},
+		{
+			"changes of another denom",
+			&indexer.QueryBalanceChangesRequest{Address: addr1.String(), Denom: "bar"},
+			codes.OK,
+			[]indexer.BalanceChange{},
+		},
+		{
+			"changes with pagination",
+			&indexer.QueryBalanceChangesRequest{Address: addr1.String(), Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			codes.OK,
+			[]indexer.BalanceChange{newChange(5, -70, 0)},
+		},
+		{
+			"invalid height range",
+			&indexer.QueryBalanceChangesRequest{Address: addr1.String(), FromHeight: 3, ToHeight: 2},
+			codes.InvalidArgument,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.indexer.BalanceChanges(gocontext.Background(), tc.req)
+			if tc.expError != codes.OK {
+				s.Require().Equal(tc.expError, status.Code(err))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expChanges, res.Changes)
+		})
+	}
+}
+
+func (s *IndexerTestSuite) TestIndexedHeights() {
+	res, err := s.indexer.IndexedHeights(gocontext.Background(), &indexer.QueryIndexedHeightsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), res.EarliestHeight)
+	s.Require().Equal(int64(5), res.LatestHeight)
+
+	// replayed blocks are not indexed again
+	cms := s.store.CacheMultiStore()
+	setAmount(cms.GetKVStore(s.key), balanceKey(addr1, fooDenom), 1)
+	ctx := sdk.NewContext(cms, tmproto.Header{Height: 5}, false, log.NewNopLogger())
+	s.Require().NoError(s.indexer.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 5}, abci.ResponseEndBlock{}))
+
+	balanceRes, err := s.indexer.Balance(gocontext.Background(), &indexer.QueryBalanceRequest{Address: addr1.String(), Denom: fooDenom})
+	s.Require().NoError(err)
+	s.Require().True(balanceRes.Balance.IsZero())
+}
+
+func TestEmptyIndex(t *testing.T) {
+	idx := indexer.NewIndexer(memdb.NewDB(), storetypes.NewKVStoreKey(banktypes.StoreKey), codec.NewProtoCodec(codectypes.NewInterfaceRegistry()))
+
+	_, err := idx.SupplyOf(gocontext.Background(), &indexer.QuerySupplyOfRequest{Denom: fooDenom})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+
+	res, err := idx.IndexedHeights(gocontext.Background(), &indexer.QueryIndexedHeightsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.LatestHeight)
+}
+
+func newChange(height, delta, balance int64) indexer.BalanceChange {
+	return indexer.BalanceChange{
+		Height:  height,
+		Denom:   fooDenom,
+		Delta:   sdk.NewInt(delta),
+		Balance: sdk.NewInt(balance),
+	}
+}
+
+func balanceKey(addr sdk.AccAddress, denom string) []byte {
+	return append(banktypes.CreateAccountBalancesPrefix(addr), denom...)
+}
+
+func supplyKey(denom string) []byte {
+	return append(banktypes.SupplyKey, denom...)
+}
+
+func setAmount(store storetypes.KVStore, key []byte, amount int64) {
+	bz, err := sdk.NewInt(amount).Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
@@ -0,0 +1,80 @@
+package indexer
+
+import (
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Keys of the index db. The supply and balances are mirrored from the bank
+// store and use the same keys; the balance changes and the earliest indexed
+// height are specific to the index.
+var (
+	SupplyPrefix         = banktypes.SupplyKey
+	BalancesPrefix       = banktypes.BalancesPrefix
+	BalanceChangesPrefix = []byte{0x10}
+	EarliestHeightKey    = []byte{0x20}
+)
+
+// balanceKey returns the key of the balance of denom held by addr
+// Items are stored with the following key: values
+// 0x02<addr_len (1 Byte)><addr><denom>
+func balanceKey(addr sdk.AccAddress, denom string) []byte {
+	prefix := banktypes.CreateAccountBalancesPrefix(addr)
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(prefix)+len(denomBz))
+	copy(key, prefix)
+	copy(key[len(prefix):], denomBz)
+	return key
+}
+
+// supplyKey returns the key of the supply of denom
+// Items are stored with the following key: values
+// 0x00<denom>
+func supplyKey(denom string) []byte {
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(SupplyPrefix)+len(denomBz))
+	copy(key, SupplyPrefix)
+	copy(key[len(SupplyPrefix):], denomBz)
+	return key
+}
+
+// balanceChangeKey returns the key of the change of the balance of denom held by addr at height
+// Items are stored with the following key: values
+// 0x10<addr_len (1 Byte)><addr><height (8 Bytes)><denom>
+func balanceChangeKey(addr sdk.AccAddress, height int64, denom string) []byte {
+	prefix := prefixBalanceChangesHeightKey(addr, height)
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(prefix)+len(denomBz))
+	copy(key, prefix)
+	copy(key[len(prefix):], denomBz)
+	return key
+}
+
+// prefixBalanceChangesKey returns the prefix of the balance changes of addr
+// Items are stored with the following key: values
+// 0x10<addr_len (1 Byte)><addr>
+func prefixBalanceChangesKey(addr sdk.AccAddress) []byte {
+	addr = address.MustLengthPrefix(addr)
+
+	key := make([]byte, len(BalanceChangesPrefix)+len(addr))
+	copy(key, BalanceChangesPrefix)
+	copy(key[len(BalanceChangesPrefix):], addr)
+	return key
+}
+
+// prefixBalanceChangesHeightKey returns the prefix of the balance changes of addr at height
+// Items are stored with the following key: values
+// 0x10<addr_len (1 Byte)><addr><height (8 Bytes)>
+func prefixBalanceChangesHeightKey(addr sdk.AccAddress, height int64) []byte {
+	prefix := prefixBalanceChangesKey(addr)
+
+	key := make([]byte, len(prefix)+8)
+	copy(key, prefix)
+	copy(key[len(prefix):], sdk.Uint64ToBigEndian(uint64(height)))
+	return key
+}
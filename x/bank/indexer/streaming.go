@@ -0,0 +1,40 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// StreamingServiceName is the name of the bank indexer streaming service, under which
+// NewStreamingService is registered with streaming.RegisterServiceConstructor.
+const StreamingServiceName = "bankindexer"
+
+// NewStreamingService is the streaming.ServiceConstructor function for creating an Indexer.
+// The bank store key must be one of the exposed keys.
+func NewStreamingService(opts servertypes.AppOptions, keys []storetypes.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	var bankKey storetypes.StoreKey
+	for _, key := range keys {
+		if key.Name() == banktypes.StoreKey {
+			bankKey = key
+			break
+		}
+	}
+	if bankKey == nil {
+		return nil, fmt.Errorf("the bank indexer requires the %s store key to be exposed", banktypes.StoreKey)
+	}
+
+	backend := cast.ToString(opts.Get(fmt.Sprintf("streamers.%s.backend", StreamingServiceName)))
+	dir := cast.ToString(opts.Get(fmt.Sprintf("streamers.%s.dir", StreamingServiceName)))
+	db, err := NewDB(backend, dir)
+	if err != nil {
+		return nil, err
+	}
+	return NewIndexer(db, bankKey, marshaller), nil
+}
@@ -0,0 +1,34 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/indexer"
+)
+
+type emptyOptions struct{}
+
+func (emptyOptions) Get(string) interface{} { return nil }
+
+func TestNewStreamingService(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	keys := []storetypes.StoreKey{storetypes.NewKVStoreKey("mockKey1"), storetypes.NewKVStoreKey("mockKey2")}
+
+	_, err := indexer.NewStreamingService(emptyOptions{}, keys, cdc)
+	require.Error(t, err)
+
+	bankKey := storetypes.NewKVStoreKey("bank")
+	serv, err := indexer.NewStreamingService(emptyOptions{}, append(keys, bankKey), cdc)
+	require.NoError(t, err)
+	require.IsType(t, &indexer.Indexer{}, serv)
+	listeners := serv.Listeners()
+	require.Len(t, listeners, 1)
+	_, ok := listeners[bankKey]
+	require.True(t, ok)
+	require.NoError(t, serv.Close())
+}
@@ -178,6 +178,78 @@ func (k BaseKeeper) DenomsMetadata(c context.Context, req *types.QueryDenomsMeta
 	}, nil
 }
 
+// DenomsMetadataByDisplay implements Query/DenomsMetadataByDisplay gRPC method.
+func (k BaseKeeper) DenomsMetadataByDisplay(c context.Context, req *types.QueryDenomsMetadataByDisplayRequest) (*types.QueryDenomsMetadataByDisplayResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Display == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid display denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	metadatas, pageRes, err := k.paginateDenomMetadataIndex(ctx, types.CreateDenomMetadataByDisplayPrefix(req.Display), req.Pagination, func(metadata types.Metadata) bool {
+		return metadata.Display == req.Display
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataByDisplayResponse{
+		Metadatas:  metadatas,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomsMetadataBySymbol implements Query/DenomsMetadataBySymbol gRPC method.
+func (k BaseKeeper) DenomsMetadataBySymbol(c context.Context, req *types.QueryDenomsMetadataBySymbolRequest) (*types.QueryDenomsMetadataBySymbolResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Symbol == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid symbol")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	metadatas, pageRes, err := k.paginateDenomMetadataIndex(ctx, types.CreateDenomMetadataBySymbolPrefix(req.Symbol), req.Pagination, func(metadata types.Metadata) bool {
+		return metadata.Symbol == req.Symbol
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsMetadataBySymbolResponse{
+		Metadatas:  metadatas,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateDenomMetadataIndex paginates the denom metadata of the index entries
+// under indexPrefix. Since the indexed values may contain the terminator of the
+// prefix, the metadata must also match the query.
+func (k BaseKeeper) paginateDenomMetadataIndex(
+	ctx sdk.Context, indexPrefix []byte, pageReq *query.PageRequest, matches func(types.Metadata) bool,
+) ([]types.Metadata, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+
+	metadatas := []types.Metadata{}
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		metadata, found := k.GetDenomMetaData(ctx, string(value))
+		if !found || !matches(metadata) {
+			return false, nil
+		}
+
+		if accumulate {
+			metadatas = append(metadatas, metadata)
+		}
+		return true, nil
+	})
+
+	return metadatas, pageRes, err
+}
+
 // DenomMetadata implements Query/DenomMetadata gRPC method.
 func (k BaseKeeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRequest) (*types.QueryDenomMetadataResponse, error) {
 	if req == nil {
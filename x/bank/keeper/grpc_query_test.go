@@ -365,6 +365,62 @@ func (suite *IntegrationTestSuite) QueryDenomMetadataRequest() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestQueryDenomsMetadataByDisplayAndSymbol() {
+	app, ctx := suite.app, suite.ctx
+	queryClient := suite.queryClient
+
+	atom := suite.getTestMetadata()[0]
+	ibcAtom := types.Metadata{
+		Name:       "Cosmos Hub Atom over IBC",
+		Symbol:     atom.Symbol,
+		DenomUnits: []*types.DenomUnit{{Denom: "ibc/atom"}, {Denom: atom.Display, Exponent: 6}},
+		Base:       "ibc/atom",
+		Display:    atom.Display,
+	}
+	app.BankKeeper.SetDenomMetaData(ctx, atom)
+	app.BankKeeper.SetDenomMetaData(ctx, ibcAtom)
+	app.BankKeeper.SetDenomMetaData(ctx, suite.getTestMetadata()[1])
+
+	displayRes, err := queryClient.DenomsMetadataByDisplay(gocontext.Background(), &types.QueryDenomsMetadataByDisplayRequest{Display: "atom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{ibcAtom, atom}, displayRes.Metadatas)
+
+	symbolRes, err := queryClient.DenomsMetadataBySymbol(gocontext.Background(), &types.QueryDenomsMetadataBySymbolRequest{
+		Symbol:     "ATOM",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{ibcAtom}, symbolRes.Metadatas)
+	suite.Require().Equal(uint64(2), symbolRes.Pagination.Total)
+
+	// replacing the metadata updates the indexes
+	ibcAtom.Symbol = "IBCATOM"
+	ibcAtom.Display = "ibcatom"
+	ibcAtom.DenomUnits[1].Denom = "ibcatom"
+	app.BankKeeper.SetDenomMetaData(ctx, ibcAtom)
+
+	displayRes, err = queryClient.DenomsMetadataByDisplay(gocontext.Background(), &types.QueryDenomsMetadataByDisplayRequest{Display: "atom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{atom}, displayRes.Metadatas)
+
+	displayRes, err = queryClient.DenomsMetadataByDisplay(gocontext.Background(), &types.QueryDenomsMetadataByDisplayRequest{Display: "ibcatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{ibcAtom}, displayRes.Metadatas)
+
+	symbolRes, err = queryClient.DenomsMetadataBySymbol(gocontext.Background(), &types.QueryDenomsMetadataBySymbolRequest{Symbol: "ATOM"})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Metadata{atom}, symbolRes.Metadatas)
+
+	symbolRes, err = queryClient.DenomsMetadataBySymbol(gocontext.Background(), &types.QueryDenomsMetadataBySymbolRequest{Symbol: "BTC"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(symbolRes.Metadatas)
+
+	_, err = queryClient.DenomsMetadataByDisplay(gocontext.Background(), &types.QueryDenomsMetadataByDisplayRequest{})
+	suite.Require().Error(err)
+	_, err = queryClient.DenomsMetadataBySymbol(gocontext.Background(), &types.QueryDenomsMetadataBySymbolRequest{})
+	suite.Require().Error(err)
+}
+
 func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 	ctx := suite.ctx
 
@@ -13,7 +13,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ Keeper = (*BaseKeeper)(nil)
@@ -57,7 +56,6 @@ type BaseKeeper struct {
 	ak                     types.AccountKeeper
 	cdc                    codec.BinaryCodec
 	storeKey               storetypes.StoreKey
-	paramSpace             paramtypes.Subspace
 	mintCoinsRestrictionFn MintingRestrictionFn
 }
 
@@ -89,8 +87,8 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 }
 
 // NewBaseKeeper returns a new BaseKeeper object with a given codec, dedicated
-// store key, an AccountKeeper implementation, and a parameter Subspace used to
-// store and fetch module parameters. The BaseKeeper also accepts a
+// store key, an AccountKeeper implementation and the address of the authority
+// allowed to update the module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
 // by using a SendCoinsFromModuleToAccount execution.
@@ -98,20 +96,14 @@ func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
-	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return BaseKeeper{
-		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, blockedAddrs, authority),
 		ak:                     ak,
 		cdc:                    cdc,
 		storeKey:               storeKey,
-		paramSpace:             paramSpace,
 		mintCoinsRestrictionFn: func(ctx sdk.Context, coins sdk.Coins) error { return nil },
 	}
 }
@@ -303,13 +295,33 @@ func (k BaseKeeper) IterateAllDenomMetaData(ctx sdk.Context, cb func(types.Metad
 	}
 }
 
-// SetDenomMetaData sets the denominations metadata
+// SetDenomMetaData sets the denominations metadata, replacing the existing
+// metadata of the same base denomination, and indexes it by display
+// denomination and symbol.
 func (k BaseKeeper) SetDenomMetaData(ctx sdk.Context, denomMetaData types.Metadata) {
 	store := ctx.KVStore(k.storeKey)
 	denomMetaDataStore := prefix.NewStore(store, types.DenomMetadataPrefix)
 
+	if oldMetadata, found := k.GetDenomMetaData(ctx, denomMetaData.Base); found {
+		store.Delete(denomMetadataByDisplayKey(oldMetadata))
+		store.Delete(denomMetadataBySymbolKey(oldMetadata))
+	}
+
 	m := k.cdc.MustMarshal(&denomMetaData)
 	denomMetaDataStore.Set([]byte(denomMetaData.Base), m)
+
+	// the index entries hold the base denomination, which can't be parsed
+	// unambiguously from the keys
+	store.Set(denomMetadataByDisplayKey(denomMetaData), []byte(denomMetaData.Base))
+	store.Set(denomMetadataBySymbolKey(denomMetaData), []byte(denomMetaData.Base))
+}
+
+func denomMetadataByDisplayKey(metadata types.Metadata) []byte {
+	return append(types.CreateDenomMetadataByDisplayPrefix(metadata.Display), metadata.Base...)
+}
+
+func denomMetadataBySymbolKey(metadata types.Metadata) []byte {
+	return append(types.CreateDenomMetadataBySymbolPrefix(metadata.Symbol), metadata.Base...)
 }
 
 // SendCoinsFromModuleToAccount transfers coins from a ModuleAccount to an AccAddress.
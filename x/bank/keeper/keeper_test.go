@@ -17,13 +17,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -86,12 +89,12 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
-		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		appCodec, app.GetKey(types.StoreKey), authtypes.ProtoBaseAccount,
+		maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keeper := keeper.NewBaseKeeper(
-		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		appCodec, app.GetKey(types.StoreKey), authKeeper, blockedAddrs,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, keeper
@@ -462,6 +465,136 @@ func (suite *IntegrationTestSuite) TestSendCoins() {
 	suite.Require().Equal(newBarCoin(25), coins[0], "expected only bar coins in the account balance, got: %v", coins)
 }
 
+func (suite *IntegrationTestSuite) TestSendCoinsWithRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(50))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	var calls []sdk.AccAddress
+	// bar can't be sent at all, and foo sent to addr2 is redirected to addr3
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, fromAddr)
+		if amt.AmountOf(barDenom).IsPositive() {
+			return nil, fmt.Errorf("%s cannot be sent", barDenom)
+		}
+		if toAddr.Equals(addr2) {
+			return addr3, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(balances, app.BankKeeper.GetAllBalances(ctx, addr1))
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Len(calls, 2)
+
+	// the transfer event reports the rewritten recipient
+	events := ctx.EventManager().Events()
+	transfer := events[len(events)-2]
+	suite.Require().Equal(types.EventTypeTransfer, transfer.Type)
+	suite.Require().Equal(addr3.String(), string(transfer.Attributes[0].Value))
+
+	// module-to-account sends go through the same restriction
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	suite.Require().Error(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Len(calls, 4)
+
+	// a bypass context skips the restriction entirely
+	suite.Require().NoError(app.BankKeeper.SendCoins(types.WithBypass(ctx), addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Len(calls, 4)
+
+	suite.Require().Error(app.BankKeeper.SendCoins(types.WithoutBypass(types.WithBypass(ctx)), addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Len(calls, 5)
+}
+
+func (suite *IntegrationTestSuite) TestInputOutputCoinsWithRestrictions() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	addr4 := sdk.AccAddress("addr4_______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	// funds sent to addr3 are redirected to the sender
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr3) {
+			return fromAddr, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	outputs := []types.Output{
+		{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(90)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr3).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr4))
+
+	// with several inputs the restriction must resolve a single recipient
+	inputs = []types.Input{
+		{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))},
+	}
+	outputs = []types.Output{{Address: addr3.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	outputs = []types.Output{{Address: addr4.String(), Coins: sdk.NewCoins(newFooCoin(20))}}
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr4))
+}
+
+func (suite *IntegrationTestSuite) TestSendRestrictionToBlockedAddr() {
+	app, ctx := suite.app, suite.ctx
+	balances := sdk.NewCoins(newFooCoin(100))
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	mintAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	suite.Require().True(app.BankKeeper.BlockedAddr(mintAddr))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// funds sent to addr2 are redirected to the blocked mint module account
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(addr2) {
+			return mintAddr, nil
+		}
+		return toAddr, nil
+	})
+	defer app.BankKeeper.ClearSendRestriction()
+
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	outputs := []types.Output{{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(10))}}
+	err = app.BankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, mintAddr).IsZero())
+
+	// sending to a blocked address the restriction leaves untouched is left to the callers
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, mintAddr, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, mintAddr))
+}
+
 func (suite *IntegrationTestSuite) TestValidateBalance() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -689,6 +822,89 @@ func (suite *IntegrationTestSuite) TestMsgMultiSendEvents() {
 	suite.Require().Equal(abci.Event(event4), events[27])
 }
 
+func (suite *IntegrationTestSuite) TestMsgBatchSendGas() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+	addr3 := sdk.AccAddress([]byte("addr3_______________"))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 100))))
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 10))
+	msg := types.NewMsgBatchSend([]types.Transfer{
+		types.NewTransfer(addr, addr2, amount),
+		types.NewTransfer(addr, addr3, amount),
+	})
+
+	// the batch is charged before any transfer is executed
+	limitedCtx := ctx.WithGasMeter(sdk.NewGasMeter(2*types.BatchSendGasPerTransfer - 1))
+	suite.Require().Panics(func() {
+		msgServer.BatchSend(sdk.WrapSDKContext(limitedCtx), msg) //nolint:errcheck
+	})
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, err := msgServer.BatchSend(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), 2*types.BatchSendGasPerTransfer)
+	suite.Require().Equal(amount, app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(amount, app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(fooDenom, 80)), app.BankKeeper.GetAllBalances(ctx, addr))
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetDenomMetadata() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	metadata := suite.getTestMetadata()[0]
+
+	invalidMetadata := metadata
+	invalidMetadata.DenomUnits = metadata.DenomUnits[1:]
+
+	testCases := []struct {
+		msg       string
+		req       *types.MsgSetDenomMetadata
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			"invalid authority",
+			types.NewMsgSetDenomMetadata(sdk.AccAddress("addr1_______________"), metadata),
+			true,
+			"invalid authority",
+		},
+		{
+			"invalid denom units",
+			types.NewMsgSetDenomMetadata(authority, invalidMetadata),
+			true,
+			"first denomination unit must be the one with base denom",
+		},
+		{
+			"success",
+			types.NewMsgSetDenomMetadata(authority, metadata),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			_, err := msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), tc.req)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			actualMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+			suite.Require().True(found)
+			suite.Require().Equal(metadata, actualMetadata)
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestSpendableCoins() {
 	app, ctx := suite.app, suite.ctx
 	now := tmtime.Now()
@@ -1053,12 +1269,12 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
-		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), authtypes.ProtoBaseAccount,
+		maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil,
+		suite.app.AccountKeeper, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// set account with multiple permissions
@@ -1180,8 +1396,8 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 	maccPerms[multiPerm] = []string{authtypes.Burner, authtypes.Minter, authtypes.Staking}
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
-		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), authtypes.ProtoBaseAccount,
+		maccPerms, sdk.Bech32MainPrefix, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
 
@@ -1220,7 +1436,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil,
+			suite.app.AccountKeeper, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
@@ -1581,14 +1797,26 @@ func (suite *IntegrationTestSuite) TestGetAllSendEnabledEntries() {
 	})
 }
 
+type mockSubspace struct {
+	ps types.Params
+}
+
+func newMockSubspace(ps types.Params) mockSubspace {
+	return mockSubspace{ps: ps}
+}
+
+func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
+	*ps.(*types.Params) = ms.ps
+}
+
 func (suite *IntegrationTestSuite) TestMigrator_Migrate3to4() {
 	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
 
 	for _, def := range []bool{true, false} {
 		params := types.Params{DefaultSendEnabled: def}
-		bankKeeper.SetParams(ctx, params)
+		require.NoError(suite.T(), bankKeeper.SetParams(ctx, params))
 		suite.T().Run(fmt.Sprintf("default %t does not change", def), func(t *testing.T) {
-			migrator := keeper.NewMigrator(bankKeeper.(keeper.BaseKeeper))
+			migrator := keeper.NewMigrator(bankKeeper.(keeper.BaseKeeper), newMockSubspace(params))
 			require.NoError(t, migrator.Migrate3to4(ctx))
 			actual := bankKeeper.GetParams(ctx)
 			assert.Equal(t, params.DefaultSendEnabled, actual.DefaultSendEnabled)
@@ -1602,9 +1830,8 @@ func (suite *IntegrationTestSuite) TestMigrator_Migrate3to4() {
 				{fmt.Sprintf("falsecoin%t", def), false},
 			},
 		}
-		bankKeeper.SetParams(ctx, params)
 		suite.T().Run(fmt.Sprintf("default %t send enabled info moved to store", def), func(t *testing.T) {
-			migrator := keeper.NewMigrator(bankKeeper.(keeper.BaseKeeper))
+			migrator := keeper.NewMigrator(bankKeeper.(keeper.BaseKeeper), newMockSubspace(params))
 			require.NoError(t, migrator.Migrate3to4(ctx))
 			newParams := bankKeeper.GetParams(ctx)
 			assert.Len(t, newParams.SendEnabled, 0)
@@ -2,19 +2,23 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
+	v048 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v048"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper BaseKeeper
+	keeper         BaseKeeper
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper BaseKeeper) Migrator {
-	return Migrator{keeper: keeper}
+func NewMigrator(keeper BaseKeeper, legacySubspace exported.Subspace) Migrator {
+	return Migrator{keeper: keeper, legacySubspace: legacySubspace}
 }
 
 // Migrate1to2 migrates from version 1 to 2.
@@ -29,8 +33,19 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 // Migrate3to4 migrates x/bank storage from version 3 to 4.
 func (m Migrator) Migrate3to4(ctx sdk.Context) error {
-	oldParams := m.keeper.GetParams(ctx)
+	var oldParams types.Params
+	m.legacySubspace.GetParamSet(ctx, &oldParams)
+
 	m.keeper.SetAllSendEnabled(ctx, oldParams.GetSendEnabled())
-	m.keeper.SetParams(ctx, banktypes.NewParams(oldParams.DefaultSendEnabled))
 	return nil
 }
+
+// Migrate4to5 migrates x/bank storage from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates x/bank storage from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v048.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -102,3 +103,86 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) BatchSend(goCtx context.Context, msg *types.MsgBatchSend) (*types.MsgBatchSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// charge the batch upfront so that its gas grows linearly with its size,
+	// whatever the transfers turn out to cost
+	ctx.GasMeter().ConsumeGas(types.BatchSendGasPerTransfer*uint64(len(msg.Transfers)), "batch send")
+
+	// every transfer is executed with the state of the message, so if one of
+	// them fails none of them is committed
+	for i, transfer := range msg.Transfers {
+		if err := k.IsSendEnabledCoins(ctx, transfer.Amount...); err != nil {
+			return nil, sdkerrors.Wrapf(err, "transfer %d", i)
+		}
+
+		from, err := sdk.AccAddressFromBech32(transfer.FromAddress)
+		if err != nil {
+			return nil, err
+		}
+		to, err := sdk.AccAddressFromBech32(transfer.ToAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.BlockedAddr(to) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", transfer.ToAddress)
+		}
+
+		if err := k.SendCoins(ctx, from, to, transfer.Amount); err != nil {
+			return nil, sdkerrors.Wrapf(err, "transfer %d", i)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgBatchSendResponse{}, nil
+}
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetDenomMetadata implements the Msg/SetDenomMetadata Msg service.
+func (k msgServer) SetDenomMetadata(goCtx context.Context, req *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := req.Metadata.Validate(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetDenomMetaData(ctx, req.Metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, req.Metadata.Base),
+		),
+	)
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
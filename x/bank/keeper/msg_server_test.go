@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.app.BankKeeper)
+	authority := suite.app.BankKeeper.GetAuthority()
+	params := types.NewParams(false)
+	testCases := []struct {
+		name      string
+		input     func() *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: "authority",
+					Params:    params,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "deprecated send enabled",
+			input: func() *types.MsgUpdateParams {
+				params1 := params
+				params1.SendEnabled = []*types.SendEnabled{types.NewSendEnabled(fooDenom, false)} //nolint:staticcheck
+
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "send_enabled",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg := tc.input()
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.Params, suite.app.BankKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // SendKeeper defines a module interface that facilitates the transfer of coins
@@ -20,8 +19,10 @@ type SendKeeper interface {
 	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
+	GetAuthority() string
+
 	GetParams(ctx sdk.Context) types.Params
-	SetParams(ctx sdk.Context, params types.Params)
+	SetParams(ctx sdk.Context, params types.Params) error
 
 	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
 	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
@@ -36,6 +37,10 @@ type SendKeeper interface {
 
 	BlockedAddr(addr sdk.AccAddress) bool
 	GetBlockedAddresses() map[string]bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -45,44 +50,96 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 type BaseSendKeeper struct {
 	BaseViewKeeper
 
-	cdc        codec.BinaryCodec
-	ak         types.AccountKeeper
-	storeKey   storetypes.StoreKey
-	paramSpace paramtypes.Subspace
+	cdc      codec.BinaryCodec
+	ak       types.AccountKeeper
+	storeKey storetypes.StoreKey
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// sendRestriction is shared by all copies of the keeper so restrictions
+	// added after construction are seen by every module holding it.
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ak types.AccountKeeper,
+	blockedAddrs map[string]bool,
+	authority string,
 ) BaseSendKeeper {
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
+// AppendSendRestriction adds the provided SendRestrictionFn to run after previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
+// GetAuthority returns the x/bank module's authority.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of bank parameters.
 //
-// nolint:staticcheck
-func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+// Note: params.SendEnabled is deprecated but it should be here regardless.
+//
+//nolint:staticcheck
+func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) error {
+	// Normally SendEnabled is deprecated but we still support it for backwards
+	// compatibility. Using params.Validate() would fail due to the SendEnabled
+	// deprecation.
 	if len(params.SendEnabled) > 0 {
 		k.SetAllSendEnabled(ctx, params.SendEnabled)
+
+		// override params without SendEnabled
+		params = types.NewParams(params.DefaultSendEnabled)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
 	}
 
-	p := types.NewParams(params.DefaultSendEnabled)
-	k.paramSpace.SetParamSet(ctx, &p)
+	store.Set(types.ParamsKey, bz)
+	return nil
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
@@ -95,7 +152,8 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
@@ -112,6 +170,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 				sdk.NewAttribute(types.AttributeKeySender, in.Address),
 			),
 		)
+		inAddresses[i] = inAddress
 	}
 
 	for _, out := range outputs {
@@ -119,6 +178,12 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		if err != nil {
 			return err
 		}
+
+		outAddress, err = k.applySendRestrictionToOutput(ctx, inAddresses, outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
 		err = k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
@@ -127,7 +192,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -146,10 +211,58 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	return nil
 }
 
+// applySendRestrictionToOutput runs the send restriction for a multi-send
+// output against every input. Since the output is funded by all inputs
+// together, each of them must agree on the (possibly rewritten) recipient.
+func (k BaseSendKeeper) applySendRestrictionToOutput(ctx sdk.Context, inAddresses []sdk.AccAddress, outAddress sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	var newOutAddress sdk.AccAddress
+	for i, inAddress := range inAddresses {
+		toAddr, err := k.sendRestriction.apply(ctx, inAddress, outAddress, amt)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 && !toAddr.Equals(newOutAddress) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "send restrictions resolved conflicting recipients %s and %s for output %s", newOutAddress, toAddr, outAddress)
+		}
+		newOutAddress = toAddr
+	}
+
+	if err := k.checkRedirectedRecipient(outAddress, newOutAddress); err != nil {
+		return nil, err
+	}
+
+	return newOutAddress, nil
+}
+
+// checkRedirectedRecipient rejects the recipient a send restriction redirected
+// a transfer to if that address is not allowed to receive funds. The original
+// recipient is checked by the callers, which may legitimately send to blocked
+// module accounts.
+func (k BaseSendKeeper) checkRedirectedRecipient(origAddr, newAddr sdk.AccAddress) error {
+	if !newAddr.Equals(origAddr) && k.BlockedAddr(newAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", newAddr)
+	}
+
+	return nil
+}
+
 // SendCoins transfers amt coins from a sending account to a receiving account.
+// The registered send restriction, if any, is run first and may reject the
+// transfer or change the receiving account, which must then not be blocked.
 // An error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	newToAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkRedirectedRecipient(toAddr, newToAddr); err != nil {
+		return err
+	}
+	toAddr = newToAddr
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -466,3 +579,41 @@ func (k BaseSendKeeper) getSendEnabledOrDefault(store sdk.KVStore, denom string,
 	}
 	return getDefault()
 }
+
+// sendRestriction is a struct that houses a SendRestrictionFn.
+// It exists so that the SendRestrictionFn can be updated in the SendKeeper
+// without needing to have a pointer receiver.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// apply applies the send restriction if there is one. If not, it's a no-op.
+// Restrictions are skipped when the context has been marked with types.WithBypass.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil || r.fn == nil || types.HasBypass(ctx) {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
@@ -0,0 +1,45 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/exported"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+type mockSubspace struct {
+	ps types.Params
+}
+
+func newMockSubspace(ps types.Params) mockSubspace {
+	return mockSubspace{ps: ps}
+}
+
+func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
+	*ps.(*types.Params) = ms.ps
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	legacySubspace := newMockSubspace(types.Params{
+		SendEnabled:        []*types.SendEnabled{{Denom: "foocoin", Enabled: false}},
+		DefaultSendEnabled: false,
+	})
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+
+	var res types.Params
+	bz := store.Get(types.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, types.NewParams(false), res)
+}
@@ -0,0 +1,38 @@
+package v048
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.47 to v0.48. The
+// migration includes:
+// - Index the denom metadata by display denomination and symbol.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// collect the metadata first, as the store must not be written to while
+	// iterating
+	var metadatas []types.Metadata
+	iterator := prefix.NewStore(store, types.DenomMetadataPrefix).Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.Metadata
+		if err := cdc.Unmarshal(iterator.Value(), &metadata); err != nil {
+			iterator.Close()
+			return err
+		}
+		metadatas = append(metadatas, metadata)
+	}
+	iterator.Close()
+
+	for _, metadata := range metadatas {
+		base := []byte(metadata.Base)
+		store.Set(append(types.CreateDenomMetadataByDisplayPrefix(metadata.Display), base...), base)
+		store.Set(append(types.CreateDenomMetadataBySymbolPrefix(metadata.Symbol), base...), base)
+	}
+
+	return nil
+}
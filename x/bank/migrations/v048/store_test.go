@@ -0,0 +1,49 @@
+package v048_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v048 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v048"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	metadatas := []types.Metadata{
+		{
+			Name:       "Cosmos Hub Atom",
+			Symbol:     "ATOM",
+			Base:       "uatom",
+			Display:    "atom",
+			DenomUnits: []*types.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+		},
+		{
+			Name:       "Osmosis",
+			Symbol:     "OSMO",
+			Base:       "uosmo",
+			Display:    "osmo",
+			DenomUnits: []*types.DenomUnit{{Denom: "uosmo"}, {Denom: "osmo", Exponent: 6}},
+		},
+	}
+	for _, metadata := range metadatas {
+		store.Set(append(types.DenomMetadataPrefix, metadata.Base...), cdc.MustMarshal(&metadata))
+	}
+
+	require.NoError(t, v048.MigrateStore(ctx, storeKey, cdc))
+
+	for _, metadata := range metadatas {
+		base := []byte(metadata.Base)
+		require.Equal(t, base, store.Get(append(types.CreateDenomMetadataByDisplayPrefix(metadata.Display), base...)))
+		require.Equal(t, base, store.Get(append(types.CreateDenomMetadataBySymbolPrefix(metadata.Symbol), base...)))
+	}
+}
@@ -21,13 +21,14 @@ import (
 const (
 	OpWeightMsgSend      = "op_weight_msg_send"
 	OpWeightMsgMultiSend = "op_weight_msg_multisend"
+	OpWeightMsgBatchSend = "op_weight_msg_batchsend"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend, weightMsgMultiSend int
+	var weightMsgSend, weightMsgMultiSend, weightMsgBatchSend int
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
 			weightMsgSend = simappparams.DefaultWeightMsgSend
@@ -40,6 +41,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgBatchSend, &weightMsgBatchSend, nil,
+		func(_ *rand.Rand) {
+			weightMsgBatchSend = simappparams.DefaultWeightMsgBatchSend
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
@@ -49,6 +56,10 @@ func WeightedOperations(
 			weightMsgMultiSend,
 			SimulateMsgMultiSend(ak, bk),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgBatchSend,
+			SimulateMsgBatchSend(ak, bk),
+		),
 	}
 }
 
@@ -372,6 +383,110 @@ func sendMsgMultiSend(
 	return nil
 }
 
+// SimulateMsgBatchSend tests and runs a single msg batch send, with a randomized,
+// capped number of transfers from distinct senders.
+// all accounts in msg fields exist in state
+func SimulateMsgBatchSend(ak types.AccountKeeper, bk keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// random number of transfers between [1, 3]
+		transfers := make([]types.Transfer, r.Intn(3)+1)
+
+		// collect signer privKeys
+		privs := make([]cryptotypes.PrivKey, len(transfers))
+
+		// use map to check if address already sends in a previous transfer
+		usedAddrs := make(map[string]bool)
+
+		for i := range transfers {
+			from, to, coins, skip := randomSendFields(r, ctx, accs, bk, ak)
+
+			// make sure account is fresh and not used in previous transfer
+			for usedAddrs[from.Address.String()] {
+				from, to, coins, skip = randomSendFields(r, ctx, accs, bk, ak)
+			}
+
+			if skip {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBatchSend, "skip all transfers"), nil, nil
+			}
+
+			// Check send_enabled status of each coin denom
+			if err := bk.IsSendEnabledCoins(ctx, coins...); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBatchSend, err.Error()), nil, nil
+			}
+
+			usedAddrs[from.Address.String()] = true
+			privs[i] = from.PrivKey
+			transfers[i] = types.NewTransfer(from.Address, to.Address, coins)
+		}
+
+		msg := types.NewMsgBatchSend(transfers)
+		err := sendMsgBatchSend(r, app, bk, ak, msg, ctx, chainID, privs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "invalid transfers"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, "", nil), nil, nil
+	}
+}
+
+// sendMsgBatchSend sends a transaction with a MsgBatchSend signed by every
+// sender of the batch.
+func sendMsgBatchSend(
+	r *rand.Rand, app *baseapp.BaseApp, bk keeper.Keeper, ak types.AccountKeeper,
+	msg *types.MsgBatchSend, ctx sdk.Context, chainID string, privkeys []cryptotypes.PrivKey,
+) error {
+	signers := msg.GetSigners()
+	accountNumbers := make([]uint64, len(signers))
+	sequenceNumbers := make([]uint64, len(signers))
+
+	for i, addr := range signers {
+		acc := ak.GetAccount(ctx, addr)
+		accountNumbers[i] = acc.GetAccountNumber()
+		sequenceNumbers[i] = acc.GetSequence()
+	}
+
+	var (
+		fees sdk.Coins
+		err  error
+	)
+
+	// feePayer is the first signer, i.e. the sender of the first transfer
+	spendable := bk.SpendableCoins(ctx, signers[0])
+
+	coins, hasNeg := spendable.SafeSub(msg.Transfers[0].Amount...)
+	if !hasNeg {
+		fees, err = simtypes.RandomFees(r, ctx, coins)
+		if err != nil {
+			return err
+		}
+	}
+
+	txGen := simappparams.MakeTestEncodingConfig().TxConfig
+	tx, err := simtestutil.GenSignedMockTx(
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		accountNumbers,
+		sequenceNumbers,
+		privkeys...,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // randomSendFields returns the sender and recipient simulation accounts as well
 // as the transferred amount.
 func randomSendFields(
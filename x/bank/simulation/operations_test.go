@@ -50,6 +50,7 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 	}{
 		{simappparams.DefaultWeightMsgSend, types.ModuleName, types.TypeMsgSend},
 		{simappparams.DefaultWeightMsgMultiSend, types.ModuleName, types.TypeMsgMultiSend},
+		{simappparams.DefaultWeightMsgBatchSend, types.ModuleName, types.TypeMsgBatchSend},
 	}
 
 	for i, w := range weightesOps {
@@ -125,6 +126,34 @@ func (suite *SimTestSuite) TestSimulateMsgMultiSend() {
 	require.Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgBatchSend() {
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 3)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: suite.app.LastBlockHeight() + 1, AppHash: suite.app.LastCommitID().Hash}})
+
+	// execute operation
+	op := simulation.SimulateMsgBatchSend(suite.app.AccountKeeper, suite.app.BankKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	require := suite.Require()
+	require.NoError(err)
+
+	var msg types.MsgBatchSend
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(operationMsg.OK)
+	require.Len(msg.Transfers, 3)
+	require.Equal("cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", msg.Transfers[1].FromAddress)
+	require.Equal("cosmos1p8wcgrjr4pjju90xg6u9cgq55dxwq8j7u4x9a0", msg.Transfers[1].ToAddress)
+	require.Equal("141880599stake", msg.Transfers[1].Amount.String())
+	require.Equal(types.TypeMsgBatchSend, msg.Type())
+	require.Equal(types.ModuleName, msg.Route())
+	require.Len(futureOperations, 0)
+}
+
 func (suite *SimTestSuite) TestSimulateModuleAccountMsgSend() {
 	const (
 		accCount       = 1
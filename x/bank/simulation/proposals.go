@@ -0,0 +1,37 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+const OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams flipping the default send
+// enabled flag of the current bank params at random.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := k.GetParams(ctx)
+		params.DefaultSendEnabled = RandomGenesisDefaultSendEnabledParam(r)
+
+		return &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+	}
+}
@@ -0,0 +1,36 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestProposalMsgs(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs(app.BankKeeper)
+	require.Len(t, weightedProposalMsgs, 1)
+
+	w0 := weightedProposalMsgs[0]
+
+	// tests w0 interface:
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w0.AppParamsKey())
+	require.Equal(t, simtestutil.DefaultWeightMsgUpdateParams, w0.DefaultWeight())
+
+	msg, ok := w0.MsgSimulatorFn()(r, ctx, nil).(*types.MsgUpdateParams)
+	require.True(t, ok)
+	require.Equal(t, app.BankKeeper.GetAuthority(), msg.Authority)
+	require.NoError(t, msg.ValidateBasic())
+}
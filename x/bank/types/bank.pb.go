@@ -216,6 +216,48 @@ func (m *Output) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Output proto.InternalMessageInfo
 
+// Transfer models a single transfer of a MsgBatchSend.
+//
+// Since: cosmos-sdk 0.47
+type Transfer struct {
+	FromAddress string                                   `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	ToAddress   string                                   `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Amount      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *Transfer) Reset()         { *m = Transfer{} }
+func (m *Transfer) String() string { return proto.CompactTextString(m) }
+func (*Transfer) ProtoMessage()    {}
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{4}
+}
+func (m *Transfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Transfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Transfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transfer.Merge(m, src)
+}
+func (m *Transfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transfer proto.InternalMessageInfo
+
 // Supply represents a struct that passively keeps track of the total supply
 // amounts in the network.
 // This message is deprecated now that supply is indexed by denom.
@@ -229,7 +271,7 @@ func (m *Supply) Reset()         { *m = Supply{} }
 func (m *Supply) String() string { return proto.CompactTextString(m) }
 func (*Supply) ProtoMessage()    {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{4}
+	return fileDescriptor_dd052eee12edf988, []int{5}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,7 +319,7 @@ func (m *DenomUnit) Reset()         { *m = DenomUnit{} }
 func (m *DenomUnit) String() string { return proto.CompactTextString(m) }
 func (*DenomUnit) ProtoMessage()    {}
 func (*DenomUnit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{5}
+	return fileDescriptor_dd052eee12edf988, []int{6}
 }
 func (m *DenomUnit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -362,7 +404,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd052eee12edf988, []int{6}
+	return fileDescriptor_dd052eee12edf988, []int{7}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,6 +494,7 @@ func init() {
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
 	proto.RegisterType((*Input)(nil), "cosmos.bank.v1beta1.Input")
 	proto.RegisterType((*Output)(nil), "cosmos.bank.v1beta1.Output")
+	proto.RegisterType((*Transfer)(nil), "cosmos.bank.v1beta1.Transfer")
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
@@ -460,48 +503,52 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 655 bytes of a gzipped FileDescriptorProto
+	// 711 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x31, 0x6f, 0x13, 0x49,
-	0x14, 0xf6, 0xd8, 0xb1, 0xbd, 0x19, 0xdf, 0x35, 0x73, 0xd6, 0xdd, 0x24, 0xc5, 0xda, 0xda, 0xe2,
-	0x64, 0x22, 0xc5, 0x76, 0x02, 0x95, 0x85, 0x84, 0x70, 0x40, 0xc1, 0x48, 0x08, 0xb4, 0x51, 0x84,
-	0x44, 0x63, 0x8d, 0xbd, 0x83, 0x3d, 0xca, 0xee, 0xcc, 0x6a, 0x67, 0x36, 0x8a, 0x5b, 0x2a, 0xa0,
-	0xa2, 0xa4, 0x4c, 0x0b, 0x15, 0x45, 0x24, 0xfe, 0x42, 0x44, 0x15, 0x51, 0x51, 0x05, 0xe4, 0x14,
-	0xf0, 0x33, 0xd0, 0xcc, 0xec, 0x3a, 0x89, 0x14, 0x10, 0x05, 0x05, 0xd5, 0xbe, 0xf7, 0xbe, 0x37,
-	0xdf, 0xfb, 0xfc, 0xe6, 0x1b, 0x43, 0x77, 0x2c, 0x64, 0x24, 0x64, 0x67, 0x44, 0xf8, 0x5e, 0x67,
-	0x7f, 0x63, 0x44, 0x15, 0xd9, 0x30, 0x49, 0x3b, 0x4e, 0x84, 0x12, 0xe8, 0x1f, 0x8b, 0xb7, 0x4d,
-	0x29, 0xc3, 0x57, 0xeb, 0x13, 0x31, 0x11, 0x06, 0xef, 0xe8, 0xc8, 0xb6, 0xae, 0xae, 0xd8, 0xd6,
-	0xa1, 0x05, 0xb2, 0x73, 0x16, 0x3a, 0x9f, 0x22, 0xe9, 0x62, 0xca, 0x58, 0x30, 0x9e, 0xe1, 0xff,
-	0x65, 0x78, 0x24, 0x27, 0x9d, 0xfd, 0x0d, 0xfd, 0xb1, 0x80, 0xf7, 0x02, 0xc0, 0xca, 0x23, 0x92,
-	0x90, 0x48, 0xa2, 0x6d, 0xf8, 0x97, 0xa4, 0x3c, 0x18, 0x52, 0x4e, 0x46, 0x21, 0x0d, 0x30, 0x68,
-	0x96, 0x5a, 0xb5, 0xcd, 0x66, 0xfb, 0x0a, 0x81, 0xed, 0x1d, 0xca, 0x83, 0xbb, 0xb6, 0xaf, 0x5f,
-	0xc4, 0xc0, 0xaf, 0xc9, 0xf3, 0x02, 0xea, 0xc2, 0x7a, 0x40, 0x9f, 0x92, 0x34, 0x54, 0xc3, 0x4b,
-	0x84, 0xc5, 0x26, 0x68, 0x39, 0x3e, 0xca, 0xb0, 0x0b, 0x14, 0xbd, 0xa5, 0xd7, 0x87, 0x8d, 0x82,
-	0xb7, 0x0d, 0x6b, 0x17, 0x8a, 0xa8, 0x0e, 0xcb, 0x01, 0xe5, 0x22, 0xc2, 0xa0, 0x09, 0x5a, 0xcb,
-	0xbe, 0x4d, 0x10, 0x86, 0xd5, 0xcb, 0x7c, 0x79, 0xda, 0x73, 0x34, 0xc9, 0xb7, 0xc3, 0x06, 0xf0,
-	0x8e, 0x00, 0x2c, 0x0f, 0x78, 0x9c, 0x2a, 0xb4, 0x09, 0xab, 0x24, 0x08, 0x12, 0x2a, 0xa5, 0x65,
-	0xe9, 0xe3, 0x8f, 0x47, 0xeb, 0xf5, 0xec, 0x17, 0xdd, 0xb6, 0xc8, 0x8e, 0x4a, 0x18, 0x9f, 0xf8,
-	0x79, 0x23, 0x22, 0xb0, 0xac, 0x37, 0x27, 0x71, 0xd1, 0x2c, 0x60, 0xe5, 0x7c, 0x01, 0x92, 0x2e,
-	0x16, 0xb0, 0x25, 0x18, 0xef, 0x77, 0x8f, 0x4f, 0x1b, 0x85, 0xb7, 0x9f, 0x1b, 0xad, 0x09, 0x53,
-	0xd3, 0x74, 0xd4, 0x1e, 0x8b, 0x28, 0xbb, 0x96, 0xec, 0xb3, 0x2e, 0x83, 0xbd, 0x8e, 0x9a, 0xc5,
-	0x54, 0x9a, 0x03, 0xd2, 0xb7, 0xcc, 0xbd, 0xfa, 0x73, 0x2b, 0xb5, 0xf0, 0xec, 0xeb, 0xbb, 0xb5,
-	0x7c, 0xb0, 0xf7, 0x06, 0xc0, 0xca, 0xc3, 0x54, 0xfd, 0xc1, 0xba, 0x9d, 0x5c, 0xb7, 0xf7, 0x1e,
-	0xc0, 0xca, 0x4e, 0x1a, 0xc7, 0xe1, 0x4c, 0xcf, 0x55, 0x42, 0x91, 0x30, 0x33, 0xcc, 0xef, 0x9d,
-	0x6b, 0x98, 0x7b, 0xf7, 0xb3, 0xb9, 0xe0, 0xc3, 0xd1, 0xfa, 0xcd, 0xb5, 0x9f, 0x9e, 0x3e, 0xb0,
-	0x2f, 0x2d, 0x62, 0x93, 0x84, 0x28, 0x26, 0xb8, 0xec, 0xec, 0x77, 0x6f, 0x74, 0xdb, 0x56, 0xeb,
-	0x00, 0x03, 0xef, 0x31, 0x5c, 0xbe, 0xa3, 0x9d, 0xb4, 0xcb, 0x99, 0xfa, 0x81, 0xc7, 0x56, 0xa1,
-	0x43, 0x0f, 0x62, 0xc1, 0x29, 0x57, 0xc6, 0x64, 0x7f, 0xfb, 0x8b, 0x5c, 0xfb, 0x8f, 0x84, 0x8c,
-	0x48, 0x2a, 0x71, 0xa9, 0x59, 0x6a, 0x2d, 0xfb, 0x79, 0xea, 0xbd, 0x2c, 0x42, 0xe7, 0x01, 0x55,
-	0x24, 0x20, 0x8a, 0xa0, 0x26, 0xac, 0x05, 0x54, 0x8e, 0x13, 0x16, 0x6b, 0x11, 0x19, 0xfd, 0xc5,
-	0x12, 0xba, 0xa5, 0x3b, 0xb8, 0x88, 0x86, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xca, 0xd7, 0xb6,
-	0xd0, 0xeb, 0xc3, 0x20, 0x0f, 0x25, 0x42, 0x70, 0x49, 0xaf, 0x18, 0x97, 0x0c, 0xb7, 0x89, 0xb5,
-	0xba, 0x80, 0xc9, 0x38, 0x24, 0x33, 0xbc, 0x64, 0xca, 0x79, 0xaa, 0xbb, 0x39, 0x89, 0x28, 0x2e,
-	0xdb, 0x6e, 0x1d, 0xa3, 0x7f, 0x61, 0x45, 0xce, 0xa2, 0x91, 0x08, 0x71, 0xc5, 0x54, 0xb3, 0x0c,
-	0xad, 0xc0, 0x52, 0x9a, 0x30, 0x5c, 0x35, 0xce, 0xab, 0xce, 0x4f, 0x1b, 0xa5, 0x5d, 0x7f, 0xe0,
-	0xeb, 0x1a, 0xfa, 0x1f, 0x3a, 0x69, 0xc2, 0x86, 0x53, 0x22, 0xa7, 0xd8, 0x31, 0x78, 0x6d, 0x7e,
-	0xda, 0xa8, 0xee, 0xfa, 0x83, 0x7b, 0x44, 0x4e, 0xfd, 0x6a, 0x9a, 0x30, 0x1d, 0xf4, 0xb7, 0x8e,
-	0xe7, 0x2e, 0x38, 0x99, 0xbb, 0xe0, 0xcb, 0xdc, 0x05, 0xaf, 0xce, 0xdc, 0xc2, 0xc9, 0x99, 0x5b,
-	0xf8, 0x74, 0xe6, 0x16, 0x9e, 0x5c, 0xfb, 0x95, 0xeb, 0x33, 0x1e, 0x18, 0x55, 0xcc, 0x7f, 0xd4,
-	0xf5, 0xef, 0x01, 0x00, 0x00, 0xff, 0xff, 0xec, 0xf7, 0xce, 0x43, 0x44, 0x05, 0x00, 0x00,
+	0x14, 0xf6, 0xd8, 0xb1, 0xbd, 0x1e, 0xe7, 0x9a, 0x39, 0xeb, 0x6e, 0x9c, 0x62, 0x6d, 0x6d, 0x71,
+	0xf2, 0x45, 0x8a, 0xed, 0xe4, 0x4e, 0x3a, 0xc9, 0x77, 0xd2, 0x09, 0x07, 0x14, 0x8c, 0x84, 0x40,
+	0x1b, 0x22, 0x24, 0x1a, 0x6b, 0xec, 0x9d, 0xd8, 0xab, 0x78, 0x67, 0x56, 0x3b, 0xb3, 0x51, 0xdc,
+	0x21, 0x2a, 0xa0, 0xa2, 0xa4, 0x4c, 0x0b, 0x15, 0x45, 0x24, 0xfe, 0x42, 0x44, 0x15, 0x51, 0x51,
+	0x05, 0xe4, 0x14, 0xf0, 0x33, 0xd0, 0xcc, 0xec, 0x3a, 0x8e, 0x14, 0x20, 0x45, 0x0a, 0xaa, 0x79,
+	0xef, 0x7d, 0xef, 0x7d, 0xf3, 0xcd, 0x7b, 0x33, 0x03, 0xed, 0x21, 0x17, 0x01, 0x17, 0xad, 0x01,
+	0x61, 0x7b, 0xad, 0xfd, 0xf5, 0x01, 0x95, 0x64, 0x5d, 0x3b, 0xcd, 0x30, 0xe2, 0x92, 0xa3, 0x5f,
+	0x0d, 0xde, 0xd4, 0xa1, 0x04, 0x5f, 0xa9, 0x8c, 0xf8, 0x88, 0x6b, 0xbc, 0xa5, 0x2c, 0x93, 0xba,
+	0x52, 0x35, 0xa9, 0x7d, 0x03, 0x24, 0x75, 0x06, 0x3a, 0xdf, 0x45, 0xd0, 0xf9, 0x2e, 0x43, 0xee,
+	0xb3, 0x04, 0xff, 0x3d, 0xc1, 0x03, 0x31, 0x6a, 0xed, 0xaf, 0xab, 0xc5, 0x00, 0xce, 0x33, 0x00,
+	0x0b, 0xf7, 0x49, 0x44, 0x02, 0x81, 0xb6, 0xe0, 0xb2, 0xa0, 0xcc, 0xeb, 0x53, 0x46, 0x06, 0x13,
+	0xea, 0x61, 0x50, 0xcf, 0x35, 0xca, 0x1b, 0xf5, 0xe6, 0x25, 0x02, 0x9b, 0xdb, 0x94, 0x79, 0xb7,
+	0x4c, 0x5e, 0x37, 0x8b, 0x81, 0x5b, 0x16, 0xe7, 0x01, 0xd4, 0x86, 0x15, 0x8f, 0xee, 0x92, 0x78,
+	0x22, 0xfb, 0x17, 0x08, 0xb3, 0x75, 0xd0, 0xb0, 0x5c, 0x94, 0x60, 0x0b, 0x14, 0x9d, 0xa5, 0x97,
+	0x87, 0xb5, 0x8c, 0xb3, 0x05, 0xcb, 0x0b, 0x41, 0x54, 0x81, 0x79, 0x8f, 0x32, 0x1e, 0x60, 0x50,
+	0x07, 0x8d, 0x92, 0x6b, 0x1c, 0x84, 0x61, 0xf1, 0x22, 0x5f, 0xea, 0x76, 0x2c, 0x45, 0xf2, 0xe5,
+	0xb0, 0x06, 0x9c, 0x23, 0x00, 0xf3, 0x3d, 0x16, 0xc6, 0x12, 0x6d, 0xc0, 0x22, 0xf1, 0xbc, 0x88,
+	0x0a, 0x61, 0x58, 0xba, 0xf8, 0xfd, 0xd1, 0x5a, 0x25, 0x39, 0xd1, 0x0d, 0x83, 0x6c, 0xcb, 0xc8,
+	0x67, 0x23, 0x37, 0x4d, 0x44, 0x04, 0xe6, 0x55, 0xe7, 0x04, 0xce, 0xea, 0x06, 0x54, 0xcf, 0x1b,
+	0x20, 0xe8, 0xbc, 0x01, 0x9b, 0xdc, 0x67, 0xdd, 0xf6, 0xf1, 0x69, 0x2d, 0xf3, 0xfa, 0x63, 0xad,
+	0x31, 0xf2, 0xe5, 0x38, 0x1e, 0x34, 0x87, 0x3c, 0x48, 0xc6, 0x92, 0x2c, 0x6b, 0xc2, 0xdb, 0x6b,
+	0xc9, 0x69, 0x48, 0x85, 0x2e, 0x10, 0xae, 0x61, 0xee, 0x54, 0x9e, 0x1a, 0xa9, 0x99, 0x27, 0x9f,
+	0xdf, 0xac, 0xa6, 0x1b, 0x3b, 0xaf, 0x00, 0x2c, 0xdc, 0x8b, 0xe5, 0x4f, 0xac, 0xdb, 0x4a, 0x75,
+	0x3b, 0x8f, 0xb3, 0xd0, 0x7a, 0x10, 0x11, 0x26, 0x76, 0x69, 0x84, 0xfe, 0x85, 0xcb, 0xbb, 0x11,
+	0x0f, 0xfa, 0x57, 0x95, 0x5c, 0x56, 0xd9, 0x49, 0x08, 0xfd, 0x03, 0xa1, 0xe4, 0xf3, 0xd2, 0xec,
+	0x0f, 0x4a, 0x4b, 0x92, 0xa7, 0x85, 0x43, 0x58, 0x20, 0x01, 0x8f, 0x99, 0xc4, 0xb9, 0xeb, 0x3f,
+	0x70, 0x42, 0xdd, 0xa9, 0x2e, 0x4e, 0xea, 0xc2, 0x29, 0x9d, 0xb7, 0x00, 0x16, 0xb6, 0xe3, 0x30,
+	0x9c, 0x4c, 0x55, 0xeb, 0x25, 0x97, 0x64, 0x82, 0xc1, 0xf5, 0x2b, 0x31, 0xcc, 0x9d, 0x3b, 0x89,
+	0x10, 0xf0, 0xee, 0x68, 0xed, 0xbf, 0xd5, 0xef, 0x56, 0x1f, 0x98, 0xcf, 0x26, 0xf0, 0x47, 0x11,
+	0x91, 0x3e, 0x67, 0xa2, 0xb5, 0xdf, 0xfe, 0xbb, 0xdd, 0x34, 0x5a, 0x7b, 0x18, 0x38, 0x0f, 0x61,
+	0xe9, 0xa6, 0x7a, 0x4c, 0x3b, 0xcc, 0x97, 0xdf, 0x78, 0x66, 0x2b, 0xd0, 0xa2, 0x07, 0x21, 0x67,
+	0x94, 0x49, 0x3d, 0x93, 0x5f, 0xdc, 0xb9, 0xaf, 0x9e, 0x20, 0x99, 0xf8, 0x44, 0x50, 0xa1, 0x3b,
+	0x5f, 0x72, 0x53, 0xd7, 0x79, 0x9e, 0x85, 0xd6, 0x5d, 0x2a, 0x89, 0x47, 0x24, 0x41, 0x75, 0x58,
+	0xf6, 0xa8, 0x18, 0x46, 0x7e, 0xa8, 0x44, 0x24, 0xf4, 0x8b, 0x21, 0xf4, 0xbf, 0xca, 0x60, 0x3c,
+	0xe8, 0xc7, 0xcc, 0x97, 0xe9, 0xbd, 0xb5, 0x2f, 0xfd, 0x70, 0xe6, 0x7a, 0x5d, 0xe8, 0xa5, 0xa6,
+	0x40, 0x08, 0x2e, 0xa9, 0x16, 0xe3, 0x9c, 0xe6, 0xd6, 0xb6, 0x52, 0xe7, 0xf9, 0x22, 0x9c, 0x90,
+	0x29, 0x5e, 0xd2, 0xe1, 0xd4, 0x55, 0xd9, 0x8c, 0x04, 0x14, 0xe7, 0x4d, 0xb6, 0xb2, 0xd1, 0x6f,
+	0xb0, 0x20, 0xa6, 0xc1, 0x80, 0x4f, 0x70, 0x41, 0x47, 0x13, 0x0f, 0x55, 0x61, 0x2e, 0x8e, 0x7c,
+	0x5c, 0xd4, 0xd7, 0xb1, 0x38, 0x3b, 0xad, 0xe5, 0x76, 0xdc, 0x9e, 0xab, 0x62, 0xe8, 0x0f, 0x68,
+	0xc5, 0x91, 0xdf, 0x1f, 0x13, 0x31, 0xc6, 0x96, 0xc6, 0xcb, 0xb3, 0xd3, 0x5a, 0x71, 0xc7, 0xed,
+	0xdd, 0x26, 0x62, 0xec, 0x16, 0xe3, 0xc8, 0x57, 0x46, 0x77, 0xf3, 0x78, 0x66, 0x83, 0x93, 0x99,
+	0x0d, 0x3e, 0xcd, 0x6c, 0xf0, 0xe2, 0xcc, 0xce, 0x9c, 0x9c, 0xd9, 0x99, 0x0f, 0x67, 0x76, 0xe6,
+	0xd1, 0x9f, 0x57, 0x19, 0x9f, 0xbe, 0x03, 0x83, 0x82, 0xfe, 0xa6, 0xff, 0xfa, 0x3a, 0x00, 0xf9,
+	0x68, 0xb2, 0x84, 0x47, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -735,6 +782,57 @@ func (m *Output) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Transfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Supply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -985,6 +1083,29 @@ func (m *Output) Size() (n int) {
 	return n
 }
 
+func (m *Transfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Supply) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1509,6 +1630,154 @@ func (m *Output) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Transfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Supply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,11 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// denom metadata events name and attributes
+	EventTypeSetDenomMetadata = "set_denom_metadata"
+
+	AttributeKeyDenom = "denom"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
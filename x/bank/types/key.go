@@ -32,6 +32,17 @@ var (
 
 	// SendEnabledPrefix is the prefix for the SendDisabled flags for a Denom.
 	SendEnabledPrefix = []byte{0x04}
+
+	// ParamsKey is the prefix for x/bank parameters
+	ParamsKey = []byte{0x05}
+
+	// DenomMetadataByDisplayPrefix is the prefix for the index of the denom
+	// metadata by display denomination.
+	DenomMetadataByDisplayPrefix = []byte{0x06}
+
+	// DenomMetadataBySymbolPrefix is the prefix for the index of the denom
+	// metadata by symbol.
+	DenomMetadataBySymbolPrefix = []byte{0x07}
 )
 
 const (
@@ -86,6 +97,28 @@ func CreateSendEnabledKey(denom string) []byte {
 	return key
 }
 
+// CreateDenomMetadataByDisplayPrefix creates the prefix of the index entries of
+// the denom metadata with the given display denomination.
+func CreateDenomMetadataByDisplayPrefix(display string) []byte {
+	return createDenomMetadataIndexPrefix(DenomMetadataByDisplayPrefix, display)
+}
+
+// CreateDenomMetadataBySymbolPrefix creates the prefix of the index entries of
+// the denom metadata with the given symbol.
+func CreateDenomMetadataBySymbolPrefix(symbol string) []byte {
+	return createDenomMetadataIndexPrefix(DenomMetadataBySymbolPrefix, symbol)
+}
+
+// createDenomMetadataIndexPrefix creates the prefix of a denom metadata index
+// entry, the value being indexed is followed by a null byte terminator. The
+// index entries are the prefix followed by the base denomination.
+func createDenomMetadataIndexPrefix(prefix []byte, value string) []byte {
+	key := make([]byte, len(prefix)+len(value)+1)
+	copy(key, prefix)
+	copy(key[len(prefix):], value)
+	return key
+}
+
 // IsTrueB returns true if the provided byte slice has exactly one byte, and it is equal to TrueB.
 func IsTrueB(bz []byte) bool {
 	return len(bz) == 1 && bz[0] == TrueB
@@ -9,6 +9,7 @@ import (
 const (
 	TypeMsgSend           = "send"
 	TypeMsgMultiSend      = "multisend"
+	TypeMsgBatchSend      = "batchsend"
 	TypeMsgSetSendEnabled = "set-send-enabled"
 )
 
@@ -102,6 +103,103 @@ func (msg MsgMultiSend) GetSigners() []sdk.AccAddress {
 	return addrs
 }
 
+const (
+	// MaxBatchSendTransfers is the maximum number of transfers of a MsgBatchSend.
+	MaxBatchSendTransfers = 1000
+
+	// BatchSendGasPerTransfer is the gas charged for each transfer of a
+	// MsgBatchSend before any of them is executed, on top of the gas of the
+	// store accesses. This makes the gas of a batch grow linearly with its size
+	// and lets an oversized batch fail before doing any work.
+	BatchSendGasPerTransfer uint64 = 10000
+)
+
+var _ sdk.Msg = &MsgBatchSend{}
+
+// NewMsgBatchSend - construct a msg executing all the transfers atomically.
+func NewMsgBatchSend(transfers []Transfer) *MsgBatchSend {
+	return &MsgBatchSend{Transfers: transfers}
+}
+
+// Route Implements Msg
+func (msg MsgBatchSend) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgBatchSend) Type() string { return TypeMsgBatchSend }
+
+// ValidateBasic Implements Msg.
+func (msg MsgBatchSend) ValidateBasic() error {
+	if len(msg.Transfers) == 0 {
+		return ErrNoTransfers
+	}
+
+	if len(msg.Transfers) > MaxBatchSendTransfers {
+		return ErrTooManyTransfers.Wrapf("got %d transfers, maximum is %d", len(msg.Transfers), MaxBatchSendTransfers)
+	}
+
+	for i, transfer := range msg.Transfers {
+		if err := transfer.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "transfer %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgBatchSend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg. It returns every sender of the batch once, in
+// the order of their first transfer.
+func (msg MsgBatchSend) GetSigners() []sdk.AccAddress {
+	seen := make(map[string]bool)
+	var addrs []sdk.AccAddress
+	for _, transfer := range msg.Transfers {
+		if seen[transfer.FromAddress] {
+			continue
+		}
+		seen[transfer.FromAddress] = true
+
+		fromAddress, _ := sdk.AccAddressFromBech32(transfer.FromAddress)
+		addrs = append(addrs, fromAddress)
+	}
+
+	return addrs
+}
+
+// NewTransfer - create a transfer, used with MsgBatchSend
+//nolint:interfacer
+func NewTransfer(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) Transfer {
+	return Transfer{
+		FromAddress: fromAddr.String(),
+		ToAddress:   toAddr.String(),
+		Amount:      amount,
+	}
+}
+
+// ValidateBasic - validate a transfer of a batch
+func (t Transfer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+	}
+
+	if !t.Amount.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, t.Amount.String())
+	}
+
+	if !t.Amount.IsAllPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, t.Amount.String())
+	}
+
+	return nil
+}
+
 // ValidateBasic - validate transaction input
 func (in Input) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(in.Address); err != nil {
@@ -119,6 +217,73 @@ func (in Input) ValidateBasic() error {
 	return nil
 }
 
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+var _ sdk.Msg = &MsgSetDenomMetadata{}
+
+// NewMsgSetDenomMetadata creates a MsgSetDenomMetadata to set the metadata of a
+// coin denomination, authorized by authority.
+//nolint:interfacer
+func NewMsgSetDenomMetadata(authority sdk.AccAddress, metadata Metadata) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{Authority: authority.String(), Metadata: metadata}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetDenomMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgSetDenomMetadata message.
+func (msg *MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if err := msg.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
 // NewInput - create a transaction input, used with MsgMultiSend
 //nolint:interfacer
 func NewInput(addr sdk.AccAddress, coins sdk.Coins) Input {
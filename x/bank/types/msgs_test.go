@@ -268,6 +268,87 @@ func TestMsgMultiSendGetSigners(t *testing.T) {
 	}
 }
 
+func TestMsgBatchSendValidation(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("_______alice________"))
+	addr2 := sdk.AccAddress([]byte("________bob_________"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+
+	var emptyAddr sdk.AccAddress
+
+	tooMany := make([]Transfer, MaxBatchSendTransfers+1)
+	for i := range tooMany {
+		tooMany[i] = NewTransfer(addr1, addr2, atom123)
+	}
+
+	cases := []struct {
+		valid bool
+		tx    MsgBatchSend
+	}{
+		{false, MsgBatchSend{}}, // no transfer
+		{false, MsgBatchSend{Transfers: []Transfer{NewTransfer(emptyAddr, addr2, atom123)}}}, // invalid from address
+		{false, MsgBatchSend{Transfers: []Transfer{NewTransfer(addr1, emptyAddr, atom123)}}}, // invalid to address
+		{false, MsgBatchSend{Transfers: []Transfer{NewTransfer(addr1, addr2, sdk.Coins{})}}}, // no amount
+		{false, MsgBatchSend{Transfers: tooMany}},
+		{true, MsgBatchSend{Transfers: []Transfer{NewTransfer(addr1, addr2, atom123)}}},
+		{true, MsgBatchSend{Transfers: tooMany[1:]}},
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
+func TestMsgBatchSendGetSigners(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input111111111111111"))
+	addr2 := sdk.AccAddress([]byte("input222222222222222"))
+	to := sdk.AccAddress([]byte("output11111111111111"))
+	msg := NewMsgBatchSend([]Transfer{
+		NewTransfer(addr2, to, nil),
+		NewTransfer(addr1, to, nil),
+		NewTransfer(addr2, addr1, nil),
+	})
+
+	res := msg.GetSigners()
+	require.Equal(t, []sdk.AccAddress{addr2, addr1}, res)
+}
+
+func TestMsgSetDenomMetadataValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________"))
+	metadata := Metadata{
+		Name:       "Cosmos Hub Atom",
+		Symbol:     "ATOM",
+		Base:       "uatom",
+		Display:    "atom",
+		DenomUnits: []*DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+	}
+	unsorted := metadata
+	unsorted.DenomUnits = []*DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}, {Denom: "matom", Exponent: 3}}
+
+	cases := []struct {
+		valid bool
+		tx    *MsgSetDenomMetadata
+	}{
+		{true, NewMsgSetDenomMetadata(authority, metadata)},
+		{false, &MsgSetDenomMetadata{Authority: "invalid", Metadata: metadata}},
+		{false, NewMsgSetDenomMetadata(authority, Metadata{})},
+		{false, NewMsgSetDenomMetadata(authority, unsorted)},
+	}
+
+	for i, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
+}
+
 func TestMsgSendGetSigners(t *testing.T) {
 	from := sdk.AccAddress([]byte("input111111111111111"))
 	msg := NewMsgSend(from, sdk.AccAddress{}, sdk.NewCoins())
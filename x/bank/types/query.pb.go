@@ -695,6 +695,239 @@ func (m *QueryDenomsMetadataResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryDenomsMetadataByDisplayRequest is the request type for the
+// Query/DenomsMetadataByDisplay RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryDenomsMetadataByDisplayRequest struct {
+	// display is the display denomination to query the metadata for.
+	Display string `protobuf:"bytes,1,opt,name=display,proto3" json:"display,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataByDisplayRequest) Reset()         { *m = QueryDenomsMetadataByDisplayRequest{} }
+func (m *QueryDenomsMetadataByDisplayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataByDisplayRequest) ProtoMessage()    {}
+func (*QueryDenomsMetadataByDisplayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{14}
+}
+func (m *QueryDenomsMetadataByDisplayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataByDisplayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataByDisplayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataByDisplayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataByDisplayRequest.Merge(m, src)
+}
+func (m *QueryDenomsMetadataByDisplayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataByDisplayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataByDisplayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataByDisplayRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataByDisplayRequest) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *QueryDenomsMetadataByDisplayRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsMetadataByDisplayResponse is the response type for the
+// Query/DenomsMetadataByDisplay RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryDenomsMetadataByDisplayResponse struct {
+	// metadatas provides the client information of the tokens with the display
+	// denomination.
+	Metadatas []Metadata `protobuf:"bytes,1,rep,name=metadatas,proto3" json:"metadatas"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataByDisplayResponse) Reset()         { *m = QueryDenomsMetadataByDisplayResponse{} }
+func (m *QueryDenomsMetadataByDisplayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataByDisplayResponse) ProtoMessage()    {}
+func (*QueryDenomsMetadataByDisplayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{15}
+}
+func (m *QueryDenomsMetadataByDisplayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataByDisplayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataByDisplayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataByDisplayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataByDisplayResponse.Merge(m, src)
+}
+func (m *QueryDenomsMetadataByDisplayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataByDisplayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataByDisplayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataByDisplayResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataByDisplayResponse) GetMetadatas() []Metadata {
+	if m != nil {
+		return m.Metadatas
+	}
+	return nil
+}
+
+func (m *QueryDenomsMetadataByDisplayResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsMetadataBySymbolRequest is the request type for the
+// Query/DenomsMetadataBySymbol RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryDenomsMetadataBySymbolRequest struct {
+	// symbol is the token symbol to query the metadata for.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) Reset()         { *m = QueryDenomsMetadataBySymbolRequest{} }
+func (m *QueryDenomsMetadataBySymbolRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataBySymbolRequest) ProtoMessage()    {}
+func (*QueryDenomsMetadataBySymbolRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{16}
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataBySymbolRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolRequest.Merge(m, src)
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataBySymbolRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataBySymbolRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataBySymbolRequest) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomsMetadataBySymbolResponse is the response type for the
+// Query/DenomsMetadataBySymbol RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryDenomsMetadataBySymbolResponse struct {
+	// metadatas provides the client information of the tokens with the symbol.
+	Metadatas []Metadata `protobuf:"bytes,1,rep,name=metadatas,proto3" json:"metadatas"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) Reset()         { *m = QueryDenomsMetadataBySymbolResponse{} }
+func (m *QueryDenomsMetadataBySymbolResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsMetadataBySymbolResponse) ProtoMessage()    {}
+func (*QueryDenomsMetadataBySymbolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{17}
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsMetadataBySymbolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolResponse.Merge(m, src)
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsMetadataBySymbolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsMetadataBySymbolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsMetadataBySymbolResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsMetadataBySymbolResponse) GetMetadatas() []Metadata {
+	if m != nil {
+		return m.Metadatas
+	}
+	return nil
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDenomMetadataRequest is the request type for the Query/DenomMetadata RPC method.
 type QueryDenomMetadataRequest struct {
 	// denom is the coin denom to query the metadata for.
@@ -705,7 +938,7 @@ func (m *QueryDenomMetadataRequest) Reset()         { *m = QueryDenomMetadataReq
 func (m *QueryDenomMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataRequest) ProtoMessage()    {}
 func (*QueryDenomMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{14}
+	return fileDescriptor_9c6fc1939682df13, []int{18}
 }
 func (m *QueryDenomMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +985,7 @@ func (m *QueryDenomMetadataResponse) Reset()         { *m = QueryDenomMetadataRe
 func (m *QueryDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomMetadataResponse) ProtoMessage()    {}
 func (*QueryDenomMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{15}
+	return fileDescriptor_9c6fc1939682df13, []int{19}
 }
 func (m *QueryDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -802,7 +1035,7 @@ func (m *QueryDenomOwnersRequest) Reset()         { *m = QueryDenomOwnersRequest
 func (m *QueryDenomOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersRequest) ProtoMessage()    {}
 func (*QueryDenomOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{16}
+	return fileDescriptor_9c6fc1939682df13, []int{20}
 }
 func (m *QueryDenomOwnersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -861,7 +1094,7 @@ func (m *DenomOwner) Reset()         { *m = DenomOwner{} }
 func (m *DenomOwner) String() string { return proto.CompactTextString(m) }
 func (*DenomOwner) ProtoMessage()    {}
 func (*DenomOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{17}
+	return fileDescriptor_9c6fc1939682df13, []int{21}
 }
 func (m *DenomOwner) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +1150,7 @@ func (m *QueryDenomOwnersResponse) Reset()         { *m = QueryDenomOwnersRespon
 func (m *QueryDenomOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDenomOwnersResponse) ProtoMessage()    {}
 func (*QueryDenomOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{18}
+	return fileDescriptor_9c6fc1939682df13, []int{22}
 }
 func (m *QueryDenomOwnersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1207,7 @@ func (m *QuerySendEnabledRequest) Reset()         { *m = QuerySendEnabledRequest
 func (m *QuerySendEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledRequest) ProtoMessage()    {}
 func (*QuerySendEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{19}
+	return fileDescriptor_9c6fc1939682df13, []int{23}
 }
 func (m *QuerySendEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1263,7 @@ func (m *QuerySendEnabledResponse) Reset()         { *m = QuerySendEnabledRespon
 func (m *QuerySendEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySendEnabledResponse) ProtoMessage()    {}
 func (*QuerySendEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c6fc1939682df13, []int{20}
+	return fileDescriptor_9c6fc1939682df13, []int{24}
 }
 func (m *QuerySendEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1088,6 +1321,10 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.bank.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryDenomsMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataRequest")
 	proto.RegisterType((*QueryDenomsMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataResponse")
+	proto.RegisterType((*QueryDenomsMetadataByDisplayRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataByDisplayRequest")
+	proto.RegisterType((*QueryDenomsMetadataByDisplayResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataByDisplayResponse")
+	proto.RegisterType((*QueryDenomsMetadataBySymbolRequest)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataBySymbolRequest")
+	proto.RegisterType((*QueryDenomsMetadataBySymbolResponse)(nil), "cosmos.bank.v1beta1.QueryDenomsMetadataBySymbolResponse")
 	proto.RegisterType((*QueryDenomMetadataRequest)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataRequest")
 	proto.RegisterType((*QueryDenomMetadataResponse)(nil), "cosmos.bank.v1beta1.QueryDenomMetadataResponse")
 	proto.RegisterType((*QueryDenomOwnersRequest)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersRequest")
@@ -1100,76 +1337,85 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1102 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0xe3, 0xc4,
-	0x17, 0xcf, 0xf4, 0xfb, 0xdd, 0xb4, 0x7d, 0x29, 0x48, 0xcc, 0x06, 0x68, 0x5d, 0x9a, 0x2c, 0x5e,
-	0xb4, 0x6d, 0x96, 0xd6, 0x6e, 0x52, 0x24, 0x28, 0x17, 0xd4, 0x94, 0x1f, 0x07, 0x84, 0xb6, 0xa4,
-	0x9c, 0x90, 0x50, 0xe4, 0xc4, 0x43, 0x88, 0x9a, 0xd8, 0xd9, 0x8c, 0xc3, 0x12, 0x55, 0x2b, 0x21,
-	0x4e, 0xdc, 0x16, 0x09, 0x21, 0x21, 0x21, 0xc4, 0x72, 0x60, 0xf9, 0x71, 0x46, 0xe2, 0x5f, 0xe8,
-	0x81, 0xc3, 0x6a, 0xb9, 0x70, 0x02, 0xd4, 0x72, 0xe0, 0xcf, 0x40, 0x99, 0x79, 0x93, 0xd8, 0xc9,
-	0xc4, 0x35, 0x25, 0x48, 0x70, 0x6a, 0x3c, 0x7e, 0x3f, 0x3e, 0x9f, 0xcf, 0xbc, 0x99, 0xf7, 0x5c,
-	0xc8, 0xd7, 0x7d, 0xde, 0xf6, 0xb9, 0x5d, 0x73, 0xbc, 0x23, 0xfb, 0xdd, 0x62, 0x8d, 0x05, 0x4e,
-	0xd1, 0xbe, 0xd9, 0x63, 0xdd, 0xbe, 0xd5, 0xe9, 0xfa, 0x81, 0x4f, 0x2f, 0x4b, 0x03, 0x6b, 0x60,
-	0x60, 0xa1, 0x81, 0x71, 0x7d, 0xe8, 0xc5, 0x99, 0xb4, 0x1e, 0xfa, 0x76, 0x9c, 0x46, 0xd3, 0x73,
-	0x82, 0xa6, 0xef, 0xc9, 0x00, 0x46, 0xb6, 0xe1, 0x37, 0x7c, 0xf1, 0xd3, 0x1e, 0xfc, 0xc2, 0xd5,
-	0x27, 0x1a, 0xbe, 0xdf, 0x68, 0x31, 0xdb, 0xe9, 0x34, 0x6d, 0xc7, 0xf3, 0xfc, 0x40, 0xb8, 0x70,
-	0x7c, 0x9b, 0x0b, 0xc7, 0x57, 0x91, 0xeb, 0x7e, 0xd3, 0x9b, 0x78, 0x1f, 0x42, 0x2d, 0x10, 0xca,
-	0xf7, 0x2b, 0xf2, 0x7d, 0x55, 0xa6, 0x45, 0x06, 0xe2, 0xc1, 0x6c, 0xc2, 0xe5, 0xd7, 0x07, 0x80,
-	0xcb, 0x4e, 0xcb, 0xf1, 0xea, 0xac, 0xc2, 0x6e, 0xf6, 0x18, 0x0f, 0x68, 0x09, 0xe6, 0x1d, 0xd7,
-	0xed, 0x32, 0xce, 0x97, 0xc9, 0x15, 0xb2, 0xb1, 0x58, 0x5e, 0x7e, 0xf0, 0xfd, 0x56, 0x16, 0x3d,
-	0xf7, 0xe4, 0x9b, 0xc3, 0xa0, 0xdb, 0xf4, 0x1a, 0x15, 0x65, 0x48, 0xb3, 0x70, 0xc9, 0x65, 0x9e,
-	0xdf, 0x5e, 0x9e, 0x1b, 0x78, 0x54, 0xe4, 0xc3, 0xf3, 0x0b, 0x1f, 0xde, 0xcd, 0xa7, 0xfe, 0xb8,
-	0x9b, 0x4f, 0x99, 0xaf, 0x42, 0x36, 0x9a, 0x8a, 0x77, 0x7c, 0x8f, 0x33, 0xba, 0x03, 0xf3, 0x35,
-	0xb9, 0x24, 0x72, 0x65, 0x4a, 0x2b, 0xd6, 0x50, 0x64, 0xce, 0x94, 0xc8, 0xd6, 0xbe, 0xdf, 0xf4,
-	0x2a, 0xca, 0xd2, 0xfc, 0x82, 0xc0, 0xe3, 0x22, 0xda, 0x5e, 0xab, 0x85, 0x01, 0xf9, 0xdf, 0x01,
-	0xff, 0x32, 0xc0, 0x68, 0xab, 0x04, 0x83, 0x4c, 0xe9, 0x5a, 0x04, 0x87, 0xac, 0x02, 0x85, 0xe6,
-	0xc0, 0x69, 0x28, 0xb1, 0x2a, 0x21, 0xcf, 0x10, 0xdd, 0x1f, 0x09, 0x2c, 0x4f, 0x22, 0x44, 0xce,
-	0x0d, 0x58, 0x40, 0x26, 0x03, 0x8c, 0xff, 0x8b, 0x25, 0x5d, 0xde, 0x3e, 0xf9, 0x25, 0x9f, 0xfa,
-	0xee, 0xd7, 0xfc, 0x46, 0xa3, 0x19, 0xbc, 0xd3, 0xab, 0x59, 0x75, 0xbf, 0x8d, 0x9b, 0x88, 0x7f,
-	0xb6, 0xb8, 0x7b, 0x64, 0x07, 0xfd, 0x0e, 0xe3, 0xc2, 0x81, 0x57, 0x86, 0xc1, 0xe9, 0x2b, 0x1a,
-	0x5e, 0xeb, 0xe7, 0xf2, 0x92, 0x28, 0xc3, 0xc4, 0xcc, 0xaf, 0x08, 0xac, 0x09, 0x3a, 0x87, 0x1d,
-	0xe6, 0xb9, 0x4e, 0xad, 0xc5, 0xfe, 0x9d, 0xb2, 0x3f, 0x20, 0x90, 0x9b, 0x86, 0xf3, 0x3f, 0x2b,
-	0xfe, 0x11, 0x16, 0xfb, 0x1b, 0x7e, 0xe0, 0xb4, 0x0e, 0x7b, 0x9d, 0x4e, 0xab, 0xaf, 0x54, 0x8f,
-	0x2a, 0x48, 0x66, 0xa0, 0xe0, 0x89, 0x2a, 0xdc, 0x48, 0x36, 0xd4, 0xae, 0x0e, 0x69, 0x2e, 0x56,
-	0xfe, 0x09, 0xe5, 0x30, 0xf4, 0xec, 0x74, 0xdb, 0xc4, 0x2b, 0x47, 0x92, 0xb8, 0xf1, 0xb6, 0x12,
-	0x6d, 0x78, 0x55, 0x91, 0xd0, 0x55, 0x65, 0x1e, 0xc0, 0xa3, 0x63, 0xd6, 0x48, 0xfa, 0x59, 0x48,
-	0x3b, 0x6d, 0xbf, 0xe7, 0x05, 0xe7, 0x5e, 0x50, 0xe5, 0xff, 0x0f, 0x48, 0x57, 0xd0, 0xdc, 0xcc,
-	0x02, 0x15, 0x11, 0x0f, 0x9c, 0xae, 0xd3, 0x56, 0x07, 0xc5, 0x3c, 0xc0, 0x3b, 0x57, 0xad, 0x62,
-	0x96, 0x5d, 0x48, 0x77, 0xc4, 0x0a, 0x66, 0x59, 0xb5, 0x34, 0xbd, 0xc6, 0x92, 0x4e, 0x2a, 0x8f,
-	0x74, 0x30, 0x5d, 0x30, 0x44, 0xc4, 0x17, 0x07, 0x3c, 0xf8, 0x6b, 0x2c, 0x70, 0x5c, 0x27, 0x70,
-	0x66, 0x5c, 0x22, 0xe6, 0xb7, 0x04, 0x56, 0xb5, 0x69, 0x90, 0xc0, 0x1e, 0x2c, 0xb6, 0x71, 0x4d,
-	0x1d, 0xac, 0x35, 0x2d, 0x07, 0xe5, 0x89, 0x2c, 0x46, 0x5e, 0xb3, 0xdb, 0xf9, 0x22, 0xac, 0x8c,
-	0xa0, 0x8e, 0x0b, 0xa2, 0xdf, 0xfe, 0xb7, 0xc2, 0x22, 0x4e, 0x90, 0x7b, 0x01, 0x16, 0x14, 0x4c,
-	0x94, 0x30, 0x11, 0xb7, 0xa1, 0x93, 0x79, 0x0b, 0xcf, 0xb0, 0x08, 0x7f, 0xe3, 0x96, 0xc7, 0xba,
-	0x3c, 0x16, 0xcf, 0xac, 0xee, 0x46, 0xf3, 0x18, 0x60, 0x94, 0xf3, 0x42, 0xb7, 0xf4, 0xee, 0xa8,
-	0x43, 0xcf, 0x25, 0x3b, 0x00, 0xc3, 0x3e, 0xfd, 0xb5, 0xba, 0x4c, 0x22, 0xb4, 0x51, 0xd3, 0x32,
-	0x2c, 0x09, 0xaa, 0x55, 0x5f, 0xac, 0x63, 0xcd, 0xe4, 0xb5, 0xba, 0x8e, 0xfc, 0x2b, 0x19, 0x77,
-	0x14, 0x6b, 0x76, 0x15, 0xd3, 0xc7, 0xfd, 0x39, 0x64, 0x9e, 0xfb, 0x92, 0x37, 0x68, 0x1c, 0xae,
-	0xda, 0x9f, 0xc7, 0x20, 0x2d, 0x52, 0x4a, 0x84, 0x8b, 0x15, 0x7c, 0x1a, 0xdb, 0xa1, 0xfa, 0x85,
-	0x77, 0xe8, 0x1b, 0x25, 0x52, 0x24, 0x37, 0x8a, 0xb4, 0x0f, 0x4b, 0x9c, 0x79, 0x6e, 0x95, 0xc9,
-	0x75, 0x14, 0xe9, 0x8a, 0x56, 0xa4, 0xb0, 0x7f, 0x86, 0x8f, 0x1e, 0xc6, 0x54, 0xaa, 0x5f, 0x58,
-	0xa5, 0xd2, 0xbd, 0x25, 0xb8, 0x24, 0xa0, 0xd2, 0x4f, 0x09, 0xcc, 0x63, 0x6b, 0xa5, 0x1b, 0x5a,
-	0x34, 0x9a, 0xc1, 0xd2, 0x28, 0x24, 0xb0, 0x94, 0x69, 0xcd, 0xe7, 0x3e, 0xf8, 0xe9, 0xf7, 0x8f,
-	0xe7, 0x4a, 0x74, 0xdb, 0xd6, 0x8f, 0xb7, 0xb2, 0xc9, 0xda, 0xc7, 0x58, 0xa5, 0xb7, 0xed, 0x5a,
-	0xbf, 0x2a, 0x4f, 0xce, 0x67, 0x04, 0x32, 0xa1, 0xa9, 0x8b, 0x6e, 0x4e, 0x4f, 0x3a, 0x39, 0x3e,
-	0x1a, 0x5b, 0x09, 0xad, 0x11, 0xa6, 0x2d, 0x60, 0x16, 0xe8, 0x7a, 0x42, 0x98, 0xf4, 0x07, 0x02,
-	0x8f, 0x4c, 0x0c, 0x27, 0xb4, 0x34, 0x3d, 0xeb, 0xb4, 0x89, 0xcb, 0xd8, 0xf9, 0x4b, 0x3e, 0x88,
-	0x77, 0x57, 0xe0, 0xdd, 0xa1, 0x45, 0x2d, 0x5e, 0xae, 0xfc, 0xaa, 0x1a, 0xe4, 0x77, 0x08, 0x64,
-	0x42, 0x43, 0x41, 0x9c, 0xae, 0x93, 0x93, 0x4a, 0x9c, 0xae, 0x9a, 0x49, 0xc3, 0xbc, 0x2a, 0x70,
-	0xae, 0xd1, 0x55, 0x3d, 0x4e, 0x89, 0xe0, 0x0e, 0x81, 0x05, 0xd5, 0xae, 0x69, 0x4c, 0x6d, 0x8d,
-	0x0d, 0x00, 0xc6, 0xf5, 0x24, 0xa6, 0x08, 0x64, 0x53, 0x00, 0xb9, 0x46, 0x9f, 0x8a, 0x01, 0x32,
-	0xaa, 0xbd, 0xf7, 0x09, 0xa4, 0x65, 0x8f, 0xa6, 0xeb, 0xd3, 0x93, 0x44, 0x06, 0x02, 0x63, 0xe3,
-	0x7c, 0xc3, 0x44, 0xa2, 0xc8, 0x69, 0x80, 0xde, 0x23, 0xf0, 0x50, 0xa4, 0x89, 0x51, 0x6b, 0x7a,
-	0x02, 0x5d, 0x83, 0x34, 0xec, 0xc4, 0xf6, 0x88, 0xeb, 0x19, 0x81, 0xcb, 0xa2, 0x9b, 0x5a, 0x5c,
-	0xf2, 0xba, 0xac, 0xaa, 0x56, 0x68, 0x1f, 0x8b, 0x85, 0xdb, 0xf4, 0x4b, 0x02, 0x0f, 0x47, 0x67,
-	0x09, 0x7a, 0x5e, 0xe6, 0xf1, 0xe1, 0xc6, 0xd8, 0x4e, 0xee, 0x90, 0x68, 0x3f, 0xc7, 0xb0, 0xd2,
-	0xcf, 0x09, 0x64, 0x42, 0xbd, 0x2b, 0xae, 0xe6, 0x27, 0x3b, 0x7b, 0x5c, 0xcd, 0x6b, 0x1a, 0xa2,
-	0x59, 0x14, 0xd0, 0x9e, 0xa6, 0x85, 0xe9, 0xd0, 0xb0, 0x57, 0x0e, 0x35, 0xfc, 0x84, 0x40, 0x26,
-	0x74, 0xed, 0xc7, 0xe1, 0x9b, 0xec, 0x6c, 0x71, 0xf8, 0x34, 0xbd, 0xc8, 0x2c, 0x08, 0x7c, 0x57,
-	0xe9, 0x93, 0xfa, 0xa3, 0x10, 0x6a, 0x53, 0xe5, 0xfd, 0x93, 0xd3, 0x1c, 0xb9, 0x7f, 0x9a, 0x23,
-	0xbf, 0x9d, 0xe6, 0xc8, 0x47, 0x67, 0xb9, 0xd4, 0xfd, 0xb3, 0x5c, 0xea, 0xe7, 0xb3, 0x5c, 0xea,
-	0xcd, 0x42, 0xec, 0xf7, 0xc0, 0x7b, 0x32, 0xa6, 0xf8, 0x2c, 0xa8, 0xa5, 0xc5, 0x3f, 0x29, 0x76,
-	0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x83, 0xa6, 0x47, 0x80, 0x97, 0x11, 0x00, 0x00,
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x04, 0xea, 0x24, 0xcf, 0x05, 0xc4, 0x24, 0x34, 0xc9, 0x86, 0xd8, 0x65, 0x53, 0x35,
+	0x49, 0x89, 0xbd, 0x89, 0x83, 0x94, 0x18, 0x0e, 0x55, 0x9c, 0x42, 0x0f, 0x08, 0x35, 0x38, 0x9c,
+	0x90, 0x90, 0xb5, 0xf6, 0x2e, 0xc6, 0x8a, 0xbd, 0xeb, 0x7a, 0x36, 0x14, 0x2b, 0x8a, 0x84, 0x10,
+	0x12, 0xdc, 0x8a, 0x84, 0x90, 0x90, 0x10, 0xa2, 0x1c, 0xf8, 0x79, 0x03, 0x21, 0xf1, 0x2f, 0x04,
+	0xa9, 0x87, 0x52, 0x2e, 0x9c, 0x00, 0x25, 0x1c, 0xf8, 0x33, 0x90, 0x67, 0xde, 0x78, 0x77, 0xed,
+	0xb1, 0xb3, 0x0d, 0x46, 0x4a, 0x4f, 0xf6, 0xcc, 0xbc, 0x1f, 0xdf, 0xfb, 0xe6, 0xcd, 0xcc, 0x67,
+	0x43, 0xaa, 0xec, 0xb2, 0xba, 0xcb, 0x8c, 0x92, 0xe9, 0xec, 0x1a, 0x6f, 0xaf, 0x96, 0x6c, 0xcf,
+	0x5c, 0x35, 0x6e, 0xee, 0xd9, 0xcd, 0x56, 0xa6, 0xd1, 0x74, 0x3d, 0x97, 0x4e, 0x08, 0x83, 0x4c,
+	0xdb, 0x20, 0x83, 0x06, 0xda, 0x95, 0x8e, 0x17, 0xb3, 0x85, 0x75, 0xc7, 0xb7, 0x61, 0x56, 0xaa,
+	0x8e, 0xe9, 0x55, 0x5d, 0x47, 0x04, 0xd0, 0x26, 0x2b, 0x6e, 0xc5, 0xe5, 0x5f, 0x8d, 0xf6, 0x37,
+	0x9c, 0x7d, 0xba, 0xe2, 0xba, 0x95, 0x9a, 0x6d, 0x98, 0x8d, 0xaa, 0x61, 0x3a, 0x8e, 0xeb, 0x71,
+	0x17, 0x86, 0xab, 0xc9, 0x60, 0x7c, 0x19, 0xb9, 0xec, 0x56, 0x9d, 0x9e, 0xf5, 0x00, 0xea, 0xf6,
+	0x00, 0xd7, 0x67, 0xc4, 0x7a, 0x51, 0xa4, 0x15, 0x03, 0xb1, 0xa4, 0x57, 0x61, 0xe2, 0xd5, 0x36,
+	0xe0, 0xbc, 0x59, 0x33, 0x9d, 0xb2, 0x5d, 0xb0, 0x6f, 0xee, 0xd9, 0xcc, 0xa3, 0x59, 0x18, 0x35,
+	0x2d, 0xab, 0x69, 0x33, 0x36, 0x4d, 0x2e, 0x92, 0xc5, 0xf1, 0xfc, 0xf4, 0xfd, 0x9f, 0xd2, 0x93,
+	0xe8, 0xb9, 0x29, 0x56, 0x76, 0xbc, 0x66, 0xd5, 0xa9, 0x14, 0xa4, 0x21, 0x9d, 0x84, 0x73, 0x96,
+	0xed, 0xb8, 0xf5, 0xe9, 0x91, 0xb6, 0x47, 0x41, 0x0c, 0x9e, 0x1f, 0xfb, 0xf0, 0x4e, 0x2a, 0xf6,
+	0xcf, 0x9d, 0x54, 0x4c, 0x7f, 0x19, 0x26, 0xc3, 0xa9, 0x58, 0xc3, 0x75, 0x98, 0x4d, 0xd7, 0x60,
+	0xb4, 0x24, 0xa6, 0x78, 0xae, 0x44, 0x76, 0x26, 0xd3, 0x21, 0x99, 0xd9, 0x92, 0xe4, 0xcc, 0x96,
+	0x5b, 0x75, 0x0a, 0xd2, 0x52, 0xff, 0x82, 0xc0, 0x14, 0x8f, 0xb6, 0x59, 0xab, 0x61, 0x40, 0xf6,
+	0x5f, 0xc0, 0xbf, 0x04, 0xe0, 0x6f, 0x15, 0xaf, 0x20, 0x91, 0xbd, 0x1c, 0xc2, 0x21, 0xba, 0x40,
+	0xa2, 0xd9, 0x36, 0x2b, 0x92, 0xac, 0x42, 0xc0, 0x33, 0x50, 0xee, 0x5d, 0x02, 0xd3, 0xbd, 0x08,
+	0xb1, 0xe6, 0x0a, 0x8c, 0x61, 0x25, 0x6d, 0x8c, 0x8f, 0x0c, 0x2c, 0x3a, 0xbf, 0x72, 0xf8, 0x47,
+	0x2a, 0xf6, 0xfd, 0x9f, 0xa9, 0xc5, 0x4a, 0xd5, 0x7b, 0x6b, 0xaf, 0x94, 0x29, 0xbb, 0x75, 0xdc,
+	0x44, 0xfc, 0x48, 0x33, 0x6b, 0xd7, 0xf0, 0x5a, 0x0d, 0x9b, 0x71, 0x07, 0x56, 0xe8, 0x04, 0xa7,
+	0xd7, 0x15, 0x75, 0x2d, 0x9c, 0x58, 0x97, 0x40, 0x19, 0x2c, 0x4c, 0xff, 0x8a, 0xc0, 0x1c, 0x2f,
+	0x67, 0xa7, 0x61, 0x3b, 0x96, 0x59, 0xaa, 0xd9, 0x67, 0x93, 0xf6, 0xfb, 0x04, 0x92, 0xfd, 0x70,
+	0x3e, 0xb4, 0xe4, 0xef, 0x62, 0xb3, 0xbf, 0xe6, 0x7a, 0x66, 0x6d, 0x67, 0xaf, 0xd1, 0xa8, 0xb5,
+	0x24, 0xeb, 0x61, 0x06, 0xc9, 0x10, 0x18, 0x3c, 0x94, 0x8d, 0x1b, 0xca, 0x86, 0xdc, 0x95, 0x21,
+	0xce, 0xf8, 0xcc, 0xff, 0xc1, 0x1c, 0x86, 0x1e, 0x1e, 0x6f, 0xcb, 0x78, 0xe5, 0x88, 0x22, 0x6e,
+	0xbc, 0x29, 0x49, 0xeb, 0x5c, 0x55, 0x24, 0x70, 0x55, 0xe9, 0xdb, 0xf0, 0x54, 0x97, 0x35, 0x16,
+	0xbd, 0x0e, 0x71, 0xb3, 0xee, 0xee, 0x39, 0xde, 0x89, 0x17, 0x54, 0xfe, 0xd1, 0x76, 0xd1, 0x05,
+	0x34, 0xd7, 0x27, 0x81, 0xf2, 0x88, 0xdb, 0x66, 0xd3, 0xac, 0xcb, 0x83, 0xa2, 0x6f, 0xc3, 0x44,
+	0x68, 0x16, 0xb3, 0xe4, 0x20, 0xde, 0xe0, 0x33, 0x98, 0x65, 0x36, 0xa3, 0x78, 0x6b, 0x32, 0xc2,
+	0x49, 0xe6, 0x11, 0x0e, 0xba, 0x05, 0x1a, 0x8f, 0x78, 0xad, 0x5d, 0x07, 0x7b, 0xc5, 0xf6, 0x4c,
+	0xcb, 0xf4, 0xcc, 0x21, 0xb7, 0x88, 0xfe, 0x1d, 0x81, 0x59, 0x65, 0x1a, 0x2c, 0x60, 0x13, 0xc6,
+	0xeb, 0x38, 0x27, 0x0f, 0xd6, 0x9c, 0xb2, 0x06, 0xe9, 0x89, 0x55, 0xf8, 0x5e, 0xc3, 0xdb, 0xf9,
+	0x0f, 0x08, 0xcc, 0x2b, 0xb0, 0xe6, 0x5b, 0xd7, 0xaa, 0xac, 0x51, 0x33, 0x3b, 0xc7, 0x67, 0x1a,
+	0x46, 0x2d, 0x31, 0x83, 0xbd, 0x20, 0x87, 0xc3, 0xba, 0x9a, 0xf4, 0x1f, 0x09, 0x5c, 0x1a, 0x8c,
+	0xe4, 0x0c, 0xd2, 0xf7, 0x3e, 0x01, 0x5d, 0x09, 0x7a, 0xa7, 0x55, 0x2f, 0xb9, 0x35, 0xc9, 0xde,
+	0x05, 0x88, 0x33, 0x3e, 0x81, 0xe4, 0xe1, 0x68, 0x68, 0xdc, 0xfd, 0xd0, 0x6f, 0x17, 0x25, 0x8c,
+	0x33, 0x48, 0xdd, 0x2a, 0xcc, 0xf8, 0x90, 0xbb, 0x8f, 0xa2, 0xfa, 0xe2, 0x79, 0x03, 0x34, 0x95,
+	0x0b, 0x16, 0x77, 0x15, 0xc6, 0x24, 0x4c, 0x3c, 0xbc, 0x91, 0x6a, 0xeb, 0x38, 0xe9, 0xb7, 0x60,
+	0xca, 0x0f, 0x7f, 0xe3, 0x96, 0x63, 0x37, 0xd9, 0x40, 0x3c, 0x43, 0xdb, 0xbe, 0x7d, 0x00, 0x3f,
+	0xe7, 0xa9, 0xf4, 0x41, 0xce, 0xd7, 0x86, 0x23, 0xd1, 0xae, 0xde, 0x8e, 0x42, 0xfc, 0x46, 0x3e,
+	0x63, 0xa1, 0xb2, 0x91, 0xd3, 0x3c, 0x9c, 0xe7, 0xa5, 0x16, 0x5d, 0x3e, 0x8f, 0x3d, 0x93, 0x52,
+	0xf2, 0xea, 0xfb, 0x17, 0x12, 0x96, 0x1f, 0x6b, 0x78, 0x1d, 0xd3, 0xc2, 0xfd, 0xd9, 0xb1, 0x1d,
+	0xeb, 0x45, 0xa7, 0x2d, 0x59, 0xac, 0xc0, 0x01, 0xe3, 0x29, 0x05, 0xc2, 0xf1, 0x02, 0x8e, 0xba,
+	0x76, 0xa8, 0x7c, 0xea, 0x1d, 0xfa, 0x56, 0x92, 0x14, 0xca, 0x8d, 0x24, 0x6d, 0xc1, 0x79, 0x66,
+	0x3b, 0x56, 0xd1, 0x16, 0xf3, 0x48, 0xd2, 0x45, 0x25, 0x49, 0x41, 0xff, 0x04, 0xf3, 0x07, 0xf4,
+	0xba, 0x02, 0xe9, 0x69, 0x58, 0xca, 0xfe, 0xf2, 0x04, 0x9c, 0xe3, 0x50, 0xe9, 0xa7, 0x04, 0x46,
+	0x51, 0xd4, 0xd1, 0x45, 0x25, 0x1a, 0xc5, 0x4f, 0x1a, 0x6d, 0x29, 0x82, 0xa5, 0x48, 0xab, 0x6f,
+	0xbc, 0xf7, 0xdb, 0xdf, 0x1f, 0x8f, 0x64, 0xe9, 0x8a, 0xa1, 0xfe, 0x61, 0xc5, 0xad, 0x99, 0xb1,
+	0x8f, 0x5d, 0x7a, 0x60, 0x94, 0x5a, 0x45, 0x71, 0x72, 0x3e, 0x23, 0x90, 0x08, 0xe8, 0x7d, 0xba,
+	0xdc, 0x3f, 0x69, 0xef, 0x0f, 0x17, 0x2d, 0x1d, 0xd1, 0x1a, 0x61, 0x1a, 0x1c, 0xe6, 0x12, 0x5d,
+	0x88, 0x08, 0x93, 0xfe, 0x4c, 0xe0, 0xc9, 0x1e, 0x59, 0x4c, 0xb3, 0xfd, 0xb3, 0xf6, 0xd3, 0xfa,
+	0xda, 0xda, 0x03, 0xf9, 0x20, 0xde, 0x1c, 0xc7, 0xbb, 0x46, 0x57, 0x95, 0x78, 0x99, 0xf4, 0x2b,
+	0x2a, 0x90, 0xdf, 0x26, 0x90, 0x08, 0xc8, 0xd1, 0x41, 0xbc, 0xf6, 0x6a, 0x64, 0x2d, 0x1d, 0xd1,
+	0x1a, 0x71, 0xce, 0x73, 0x9c, 0x73, 0x74, 0x56, 0x8d, 0x53, 0x20, 0xb8, 0x4d, 0x60, 0x4c, 0x0a,
+	0x45, 0x3a, 0xa0, 0xb7, 0xba, 0xa4, 0xa7, 0x76, 0x25, 0x8a, 0x29, 0x02, 0x59, 0xe6, 0x40, 0x2e,
+	0xd3, 0x4b, 0x03, 0x80, 0xf8, 0xbd, 0xf7, 0x2e, 0x81, 0xb8, 0x50, 0x87, 0x74, 0xa1, 0x7f, 0x92,
+	0x90, 0x14, 0xd5, 0x16, 0x4f, 0x36, 0x8c, 0x44, 0x8a, 0xd0, 0xa1, 0xf4, 0x6b, 0x02, 0x8f, 0x85,
+	0x1e, 0x31, 0x9a, 0xe9, 0x9f, 0x40, 0xf5, 0x40, 0x6a, 0x46, 0x64, 0x7b, 0xc4, 0xf5, 0x1c, 0xc7,
+	0x95, 0xa1, 0xcb, 0x4a, 0x5c, 0xe2, 0xba, 0x2c, 0xca, 0xa7, 0xd0, 0xd8, 0xe7, 0x13, 0x07, 0xf4,
+	0x4b, 0x02, 0x8f, 0x87, 0x35, 0x05, 0x3d, 0x29, 0x73, 0xb7, 0xac, 0xd6, 0x56, 0xa2, 0x3b, 0x44,
+	0xda, 0xcf, 0x2e, 0xac, 0xf4, 0x57, 0x02, 0x53, 0x7d, 0x34, 0x23, 0xdd, 0x88, 0x9a, 0xbb, 0x5b,
+	0xf0, 0x6a, 0xb9, 0x53, 0x78, 0x22, 0xfc, 0x4d, 0x0e, 0xff, 0x05, 0x9a, 0x8b, 0x02, 0xbf, 0xd8,
+	0xee, 0x4b, 0x11, 0xc0, 0xd8, 0xc7, 0x2f, 0x07, 0xf4, 0x2e, 0x81, 0x0b, 0x6a, 0x2d, 0x47, 0xd7,
+	0xa3, 0x03, 0x0b, 0x89, 0x50, 0x6d, 0xe3, 0xc1, 0x1d, 0xb1, 0xa0, 0xab, 0xbc, 0xa0, 0x1c, 0x5d,
+	0x8f, 0x5a, 0x90, 0x90, 0xb7, 0xc6, 0xbe, 0xf8, 0x3c, 0xa0, 0x9f, 0x13, 0x48, 0x04, 0xe4, 0xc5,
+	0xa0, 0x6b, 0xa9, 0x57, 0x7c, 0x69, 0xe9, 0x88, 0xd6, 0x88, 0x76, 0x95, 0xa3, 0x7d, 0x96, 0x2e,
+	0xf5, 0x47, 0x8b, 0x72, 0xa6, 0xd3, 0xe6, 0x9f, 0x10, 0x48, 0x04, 0x5e, 0xe6, 0x41, 0xf8, 0x7a,
+	0xc5, 0x87, 0x96, 0x8e, 0x68, 0x8d, 0xf8, 0x96, 0x38, 0xbe, 0x79, 0xfa, 0x8c, 0xfa, 0xb6, 0x0a,
+	0x28, 0x89, 0xfc, 0xd6, 0xe1, 0x51, 0x92, 0xdc, 0x3b, 0x4a, 0x92, 0xbf, 0x8e, 0x92, 0xe4, 0xa3,
+	0xe3, 0x64, 0xec, 0xde, 0x71, 0x32, 0xf6, 0xfb, 0x71, 0x32, 0xf6, 0xfa, 0xd2, 0xc0, 0x3f, 0x0b,
+	0xde, 0x11, 0x31, 0xf9, 0x7f, 0x06, 0xa5, 0x38, 0xff, 0x07, 0x73, 0xed, 0xdf, 0x01, 0x00, 0xdd,
+	0x38, 0xdb, 0x57, 0xb4, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1204,6 +1450,16 @@ type QueryClient interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(ctx context.Context, in *QueryDenomsMetadataRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByDisplay queries the client metadata of the coin
+	// denominations with the given display denomination.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataByDisplay(ctx context.Context, in *QueryDenomsMetadataByDisplayRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByDisplayResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of the coin
+	// denominations with the given symbol.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -1299,6 +1555,24 @@ func (c *queryClient) DenomsMetadata(ctx context.Context, in *QueryDenomsMetadat
 	return out, nil
 }
 
+func (c *queryClient) DenomsMetadataByDisplay(ctx context.Context, in *QueryDenomsMetadataByDisplayRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataByDisplayResponse, error) {
+	out := new(QueryDenomsMetadataByDisplayResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsMetadataByDisplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomsMetadataBySymbol(ctx context.Context, in *QueryDenomsMetadataBySymbolRequest, opts ...grpc.CallOption) (*QueryDenomsMetadataBySymbolResponse, error) {
+	out := new(QueryDenomsMetadataBySymbolResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error) {
 	out := new(QueryDenomOwnersResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/DenomOwners", in, out, opts...)
@@ -1339,6 +1613,16 @@ type QueryServer interface {
 	// DenomsMetadata queries the client metadata for all registered coin
 	// denominations.
 	DenomsMetadata(context.Context, *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error)
+	// DenomsMetadataByDisplay queries the client metadata of the coin
+	// denominations with the given display denomination.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataByDisplay(context.Context, *QueryDenomsMetadataByDisplayRequest) (*QueryDenomsMetadataByDisplayResponse, error)
+	// DenomsMetadataBySymbol queries the client metadata of the coin
+	// denominations with the given symbol.
+	//
+	// Since: cosmos-sdk 0.47
+	DenomsMetadataBySymbol(context.Context, *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error)
 	// DenomOwners queries for all account addresses that own a particular token
 	// denomination.
 	//
@@ -1382,6 +1666,12 @@ func (*UnimplementedQueryServer) DenomMetadata(ctx context.Context, req *QueryDe
 func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryDenomsMetadataRequest) (*QueryDenomsMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadata not implemented")
 }
+func (*UnimplementedQueryServer) DenomsMetadataByDisplay(ctx context.Context, req *QueryDenomsMetadataByDisplayRequest) (*QueryDenomsMetadataByDisplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataByDisplay not implemented")
+}
+func (*UnimplementedQueryServer) DenomsMetadataBySymbol(ctx context.Context, req *QueryDenomsMetadataBySymbolRequest) (*QueryDenomsMetadataBySymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsMetadataBySymbol not implemented")
+}
 func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
@@ -1537,6 +1827,42 @@ func _Query_DenomsMetadata_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomsMetadataByDisplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataByDisplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataByDisplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsMetadataByDisplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataByDisplay(ctx, req.(*QueryDenomsMetadataByDisplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsMetadataBySymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsMetadataBySymbolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/DenomsMetadataBySymbol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsMetadataBySymbol(ctx, req.(*QueryDenomsMetadataBySymbolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryDenomOwnersRequest)
 	if err := dec(in); err != nil {
@@ -1609,6 +1935,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsMetadata",
 			Handler:    _Query_DenomsMetadata_Handler,
 		},
+		{
+			MethodName: "DenomsMetadataByDisplay",
+			Handler:    _Query_DenomsMetadataByDisplay_Handler,
+		},
+		{
+			MethodName: "DenomsMetadataBySymbol",
+			Handler:    _Query_DenomsMetadataBySymbol_Handler,
+		},
 		{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
@@ -2163,7 +2497,7 @@ func (m *QueryDenomsMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDenomsMetadataByDisplayRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2173,27 +2507,39 @@ func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataByDisplayRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataByDisplayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Display)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDenomsMetadataByDisplayResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2203,27 +2549,197 @@ func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataByDisplayResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDenomsMetadataByDisplayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if len(m.Metadatas) > 0 {
+		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Metadatas) > 0 {
+		for iNdEx := len(m.Metadatas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Metadatas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
 func (m *QueryDenomOwnersRequest) Marshal() (dAtA []byte, err error) {
@@ -2675,6 +3191,78 @@ func (m *QueryDenomsMetadataResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomsMetadataByDisplayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsMetadataByDisplayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for _, e := range m.Metadatas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsMetadataBySymbolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsMetadataBySymbolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Metadatas) > 0 {
+		for _, e := range m.Metadatas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryDenomMetadataRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4180,6 +4768,482 @@ func (m *QueryDenomsMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomsMetadataByDisplayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataByDisplayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataByDisplayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsMetadataByDisplayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataByDisplayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataByDisplayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsMetadataBySymbolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsMetadataBySymbolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsMetadataBySymbolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadatas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadatas = append(m.Metadatas, Metadata{})
+			if err := m.Metadatas[len(m.Metadatas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryDenomMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	ctx := sdk.Context{}
+	fromAddr := sdk.AccAddress("from________________")
+	toAddr := sdk.AccAddress("to__________________")
+	otherAddr := sdk.AccAddress("other_______________")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+
+	var order []string
+	redirect := func(name string, addr sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			order = append(order, name)
+			return addr, nil
+		}
+	}
+	reject := func(name string) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			order = append(order, name)
+			return toAddr, errors.New(name)
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		fn        types.SendRestrictionFn
+		expAddr   sdk.AccAddress
+		expErr    string
+		expCalled []string
+	}{
+		{"no restrictions", types.ComposeSendRestrictions(), nil, "", nil},
+		{"only nil restrictions", types.ComposeSendRestrictions(nil, nil), nil, "", nil},
+		{"no-op", types.ComposeSendRestrictions(nil, types.NoOpSendRestrictionFn), toAddr, "", nil},
+		{
+			"runs in order and passes the new address along",
+			types.ComposeSendRestrictions(redirect("a", otherAddr), nil, redirect("b", fromAddr)),
+			fromAddr, "", []string{"a", "b"},
+		},
+		{
+			"stops at the first error",
+			redirect("a", otherAddr).Then(reject("b")).Then(redirect("c", toAddr)),
+			otherAddr, "b", []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			order = nil
+			if tc.expAddr == nil {
+				require.Nil(t, tc.fn)
+				return
+			}
+
+			addr, err := tc.fn(ctx, fromAddr, toAddr, amt)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expAddr, addr)
+			require.Equal(t, tc.expCalled, order)
+		})
+	}
+}
+
+func TestSendRestrictionBypass(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	require.False(t, types.HasBypass(ctx))
+
+	ctx = types.WithBypass(ctx)
+	require.True(t, types.HasBypass(ctx))
+
+	ctx = types.WithoutBypass(ctx)
+	require.False(t, types.HasBypass(ctx))
+}
@@ -0,0 +1,13 @@
+package exported
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Subspace defines an interface that implements the legacy x/params Subspace
+// type.
+//
+// NOTE: This is used solely for migration of x/params managed parameters.
+type Subspace interface {
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.NewContext(false, tmproto.Header{})
+
+	authority := app.CrisisKeeper.GetAuthority()
+	constantFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	testCases := []struct {
+		name      string
+		input     func() *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority:   authority,
+					ConstantFee: constantFee,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority:   "authority",
+					ConstantFee: constantFee,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "negative constant fee",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority:   authority,
+					ConstantFee: sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1000)},
+				}
+			},
+			expErr:    true,
+			expErrMsg: "constant fee",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.input()
+			_, err := app.CrisisKeeper.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.ConstantFee, app.CrisisKeeper.GetConstantFee(ctx))
+			}
+		})
+	}
+}
@@ -2,16 +2,34 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// GetConstantFee get's the constant fee from the paramSpace
+// GetConstantFee get's the constant fee from the store
 func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
-	return
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConstantFeeKey)
+	if bz == nil {
+		return constantFee
+	}
+
+	k.cdc.MustUnmarshal(bz, &constantFee)
+	return constantFee
 }
 
-// GetConstantFee set's the constant fee in the paramSpace
-func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+// SetConstantFee set's the constant fee in the store
+func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) error {
+	if !constantFee.IsValid() || constantFee.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "negative or invalid constant fee: %s", constantFee)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&constantFee)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ConstantFeeKey, bz)
+	return nil
 }
@@ -0,0 +1,34 @@
+package v047
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/exported"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+// - Migrate the x/crisis constant fee from the x/params module into the
+// x/crisis module store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	var currConstantFee sdk.Coin
+	legacySubspace.Get(ctx, types.ParamStoreKeyConstantFee, &currConstantFee)
+
+	if !currConstantFee.IsValid() {
+		return fmt.Errorf("invalid constant fee: %s", currConstantFee)
+	}
+
+	bz, err := cdc.Marshal(&currConstantFee)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ConstantFeeKey, bz)
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/crisis/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+type mockSubspace struct {
+	constantFee sdk.Coin
+}
+
+func newMockSubspace(fee sdk.Coin) mockSubspace {
+	return mockSubspace{constantFee: fee}
+}
+
+func (ms mockSubspace) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	*ptr.(*sdk.Coin) = ms.constantFee
+}
+
+func TestMigrate(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	legacySubspace := newMockSubspace(types.DefaultGenesisState().ConstantFee)
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+
+	var res sdk.Coin
+	bz := store.Get(types.ConstantFeeKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, legacySubspace.constantFee, res)
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgVerifyInvariant{}
+var _, _ sdk.Msg = &MsgVerifyInvariant{}, &MsgUpdateParams{}
 
 // NewMsgVerifyInvariant creates a new MsgVerifyInvariant object
 //nolint:interfacer
@@ -45,3 +45,37 @@ func (msg MsgVerifyInvariant) ValidateBasic() error {
 func (msg MsgVerifyInvariant) FullInvariantRoute() string {
 	return msg.InvariantModuleName + "/" + msg.InvariantRoute
 }
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if !msg.ConstantFee.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid constant fee")
+	}
+
+	if msg.ConstantFee.IsNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "negative constant fee")
+	}
+
+	return nil
+}
@@ -31,6 +31,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryValidatorSlashes(),
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
+		GetCmdQueryContinuousFunds(),
+		GetCmdQueryDelegatorAutoRestake(),
+		GetCmdQueryDelegatorWithdrawAddressWeights(),
 	)
 
 	return distQueryCmd
@@ -319,3 +322,135 @@ $ %s query distribution community-pool
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryContinuousFunds implements the query continuous funds command.
+func GetCmdQueryContinuousFunds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "continuous-funds",
+		Args:  cobra.NoArgs,
+		Short: "Query the continuous funds of the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the continuous funds paying recipients a percentage of the community pool inflow of each block.
+
+Example:
+$ %s query distribution continuous-funds
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ContinuousFunds(cmd.Context(), &types.QueryContinuousFundsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "continuous funds")
+	return cmd
+}
+
+// GetCmdQueryDelegatorAutoRestake implements the query delegator auto-restake command.
+func GetCmdQueryDelegatorAutoRestake() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-restake [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the rewards of a delegator are automatically re-delegated",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the rewards of a delegator are automatically re-delegated.
+
+Example:
+$ %s query distribution auto-restake %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoRestake(
+				cmd.Context(),
+				&types.QueryDelegatorAutoRestakeRequest{DelegatorAddress: delAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDelegatorWithdrawAddressWeights implements the query delegator
+// weighted withdraw addresses command.
+func GetCmdQueryDelegatorWithdrawAddressWeights() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "withdraw-addr-weights [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the weighted withdraw addresses of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses the rewards of a delegator are withdrawn to and their weights.
+
+Example:
+$ %s query distribution withdraw-addr-weights %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorWithdrawAddressWeights(
+				cmd.Context(),
+				&types.QueryDelegatorWithdrawAddressWeightsRequest{DelegatorAddress: delAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
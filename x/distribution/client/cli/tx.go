@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,9 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewSetAutoRestakeCmd(),
+		NewSetWithdrawAddrWeightsCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
 	)
 
 	return distTxCmd
@@ -223,6 +227,124 @@ $ %s tx distribution set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
 	return cmd
 }
 
+// NewSetAutoRestakeCmd returns a CLI command handler for creating a MsgSetAutoRestake transaction.
+func NewSetAutoRestakeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-restake [enabled]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable the automatic re-delegation of the rewards of a delegator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the automatic re-delegation of the rewards of a delegator to the
+validators they were earned from. The rewards can only be re-delegated when the withdraw
+address of the delegator is the delegator address itself.
+
+Example:
+$ %s tx distribution set-auto-restake true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoRestake(delAddr, enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetWithdrawAddrWeightsCmd returns a CLI command handler for creating a MsgSetWithdrawAddressWeights transaction.
+func NewSetWithdrawAddrWeightsCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-addr-weights [address=weight,...]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Split the withdrawn rewards of a delegator between several addresses",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Split the withdrawn rewards of a delegator (or commission of a validator) between several
+addresses. The weights must add up to one. An empty list removes the weights, the rewards are then
+withdrawn to the withdraw address again.
+
+Example:
+$ %s tx distribution set-withdraw-addr-weights %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p=0.7,%s1n5pepvmgsfd3p2tqqgvt505jvymmstf6s9gw27=0.3 --from mykey
+$ %s tx distribution set-withdraw-addr-weights "" --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr, bech32PrefixAccAddr, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			weights, err := ParseWithdrawAddressWeights(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetWithdrawAddressWeights(delAddr, weights)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards [record-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw the rewards of the delegation backing a tokenize share record",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the delegation backing a tokenize share record to its owner.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			ownerAddr := clientCtx.GetFromAddress()
+			recordID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(ownerAddr, recordID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewFundCommunityPoolCmd returns a CLI command handler for creating a MsgFundCommunityPool transaction.
 func NewFundCommunityPoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -86,3 +86,24 @@ func TestParseProposal(t *testing.T) {
 	require.Equal(t, "1000stake", proposal.Deposit)
 	require.Equal(t, "1000stake", proposal.Amount)
 }
+
+func TestParseWithdrawAddressWeights(t *testing.T) {
+	weights, err := ParseWithdrawAddressWeights("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq=0.7, cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p=0.3")
+	require.NoError(t, err)
+	require.Len(t, weights, 2)
+	require.Equal(t, "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq", weights[0].Address)
+	require.Equal(t, sdk.NewDecWithPrec(7, 1), weights[0].Weight)
+	require.Equal(t, "cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p", weights[1].Address)
+	require.Equal(t, sdk.NewDecWithPrec(3, 1), weights[1].Weight)
+
+	weights, err = ParseWithdrawAddressWeights("")
+	require.NoError(t, err)
+	require.Empty(t, weights)
+
+	_, err = ParseWithdrawAddressWeights("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq")
+	require.Error(t, err)
+	_, err = ParseWithdrawAddressWeights("invalid=1")
+	require.Error(t, err)
+	_, err = ParseWithdrawAddressWeights("cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq=foo")
+	require.Error(t, err)
+}
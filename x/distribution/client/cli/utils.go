@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -22,3 +25,35 @@ func ParseCommunityPoolSpendProposalWithDeposit(cdc codec.JSONCodec, proposalFil
 
 	return proposal, nil
 }
+
+// ParseWithdrawAddressWeights parses a comma separated list of address=weight
+// pairs, an empty string yields no weights.
+func ParseWithdrawAddressWeights(arg string) ([]types.WithdrawAddressWeight, error) {
+	arg = strings.TrimSpace(arg)
+	if arg == "" {
+		return nil, nil
+	}
+
+	pairs := strings.Split(arg, ",")
+	weights := make([]types.WithdrawAddressWeight, len(pairs))
+	for i, pair := range pairs {
+		addr, weight, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid withdraw address weight %q, expected address=weight", pair)
+		}
+
+		withdrawAddr, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		dec, err := sdk.NewDecFromStr(weight)
+		if err != nil {
+			return nil, err
+		}
+
+		weights[i] = types.NewWithdrawAddressWeight(withdrawAddr, dec)
+	}
+
+	return weights, nil
+}
@@ -36,7 +36,7 @@ func (k Keeper) AllocateTokens(
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
 	feePool := k.GetFeePool(ctx)
 	if totalPreviousPower == 0 {
-		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
+		feePool.CommunityPool = feePool.CommunityPool.Add(k.distributeToContinuousFunds(ctx, feesCollected)...)
 		k.SetFeePool(ctx, feePool)
 		return
 	}
@@ -95,8 +95,8 @@ func (k Keeper) AllocateTokens(
 		remaining = remaining.Sub(reward)
 	}
 
-	// allocate community funding
-	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
+	// allocate community funding, paying the continuous funds their share first
+	feePool.CommunityPool = feePool.CommunityPool.Add(k.distributeToContinuousFunds(ctx, remaining)...)
 	k.SetFeePool(ctx, feePool)
 }
 
@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"bytes"
+	"strconv"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetAutoRestake returns whether the rewards of a delegator are automatically
+// re-delegated.
+func (k Keeper) GetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoRestakeKey(delAddr))
+}
+
+// SetAutoRestake enables or disables the automatic re-delegation of the
+// rewards of a delegator.
+func (k Keeper) SetAutoRestake(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) {
+	store := ctx.KVStore(k.storeKey)
+	if enabled {
+		store.Set(types.GetAutoRestakeKey(delAddr), []byte{0x01})
+	} else {
+		store.Delete(types.GetAutoRestakeKey(delAddr))
+	}
+}
+
+// IterateAutoRestakeDelegators iterates over the delegators with auto-restake
+// enabled ordered by address.
+func (k Keeper) IterateAutoRestakeDelegators(ctx sdk.Context, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoRestakePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoRestakeAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetAllAutoRestakeDelegators returns the delegators with auto-restake enabled
+// ordered by address.
+func (k Keeper) GetAllAutoRestakeDelegators(ctx sdk.Context) []string {
+	delegators := make([]string, 0)
+	k.IterateAutoRestakeDelegators(ctx, func(delAddr sdk.AccAddress) (stop bool) {
+		delegators = append(delegators, delAddr.String())
+		return false
+	})
+
+	return delegators
+}
+
+// WithdrawAllDelegationRewards withdraws the rewards of a delegator from all
+// the validators it delegates to and returns their sum.
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	valAddrs := k.delegatorValidators(ctx, delAddr)
+	if len(valAddrs) == 0 {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	total := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		total = total.Add(rewards...)
+	}
+
+	return total, nil
+}
+
+// autoRestakeDelegation is a delegation whose rewards are re-delegated by
+// ProcessAutoRestakes.
+type autoRestakeDelegation struct {
+	delAddr sdk.AccAddress
+	valAddr sdk.ValAddress
+}
+
+// ProcessAutoRestakes re-delegates the rewards of the next batch of
+// delegations of the delegators with auto-restake enabled. At most
+// MaxAutoRestakePerBlock delegations are processed, the batch of the next block
+// starts where this one stopped, possibly in the middle of the delegations of a
+// delegator. A delegator visited without processing any of its delegations
+// counts as one delegation, so the number of delegators scanned is bounded too.
+func (k Keeper) ProcessAutoRestakes(ctx sdk.Context) {
+	maxDelegations := k.GetParams(ctx).MaxAutoRestakePerBlock
+	if maxDelegations == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	var (
+		start     = types.AutoRestakePrefix
+		cursorDel sdk.AccAddress
+		cursorVal sdk.ValAddress
+		visited   uint32
+		batch     = make([]autoRestakeDelegation, 0, maxDelegations)
+		next      []byte
+	)
+	if cursor := store.Get(types.AutoRestakeCursorKey); cursor != nil {
+		cursorDel, cursorVal = types.ParseAutoRestakeCursor(cursor)
+		start = types.GetAutoRestakeKey(cursorDel)
+	}
+
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoRestakePrefix))
+	for ; iter.Valid() && next == nil; iter.Next() {
+		delAddr := types.GetAutoRestakeAddress(iter.Key())
+		if visited == maxDelegations {
+			next = types.GetAutoRestakeCursor(delAddr, nil)
+			break
+		}
+
+		// the rewards can only be re-delegated when the delegator receives them
+		var valAddrs []sdk.ValAddress
+		if k.withdrawsToDelegator(ctx, delAddr) {
+			valAddrs = k.delegatorValidators(ctx, delAddr)
+		}
+
+		processed := false
+		for _, valAddr := range valAddrs {
+			// the delegations of the cursor delegator before the cursor were
+			// processed by the previous batch
+			if delAddr.Equals(cursorDel) &&
+				bytes.Compare(address.MustLengthPrefix(valAddr), address.MustLengthPrefix(cursorVal)) < 0 {
+				continue
+			}
+
+			if visited == maxDelegations {
+				next = types.GetAutoRestakeCursor(delAddr, valAddr)
+				break
+			}
+			batch = append(batch, autoRestakeDelegation{delAddr: delAddr, valAddr: valAddr})
+			visited++
+			processed = true
+		}
+
+		if !processed && next == nil {
+			visited++
+		}
+	}
+	iter.Close()
+
+	// start over from the first delegation once all of them have been processed
+	if next != nil {
+		store.Set(types.AutoRestakeCursorKey, next)
+	} else {
+		store.Delete(types.AutoRestakeCursorKey)
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	for _, del := range batch {
+		k.autoRestake(ctx, del.delAddr, del.valAddr, bondDenom)
+	}
+}
+
+// autoRestake re-delegates the bond denom rewards of a delegation to its
+// validator.
+func (k Keeper) autoRestake(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) {
+	// a failing delegation must not leave its rewards withdrawn
+	cacheCtx, write := ctx.CacheContext()
+	amount, err := k.restakeDelegationRewards(cacheCtx, delAddr, valAddr, bondDenom)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"failed to auto-restake delegation rewards",
+			"delegator", delAddr.String(), "validator", valAddr.String(), "err", err,
+		)
+		return
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	if amount.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoRestake,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(bondDenom, amount).String()),
+				sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			),
+		)
+	}
+}
+
+// restakeDelegationRewards withdraws the rewards of a delegation and delegates
+// the bond denom part of them back to the validator. It returns the amount
+// delegated.
+func (k Keeper) restakeDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, bondDenom string) (math.Int, error) {
+	rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	amount := rewards.AmountOf(bondDenom)
+	if !amount.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), types.ErrNoValidatorExists
+	}
+
+	// NOTE: the withdrawn rewards are unbonded funds of the delegator
+	if _, err := k.stakingKeeper.Delegate(ctx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+		return math.ZeroInt(), err
+	}
+
+	return amount, nil
+}
+
+// withdrawsToDelegator returns whether all the rewards of a delegator are
+// withdrawn to its own address.
+func (k Keeper) withdrawsToDelegator(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	return k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) &&
+		len(k.GetDelegatorWithdrawAddressWeights(ctx, delAddr)) == 0
+}
+
+// disableAutoRestakeIfWithdrawnElsewhere disables auto-restake for a delegator
+// whose rewards are no longer withdrawn to its own address, as they could not
+// be re-delegated anymore.
+func (k Keeper) disableAutoRestakeIfWithdrawnElsewhere(ctx sdk.Context, delAddr sdk.AccAddress) {
+	if !k.GetAutoRestake(ctx, delAddr) || k.withdrawsToDelegator(ctx, delAddr) {
+		return
+	}
+
+	k.SetAutoRestake(ctx, delAddr, false)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(false)),
+		),
+	)
+}
+
+// disableAutoRestakeIfLastDelegation disables auto-restake for a delegator
+// whose last delegation is about to be removed, so that the end blocker does
+// not keep visiting delegators without delegations.
+func (k Keeper) disableAutoRestakeIfLastDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	if !k.GetAutoRestake(ctx, delAddr) {
+		return
+	}
+
+	valAddrs := k.delegatorValidators(ctx, delAddr)
+	if len(valAddrs) > 1 || (len(valAddrs) == 1 && !valAddrs[0].Equals(valAddr)) {
+		return
+	}
+
+	k.SetAutoRestake(ctx, delAddr, false)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(false)),
+		),
+	)
+}
+
+// delegatorValidators returns the validators a delegator delegates to.
+func (k Keeper) delegatorValidators(ctx sdk.Context, delAddr sdk.AccAddress) []sdk.ValAddress {
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	return valAddrs
+}
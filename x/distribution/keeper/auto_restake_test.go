@@ -0,0 +1,371 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 4, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// create two validators with 50% commission and delegate to both
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[2], valAddrs[0], sdk.NewInt(100))
+	tstaking.Delegate(addrs[2], valAddrs[1], sdk.NewInt(100))
+
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate 100 tokens to each validator, 25 of which go to the delegator
+	tokens := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))))
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[1]), tokens)
+
+	balance := bankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom)
+	rewards, err := distrKeeper.WithdrawAllDelegationRewards(ctx, addrs[2])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))), rewards)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(50)), bankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom))
+
+	// the rewards are withdrawn through the message as well
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	res, err := msgServer.WithdrawAllDelegatorRewards(ctx, types.NewMsgWithdrawAllDelegatorRewards(addrs[2]))
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	// an account without delegations has no rewards to withdraw
+	_, err = distrKeeper.WithdrawAllDelegationRewards(ctx, addrs[3])
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+}
+
+func TestProcessAutoRestakes(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	params := distrKeeper.GetParams(ctx)
+	params.MaxAutoRestakePerBlock = 2
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 4, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// create a validator with 50% commission and three delegators with auto-restake
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	for _, addr := range addrs[1:] {
+		tstaking.Delegate(addr, valAddrs[0], sdk.NewInt(100))
+		distrKeeper.SetAutoRestake(ctx, addr, true)
+	}
+
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate 800 tokens, 100 of which go to each delegation
+	allocate := func() {
+		tokens := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(800)))
+		require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(800)))))
+		distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+	}
+	shares := func(delAddr sdk.AccAddress) sdk.Dec {
+		return stakingKeeper.Delegation(ctx, delAddr, valAddrs[0]).GetShares()
+	}
+	allocate()
+
+	// the delegators are processed in address order
+	delegators := distrKeeper.GetAllAutoRestakeDelegators(ctx)
+	require.Len(t, delegators, 3)
+	dels := make([]sdk.AccAddress, len(delegators))
+	for i, del := range delegators {
+		dels[i] = sdk.MustAccAddressFromBech32(del)
+	}
+
+	// the first block restakes the rewards of the first two delegators
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(dels[0]))
+	require.Equal(t, sdk.NewDec(200), shares(dels[1]))
+	require.Equal(t, sdk.NewDec(100), shares(dels[2]))
+
+	var restakes int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoRestake {
+			restakes++
+		}
+	}
+	require.Equal(t, 2, restakes)
+
+	// the next block continues with the last delegator
+	distrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(dels[0]))
+	require.Equal(t, sdk.NewDec(200), shares(dels[1]))
+	require.Equal(t, sdk.NewDec(200), shares(dels[2]))
+
+	// the rewards of a delegator withdrawing to another address are not restaked,
+	// the delegations earn rewards again from the next block
+	distrKeeper.SetDelegatorWithdrawAddr(ctx, dels[0], addrs[0])
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	allocate()
+	distrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, sdk.NewDec(200), shares(dels[0]))
+	require.True(t, shares(dels[1]).GT(sdk.NewDec(200)))
+
+	// the skipped delegator counts toward the maximum, so the last delegator is
+	// processed by the next block
+	require.Equal(t, sdk.NewDec(200), shares(dels[2]))
+	distrKeeper.ProcessAutoRestakes(ctx)
+	require.True(t, shares(dels[2]).GT(sdk.NewDec(200)))
+
+	// the delegations of a delegator are split between the batches as well
+	tstaking.CreateValidator(valAddrs[1], valConsPk2, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[2], valConsPk3, sdk.NewInt(100), true)
+	tstaking.Delegate(dels[1], valAddrs[1], sdk.NewInt(100))
+	tstaking.Delegate(dels[1], valAddrs[2], sdk.NewInt(100))
+	distrKeeper.SetAutoRestake(ctx, dels[0], false)
+	distrKeeper.SetAutoRestake(ctx, dels[2], false)
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	restaked := func() map[string]bool {
+		res := make(map[string]bool)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeAutoRestake {
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyValidator {
+						res[string(attr.Value)] = true
+					}
+				}
+			}
+		}
+		return res
+	}
+	for _, valAddr := range valAddrs[:3] {
+		tokens := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(800)))
+		require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(800)))))
+		distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddr), tokens)
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.ProcessAutoRestakes(ctx)
+	first := restaked()
+	require.Len(t, first, 2)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.ProcessAutoRestakes(ctx)
+	second := restaked()
+	require.Len(t, second, 1)
+	for valAddr := range second {
+		require.False(t, first[valAddr])
+	}
+
+	// nothing is processed when the maximum is zero
+	params.MaxAutoRestakePerBlock = 0
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	before := shares(dels[2])
+	distrKeeper.ProcessAutoRestakes(ctx)
+	require.Equal(t, before, shares(dels[2]))
+}
+
+func TestMsgSetAutoRestake(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 4, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+	tstaking.CreateValidator(valAddrs[2], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[0], valAddrs[2], sdk.NewInt(100))
+	tstaking.Delegate(addrs[1], valAddrs[2], sdk.NewInt(100))
+
+	query := func(addr sdk.AccAddress) bool {
+		res, err := distrKeeper.DelegatorAutoRestake(ctx, &types.QueryDelegatorAutoRestakeRequest{DelegatorAddress: addr.String()})
+		require.NoError(t, err)
+		return res.Enabled
+	}
+
+	_, err = msgServer.SetAutoRestake(ctx, types.NewMsgSetAutoRestake(addrs[0], true))
+	require.NoError(t, err)
+	require.True(t, query(addrs[0]))
+	require.Equal(t, []string{addrs[0].String()}, distrKeeper.GetAllAutoRestakeDelegators(ctx))
+
+	_, err = msgServer.SetAutoRestake(ctx, types.NewMsgSetAutoRestake(addrs[0], false))
+	require.NoError(t, err)
+	require.False(t, query(addrs[0]))
+
+	// auto-restake cannot be enabled when the rewards go to another address
+	distrKeeper.SetDelegatorWithdrawAddr(ctx, addrs[1], addrs[0])
+	_, err = msgServer.SetAutoRestake(ctx, types.NewMsgSetAutoRestake(addrs[1], true))
+	require.ErrorIs(t, err, types.ErrAutoRestakeWithdrawAddr)
+	require.False(t, query(addrs[1]))
+
+	// nor when the delegator has no delegations
+	_, err = msgServer.SetAutoRestake(ctx, types.NewMsgSetAutoRestake(addrs[3], true))
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+	require.False(t, query(addrs[3]))
+
+	_, err = distrKeeper.DelegatorAutoRestake(ctx, &types.QueryDelegatorAutoRestakeRequest{})
+	require.Error(t, err)
+}
+
+func TestWithdrawAddressChangeDisablesAutoRestake(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.ZeroInt())
+	disabled := func() bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSetAutoRestake {
+				return true
+			}
+		}
+		return false
+	}
+
+	// withdrawing to the delegator itself keeps auto-restake enabled
+	distrKeeper.SetAutoRestake(ctx, addrs[0], true)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.SetWithdrawAddress(ctx, types.NewMsgSetWithdrawAddress(addrs[0], addrs[0]))
+	require.NoError(t, err)
+	require.True(t, distrKeeper.GetAutoRestake(ctx, addrs[0]))
+	require.False(t, disabled())
+
+	// withdrawing to another address disables it
+	_, err = msgServer.SetWithdrawAddress(ctx, types.NewMsgSetWithdrawAddress(addrs[0], addrs[1]))
+	require.NoError(t, err)
+	require.False(t, distrKeeper.GetAutoRestake(ctx, addrs[0]))
+	require.True(t, disabled())
+
+	// and so do weighted withdraw addresses
+	_, err = msgServer.SetWithdrawAddress(ctx, types.NewMsgSetWithdrawAddress(addrs[0], addrs[0]))
+	require.NoError(t, err)
+	distrKeeper.SetAutoRestake(ctx, addrs[0], true)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	weights := []types.WithdrawAddressWeight{
+		types.NewWithdrawAddressWeight(addrs[0], sdk.NewDecWithPrec(5, 1)),
+		types.NewWithdrawAddressWeight(addrs[1], sdk.NewDecWithPrec(5, 1)),
+	}
+	_, err = msgServer.SetWithdrawAddressWeights(ctx, types.NewMsgSetWithdrawAddressWeights(addrs[0], weights))
+	require.NoError(t, err)
+	require.False(t, distrKeeper.GetAutoRestake(ctx, addrs[0]))
+	require.True(t, disabled())
+}
+
+func TestRemovingLastDelegationDisablesAutoRestake(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+	tstaking.CreateValidator(valAddrs[1], valConsPk1, sdk.NewInt(100), true)
+	tstaking.CreateValidator(valAddrs[2], valConsPk2, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[0], valAddrs[1], sdk.NewInt(100))
+	tstaking.Delegate(addrs[0], valAddrs[2], sdk.NewInt(100))
+
+	_, err = msgServer.SetAutoRestake(ctx, types.NewMsgSetAutoRestake(addrs[0], true))
+	require.NoError(t, err)
+
+	// removing a delegation other than the last one keeps auto-restake enabled
+	tstaking.Undelegate(addrs[0], valAddrs[1], sdk.NewInt(100), true)
+	require.True(t, distrKeeper.GetAutoRestake(ctx, addrs[0]))
+
+	// removing the last one disables it
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	tstaking.Ctx = ctx
+	tstaking.Undelegate(addrs[0], valAddrs[2], sdk.NewInt(100), true)
+	require.False(t, distrKeeper.GetAutoRestake(ctx, addrs[0]))
+	require.Empty(t, distrKeeper.GetAllAutoRestakeDelegators(ctx))
+
+	var disabled bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSetAutoRestake {
+			disabled = true
+		}
+	}
+	require.True(t, disabled)
+}
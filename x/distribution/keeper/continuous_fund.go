@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// GetContinuousFund returns the continuous fund of a recipient.
+func (k Keeper) GetContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) (fund types.ContinuousFund, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetContinuousFundKey(recipient))
+	if b == nil {
+		return fund, false
+	}
+
+	k.cdc.MustUnmarshal(b, &fund)
+	return fund, true
+}
+
+// SetContinuousFund sets the continuous fund of a recipient.
+func (k Keeper) SetContinuousFund(ctx sdk.Context, fund types.ContinuousFund) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&fund)
+	store.Set(types.GetContinuousFundKey(sdk.MustAccAddressFromBech32(fund.Recipient)), b)
+}
+
+// DeleteContinuousFund deletes the continuous fund of a recipient.
+func (k Keeper) DeleteContinuousFund(ctx sdk.Context, recipient sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetContinuousFundKey(recipient))
+}
+
+// IterateContinuousFunds iterates over all the continuous funds ordered by
+// recipient.
+func (k Keeper) IterateContinuousFunds(ctx sdk.Context, handler func(fund types.ContinuousFund) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ContinuousFundPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var fund types.ContinuousFund
+		k.cdc.MustUnmarshal(iter.Value(), &fund)
+		if handler(fund) {
+			break
+		}
+	}
+}
+
+// GetAllContinuousFunds returns all the continuous funds ordered by recipient.
+func (k Keeper) GetAllContinuousFunds(ctx sdk.Context) []types.ContinuousFund {
+	funds := make([]types.ContinuousFund, 0)
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		funds = append(funds, fund)
+		return false
+	})
+
+	return funds
+}
+
+// distributeToContinuousFunds pays the continuous funds their percentage of
+// the given community pool inflow and returns what is left of it for the
+// community pool. The funds which expired are deleted.
+func (k Keeper) distributeToContinuousFunds(ctx sdk.Context, inflow sdk.DecCoins) sdk.DecCoins {
+	remaining := inflow
+	for _, fund := range k.GetAllContinuousFunds(ctx) {
+		recipient := sdk.MustAccAddressFromBech32(fund.Recipient)
+		if fund.IsExpired(ctx.BlockTime()) {
+			k.DeleteContinuousFund(ctx, recipient)
+			continue
+		}
+
+		amount, _ := inflow.MulDecTruncate(fund.Percentage).TruncateDecimal()
+		if amount.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+			// the share of the fund remains in the community pool
+			k.Logger(ctx).Error("failed to pay continuous fund", "recipient", fund.Recipient, "amount", amount.String(), "err", err)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeContinuousFund,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, fund.Recipient),
+			),
+		)
+
+		remaining = remaining.Sub(sdk.NewDecCoinsFromCoins(amount...))
+	}
+
+	return remaining
+}
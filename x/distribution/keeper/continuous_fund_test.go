@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+func TestAllocateTokensToContinuousFunds(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.ZeroInt())
+	expiry, expired := now.Add(time.Hour), now
+	distrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addrs[0], sdk.NewDecWithPrec(5, 1), nil))
+	distrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addrs[1], sdk.NewDecWithPrec(25, 2), &expired))
+	distrKeeper.SetContinuousFund(ctx, types.NewContinuousFund(addrs[2], sdk.NewDecWithPrec(1, 1), &expiry))
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1005)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, authtypes.FeeCollectorName, fees))
+	distrAcc := accountKeeper.GetModuleAddress(types.ModuleName)
+	distrBalance := bankKeeper.GetBalance(ctx, distrAcc, sdk.DefaultBondDenom)
+
+	// without any voting power all the fees go to the community pool
+	distrKeeper.AllocateTokens(ctx, 0, 0, valConsAddr1, nil)
+
+	require.Equal(t, sdk.NewInt(502), bankKeeper.GetBalance(ctx, addrs[0], sdk.DefaultBondDenom).Amount)
+	require.True(t, bankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom).IsZero())
+	require.Equal(t, sdk.NewInt(100), bankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom).Amount)
+
+	// the remaining inflow, including the decimals truncated from the
+	// payments, goes to the community pool
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: sdk.NewDec(403)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, distrBalance.Amount.AddRaw(403), bankKeeper.GetBalance(ctx, distrAcc, sdk.DefaultBondDenom).Amount)
+
+	// the expired fund is deleted
+	_, found := distrKeeper.GetContinuousFund(ctx, addrs[1])
+	require.False(t, found)
+	require.Len(t, distrKeeper.GetAllContinuousFunds(ctx), 2)
+}
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 2, sdk.ZeroInt())
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, banktestutil.FundAccount(bankKeeper, ctx, addrs[0], amount))
+	require.NoError(t, distrKeeper.FundCommunityPool(ctx, amount, addrs[0]))
+
+	spend := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(addrs[0].String(), addrs[1], spend))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, authtypes.NewModuleAddress(authtypes.FeeCollectorName), spend))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], amount.Add(amount...)))
+	require.ErrorIs(t, err, types.ErrBadDistribution)
+
+	_, err = msgServer.CommunityPoolSpend(sdk.WrapSDKContext(ctx), types.NewMsgCommunityPoolSpend(authority, addrs[1], spend))
+	require.NoError(t, err)
+	require.Equal(t, spend, bankKeeper.GetAllBalances(ctx, addrs[1]))
+	require.Equal(t, sdk.NewDecCoinsFromCoins(amount.Sub(spend...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestMsgContinuousFund(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	now := time.Now().UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.ZeroInt())
+	expiry, expired := now.Add(time.Hour), now
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgCreateContinuousFund
+		expErr error
+	}{
+		{
+			"invalid authority",
+			types.NewMsgCreateContinuousFund(addrs[0].String(), addrs[0], sdk.NewDecWithPrec(5, 1), nil),
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"blocked recipient",
+			types.NewMsgCreateContinuousFund(authority, authtypes.NewModuleAddress(authtypes.FeeCollectorName), sdk.NewDecWithPrec(5, 1), nil),
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"expired",
+			types.NewMsgCreateContinuousFund(authority, addrs[0], sdk.NewDecWithPrec(5, 1), &expired),
+			types.ErrInvalidContinuousFund,
+		},
+		{
+			"valid",
+			types.NewMsgCreateContinuousFund(authority, addrs[0], sdk.NewDecWithPrec(5, 1), &expiry),
+			nil,
+		},
+		{
+			"existing recipient",
+			types.NewMsgCreateContinuousFund(authority, addrs[0], sdk.NewDecWithPrec(1, 1), nil),
+			types.ErrContinuousFundExists,
+		},
+		{
+			"total percentage exceeds one",
+			types.NewMsgCreateContinuousFund(authority, addrs[1], sdk.NewDecWithPrec(6, 1), nil),
+			types.ErrInvalidContinuousFund,
+		},
+		{
+			"valid without expiry",
+			types.NewMsgCreateContinuousFund(authority, addrs[1], sdk.NewDecWithPrec(5, 1), nil),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := msgServer.CreateContinuousFund(goCtx, tc.msg)
+		if tc.expErr != nil {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	require.ElementsMatch(t, []types.ContinuousFund{
+		types.NewContinuousFund(addrs[0], sdk.NewDecWithPrec(5, 1), &expiry),
+		types.NewContinuousFund(addrs[1], sdk.NewDecWithPrec(5, 1), nil),
+	}, distrKeeper.GetAllContinuousFunds(ctx))
+
+	// expired funds do not count towards the total percentage
+	ctx = ctx.WithBlockTime(expiry)
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[2], sdk.NewDecWithPrec(5, 1), nil))
+	require.NoError(t, err)
+
+	_, err = msgServer.CancelContinuousFund(goCtx, types.NewMsgCancelContinuousFund(addrs[0].String(), addrs[1]))
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.CancelContinuousFund(goCtx, types.NewMsgCancelContinuousFund(authority, addrs[1]))
+	require.NoError(t, err)
+	_, found := distrKeeper.GetContinuousFund(ctx, addrs[1])
+	require.False(t, found)
+
+	_, err = msgServer.CancelContinuousFund(goCtx, types.NewMsgCancelContinuousFund(authority, addrs[1]))
+	require.ErrorIs(t, err, types.ErrContinuousFundNotFound)
+
+	// an expired fund which was not deleted yet is replaced
+	_, err = msgServer.CreateContinuousFund(sdk.WrapSDKContext(ctx), types.NewMsgCreateContinuousFund(authority, addrs[0], sdk.NewDecWithPrec(2, 1), nil))
+	require.NoError(t, err)
+	fund, found := distrKeeper.GetContinuousFund(ctx, addrs[0])
+	require.True(t, found)
+	require.Equal(t, types.NewContinuousFund(addrs[0], sdk.NewDecWithPrec(2, 1), nil), fund)
+}
@@ -12,7 +12,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	var moduleHoldings sdk.DecCoins
 
 	k.SetFeePool(ctx, data.FeePool)
-	k.SetParams(ctx, data.Params)
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 
 	for _, dwi := range data.DelegatorWithdrawInfos {
 		delegatorAddress := sdk.MustAccAddressFromBech32(dwi.DelegatorAddress)
@@ -76,6 +78,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, fund := range data.ContinuousFunds {
+		k.SetContinuousFund(ctx, fund)
+	}
+	for _, del := range data.AutoRestakeDelegators {
+		k.SetAutoRestake(ctx, sdk.MustAccAddressFromBech32(del), true)
+	}
+	for _, entry := range data.DelegatorWithdrawAddressWeights {
+		k.SetDelegatorWithdrawAddressWeights(ctx, sdk.MustAccAddressFromBech32(entry.DelegatorAddress), entry.Weights)
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
@@ -181,5 +192,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	funds := k.GetAllContinuousFunds(ctx)
+	autoRestake := k.GetAllAutoRestakeDelegators(ctx)
+
+	weights := make([]types.DelegatorWithdrawAddressWeights, 0)
+	k.IterateDelegatorWithdrawAddressWeights(ctx,
+		func(del sdk.AccAddress, w []types.WithdrawAddressWeight) (stop bool) {
+			weights = append(weights, types.DelegatorWithdrawAddressWeights{
+				DelegatorAddress: del.String(),
+				Weights:          w,
+			})
+			return false
+		},
+	)
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, funds, autoRestake, weights)
 }
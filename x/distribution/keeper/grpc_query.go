@@ -19,8 +19,7 @@ var _ types.QueryServer = Keeper{}
 // Params queries params of distribution module
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	params := k.GetParams(ctx)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
@@ -246,3 +245,74 @@ func (k Keeper) CommunityPool(c context.Context, req *types.QueryCommunityPoolRe
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// ContinuousFunds queries all the continuous funds of the community pool
+func (k Keeper) ContinuousFunds(c context.Context, req *types.QueryContinuousFundsRequest) (*types.QueryContinuousFundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	fundStore := prefix.NewStore(store, types.ContinuousFundPrefix)
+
+	var funds []types.ContinuousFund
+	pageRes, err := query.Paginate(fundStore, req.Pagination, func(key []byte, value []byte) error {
+		var fund types.ContinuousFund
+		if err := k.cdc.Unmarshal(value, &fund); err != nil {
+			return err
+		}
+
+		funds = append(funds, fund)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryContinuousFundsResponse{ContinuousFunds: funds, Pagination: pageRes}, nil
+}
+
+// DelegatorAutoRestake queries whether the rewards of a delegator are
+// automatically re-delegated.
+func (k Keeper) DelegatorAutoRestake(c context.Context, req *types.QueryDelegatorAutoRestakeRequest) (*types.QueryDelegatorAutoRestakeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDelegatorAutoRestakeResponse{Enabled: k.GetAutoRestake(ctx, delAdr)}, nil
+}
+
+// DelegatorWithdrawAddressWeights queries the weighted withdraw addresses of a
+// delegator.
+func (k Keeper) DelegatorWithdrawAddressWeights(c context.Context, req *types.QueryDelegatorWithdrawAddressWeightsRequest) (*types.QueryDelegatorWithdrawAddressWeightsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	weights := k.GetDelegatorWithdrawAddressWeights(ctx, delAdr)
+	if weights == nil {
+		weights = []types.WithdrawAddressWeight{}
+	}
+
+	return &types.QueryDelegatorWithdrawAddressWeightsResponse{Weights: weights}, nil
+}
@@ -670,6 +670,33 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCContinuousFunds() {
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+
+	res, err := queryClient.ContinuousFunds(gocontext.Background(), &types.QueryContinuousFundsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ContinuousFunds)
+
+	funds := []types.ContinuousFund{
+		types.NewContinuousFund(addrs[0], sdk.NewDecWithPrec(1, 1), nil),
+		types.NewContinuousFund(addrs[1], sdk.NewDecWithPrec(2, 1), nil),
+	}
+	for _, fund := range funds {
+		suite.distrKeeper.SetContinuousFund(ctx, fund)
+	}
+
+	res, err = queryClient.ContinuousFunds(gocontext.Background(), &types.QueryContinuousFundsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(funds, res.ContinuousFunds)
+
+	res, err = queryClient.ContinuousFunds(gocontext.Background(), &types.QueryContinuousFundsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ContinuousFunds, 1)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -45,9 +44,8 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 		// add to validator account
 		if !coins.IsZero() {
 			accAddr := sdk.AccAddress(valAddr)
-			withdrawAddr := h.k.GetDelegatorWithdrawAddr(ctx, accAddr)
 
-			if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins); err != nil {
+			if err := h.k.sendToWithdrawAddrs(ctx, accAddr, coins); err != nil {
 				return err
 			}
 		}
@@ -82,6 +80,17 @@ func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr
 func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
 	val := h.k.stakingKeeper.Validator(ctx, valAddr)
 	_ = h.k.IncrementValidatorPeriod(ctx, val)
+
+	// nobody can sign for the address holding a tokenized delegation, its
+	// rewards are withdrawn to the owner of the record instead
+	if record, err := h.k.stakingKeeper.GetTokenizeShareRecordByAddress(ctx, delAddr); err == nil {
+		owner, err := sdk.AccAddressFromBech32(record.Owner)
+		if err != nil {
+			return err
+		}
+		h.k.SetDelegatorWithdrawAddr(ctx, delAddr, owner)
+	}
+
 	return nil
 }
 
@@ -118,6 +127,12 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+// disable auto-restake when the last delegation of the delegator is removed
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	h.k.disableAutoRestakeIfLastDelegation(ctx, delAddr, valAddr)
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
 	return nil
 }
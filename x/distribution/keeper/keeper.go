@@ -10,48 +10,50 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // Keeper of the distribution store
 type Keeper struct {
 	storeKey      storetypes.StoreKey
 	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 	stakingKeeper types.StakingKeeper
 
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
 
 // NewKeeper creates a new distribution Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
-	feeCollectorName string,
+	feeCollectorName string, authority string,
 ) Keeper {
 	// ensure distribution module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
-	// set KeyTable if it has not already been set
-	if !paramSpace.HasKeyTable() {
-		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return Keeper{
 		storeKey:         key,
 		cdc:              cdc,
-		paramSpace:       paramSpace,
 		authKeeper:       ak,
 		bankKeeper:       bk,
 		stakingKeeper:    sk,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
+// GetAuthority returns the x/distribution module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -75,6 +77,75 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	)
 
 	k.SetDelegatorWithdrawAddr(ctx, delegatorAddr, withdrawAddr)
+
+	// the withdraw address replaces any weighted withdraw addresses
+	k.DeleteDelegatorWithdrawAddressWeights(ctx, delegatorAddr)
+
+	k.disableAutoRestakeIfWithdrawnElsewhere(ctx, delegatorAddr)
+	return nil
+}
+
+// SetWithdrawAddressWeights sets the weighted list of addresses that will
+// receive the rewards upon withdrawal, an empty list removes it
+func (k Keeper) SetWithdrawAddressWeights(ctx sdk.Context, delegatorAddr sdk.AccAddress, weights []types.WithdrawAddressWeight) error {
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	attrs := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyDelegator, delegatorAddr.String())}
+	for _, w := range weights {
+		withdrawAddr, err := sdk.AccAddressFromBech32(w.Address)
+		if err != nil {
+			return err
+		}
+
+		if k.bankKeeper.BlockedAddr(withdrawAddr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+		}
+
+		attrs = append(attrs,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, w.Address),
+			sdk.NewAttribute(types.AttributeKeyWeight, w.Weight.String()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeSetWithdrawAddressWeights, attrs...))
+
+	if len(weights) == 0 {
+		k.DeleteDelegatorWithdrawAddressWeights(ctx, delegatorAddr)
+	} else {
+		k.SetDelegatorWithdrawAddressWeights(ctx, delegatorAddr, weights)
+	}
+
+	k.disableAutoRestakeIfWithdrawnElsewhere(ctx, delegatorAddr)
+	return nil
+}
+
+// sendToWithdrawAddrs sends withdrawn rewards or commission of a delegator from
+// the module account to its withdraw address, or splits them between its
+// weighted withdraw addresses when it has set them
+func (k Keeper) sendToWithdrawAddrs(ctx sdk.Context, delAddr sdk.AccAddress, coins sdk.Coins) error {
+	weights := k.GetDelegatorWithdrawAddressWeights(ctx, delAddr)
+	if len(weights) == 0 {
+		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, coins)
+	}
+
+	for i, share := range types.SplitCoinsByWeights(coins, weights) {
+		if share.IsZero() {
+			continue
+		}
+
+		withdrawAddr, err := sdk.AccAddressFromBech32(weights[i].Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, share); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -134,8 +205,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 
 	if !commission.IsZero() {
 		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
+		err := k.sendToWithdrawAddrs(ctx, accAddr, commission)
 		if err != nil {
 			return nil, err
 		}
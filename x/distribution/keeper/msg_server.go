@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type msgServer struct {
@@ -143,3 +146,236 @@ func (k msgServer) FundCommunityPool(goCtx context.Context, msg *types.MsgFundCo
 
 	return &types.MsgFundCommunityPoolResponse{}, nil
 }
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) CommunityPoolSpend(goCtx context.Context, req *types.MsgCommunityPoolSpend) (*types.MsgCommunityPoolSpendResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", req.Recipient)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.DistributeFromFeePool(ctx, req.Amount, recipient); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("transferred from the community pool to recipient", "amount", req.Amount.String(), "recipient", req.Recipient)
+
+	return &types.MsgCommunityPoolSpendResponse{}, nil
+}
+
+func (k msgServer) CreateContinuousFund(goCtx context.Context, req *types.MsgCreateContinuousFund) (*types.MsgCreateContinuousFundResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", req.Recipient)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	existing, found := k.GetContinuousFund(ctx, recipient)
+	if found && !existing.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrContinuousFundExists.Wrapf("recipient %s", req.Recipient)
+	}
+
+	fund := types.NewContinuousFund(recipient, req.Percentage, req.Expiry)
+	if fund.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrInvalidContinuousFund.Wrapf("expiry %s is not after the block time", fund.Expiry)
+	}
+
+	// the expired funds are only deleted once the community pool is funded,
+	// they must not count towards the total percentage
+	funds := []types.ContinuousFund{fund}
+	k.IterateContinuousFunds(ctx, func(fund types.ContinuousFund) (stop bool) {
+		if !fund.IsExpired(ctx.BlockTime()) {
+			funds = append(funds, fund)
+		}
+		return false
+	})
+	if err := types.ValidateContinuousFunds(funds); err != nil {
+		return nil, err
+	}
+
+	// an existing fund of the recipient has expired without being deleted yet,
+	// it is replaced by the new one
+	if found {
+		k.DeleteContinuousFund(ctx, recipient)
+	}
+	k.SetContinuousFund(ctx, fund)
+
+	return &types.MsgCreateContinuousFundResponse{}, nil
+}
+
+func (k msgServer) CancelContinuousFund(goCtx context.Context, req *types.MsgCancelContinuousFund) (*types.MsgCancelContinuousFundResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetContinuousFund(ctx, recipient); !found {
+		return nil, types.ErrContinuousFundNotFound.Wrapf("recipient %s", req.Recipient)
+	}
+
+	k.DeleteContinuousFund(ctx, recipient)
+
+	return &types.MsgCancelContinuousFundResponse{}, nil
+}
+
+func (k msgServer) WithdrawAllDelegatorRewards(goCtx context.Context, msg *types.MsgWithdrawAllDelegatorRewards) (*types.MsgWithdrawAllDelegatorRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.WithdrawAllDelegationRewards(ctx, delegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+	return &types.MsgWithdrawAllDelegatorRewardsResponse{Amount: amount}, nil
+}
+
+func (k msgServer) SetAutoRestake(goCtx context.Context, msg *types.MsgSetAutoRestake) (*types.MsgSetAutoRestakeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rewards can only be re-delegated when the delegator receives them
+	if msg.Enabled && !k.withdrawsToDelegator(ctx, delegatorAddress) {
+		return nil, types.ErrAutoRestakeWithdrawAddr
+	}
+
+	// only delegators with rewards to re-delegate are processed by the end blocker
+	if msg.Enabled && len(k.delegatorValidators(ctx, delegatorAddress)) == 0 {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	k.Keeper.SetAutoRestake(ctx, delegatorAddress, msg.Enabled)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoRestake,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgSetAutoRestakeResponse{}, nil
+}
+
+func (k msgServer) SetWithdrawAddressWeights(goCtx context.Context, msg *types.MsgSetWithdrawAddressWeights) (*types.MsgSetWithdrawAddressWeightsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	err = k.Keeper.SetWithdrawAddressWeights(ctx, delegatorAddress, msg.Weights)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	)
+
+	return &types.MsgSetWithdrawAddressWeightsResponse{}, nil
+}
+
+func (k msgServer) WithdrawTokenizeShareRecordReward(goCtx context.Context, msg *types.MsgWithdrawTokenizeShareRecordReward) (*types.MsgWithdrawTokenizeShareRecordRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddress, err := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := k.Keeper.WithdrawTokenizeShareRecordReward(ctx, ownerAddress, msg.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		for _, a := range amount {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "withdraw_reward"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OwnerAddress),
+		),
+	)
+	return &types.MsgWithdrawTokenizeShareRecordRewardResponse{Amount: amount}, nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var distrKeeper keeper.Keeper
+
+	app, err := simtestutil.Setup(testutil.AppConfig, &distrKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	authority := distrKeeper.GetAuthority()
+	params := types.DefaultParams()
+	testCases := []struct {
+		name      string
+		input     func() *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: "authority",
+					Params:    params,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "negative community tax",
+			input: func() *types.MsgUpdateParams {
+				params1 := params
+				params1.CommunityTax = sdk.NewDecWithPrec(-2, 1)
+
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "community tax",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.input()
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Params, distrKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
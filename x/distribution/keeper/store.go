@@ -43,6 +43,47 @@ func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del
 	}
 }
 
+// get the weighted withdraw addresses of a delegator, the rewards are withdrawn
+// to the delegator withdraw address when there are none
+func (k Keeper) GetDelegatorWithdrawAddressWeights(ctx sdk.Context, delAddr sdk.AccAddress) []types.WithdrawAddressWeight {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetWithdrawAddressWeightsKey(delAddr))
+	if b == nil {
+		return nil
+	}
+	var weights types.WithdrawAddressWeights
+	k.cdc.MustUnmarshal(b, &weights)
+	return weights.Weights
+}
+
+// set the weighted withdraw addresses of a delegator
+func (k Keeper) SetDelegatorWithdrawAddressWeights(ctx sdk.Context, delAddr sdk.AccAddress, weights []types.WithdrawAddressWeight) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.WithdrawAddressWeights{Weights: weights})
+	store.Set(types.GetWithdrawAddressWeightsKey(delAddr), b)
+}
+
+// delete the weighted withdraw addresses of a delegator
+func (k Keeper) DeleteDelegatorWithdrawAddressWeights(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetWithdrawAddressWeightsKey(delAddr))
+}
+
+// iterate over the weighted withdraw addresses of the delegators
+func (k Keeper) IterateDelegatorWithdrawAddressWeights(ctx sdk.Context, handler func(del sdk.AccAddress, weights []types.WithdrawAddressWeight) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WithdrawAddressWeightsPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var weights types.WithdrawAddressWeights
+		k.cdc.MustUnmarshal(iter.Value(), &weights)
+		del := types.GetWithdrawAddressWeightsAddress(iter.Key())
+		if handler(del, weights.Weights) {
+			break
+		}
+	}
+}
+
 // get the global fee pool distribution info
 func (k Keeper) GetFeePool(ctx sdk.Context) (feePool types.FeePool) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegation
+// backing a tokenize share record to the owner of the record.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, owner sdk.AccAddress, recordID uint64) (sdk.Coins, error) {
+	record, err := k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if err != nil {
+		return nil, err
+	}
+
+	if record.Owner != owner.String() {
+		return nil, types.ErrNotTokenizeShareRecordOwner
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	// the withdraw address of the record address is set to the owner when the
+	// delegation is created, see Hooks.BeforeDelegationCreated
+	return k.WithdrawDelegationRewards(ctx, record.GetModuleAddress(), valAddr)
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	var (
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// create a validator with 50% commission and tokenize a delegation to it
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+	_, err = stakingMsgServer.TokenizeShares(sdk.WrapSDKContext(ctx), stakingtypes.NewMsgTokenizeShares(addrs[1], valAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), addrs[2]))
+	require.NoError(t, err)
+
+	record, err := stakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, addrs[2], distrKeeper.GetDelegatorWithdrawAddr(ctx, record.GetModuleAddress()))
+
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// allocate 100 tokens to the validator, 25 of which go to the record
+	tokens := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+
+	// only the owner of the record can withdraw its rewards
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+	_, err = msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(addrs[1], record.Id))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+
+	_, err = msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(addrs[2], record.Id+1))
+	require.ErrorIs(t, err, stakingtypes.ErrTokenizeShareRecordNotExists)
+
+	balance := bankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom)
+	res, err := msgServer.WithdrawTokenizeShareRecordReward(sdk.WrapSDKContext(ctx), types.NewMsgWithdrawTokenizeShareRecordReward(addrs[2], record.Id))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(25))), res.Amount)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(25)), bankKeeper.GetBalance(ctx, addrs[2], sdk.DefaultBondDenom))
+	require.True(t, bankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestWithdrawAddressWeights(t *testing.T) {
+	var (
+		accountKeeper authkeeper.AccountKeeper
+		bankKeeper    bankkeeper.Keeper
+		distrKeeper   keeper.Keeper
+		stakingKeeper *stakingkeeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(testutil.AppConfig,
+		&accountKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(distrKeeper)
+
+	addrs := simtestutil.AddTestAddrs(bankKeeper, stakingKeeper, ctx, 4, sdk.NewInt(1000))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
+	tstaking := teststaking.NewHelper(t, ctx, stakingKeeper)
+
+	// create a validator with 50% commission and a delegation to it
+	tstaking.Commission = stakingtypes.NewCommissionRates(sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDec(0))
+	tstaking.CreateValidator(valAddrs[0], valConsPk1, sdk.NewInt(100), true)
+	tstaking.Delegate(addrs[1], valAddrs[0], sdk.NewInt(100))
+
+	staking.EndBlocker(ctx, stakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the delegator splits its rewards 70/30 and the validator its commission 50/50
+	delWeights := []types.WithdrawAddressWeight{
+		types.NewWithdrawAddressWeight(addrs[2], sdk.NewDecWithPrec(7, 1)),
+		types.NewWithdrawAddressWeight(addrs[3], sdk.NewDecWithPrec(3, 1)),
+	}
+	valWeights := []types.WithdrawAddressWeight{
+		types.NewWithdrawAddressWeight(addrs[0], sdk.NewDecWithPrec(5, 1)),
+		types.NewWithdrawAddressWeight(addrs[3], sdk.NewDecWithPrec(5, 1)),
+	}
+	_, err = msgServer.SetWithdrawAddressWeights(ctx, types.NewMsgSetWithdrawAddressWeights(addrs[1], delWeights))
+	require.NoError(t, err)
+	_, err = msgServer.SetWithdrawAddressWeights(ctx, types.NewMsgSetWithdrawAddressWeights(addrs[0], valWeights))
+	require.NoError(t, err)
+
+	res, err := distrKeeper.DelegatorWithdrawAddressWeights(ctx, &types.QueryDelegatorWithdrawAddressWeightsRequest{DelegatorAddress: addrs[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, delWeights, res.Weights)
+
+	// allocate 200 tokens, 100 of which are commission and 50 go to the delegator
+	tokens := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))
+	require.NoError(t, banktestutil.FundModuleAccount(bankKeeper, ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))))
+	distrKeeper.AllocateTokensToValidator(ctx, stakingKeeper.Validator(ctx, valAddrs[0]), tokens)
+
+	balance := func(addr sdk.AccAddress) sdk.Int {
+		return bankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom).Amount
+	}
+	before := []sdk.Int{balance(addrs[0]), balance(addrs[1]), balance(addrs[2]), balance(addrs[3])}
+
+	_, err = distrKeeper.WithdrawDelegationRewards(ctx, addrs[1], valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, before[1], balance(addrs[1]))
+	require.Equal(t, before[2].AddRaw(35), balance(addrs[2]))
+	require.Equal(t, before[3].AddRaw(15), balance(addrs[3]))
+
+	_, err = distrKeeper.WithdrawValidatorCommission(ctx, valAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, before[0].AddRaw(50), balance(addrs[0]))
+	require.Equal(t, before[3].AddRaw(65), balance(addrs[3]))
+
+	// auto-restake cannot be enabled while the rewards are split
+	_, err = msgServer.SetAutoRestake(ctx, types.NewMsgSetAutoRestake(addrs[1], true))
+	require.ErrorIs(t, err, types.ErrAutoRestakeWithdrawAddr)
+
+	// setting a withdraw address removes the weights
+	require.NoError(t, distrKeeper.SetWithdrawAddr(ctx, addrs[1], addrs[2]))
+	require.Empty(t, distrKeeper.GetDelegatorWithdrawAddressWeights(ctx, addrs[1]))
+
+	// an empty list removes the weights
+	_, err = msgServer.SetWithdrawAddressWeights(ctx, types.NewMsgSetWithdrawAddressWeights(addrs[0], nil))
+	require.NoError(t, err)
+	require.Empty(t, distrKeeper.GetDelegatorWithdrawAddressWeights(ctx, addrs[0]))
+
+	// module accounts cannot receive the rewards
+	blocked := []types.WithdrawAddressWeight{
+		types.NewWithdrawAddressWeight(addrs[2], sdk.NewDecWithPrec(5, 1)),
+		types.NewWithdrawAddressWeight(accountKeeper.GetModuleAddress(types.ModuleName), sdk.NewDecWithPrec(5, 1)),
+	}
+	_, err = msgServer.SetWithdrawAddressWeights(ctx, types.NewMsgSetWithdrawAddressWeights(addrs[1], blocked))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the weights cannot be set when withdraw addresses are disabled
+	params := distrKeeper.GetParams(ctx)
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	_, err = msgServer.SetWithdrawAddressWeights(ctx, types.NewMsgSetWithdrawAddressWeights(addrs[1], delWeights))
+	require.ErrorIs(t, err, types.ErrSetWithdrawAddrDisabled)
+}
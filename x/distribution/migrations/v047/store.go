@@ -0,0 +1,38 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/exported"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+// - Migrate the x/distribution parameters from the x/params module into the x/distribution
+// module store.
+// - Set the auto-restake parameter to its default value.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	// the auto-restake parameter is not part of the legacy parameters
+	if currParams.MaxAutoRestakePerBlock == 0 {
+		currParams.MaxAutoRestakePerBlock = types.DefaultMaxAutoRestakePerBlock
+	}
+
+	if err := currParams.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
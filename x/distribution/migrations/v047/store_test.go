@@ -0,0 +1,61 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/exported"
+	v047 "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+type mockSubspace struct {
+	ps types.Params
+}
+
+func newMockSubspace(ps types.Params) mockSubspace {
+	return mockSubspace{ps: ps}
+}
+
+func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
+	*ps.(*types.Params) = ms.ps
+}
+
+func TestMigrate(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	legacySubspace := newMockSubspace(types.DefaultParams())
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+
+	var res types.Params
+	bz := store.Get(types.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, legacySubspace.ps, res)
+}
+
+func TestMigrateMaxAutoRestakePerBlock(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// the legacy parameters have no auto-restake parameter
+	legacyParams := types.DefaultParams()
+	legacyParams.MaxAutoRestakePerBlock = 0
+	legacySubspace := newMockSubspace(legacyParams)
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+
+	var res types.Params
+	bz := store.Get(types.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, types.DefaultMaxAutoRestakePerBlock, res.MaxAutoRestakePerBlock)
+}
@@ -63,6 +63,26 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &eventB)
 			return fmt.Sprintf("%v\n%v", eventA, eventB)
 
+		case bytes.Equal(kvA.Key[:1], types.ContinuousFundPrefix):
+			var fundA, fundB types.ContinuousFund
+			cdc.MustUnmarshal(kvA.Value, &fundA)
+			cdc.MustUnmarshal(kvB.Value, &fundB)
+			return fmt.Sprintf("%v\n%v", fundA, fundB)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakePrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.AutoRestakeCursorKey):
+			delAddrA, valAddrA := types.ParseAutoRestakeCursor(kvA.Value)
+			delAddrB, valAddrB := types.ParseAutoRestakeCursor(kvB.Value)
+			return fmt.Sprintf("%v %v\n%v %v", delAddrA, valAddrA, delAddrB, valAddrB)
+
+		case bytes.Equal(kvA.Key[:1], types.WithdrawAddressWeightsPrefix):
+			var weightsA, weightsB types.WithdrawAddressWeights
+			cdc.MustUnmarshal(kvA.Value, &weightsA)
+			cdc.MustUnmarshal(kvB.Value, &weightsB)
+			return fmt.Sprintf("%v\n%v", weightsA, weightsB)
+
 		default:
 			panic(fmt.Sprintf("invalid distribution key prefix %X", kvA.Key[:1]))
 		}
@@ -39,6 +39,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	historicalRewards := types.NewValidatorHistoricalRewards(decCoins, 100)
 	currentRewards := types.NewValidatorCurrentRewards(decCoins, 5)
 	slashEvent := types.NewValidatorSlashEvent(10, sdk.OneDec())
+	fund := types.NewContinuousFund(delAddr1, sdk.NewDecWithPrec(1, 1), nil)
+	weights := types.WithdrawAddressWeights{Weights: []types.WithdrawAddressWeight{types.NewWithdrawAddressWeight(delAddr1, sdk.OneDec())}}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -51,6 +53,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetContinuousFundKey(delAddr1), Value: cdc.MustMarshal(&fund)},
+			{Key: types.GetAutoRestakeKey(delAddr1), Value: []byte{0x01}},
+			{Key: types.AutoRestakeCursorKey, Value: types.GetAutoRestakeCursor(delAddr1, valAddr1)},
+			{Key: types.GetWithdrawAddressWeightsKey(delAddr1), Value: cdc.MustMarshal(&weights)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -68,6 +74,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"ContinuousFund", fmt.Sprintf("%v\n%v", fund, fund)},
+		{"AutoRestake", fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
+		{"AutoRestakeCursor", fmt.Sprintf("%v %v\n%v %v", delAddr1, valAddr1, delAddr1, valAddr1)},
+		{"WithdrawAddressWeights", fmt.Sprintf("%v\n%v", weights, weights)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -18,6 +18,7 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	MaxAutoRestake      = "max_auto_restake_per_block"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +41,11 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenMaxAutoRestakePerBlock returns a randomized MaxAutoRestakePerBlock parameter.
+func GenMaxAutoRestakePerBlock(r *rand.Rand) uint32 {
+	return uint32(r.Intn(20))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +72,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var maxAutoRestake uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAutoRestake, &maxAutoRestake, simState.Rand,
+		func(r *rand.Rand) { maxAutoRestake = GenMaxAutoRestakePerBlock(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:           communityTax,
+			BaseProposerReward:     baseProposerReward,
+			BonusProposerReward:    bonusProposerReward,
+			WithdrawAddrEnabled:    withdrawEnabled,
+			MaxAutoRestakePerBlock: maxAutoRestake,
 		},
 	}
 
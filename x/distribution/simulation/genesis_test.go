@@ -48,6 +48,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec2, distrGenesis.Params.BonusProposerReward)
 	require.Equal(t, dec3, distrGenesis.Params.CommunityTax)
 	require.Equal(t, true, distrGenesis.Params.WithdrawAddrEnabled)
+	require.Equal(t, uint32(11), distrGenesis.Params.MaxAutoRestakePerBlock)
 	require.Len(t, distrGenesis.DelegatorStartingInfos, 0)
 	require.Len(t, distrGenesis.DelegatorWithdrawInfos, 0)
 	require.Len(t, distrGenesis.ValidatorSlashEvents, 0)
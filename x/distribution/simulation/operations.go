@@ -25,6 +25,9 @@ const (
 	OpWeightMsgWithdrawDelegationReward    = "op_weight_msg_withdraw_delegation_reward"
 	OpWeightMsgWithdrawValidatorCommission = "op_weight_msg_withdraw_validator_commission"
 	OpWeightMsgFundCommunityPool           = "op_weight_msg_fund_community_pool"
+	OpWeightMsgWithdrawAllDelegatorRewards = "op_weight_msg_withdraw_all_delegator_rewards"
+	OpWeightMsgSetAutoRestake              = "op_weight_msg_set_auto_restake"
+	OpWeightMsgSetWithdrawAddressWeights   = "op_weight_msg_set_withdraw_address_weights"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -57,6 +60,27 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 		},
 	)
 
+	var weightMsgWithdrawAllDelegatorRewards int
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdrawAllDelegatorRewards, &weightMsgWithdrawAllDelegatorRewards, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawAllDelegatorRewards = simtestutil.DefaultWeightMsgWithdrawAllDelegatorRewards
+		},
+	)
+
+	var weightMsgSetAutoRestake int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetAutoRestake, &weightMsgSetAutoRestake, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetAutoRestake = simtestutil.DefaultWeightMsgSetAutoRestake
+		},
+	)
+
+	var weightMsgSetWithdrawAddressWeights int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetWithdrawAddressWeights, &weightMsgSetWithdrawAddressWeights, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetWithdrawAddressWeights = simtestutil.DefaultWeightMsgSetWithdrawAddressWeights
+		},
+	)
+
 	stakeKeeper := sk.(*stakingkeeper.Keeper)
 
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
@@ -79,6 +103,18 @@ func WeightedOperations(appParams simtypes.AppParams, cdc codec.JSONCodec, ak ty
 			weightMsgFundCommunityPool,
 			SimulateMsgFundCommunityPool(txConfig, ak, bk, k, stakeKeeper),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdrawAllDelegatorRewards,
+			SimulateMsgWithdrawAllDelegatorRewards(txConfig, ak, bk, k, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetAutoRestake,
+			SimulateMsgSetAutoRestake(txConfig, ak, bk, k, stakeKeeper),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetWithdrawAddressWeights,
+			SimulateMsgSetWithdrawAddressWeights(txConfig, ak, bk, k),
+		),
 	}
 }
 
@@ -250,3 +286,148 @@ func SimulateMsgFundCommunityPool(txConfig client.TxConfig, ak types.AccountKeep
 		return simulation.GenAndDeliverTx(txCtx, fees)
 	}
 }
+
+// SimulateMsgWithdrawAllDelegatorRewards generates a MsgWithdrawAllDelegatorRewards with random values.
+func SimulateMsgWithdrawAllDelegatorRewards(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk *stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetAllDelegatorDelegations(ctx, simAccount.Address)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgWithdrawAllDelegatorRewards, "number of delegators equal 0"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgWithdrawAllDelegatorRewards(simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetAutoRestake generates a MsgSetAutoRestake with random values.
+func SimulateMsgSetAutoRestake(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, sk *stakingkeeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		enabled := r.Intn(2) == 0
+
+		if enabled && (!k.GetDelegatorWithdrawAddr(ctx, simAccount.Address).Equals(simAccount.Address) ||
+			len(k.GetDelegatorWithdrawAddressWeights(ctx, simAccount.Address)) > 0) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoRestake, "withdraw address is not the delegator address"), nil, nil
+		}
+
+		if enabled && len(sk.GetAllDelegatorDelegations(ctx, simAccount.Address)) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetAutoRestake, "number of delegators equal 0"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgSetAutoRestake(simAccount.Address, enabled)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgSetWithdrawAddressWeights generates a MsgSetWithdrawAddressWeights with random values.
+func SimulateMsgSetWithdrawAddressWeights(txConfig client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetWithdrawAddrEnabled(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetWithdrawAddressWeights, "withdrawal is not enabled"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		msg := types.NewMsgSetWithdrawAddressWeights(simAccount.Address, randomWithdrawAddressWeights(r, accs))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomWithdrawAddressWeights returns up to three weighted withdraw addresses
+// adding up to one, or none to remove the weights.
+func randomWithdrawAddressWeights(r *rand.Rand, accs []simtypes.Account) []types.WithdrawAddressWeight {
+	n := r.Intn(4)
+	if n == 0 {
+		return nil
+	}
+
+	addrs := make(map[string]bool, n)
+	weights := make([]types.WithdrawAddressWeight, 0, n)
+	remaining := sdk.OneDec()
+	for i := 0; i < n; i++ {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		if addrs[acc.Address.String()] {
+			continue
+		}
+		addrs[acc.Address.String()] = true
+
+		// the last address receives whatever is left
+		weight := remaining
+		if i < n-1 {
+			weight = remaining.MulInt64(int64(r.Intn(99) + 1)).QuoInt64(100)
+		}
+		remaining = remaining.Sub(weight)
+
+		weights = append(weights, types.NewWithdrawAddressWeight(acc.Address, weight))
+	}
+
+	// what is left when the last address was a duplicate goes to the first one
+	weights[0].Weight = weights[0].Weight.Add(remaining)
+
+	return weights
+}
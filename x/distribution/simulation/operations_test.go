@@ -47,6 +47,9 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simtestutil.DefaultWeightMsgWithdrawDelegationReward, types.ModuleName, types.TypeMsgWithdrawDelegatorReward},
 		{simtestutil.DefaultWeightMsgWithdrawValidatorCommission, types.ModuleName, types.TypeMsgWithdrawValidatorCommission},
 		{simtestutil.DefaultWeightMsgFundCommunityPool, types.ModuleName, types.TypeMsgFundCommunityPool},
+		{simtestutil.DefaultWeightMsgWithdrawAllDelegatorRewards, types.ModuleName, types.TypeMsgWithdrawAllDelegatorRewards},
+		{simtestutil.DefaultWeightMsgSetAutoRestake, types.ModuleName, types.TypeMsgSetAutoRestake},
+		{simtestutil.DefaultWeightMsgSetWithdrawAddressWeights, types.ModuleName, types.TypeMsgSetWithdrawAddressWeights},
 	}
 
 	for i, w := range weightesOps {
@@ -11,8 +11,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
-const OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+// Simulation proposal weights keys
+const (
+	// OpWeightSubmitCommunitySpendProposal app params key for community spend proposal
+	OpWeightSubmitCommunitySpendProposal = "op_weight_submit_community_spend_proposal"
+	// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
@@ -49,3 +54,29 @@ func SimulateCommunityPoolSpendProposalContent(k keeper.Keeper) simtypes.Content
 		)
 	}
 }
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams randomizing the reward
+// and auto restake params of the current distribution params.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := k.GetParams(ctx)
+		params.CommunityTax = GenCommunityTax(r)
+		params.BaseProposerReward = GenBaseProposerReward(r)
+		params.BonusProposerReward = GenBonusProposerReward(r)
+		params.WithdrawAddrEnabled = GenWithdrawEnabled(r)
+		params.MaxAutoRestakePerBlock = GenMaxAutoRestakePerBlock(r)
+
+		return &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	"github.com/cosmos/cosmos-sdk/x/distribution/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestProposalContents(t *testing.T) {
@@ -51,3 +52,30 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, "distribution", content.ProposalRoute())
 	require.Equal(t, "CommunityPoolSpend", content.ProposalType())
 }
+
+func TestProposalMsgs(t *testing.T) {
+	var distrKeeper keeper.Keeper
+	app, err := simtestutil.Setup(testutil.AppConfig, &distrKeeper)
+	require.NoError(t, err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	// initialize parameters
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs(distrKeeper)
+	require.Len(t, weightedProposalMsgs, 1)
+
+	w0 := weightedProposalMsgs[0]
+
+	// tests w0 interface:
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w0.AppParamsKey())
+	require.Equal(t, simtestutil.DefaultWeightMsgUpdateParams, w0.DefaultWeight())
+
+	msg, ok := w0.MsgSimulatorFn()(r, ctx, nil).(*types.MsgUpdateParams)
+	require.True(t, ok)
+	require.Equal(t, distrKeeper.GetAuthority(), msg.Authority)
+	require.NoError(t, msg.ValidateBasic())
+}
@@ -18,6 +18,14 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValCommission")
 	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(cdc, &MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/distribution/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgCommunityPoolSpend{}, "cosmos-sdk/distr/MsgCommunityPoolSpend")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateContinuousFund{}, "cosmos-sdk/MsgCreateContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelContinuousFund{}, "cosmos-sdk/MsgCancelContinuousFund")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoRestake{}, "cosmos-sdk/MsgSetAutoRestake")
+	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddressWeights{}, "cosmos-sdk/MsgSetWithdrawAddressWeights")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTSRReward")
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 }
 
@@ -28,6 +36,14 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawValidatorCommission{},
 		&MsgSetWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgUpdateParams{},
+		&MsgCommunityPoolSpend{},
+		&MsgCreateContinuousFund{},
+		&MsgCancelContinuousFund{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgSetAutoRestake{},
+		&MsgSetWithdrawAddressWeights{},
+		&MsgWithdrawTokenizeShareRecordReward{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewContinuousFund creates a new ContinuousFund instance.
+//
+//nolint:interfacer
+func NewContinuousFund(recipient sdk.AccAddress, percentage sdk.Dec, expiry *time.Time) ContinuousFund {
+	return ContinuousFund{
+		Recipient:  recipient.String(),
+		Percentage: percentage,
+		Expiry:     expiry,
+	}
+}
+
+// IsExpired returns true if the continuous fund is no longer paid at the given
+// block time.
+func (cf ContinuousFund) IsExpired(blockTime time.Time) bool {
+	return cf.Expiry != nil && !blockTime.Before(*cf.Expiry)
+}
+
+// Validate performs a stateless validation of the continuous fund.
+func (cf ContinuousFund) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cf.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return validateContinuousFundPercentage(cf.Percentage)
+}
+
+func validateContinuousFundPercentage(percentage sdk.Dec) error {
+	if percentage.IsNil() || !percentage.IsPositive() || percentage.GT(sdk.OneDec()) {
+		return ErrInvalidContinuousFund.Wrapf("percentage must be positive and at most one: %s", percentage)
+	}
+
+	return nil
+}
+
+// ValidateContinuousFunds validates a set of continuous funds, the recipients
+// must be unique and the percentages must not sum up to more than one.
+func ValidateContinuousFunds(funds []ContinuousFund) error {
+	total := sdk.ZeroDec()
+	recipients := make(map[string]bool, len(funds))
+	for _, fund := range funds {
+		if err := fund.Validate(); err != nil {
+			return err
+		}
+
+		if recipients[fund.Recipient] {
+			return ErrContinuousFundExists.Wrapf("duplicate continuous fund for recipient %s", fund.Recipient)
+		}
+		recipients[fund.Recipient] = true
+
+		total = total.Add(fund.Percentage)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return ErrInvalidContinuousFund.Wrapf("total percentage of the continuous funds exceeds one: %s", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestContinuousFundIsExpired(t *testing.T) {
+	now := time.Now().UTC()
+	expiry := now.Add(time.Hour)
+
+	require.False(t, NewContinuousFund(delAddr1, sdk.OneDec(), nil).IsExpired(now))
+	require.False(t, NewContinuousFund(delAddr1, sdk.OneDec(), &expiry).IsExpired(now))
+	require.True(t, NewContinuousFund(delAddr1, sdk.OneDec(), &expiry).IsExpired(expiry))
+}
+
+func TestValidateContinuousFunds(t *testing.T) {
+	tests := []struct {
+		name       string
+		funds      []ContinuousFund
+		expectPass bool
+	}{
+		{"empty", nil, true},
+		{"invalid recipient", []ContinuousFund{{Recipient: "invalid", Percentage: sdk.OneDec()}}, false},
+		{"invalid percentage", []ContinuousFund{NewContinuousFund(delAddr1, sdk.ZeroDec(), nil)}, false},
+		{
+			"duplicate recipient",
+			[]ContinuousFund{
+				NewContinuousFund(delAddr1, sdk.NewDecWithPrec(1, 1), nil),
+				NewContinuousFund(delAddr1, sdk.NewDecWithPrec(1, 1), nil),
+			},
+			false,
+		},
+		{
+			"total percentage exceeds one",
+			[]ContinuousFund{
+				NewContinuousFund(delAddr1, sdk.NewDecWithPrec(5, 1), nil),
+				NewContinuousFund(delAddr2, sdk.NewDecWithPrec(6, 1), nil),
+			},
+			false,
+		},
+		{
+			"valid",
+			[]ContinuousFund{
+				NewContinuousFund(delAddr1, sdk.NewDecWithPrec(5, 1), nil),
+				NewContinuousFund(delAddr2, sdk.NewDecWithPrec(5, 1), nil),
+			},
+			true,
+		},
+	}
+	for _, tc := range tests {
+		err := ValidateContinuousFunds(tc.funds)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
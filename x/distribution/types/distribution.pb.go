@@ -10,15 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -32,6 +36,12 @@ type Params struct {
 	BaseProposerReward  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_proposer_reward,json=baseProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_proposer_reward"`
 	BonusProposerReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// max_auto_restake_per_block is the maximum number of auto-restaking
+	// delegations processed by the end blocker in a single block. Auto-restaking
+	// is paused when it is zero.
+	//
+	// Since: cosmos-sdk 0.47
+	MaxAutoRestakePerBlock uint32 `protobuf:"varint,5,opt,name=max_auto_restake_per_block,json=maxAutoRestakePerBlock,proto3" json:"max_auto_restake_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,6 +83,13 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMaxAutoRestakePerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoRestakePerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -80,11 +97,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -606,6 +624,166 @@ func (m *CommunityPoolSpendProposalWithDeposit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageInfo
 
+// ContinuousFund defines a funding stream paying a recipient a percentage of
+// the community pool inflow of each block until it expires.
+//
+// Since: cosmos-sdk 0.47
+type ContinuousFund struct {
+	// recipient is the address receiving the funds.
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// percentage is the fraction of the community pool inflow of each block
+	// paid to the recipient.
+	Percentage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=percentage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percentage"`
+	// expiry is the time from which the fund is no longer paid, the fund is paid
+	// until it is cancelled when it is not set.
+	Expiry *time.Time `protobuf:"bytes,3,opt,name=expiry,proto3,stdtime" json:"expiry,omitempty"`
+}
+
+func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
+func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
+func (*ContinuousFund) ProtoMessage()    {}
+func (*ContinuousFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContinuousFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContinuousFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContinuousFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContinuousFund.Merge(m, src)
+}
+func (m *ContinuousFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContinuousFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContinuousFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContinuousFund proto.InternalMessageInfo
+
+func (m *ContinuousFund) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ContinuousFund) GetExpiry() *time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+// WithdrawAddressWeight defines an address receiving a share of the withdrawn
+// rewards and commission of a delegator.
+//
+// Since: cosmos-sdk 0.47
+type WithdrawAddressWeight struct {
+	// address is the address receiving the share.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight is the fraction of the withdrawn funds sent to the address.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *WithdrawAddressWeight) Reset()         { *m = WithdrawAddressWeight{} }
+func (m *WithdrawAddressWeight) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddressWeight) ProtoMessage()    {}
+func (*WithdrawAddressWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *WithdrawAddressWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddressWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddressWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddressWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddressWeight.Merge(m, src)
+}
+func (m *WithdrawAddressWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddressWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddressWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddressWeight proto.InternalMessageInfo
+
+func (m *WithdrawAddressWeight) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// WithdrawAddressWeights is a collection of WithdrawAddressWeight messages.
+//
+// Since: cosmos-sdk 0.47
+type WithdrawAddressWeights struct {
+	Weights []WithdrawAddressWeight `protobuf:"bytes,1,rep,name=weights,proto3" json:"weights"`
+}
+
+func (m *WithdrawAddressWeights) Reset()         { *m = WithdrawAddressWeights{} }
+func (m *WithdrawAddressWeights) String() string { return proto.CompactTextString(m) }
+func (*WithdrawAddressWeights) ProtoMessage()    {}
+func (*WithdrawAddressWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{14}
+}
+func (m *WithdrawAddressWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithdrawAddressWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WithdrawAddressWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *WithdrawAddressWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawAddressWeights.Merge(m, src)
+}
+func (m *WithdrawAddressWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithdrawAddressWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawAddressWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawAddressWeights proto.InternalMessageInfo
+
+func (m *WithdrawAddressWeights) GetWeights() []WithdrawAddressWeight {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
@@ -619,6 +797,9 @@ func init() {
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
 	proto.RegisterType((*CommunityPoolSpendProposalWithDeposit)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposalWithDeposit")
+	proto.RegisterType((*ContinuousFund)(nil), "cosmos.distribution.v1beta1.ContinuousFund")
+	proto.RegisterType((*WithdrawAddressWeight)(nil), "cosmos.distribution.v1beta1.WithdrawAddressWeight")
+	proto.RegisterType((*WithdrawAddressWeights)(nil), "cosmos.distribution.v1beta1.WithdrawAddressWeights")
 }
 
 func init() {
@@ -626,65 +807,77 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0x7c, 0xe3, 0x38, 0xe9, 0x6b, 0x9b, 0x7c, 0x99, 0x38, 0xa9, 0xe3, 0x56, 0x76, 0xb4,
-	0x12, 0x10, 0x54, 0xc5, 0x69, 0xda, 0x5b, 0xc4, 0x25, 0x76, 0x82, 0xe0, 0xd4, 0x68, 0x83, 0x00,
-	0x71, 0x59, 0x8d, 0x77, 0x27, 0xf6, 0x28, 0xbb, 0x33, 0xcb, 0xcc, 0xac, 0x93, 0x9e, 0x39, 0xf0,
-	0xe3, 0x84, 0xc4, 0x05, 0x71, 0x40, 0x3d, 0x22, 0xce, 0xbd, 0x70, 0xe4, 0xd6, 0x63, 0xe9, 0x05,
-	0xc4, 0x21, 0xa0, 0x44, 0x48, 0x88, 0xbf, 0x02, 0xcd, 0xce, 0x78, 0xd7, 0x81, 0x50, 0xf5, 0x10,
-	0x8b, 0x93, 0x3d, 0xef, 0xcd, 0x7e, 0x7e, 0xbc, 0x79, 0xfb, 0x66, 0xa1, 0x13, 0x0a, 0x95, 0x08,
-	0xb5, 0x19, 0x31, 0xa5, 0x25, 0xeb, 0x67, 0x9a, 0x09, 0xbe, 0x39, 0xda, 0xea, 0x53, 0x4d, 0xb6,
-	0x2e, 0x04, 0x3b, 0xa9, 0x14, 0x5a, 0xe0, 0xdb, 0x76, 0x7f, 0xe7, 0x42, 0xca, 0xed, 0x6f, 0xd6,
-	0x07, 0x62, 0x20, 0xf2, 0x7d, 0x9b, 0xe6, 0x9f, 0x7d, 0xa4, 0xd9, 0x72, 0x14, 0x7d, 0xa2, 0x68,
-	0x01, 0x1d, 0x0a, 0xe6, 0x20, 0x9b, 0xab, 0x36, 0x1f, 0xd8, 0x07, 0x1d, 0x7e, 0xbe, 0xf0, 0x3e,
-	0x99, 0x81, 0xda, 0x3e, 0x91, 0x24, 0x51, 0x98, 0xc0, 0xcd, 0x50, 0x24, 0x49, 0xc6, 0x99, 0x7e,
-	0x14, 0x68, 0x72, 0xd2, 0x40, 0x6b, 0x68, 0xfd, 0x5a, 0xf7, 0xcd, 0xa7, 0xa7, 0xed, 0xca, 0x2f,
-	0xa7, 0xed, 0xd7, 0x06, 0x4c, 0x0f, 0xb3, 0x7e, 0x27, 0x14, 0x89, 0x83, 0x70, 0x3f, 0x1b, 0x2a,
-	0x3a, 0xda, 0xd4, 0x8f, 0x52, 0xaa, 0x3a, 0xbb, 0x34, 0x7c, 0xfe, 0x64, 0x03, 0x1c, 0xc3, 0x2e,
-	0x0d, 0xfd, 0x1b, 0x05, 0xe4, 0xbb, 0xe4, 0x04, 0x73, 0xa8, 0x1b, 0x8d, 0x46, 0x48, 0x2a, 0x14,
-	0x95, 0x81, 0xa4, 0xc7, 0x44, 0x46, 0x8d, 0xff, 0x5d, 0x01, 0x13, 0x36, 0xc8, 0xfb, 0x0e, 0xd8,
-	0xcf, 0x71, 0x71, 0x0a, 0xcb, 0x7d, 0xc1, 0x33, 0xf5, 0x0f, 0xc2, 0x99, 0x2b, 0x20, 0x5c, 0xca,
-	0xa1, 0xff, 0xc6, 0x78, 0x1f, 0x96, 0x8f, 0x99, 0x1e, 0x46, 0x92, 0x1c, 0x07, 0x24, 0x8a, 0x64,
-	0x40, 0x39, 0xe9, 0xc7, 0x34, 0x6a, 0x54, 0xd7, 0xd0, 0xfa, 0xbc, 0xbf, 0x34, 0x4e, 0xee, 0x44,
-	0x91, 0xdc, 0xb3, 0xa9, 0xed, 0xea, 0x57, 0x8f, 0xdb, 0x15, 0xef, 0x47, 0x04, 0xcd, 0xf7, 0x48,
-	0xcc, 0x22, 0xa2, 0x85, 0x7c, 0x9b, 0x29, 0x2d, 0x24, 0x0b, 0x49, 0x6c, 0x71, 0x15, 0xfe, 0x0c,
-	0xc1, 0xad, 0x30, 0x4b, 0xb2, 0x98, 0x68, 0x36, 0xa2, 0xce, 0x47, 0x20, 0x89, 0x66, 0xa2, 0x81,
-	0xd6, 0x66, 0xd6, 0xaf, 0xdf, 0xbf, 0xe3, 0x3a, 0xad, 0x63, 0x0a, 0x31, 0xee, 0x18, 0xa3, 0xb4,
-	0x27, 0x18, 0xef, 0x3e, 0x30, 0x5e, 0xbf, 0xfb, 0xb5, 0x7d, 0xf7, 0xe5, 0xbc, 0x9a, 0x67, 0x94,
-	0xbf, 0x5c, 0x32, 0x5a, 0x1d, 0xbe, 0xe1, 0xc3, 0xaf, 0xc3, 0xa2, 0xa4, 0x87, 0x54, 0x52, 0x1e,
-	0xd2, 0x20, 0x14, 0x19, 0xd7, 0xf9, 0x09, 0xde, 0xf4, 0x17, 0x8a, 0x70, 0xcf, 0x44, 0xbd, 0x6f,
-	0x10, 0xdc, 0x2a, 0x3c, 0xf5, 0x32, 0x29, 0x29, 0xd7, 0x63, 0x43, 0x47, 0x30, 0x67, 0x4d, 0xa8,
-	0xe9, 0xe9, 0x1f, 0x33, 0xe0, 0x15, 0xa8, 0xa5, 0x54, 0x32, 0x61, 0x5b, 0xad, 0xea, 0xbb, 0x95,
-	0xf7, 0x25, 0x82, 0x56, 0x21, 0x70, 0x27, 0x74, 0x76, 0x69, 0xd4, 0x13, 0x49, 0xc2, 0x94, 0x62,
-	0x82, 0xe3, 0x8f, 0x00, 0xc2, 0x62, 0x35, 0x3d, 0xa9, 0x13, 0x24, 0xde, 0xe7, 0x08, 0x6e, 0x17,
-	0xaa, 0x1e, 0x66, 0x5a, 0x69, 0xc2, 0x23, 0xc6, 0x07, 0xff, 0x45, 0xe9, 0xbc, 0xaf, 0x11, 0x2c,
-	0x15, 0x62, 0x0e, 0x62, 0xa2, 0x86, 0x7b, 0x23, 0xca, 0x35, 0x7e, 0x03, 0xfe, 0x3f, 0x1a, 0x87,
-	0x03, 0x57, 0x5c, 0x94, 0x17, 0x77, 0xb1, 0x88, 0xef, 0xe7, 0x61, 0xfc, 0x01, 0xcc, 0x1f, 0x4a,
-	0x12, 0x9a, 0x49, 0x76, 0x25, 0xaf, 0x7a, 0x81, 0x66, 0x2a, 0x55, 0xbf, 0x44, 0x9c, 0xc2, 0x31,
-	0xac, 0x94, 0xea, 0x94, 0x49, 0x04, 0x34, 0xcf, 0xb8, 0x8a, 0xdd, 0xeb, 0xbc, 0x60, 0xcc, 0x76,
-	0x2e, 0x81, 0xec, 0x56, 0x8d, 0x64, 0xbf, 0x3e, 0xba, 0x84, 0xcd, 0xbd, 0xc1, 0x1f, 0x23, 0x98,
-	0x7b, 0x8b, 0xd2, 0x7d, 0x21, 0x62, 0x7c, 0x02, 0x0b, 0xe5, 0x30, 0x4d, 0x85, 0x88, 0xa7, 0x77,
-	0x52, 0xe5, 0xd4, 0x36, 0xcc, 0xde, 0xef, 0x08, 0x9a, 0xbd, 0xc9, 0xc8, 0x41, 0x4a, 0x79, 0x64,
-	0xc7, 0x14, 0x89, 0x71, 0x1d, 0x66, 0x35, 0xd3, 0x31, 0xb5, 0xd3, 0xdd, 0xb7, 0x0b, 0xbc, 0x06,
-	0xd7, 0x23, 0xaa, 0x42, 0xc9, 0xd2, 0xf2, 0x90, 0xfc, 0xc9, 0x10, 0xbe, 0x03, 0xd7, 0x24, 0x0d,
-	0x59, 0xca, 0x28, 0xd7, 0x76, 0x7c, 0xfa, 0x65, 0x00, 0x87, 0x50, 0x23, 0x49, 0x3e, 0x08, 0xaa,
-	0xb9, 0xcd, 0xd5, 0x4b, 0x6d, 0xe6, 0x1e, 0xef, 0x39, 0x8f, 0xeb, 0x2f, 0xe1, 0xd1, 0x1a, 0x74,
-	0xd0, 0xdb, 0x37, 0x3e, 0x7d, 0xdc, 0xae, 0x98, 0x4a, 0xff, 0x61, 0xaa, 0xfd, 0x03, 0x82, 0xe5,
-	0x5d, 0x1a, 0xd3, 0x41, 0x7e, 0x18, 0x9a, 0x48, 0xcd, 0xf8, 0xe0, 0x1d, 0x7e, 0x98, 0x8f, 0xa7,
-	0x54, 0xd2, 0x11, 0x13, 0x66, 0xf0, 0x4f, 0x36, 0xe6, 0xc2, 0x38, 0xec, 0xfa, 0xd2, 0x87, 0x59,
-	0xa5, 0xc9, 0x11, 0xbd, 0x92, 0xa6, 0xb4, 0x50, 0xf8, 0x2e, 0xd4, 0x86, 0x94, 0x0d, 0x86, 0xb6,
-	0x48, 0xd5, 0xee, 0xd2, 0x9f, 0xa7, 0xed, 0xc5, 0x50, 0x52, 0x33, 0x38, 0x79, 0x60, 0x53, 0xbe,
-	0xdb, 0xe2, 0xfd, 0x84, 0x60, 0xd5, 0x79, 0x60, 0x82, 0x17, 0x6e, 0xdc, 0x5d, 0xb2, 0x07, 0xaf,
-	0x94, 0x3d, 0x6c, 0x2e, 0x13, 0xaa, 0x94, 0xbb, 0x94, 0x1b, 0xcf, 0x9f, 0x6c, 0xd4, 0x1d, 0xf9,
-	0x8e, 0xcd, 0x1c, 0x68, 0x69, 0x46, 0x44, 0xf9, 0x52, 0xba, 0x38, 0x66, 0x50, 0x2b, 0xae, 0xd9,
-	0x29, 0xb5, 0xa0, 0x23, 0xd8, 0x9e, 0x77, 0x27, 0x84, 0xbc, 0xef, 0x11, 0xbc, 0xfa, 0xef, 0x5d,
-	0xf8, 0x3e, 0xd3, 0xc3, 0x5d, 0x9a, 0x0a, 0xc5, 0xf4, 0x94, 0x1a, 0x72, 0x65, 0xa2, 0x21, 0x4d,
-	0xca, 0xad, 0x70, 0x03, 0xe6, 0x22, 0x4b, 0xdc, 0x98, 0xcd, 0x13, 0xe3, 0x65, 0xa9, 0xbd, 0xfb,
-	0xf0, 0xdb, 0xb3, 0x16, 0x7a, 0x7a, 0xd6, 0x42, 0xcf, 0xce, 0x5a, 0xe8, 0xb7, 0xb3, 0x16, 0xfa,
-	0xe2, 0xbc, 0x55, 0x79, 0x76, 0xde, 0xaa, 0xfc, 0x7c, 0xde, 0xaa, 0x7c, 0xb8, 0xf5, 0xc2, 0xc2,
-	0x9c, 0x5c, 0xfc, 0xce, 0xcb, 0xeb, 0xd4, 0xaf, 0xe5, 0xdf, 0x5a, 0x0f, 0xfe, 0x0a, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x12, 0x5a, 0x76, 0x0b, 0x0a, 0x00, 0x00,
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x50, 0xc7, 0x49, 0x26, 0x4d, 0x02, 0x13, 0x27, 0x75, 0xdc, 0xca, 0x8e, 0x2c, 0x01,
+	0x41, 0x55, 0xec, 0x26, 0x95, 0x10, 0x8a, 0xb8, 0xc4, 0x4e, 0x2a, 0x38, 0x35, 0xda, 0x44, 0x04,
+	0x21, 0xa4, 0xd5, 0x78, 0x77, 0x62, 0x8f, 0xb2, 0x3b, 0xb3, 0xcc, 0xcc, 0x3a, 0xce, 0x99, 0x0b,
+	0x70, 0xaa, 0xc4, 0x05, 0x71, 0x40, 0xe5, 0x86, 0x38, 0xf7, 0xc2, 0x11, 0x71, 0xe9, 0xb1, 0xf4,
+	0x02, 0xe2, 0x90, 0x56, 0x89, 0x90, 0x10, 0xbf, 0x02, 0xcd, 0xce, 0xec, 0xda, 0x01, 0x13, 0x72,
+	0x70, 0xc4, 0x29, 0x99, 0xf7, 0xde, 0x7e, 0xdf, 0xfb, 0xde, 0x7b, 0xfb, 0x66, 0x0d, 0xeb, 0x1e,
+	0x97, 0x21, 0x97, 0x0d, 0x9f, 0x4a, 0x25, 0x68, 0x3b, 0x56, 0x94, 0xb3, 0x46, 0x6f, 0xbd, 0x4d,
+	0x14, 0x5e, 0xbf, 0x60, 0xac, 0x47, 0x82, 0x2b, 0x8e, 0x6e, 0x9b, 0xf8, 0xfa, 0x05, 0x97, 0x8d,
+	0x2f, 0x17, 0x3b, 0xbc, 0xc3, 0x93, 0xb8, 0x86, 0xfe, 0xcf, 0x3c, 0x52, 0xae, 0x58, 0x8a, 0x36,
+	0x96, 0x24, 0x83, 0xf6, 0x38, 0xb5, 0x90, 0xe5, 0x65, 0xe3, 0x77, 0xcd, 0x83, 0x16, 0xdf, 0xb8,
+	0xaa, 0x1d, 0xce, 0x3b, 0x01, 0x69, 0x24, 0xa7, 0x76, 0x7c, 0xd8, 0x50, 0x34, 0x24, 0x52, 0xe1,
+	0x30, 0x32, 0x01, 0xb5, 0x9f, 0x6e, 0xc0, 0xc2, 0x2e, 0x16, 0x38, 0x94, 0x08, 0xc3, 0x59, 0x8f,
+	0x87, 0x61, 0xcc, 0xa8, 0x3a, 0x71, 0x15, 0xee, 0x97, 0xc0, 0x0a, 0x58, 0x9d, 0x6e, 0xbe, 0xfb,
+	0xf4, 0xb4, 0x9a, 0xfb, 0xed, 0xb4, 0xfa, 0x46, 0x87, 0xaa, 0x6e, 0xdc, 0xae, 0x7b, 0x3c, 0xb4,
+	0x1c, 0xf6, 0xcf, 0x9a, 0xf4, 0x8f, 0x1a, 0xea, 0x24, 0x22, 0xb2, 0xbe, 0x4d, 0xbc, 0xe7, 0x4f,
+	0xd6, 0xa0, 0x4d, 0x61, 0x9b, 0x78, 0xce, 0xcd, 0x0c, 0x72, 0x1f, 0xf7, 0x11, 0x83, 0x45, 0x2d,
+	0x42, 0x67, 0x1a, 0x71, 0x49, 0x84, 0x2b, 0xc8, 0x31, 0x16, 0x7e, 0xe9, 0x95, 0x31, 0x30, 0x21,
+	0x8d, 0xbc, 0x6b, 0x81, 0x9d, 0x04, 0x17, 0x45, 0x70, 0xb1, 0xcd, 0x59, 0x2c, 0xff, 0x41, 0x78,
+	0x63, 0x0c, 0x84, 0x0b, 0x09, 0xf4, 0xdf, 0x18, 0x37, 0xe0, 0xe2, 0x31, 0x55, 0x5d, 0x5f, 0xe0,
+	0x63, 0x17, 0xfb, 0xbe, 0x70, 0x09, 0xc3, 0xed, 0x80, 0xf8, 0xa5, 0xfc, 0x0a, 0x58, 0x9d, 0x72,
+	0x16, 0x52, 0xe7, 0x96, 0xef, 0x8b, 0x1d, 0xe3, 0x42, 0x9b, 0xb0, 0x1c, 0xe2, 0xbe, 0x8b, 0x63,
+	0xc5, 0x5d, 0xa1, 0xbb, 0x73, 0x44, 0xdc, 0x88, 0x08, 0xb7, 0x1d, 0x70, 0xef, 0xa8, 0x34, 0xb1,
+	0x02, 0x56, 0x67, 0x9d, 0xa5, 0x10, 0xf7, 0xb7, 0x62, 0xc5, 0x1d, 0xe3, 0xdf, 0x25, 0xa2, 0xa9,
+	0xbd, 0x9b, 0xf9, 0xaf, 0x1e, 0x57, 0x73, 0xb5, 0x9f, 0x01, 0x2c, 0x7f, 0x80, 0x03, 0xea, 0x63,
+	0xc5, 0xc5, 0x7b, 0x54, 0x2a, 0x2e, 0xa8, 0x87, 0x03, 0x93, 0x93, 0x44, 0x9f, 0x03, 0x78, 0xcb,
+	0x8b, 0xc3, 0x38, 0xc0, 0x8a, 0xf6, 0x88, 0xad, 0x81, 0x2b, 0xb0, 0xa2, 0xbc, 0x04, 0x56, 0x6e,
+	0xac, 0xce, 0x6c, 0xdc, 0xb1, 0x63, 0x5c, 0xd7, 0x45, 0x4c, 0xc7, 0x51, 0xab, 0x6c, 0x71, 0xca,
+	0x9a, 0xf7, 0x75, 0x9d, 0xbe, 0x7f, 0x51, 0xbd, 0x7b, 0xb5, 0x3a, 0xe9, 0x67, 0xa4, 0xb3, 0x38,
+	0x60, 0x34, 0x79, 0x38, 0x9a, 0x0f, 0xbd, 0x09, 0xe7, 0x05, 0x39, 0x24, 0x82, 0x30, 0x8f, 0xb8,
+	0x1e, 0x8f, 0x99, 0x4a, 0xba, 0x3f, 0xeb, 0xcc, 0x65, 0xe6, 0x96, 0xb6, 0xd6, 0xbe, 0x01, 0xf0,
+	0x56, 0xa6, 0xa9, 0x15, 0x0b, 0x41, 0x98, 0x4a, 0x05, 0x1d, 0xc1, 0x49, 0x23, 0x42, 0x5e, 0x5f,
+	0xfe, 0x29, 0x03, 0x5a, 0x82, 0x85, 0x88, 0x08, 0xca, 0xcd, 0x98, 0xe6, 0x1d, 0x7b, 0xaa, 0x7d,
+	0x09, 0x60, 0x25, 0x4b, 0x70, 0xcb, 0xb3, 0x72, 0x89, 0xdf, 0xe2, 0x61, 0x48, 0xa5, 0xa4, 0x9c,
+	0xa1, 0x4f, 0x20, 0xf4, 0xb2, 0xd3, 0xf5, 0xa5, 0x3a, 0x44, 0x52, 0xfb, 0x02, 0xc0, 0xdb, 0x59,
+	0x56, 0x0f, 0x63, 0x25, 0x15, 0x66, 0x3e, 0x65, 0x9d, 0xff, 0xa3, 0x74, 0xb5, 0xaf, 0x01, 0x5c,
+	0xc8, 0x92, 0xd9, 0x0b, 0xb0, 0xec, 0xee, 0xf4, 0x08, 0x53, 0xe8, 0x2d, 0xf8, 0x6a, 0x2f, 0x35,
+	0xbb, 0xb6, 0xb8, 0x20, 0x29, 0xee, 0x7c, 0x66, 0xdf, 0x4d, 0xcc, 0xe8, 0x43, 0x38, 0x75, 0x28,
+	0xb0, 0xa7, 0xd7, 0xe4, 0x58, 0xd6, 0x44, 0x86, 0xa6, 0x2b, 0x55, 0x1c, 0x91, 0x9c, 0x44, 0x01,
+	0x5c, 0x1a, 0x64, 0x27, 0xb5, 0xc3, 0x25, 0x89, 0xc7, 0x56, 0xec, 0x5e, 0xfd, 0x92, 0x1d, 0x5e,
+	0x1f, 0x01, 0xd9, 0xcc, 0xeb, 0x94, 0x9d, 0x62, 0x6f, 0x04, 0x9b, 0x7d, 0x83, 0x3f, 0x05, 0x70,
+	0xf2, 0x01, 0x21, 0xbb, 0x9c, 0x07, 0xa8, 0x0f, 0xe7, 0x06, 0x8b, 0x38, 0xe2, 0x3c, 0xb8, 0xbe,
+	0x4e, 0x0d, 0x36, 0xbe, 0x66, 0xae, 0xfd, 0x0e, 0x60, 0xb9, 0x35, 0x6c, 0xd9, 0x8b, 0x08, 0xf3,
+	0xcd, 0x8a, 0xc3, 0x01, 0x2a, 0xc2, 0x09, 0x45, 0x55, 0x40, 0xcc, 0xcd, 0xe0, 0x98, 0x03, 0x5a,
+	0x81, 0x33, 0x3e, 0x91, 0x9e, 0xa0, 0xd1, 0xa0, 0x49, 0xce, 0xb0, 0x09, 0xdd, 0x81, 0xd3, 0x82,
+	0x78, 0x34, 0xa2, 0x84, 0x29, 0xb3, 0x7a, 0x9d, 0x81, 0x01, 0x79, 0xb0, 0x80, 0xc3, 0x64, 0x11,
+	0xe4, 0x13, 0x99, 0xcb, 0x23, 0x65, 0x26, 0x1a, 0xef, 0x59, 0x8d, 0xab, 0x57, 0xd0, 0x68, 0x04,
+	0x5a, 0xe8, 0xcd, 0x9b, 0x9f, 0x3d, 0xae, 0xe6, 0x74, 0xa5, 0xff, 0xd0, 0xd5, 0xfe, 0x11, 0xc0,
+	0xc5, 0x6d, 0x12, 0x90, 0x4e, 0xd2, 0x0c, 0x85, 0x85, 0xa2, 0xac, 0xf3, 0x3e, 0x3b, 0x4c, 0xd6,
+	0x53, 0x24, 0x48, 0x8f, 0x72, 0x7d, 0x69, 0x0c, 0x0f, 0xe6, 0x5c, 0x6a, 0xb6, 0x73, 0xe9, 0xc0,
+	0x89, 0x64, 0x13, 0x8f, 0x65, 0x28, 0x0d, 0x14, 0xba, 0x0b, 0x0b, 0x5d, 0x42, 0x3b, 0x5d, 0x53,
+	0xa4, 0x7c, 0x73, 0xe1, 0xcf, 0xd3, 0xea, 0xbc, 0x27, 0x88, 0x5e, 0x9c, 0xcc, 0x35, 0x2e, 0xc7,
+	0x86, 0xd4, 0x7e, 0x01, 0x70, 0xd9, 0x6a, 0xa0, 0x9c, 0x65, 0x6a, 0xec, 0x3d, 0xb4, 0x03, 0x5f,
+	0x1b, 0xcc, 0xb0, 0xbe, 0x88, 0x88, 0x94, 0xf6, 0x42, 0x2f, 0x3d, 0x7f, 0xb2, 0x56, 0xb4, 0xe4,
+	0x5b, 0xc6, 0xb3, 0xa7, 0x84, 0x5e, 0x11, 0x83, 0x97, 0xd2, 0xda, 0x11, 0x85, 0x85, 0xec, 0x8a,
+	0xbe, 0xa6, 0x11, 0xb4, 0x04, 0x9b, 0x53, 0xb6, 0x43, 0xa0, 0xf6, 0x03, 0x80, 0xaf, 0xff, 0xfb,
+	0x14, 0x1e, 0x50, 0xd5, 0xdd, 0x26, 0x11, 0x97, 0x54, 0x5d, 0xd3, 0x40, 0x2e, 0x0d, 0x0d, 0xa4,
+	0x76, 0xd9, 0x13, 0x2a, 0xc1, 0x49, 0xdf, 0x10, 0x27, 0x97, 0xf2, 0xb4, 0x93, 0x1e, 0x87, 0x72,
+	0x7f, 0x09, 0xe0, 0x5c, 0x8b, 0x33, 0x45, 0x59, 0xcc, 0x63, 0xf9, 0x20, 0x66, 0x3e, 0x7a, 0x7b,
+	0x98, 0xec, 0xbf, 0x5a, 0x30, 0x94, 0xc6, 0xc7, 0x10, 0x46, 0x44, 0x78, 0x84, 0x29, 0xdc, 0x19,
+	0xcf, 0x98, 0x0d, 0xe1, 0xa1, 0x77, 0x60, 0x81, 0xf4, 0x23, 0x2a, 0x4e, 0x12, 0xfd, 0x33, 0x1b,
+	0xe5, 0xba, 0xf9, 0x54, 0xac, 0xa7, 0x9f, 0x8a, 0xf5, 0xfd, 0xf4, 0x53, 0xb1, 0x99, 0x7f, 0xf4,
+	0xa2, 0x0a, 0x1c, 0x1b, 0x5f, 0xfb, 0x16, 0xc0, 0xc5, 0x83, 0xa1, 0xcf, 0x18, 0x22, 0xe5, 0x41,
+	0x32, 0x92, 0x68, 0x03, 0x4e, 0x5e, 0x75, 0xd4, 0xd2, 0x40, 0xb4, 0x0f, 0x0b, 0xc7, 0x66, 0xe6,
+	0xc7, 0xa1, 0xd0, 0x62, 0xd5, 0x02, 0xb8, 0x34, 0x32, 0x45, 0x89, 0x1c, 0x38, 0x69, 0x62, 0xd2,
+	0x6d, 0xbe, 0x71, 0xe9, 0x36, 0x1f, 0x89, 0x62, 0xf7, 0x79, 0x0a, 0xd4, 0x7c, 0xf8, 0xdd, 0x59,
+	0x05, 0x3c, 0x3d, 0xab, 0x80, 0x67, 0x67, 0x15, 0xf0, 0xf2, 0xac, 0x02, 0x1e, 0x9d, 0x57, 0x72,
+	0xcf, 0xce, 0x2b, 0xb9, 0x5f, 0xcf, 0x2b, 0xb9, 0x8f, 0xd6, 0x2f, 0x55, 0xd2, 0xbf, 0xf8, 0xcb,
+	0x21, 0x11, 0xd6, 0x2e, 0x24, 0x4d, 0xb8, 0xff, 0xd7, 0x00, 0x24, 0xf2, 0xc7, 0x22, 0x5d, 0x0c,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -718,6 +911,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.MaxAutoRestakePerBlock != that1.MaxAutoRestakePerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1025,6 +1221,96 @@ func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool
 	}
 	return true
 }
+func (this *ContinuousFund) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContinuousFund)
+	if !ok {
+		that2, ok := that.(ContinuousFund)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Percentage.Equal(that1.Percentage) {
+		return false
+	}
+	if that1.Expiry == nil {
+		if this.Expiry != nil {
+			return false
+		}
+	} else if !this.Expiry.Equal(*that1.Expiry) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawAddressWeight) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawAddressWeight)
+	if !ok {
+		that2, ok := that.(WithdrawAddressWeight)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (this *WithdrawAddressWeights) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WithdrawAddressWeights)
+	if !ok {
+		that2, ok := that.(WithdrawAddressWeights)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Weights) != len(that1.Weights) {
+		return false
+	}
+	for i := range this.Weights {
+		if !this.Weights[i].Equal(&that1.Weights[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1045,6 +1331,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoRestakePerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoRestakePerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1561,69 +1852,199 @@ func (m *CommunityPoolSpendProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDistribution(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CommunityTax.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BaseProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	l = m.BonusProposerReward.Size()
-	n += 1 + l + sovDistribution(uint64(l))
-	if m.WithdrawAddrEnabled {
-		n += 2
-	}
-	return n
+
+func (m *ContinuousFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ValidatorHistoricalRewards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CumulativeRewardRatio) > 0 {
-		for _, e := range m.CumulativeRewardRatio {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	if m.Expiry != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry):])
+		if err1 != nil {
+			return 0, err1
 		}
+		i -= n1
+		i = encodeVarintDistribution(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ReferenceCount != 0 {
-		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	{
+		size := m.Percentage.Size()
+		i -= size
+		if _, err := m.Percentage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorCurrentRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WithdrawAddressWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddressWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddressWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovDistribution(uint64(l))
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	if m.Period != 0 {
-		n += 1 + sovDistribution(uint64(m.Period))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *WithdrawAddressWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithdrawAddressWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithdrawAddressWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDistribution(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDistribution(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityTax.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BaseProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.BonusProposerReward.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.WithdrawAddrEnabled {
+		n += 2
+	}
+	if m.MaxAutoRestakePerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoRestakePerBlock))
+	}
+	return n
+}
+
+func (m *ValidatorHistoricalRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeRewardRatio) > 0 {
+		for _, e := range m.CumulativeRewardRatio {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.ReferenceCount != 0 {
+		n += 1 + sovDistribution(uint64(m.ReferenceCount))
+	}
+	return n
+}
+
+func (m *ValidatorCurrentRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovDistribution(uint64(m.Period))
+	}
+	return n
 }
 
 func (m *ValidatorAccumulatedCommission) Size() (n int) {
@@ -1792,6 +2213,55 @@ func (m *CommunityPoolSpendProposalWithDeposit) Size() (n int) {
 	return n
 }
 
+func (m *ContinuousFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Percentage.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	if m.Expiry != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiry)
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	return n
+}
+
+func (m *WithdrawAddressWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *WithdrawAddressWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovDistribution(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1949,6 +2419,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoRestakePerBlock", wireType)
+			}
+			m.MaxAutoRestakePerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoRestakePerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -3243,6 +3732,358 @@ func (m *CommunityPoolSpendProposalWithDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContinuousFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinuousFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinuousFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Percentage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddressWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddressWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddressWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WithdrawAddressWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithdrawAddressWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithdrawAddressWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, WithdrawAddressWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipDistribution(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,9 +8,18 @@ const (
 	EventTypeWithdrawRewards    = "withdraw_rewards"
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
+	EventTypeContinuousFund     = "continuous_fund"
+	EventTypeSetAutoRestake     = "set_auto_restake"
+	EventTypeAutoRestake        = "auto_restake"
+
+	EventTypeSetWithdrawAddressWeights = "set_withdraw_address_weights"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
+	AttributeKeyWeight          = "weight"
 
 	AttributeValueCategory = ModuleName
 )
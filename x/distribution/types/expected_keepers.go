@@ -1,6 +1,8 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -47,6 +49,16 @@ type StakingKeeper interface {
 		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool))
 
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
+
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	Delegate(
+		ctx sdk.Context, delAddr sdk.AccAddress, bondAmt math.Int, tokenSrc stakingtypes.BondStatus,
+		validator stakingtypes.Validator, subtractAccount bool,
+	) (newShares sdk.Dec, err error)
+	BondDenom(ctx sdk.Context) string
+
+	GetTokenizeShareRecord(ctx sdk.Context, id uint64) (stakingtypes.TokenizeShareRecord, error)
+	GetTokenizeShareRecordByAddress(ctx sdk.Context, addr sdk.AccAddress) (stakingtypes.TokenizeShareRecord, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,6 +11,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	funds []ContinuousFund, autoRestake []string, weights []DelegatorWithdrawAddressWeights,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +24,9 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		ContinuousFunds:                 funds,
+		AutoRestakeDelegators:           autoRestake,
+		DelegatorWithdrawAddressWeights: weights,
 	}
 }
 
@@ -37,6 +43,9 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		ContinuousFunds:                 []ContinuousFund{},
+		AutoRestakeDelegators:           []string{},
+		DelegatorWithdrawAddressWeights: []DelegatorWithdrawAddressWeights{},
 	}
 }
 
@@ -45,5 +54,56 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if err := ValidateContinuousFunds(gs.ContinuousFunds); err != nil {
+		return err
+	}
+	if err := validateAutoRestakeDelegators(gs.AutoRestakeDelegators); err != nil {
+		return err
+	}
+	if err := validateDelegatorWithdrawAddressWeights(gs.DelegatorWithdrawAddressWeights); err != nil {
+		return err
+	}
 	return gs.FeePool.ValidateGenesis()
 }
+
+// validateAutoRestakeDelegators checks that the auto-restake delegators are
+// valid and unique addresses.
+func validateAutoRestakeDelegators(delegators []string) error {
+	seen := make(map[string]bool, len(delegators))
+	for _, del := range delegators {
+		if _, err := sdk.AccAddressFromBech32(del); err != nil {
+			return fmt.Errorf("invalid auto-restake delegator %s: %w", del, err)
+		}
+		if seen[del] {
+			return fmt.Errorf("duplicate auto-restake delegator %s", del)
+		}
+		seen[del] = true
+	}
+
+	return nil
+}
+
+// validateDelegatorWithdrawAddressWeights checks that the delegators are valid
+// and unique addresses with a non-empty valid list of weighted withdraw
+// addresses.
+func validateDelegatorWithdrawAddressWeights(entries []DelegatorWithdrawAddressWeights) error {
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if _, err := sdk.AccAddressFromBech32(entry.DelegatorAddress); err != nil {
+			return fmt.Errorf("invalid withdraw address weights delegator %s: %w", entry.DelegatorAddress, err)
+		}
+		if seen[entry.DelegatorAddress] {
+			return fmt.Errorf("duplicate withdraw address weights for delegator %s", entry.DelegatorAddress)
+		}
+		seen[entry.DelegatorAddress] = true
+
+		if len(entry.Weights) == 0 {
+			return fmt.Errorf("empty withdraw address weights for delegator %s", entry.DelegatorAddress)
+		}
+		if err := ValidateWithdrawAddressWeights(entry.Weights); err != nil {
+			return fmt.Errorf("invalid withdraw address weights for delegator %s: %w", entry.DelegatorAddress, err)
+		}
+	}
+
+	return nil
+}
@@ -69,6 +69,51 @@ func (m *DelegatorWithdrawInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DelegatorWithdrawInfo proto.InternalMessageInfo
 
+// DelegatorWithdrawAddressWeights is the weighted list of addresses the
+// rewards and commission of a delegator are withdrawn to. It is only used
+// for import/export via genesis json.
+//
+// Since: cosmos-sdk 0.47
+type DelegatorWithdrawAddressWeights struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// weights are the addresses the rewards are withdrawn to and their weights.
+	Weights []WithdrawAddressWeight `protobuf:"bytes,2,rep,name=weights,proto3" json:"weights"`
+}
+
+func (m *DelegatorWithdrawAddressWeights) Reset()         { *m = DelegatorWithdrawAddressWeights{} }
+func (m *DelegatorWithdrawAddressWeights) String() string { return proto.CompactTextString(m) }
+func (*DelegatorWithdrawAddressWeights) ProtoMessage()    {}
+func (*DelegatorWithdrawAddressWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{1}
+}
+func (m *DelegatorWithdrawAddressWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorWithdrawAddressWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorWithdrawAddressWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorWithdrawAddressWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorWithdrawAddressWeights.Merge(m, src)
+}
+func (m *DelegatorWithdrawAddressWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorWithdrawAddressWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorWithdrawAddressWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorWithdrawAddressWeights proto.InternalMessageInfo
+
 // ValidatorOutstandingRewardsRecord is used for import/export via genesis json.
 type ValidatorOutstandingRewardsRecord struct {
 	// validator_address is the address of the validator.
@@ -81,7 +126,7 @@ func (m *ValidatorOutstandingRewardsRecord) Reset()         { *m = ValidatorOuts
 func (m *ValidatorOutstandingRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewardsRecord) ProtoMessage()    {}
 func (*ValidatorOutstandingRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{1}
+	return fileDescriptor_76eed0f9489db580, []int{2}
 }
 func (m *ValidatorOutstandingRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -123,7 +168,7 @@ func (m *ValidatorAccumulatedCommissionRecord) Reset()         { *m = ValidatorA
 func (m *ValidatorAccumulatedCommissionRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommissionRecord) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommissionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{2}
+	return fileDescriptor_76eed0f9489db580, []int{3}
 }
 func (m *ValidatorAccumulatedCommissionRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,7 +212,7 @@ func (m *ValidatorHistoricalRewardsRecord) Reset()         { *m = ValidatorHisto
 func (m *ValidatorHistoricalRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewardsRecord) ProtoMessage()    {}
 func (*ValidatorHistoricalRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{3}
+	return fileDescriptor_76eed0f9489db580, []int{4}
 }
 func (m *ValidatorHistoricalRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -208,7 +253,7 @@ func (m *ValidatorCurrentRewardsRecord) Reset()         { *m = ValidatorCurrentR
 func (m *ValidatorCurrentRewardsRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewardsRecord) ProtoMessage()    {}
 func (*ValidatorCurrentRewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{4}
+	return fileDescriptor_76eed0f9489db580, []int{5}
 }
 func (m *ValidatorCurrentRewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -251,7 +296,7 @@ func (m *DelegatorStartingInfoRecord) Reset()         { *m = DelegatorStartingIn
 func (m *DelegatorStartingInfoRecord) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfoRecord) ProtoMessage()    {}
 func (*DelegatorStartingInfoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{5}
+	return fileDescriptor_76eed0f9489db580, []int{6}
 }
 func (m *DelegatorStartingInfoRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +341,7 @@ func (m *ValidatorSlashEventRecord) Reset()         { *m = ValidatorSlashEventRe
 func (m *ValidatorSlashEventRecord) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEventRecord) ProtoMessage()    {}
 func (*ValidatorSlashEventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{6}
+	return fileDescriptor_76eed0f9489db580, []int{7}
 }
 func (m *ValidatorSlashEventRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,13 +392,28 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// continuous_funds defines the continuous funds of the community pool at
+	// genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	ContinuousFunds []ContinuousFund `protobuf:"bytes,11,rep,name=continuous_funds,json=continuousFunds,proto3" json:"continuous_funds"`
+	// auto_restake_delegators defines the delegators whose rewards are
+	// automatically re-delegated at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	AutoRestakeDelegators []string `protobuf:"bytes,12,rep,name=auto_restake_delegators,json=autoRestakeDelegators,proto3" json:"auto_restake_delegators,omitempty"`
+	// delegator_withdraw_address_weights defines the weighted withdraw addresses
+	// of the delegators at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	DelegatorWithdrawAddressWeights []DelegatorWithdrawAddressWeights `protobuf:"bytes,13,rep,name=delegator_withdraw_address_weights,json=delegatorWithdrawAddressWeights,proto3" json:"delegator_withdraw_address_weights"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -384,6 +444,7 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*DelegatorWithdrawInfo)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawInfo")
+	proto.RegisterType((*DelegatorWithdrawAddressWeights)(nil), "cosmos.distribution.v1beta1.DelegatorWithdrawAddressWeights")
 	proto.RegisterType((*ValidatorOutstandingRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorOutstandingRewardsRecord")
 	proto.RegisterType((*ValidatorAccumulatedCommissionRecord)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommissionRecord")
 	proto.RegisterType((*ValidatorHistoricalRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewardsRecord")
@@ -398,63 +459,71 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xeb, 0x44,
-	0x14, 0x8e, 0x93, 0x92, 0x9b, 0x3b, 0xb9, 0x88, 0x8b, 0x6f, 0x6f, 0x71, 0x7b, 0x8b, 0xd3, 0x96,
-	0x2e, 0x8a, 0x50, 0x1d, 0x9a, 0x22, 0x40, 0x45, 0x20, 0x25, 0x69, 0xf9, 0x59, 0xb5, 0x4a, 0x10,
-	0x95, 0x90, 0x50, 0x34, 0xb1, 0x27, 0xce, 0x40, 0xe2, 0x89, 0x66, 0xc6, 0x6e, 0x91, 0x58, 0x21,
-	0x21, 0x75, 0x89, 0x04, 0x0f, 0xd0, 0x25, 0x42, 0x62, 0xc7, 0x33, 0xa0, 0x2e, 0x2b, 0x56, 0x2c,
-	0x50, 0x41, 0x29, 0x0b, 0x5e, 0x81, 0x1d, 0xf2, 0x78, 0xfc, 0xa7, 0xba, 0x26, 0x2d, 0xed, 0xaa,
-	0x1d, 0xcf, 0xf9, 0xf9, 0xbe, 0x73, 0xbe, 0x9c, 0x33, 0xe0, 0x55, 0x93, 0xb0, 0x31, 0x61, 0x75,
-	0x0b, 0x33, 0x4e, 0x71, 0xdf, 0xe5, 0x98, 0x38, 0x75, 0x6f, 0xab, 0x8f, 0x38, 0xdc, 0xaa, 0xdb,
-	0xc8, 0x41, 0x0c, 0x33, 0x63, 0x42, 0x09, 0x27, 0xea, 0xb3, 0xc0, 0xd4, 0x48, 0x9a, 0x1a, 0xd2,
-	0x74, 0x69, 0xde, 0x26, 0x36, 0x11, 0x76, 0x75, 0xff, 0xbf, 0xc0, 0x65, 0x49, 0x97, 0xd1, 0xfb,
-	0x90, 0xa1, 0x28, 0xaa, 0x49, 0xb0, 0x23, 0xef, 0x8d, 0xbc, 0xec, 0xa9, 0x3c, 0x81, 0xfd, 0x62,
-	0x60, 0xdf, 0x0b, 0x12, 0x49, 0x3c, 0xe2, 0xb0, 0xf6, 0x93, 0x02, 0x9e, 0xee, 0xa2, 0x11, 0xb2,
-	0x21, 0x27, 0xf4, 0x10, 0xf3, 0xa1, 0x45, 0xe1, 0xd1, 0x47, 0xce, 0x80, 0xa8, 0x7b, 0xe0, 0x45,
-	0x2b, 0xbc, 0xe8, 0x41, 0xcb, 0xa2, 0x88, 0x31, 0x4d, 0x59, 0x51, 0x36, 0x1e, 0xb6, 0xb4, 0x5f,
-	0x7f, 0xde, 0x9c, 0x97, 0x61, 0x9a, 0xc1, 0x4d, 0x97, 0x53, 0xec, 0xd8, 0x9d, 0xc7, 0x91, 0x8b,
-	0xfc, 0xae, 0xb6, 0xc1, 0xe3, 0x23, 0x19, 0x36, 0x8a, 0x52, 0xfc, 0x8f, 0x28, 0x2f, 0x84, 0x1e,
-	0xf2, 0xf3, 0x4e, 0xe5, 0xe4, 0xb4, 0x56, 0xf8, 0xfb, 0xb4, 0x56, 0x58, 0xfb, 0x47, 0x01, 0xab,
-	0x9f, 0xc0, 0x11, 0xb6, 0xfc, 0x1c, 0xfb, 0x2e, 0x67, 0x1c, 0x3a, 0x96, 0xef, 0x83, 0x8e, 0x20,
-	0xb5, 0x58, 0x07, 0x99, 0x84, 0x5a, 0x3e, 0x76, 0x2f, 0x34, 0x9a, 0x1d, 0x7b, 0xe4, 0x12, 0x62,
-	0xff, 0x5a, 0x01, 0x4f, 0x48, 0x9c, 0xa3, 0x47, 0x83, 0x24, 0x5a, 0x71, 0xa5, 0xb4, 0x51, 0x6d,
-	0x2c, 0xcb, 0x36, 0x18, 0x7e, 0x9b, 0xc2, 0x8e, 0x1a, 0xbb, 0xc8, 0x6c, 0x13, 0xec, 0xb4, 0xb6,
-	0xcf, 0x2e, 0x6a, 0x85, 0x1f, 0xff, 0xa8, 0xbd, 0x66, 0x63, 0x3e, 0x74, 0xfb, 0x86, 0x49, 0xc6,
-	0xb2, 0xf2, 0xf2, 0xcf, 0x26, 0xb3, 0xbe, 0xa8, 0xf3, 0x2f, 0x27, 0x88, 0x85, 0x3e, 0xac, 0xa3,
-	0x92, 0x2b, 0x8c, 0x12, 0xdc, 0x7f, 0x57, 0xc0, 0x7a, 0xc4, 0xbd, 0x69, 0x9a, 0xee, 0xd8, 0x1d,
-	0x41, 0x8e, 0xac, 0x36, 0x19, 0x8f, 0x31, 0x63, 0x98, 0x38, 0x77, 0x4b, 0xdf, 0x04, 0x55, 0x18,
-	0x67, 0x11, 0x5d, 0xab, 0x36, 0xde, 0x31, 0x72, 0xf4, 0x6c, 0xe4, 0xc3, 0x6b, 0xcd, 0xf9, 0x45,
-	0xe9, 0x24, 0xa3, 0x26, 0xe8, 0xfd, 0xa5, 0x80, 0x95, 0xc8, 0xff, 0x43, 0xcc, 0x38, 0xa1, 0xd8,
-	0x84, 0xa3, 0x7b, 0xe9, 0xec, 0x02, 0x28, 0x4f, 0x10, 0xc5, 0x24, 0x60, 0x35, 0xd7, 0x91, 0x27,
-	0xf5, 0x10, 0x3c, 0x08, 0x9b, 0x5c, 0x12, 0x74, 0xdf, 0x9a, 0x8d, 0xee, 0x15, 0xb8, 0x92, 0x6a,
-	0x18, 0x2d, 0x41, 0xf3, 0x17, 0x05, 0xbc, 0x1c, 0xf9, 0xb5, 0x5d, 0x4a, 0x91, 0xc3, 0xef, 0x85,
-	0xe3, 0xc7, 0x31, 0x97, 0xa0, 0x75, 0x6f, 0xcc, 0xc6, 0x25, 0x8d, 0xe9, 0x7a, 0x22, 0xdf, 0x17,
-	0xc1, 0xb3, 0x68, 0x74, 0x74, 0x39, 0xa4, 0x1c, 0x3b, 0xb6, 0x3f, 0x3a, 0x62, 0x1a, 0x77, 0x31,
-	0x40, 0x32, 0xab, 0x51, 0xbc, 0x71, 0x35, 0x3e, 0x03, 0xcf, 0x33, 0x89, 0xb1, 0x87, 0x9d, 0x01,
-	0x91, 0xfd, 0x6d, 0xe4, 0xd6, 0x24, 0x93, 0x9e, 0xac, 0xc8, 0x23, 0x96, 0xf8, 0x96, 0x28, 0xcb,
-	0x49, 0x11, 0x2c, 0x46, 0xb5, 0xec, 0x8e, 0x20, 0x1b, 0xee, 0x79, 0xa2, 0x9c, 0x77, 0xac, 0xdf,
-	0x21, 0xc2, 0xf6, 0x90, 0x87, 0xfa, 0x0d, 0x4e, 0x09, 0x5d, 0x97, 0x52, 0xba, 0xfe, 0x1c, 0x3c,
-	0x8d, 0xd3, 0x32, 0x1f, 0x54, 0x0f, 0xf9, 0xa8, 0xb4, 0x39, 0x51, 0x85, 0xd7, 0x67, 0x53, 0x46,
-	0xcc, 0x46, 0xd6, 0xe0, 0x89, 0x77, 0xf5, 0x2a, 0x51, 0x8a, 0x8b, 0x0a, 0x78, 0xf4, 0x41, 0xb0,
-	0x0c, 0xbb, 0x1c, 0x72, 0xa4, 0x36, 0x41, 0x79, 0x02, 0x29, 0x1c, 0x07, 0x94, 0xab, 0x8d, 0x57,
-	0x72, 0xf3, 0x1e, 0x08, 0x53, 0x99, 0x4a, 0x3a, 0xaa, 0x7b, 0xa0, 0x32, 0x40, 0xa8, 0x37, 0x21,
-	0x64, 0x24, 0x65, 0xbd, 0x9e, 0x1b, 0xe4, 0x7d, 0x84, 0x0e, 0x08, 0x19, 0x85, 0x32, 0x1e, 0x04,
-	0x47, 0x95, 0x02, 0x2d, 0x16, 0x67, 0xb4, 0xa0, 0x7c, 0x61, 0xf8, 0xbf, 0xfc, 0xd2, 0xec, 0xca,
-	0x48, 0xee, 0x4c, 0x99, 0x64, 0xc1, 0xca, 0xba, 0x14, 0x4a, 0x9e, 0x50, 0xe4, 0x61, 0xe2, 0x8a,
-	0x55, 0x3c, 0x21, 0x0c, 0x51, 0xd1, 0x80, 0xdc, 0xde, 0x87, 0x2e, 0x07, 0xd2, 0x43, 0x75, 0xb3,
-	0x97, 0xd2, 0x73, 0x02, 0xf5, 0x7b, 0xb3, 0x75, 0xf2, 0xba, 0xcd, 0x29, 0x19, 0x64, 0xec, 0x21,
-	0xf5, 0x3b, 0x05, 0xac, 0x26, 0xa4, 0x1b, 0x8f, 0xf0, 0x9e, 0x19, 0x0d, 0x78, 0xa6, 0x95, 0x05,
-	0x8a, 0xe6, 0xff, 0x58, 0x12, 0x29, 0x20, 0x35, 0x2f, 0xd7, 0x96, 0xa9, 0xdf, 0x28, 0x60, 0x39,
-	0x46, 0x35, 0x8c, 0xc6, 0x70, 0x54, 0x96, 0x07, 0x02, 0xd0, 0xbb, 0xb7, 0x1c, 0xe3, 0x29, 0x30,
-	0x4b, 0xde, 0xb5, 0x76, 0xea, 0x57, 0x60, 0x31, 0x86, 0x61, 0x06, 0x13, 0x34, 0xc2, 0x50, 0x11,
-	0x18, 0x76, 0x6e, 0x33, 0x7e, 0x53, 0x00, 0x5e, 0xf2, 0xb2, 0x8d, 0xd4, 0xe3, 0xa4, 0x9a, 0x53,
-	0x63, 0x8e, 0x69, 0x0f, 0x45, 0xf2, 0xb7, 0x6f, 0x3e, 0xe7, 0x52, 0xa9, 0x63, 0x4d, 0x27, 0x4d,
-	0x98, 0x4a, 0xc1, 0x42, 0xe6, 0x60, 0x61, 0x1a, 0x10, 0x79, 0xdf, 0xbc, 0xe9, 0x64, 0x49, 0x65,
-	0x9d, 0xcf, 0x98, 0x2f, 0x89, 0x15, 0xd4, 0xda, 0xff, 0x61, 0xaa, 0x2b, 0x67, 0x53, 0x5d, 0x39,
-	0x9f, 0xea, 0xca, 0x9f, 0x53, 0x5d, 0xf9, 0xf6, 0x52, 0x2f, 0x9c, 0x5f, 0xea, 0x85, 0xdf, 0x2e,
-	0xf5, 0xc2, 0xa7, 0x5b, 0xb9, 0x4f, 0xaf, 0xe3, 0xf4, 0xf3, 0x59, 0xbc, 0xc4, 0xfa, 0x65, 0xf1,
-	0x2a, 0xde, 0xfe, 0x37, 0x00, 0x00, 0xff, 0xff, 0x18, 0xd7, 0x00, 0x14, 0xe0, 0x0b, 0x00, 0x00,
+	// 1018 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0x21, 0x4d, 0xc7, 0xa9, 0x1a, 0xa6, 0x49, 0xba, 0x49, 0x8b, 0x9d, 0x86, 0x1e,
+	0x8a, 0xaa, 0xda, 0x24, 0x45, 0x80, 0xca, 0x0f, 0x29, 0x71, 0x53, 0xe0, 0xd4, 0xc8, 0x41, 0x44,
+	0x42, 0xa0, 0xd5, 0x78, 0x77, 0x6c, 0x0f, 0xb5, 0x77, 0xac, 0x79, 0xb3, 0x4e, 0x91, 0x38, 0x21,
+	0x21, 0xf5, 0x06, 0x12, 0xfc, 0x01, 0x39, 0x22, 0x24, 0x6e, 0xfc, 0x0d, 0x55, 0x8f, 0x15, 0x27,
+	0x0e, 0x08, 0x50, 0xc2, 0x81, 0x7f, 0x81, 0x1b, 0xda, 0xd9, 0xd9, 0xd9, 0x5d, 0x79, 0xb3, 0x75,
+	0x4a, 0x7a, 0x4a, 0x76, 0xf7, 0xbd, 0xf7, 0x7d, 0xdf, 0x7b, 0x6f, 0xbf, 0x59, 0xa3, 0xd7, 0x5c,
+	0x0e, 0x43, 0x0e, 0x4d, 0x8f, 0x81, 0x14, 0xac, 0x13, 0x48, 0xc6, 0xfd, 0xe6, 0x78, 0xa3, 0x43,
+	0x25, 0xd9, 0x68, 0xf6, 0xa8, 0x4f, 0x81, 0x41, 0x63, 0x24, 0xb8, 0xe4, 0xf8, 0x4a, 0x14, 0xda,
+	0x48, 0x87, 0x36, 0x74, 0xe8, 0xea, 0x62, 0x8f, 0xf7, 0xb8, 0x8a, 0x6b, 0x86, 0xff, 0x45, 0x29,
+	0xab, 0x35, 0x5d, 0xbd, 0x43, 0x80, 0x9a, 0xaa, 0x2e, 0x67, 0xbe, 0x7e, 0xde, 0x28, 0x42, 0xcf,
+	0xe0, 0x44, 0xf1, 0x2b, 0x51, 0xbc, 0x13, 0x01, 0x69, 0x3e, 0xea, 0x62, 0xfd, 0x67, 0x0b, 0x2d,
+	0xdd, 0xa5, 0x03, 0xda, 0x23, 0x92, 0x8b, 0x7d, 0x26, 0xfb, 0x9e, 0x20, 0x07, 0x1f, 0xf9, 0x5d,
+	0x8e, 0x77, 0xd0, 0xcb, 0x5e, 0xfc, 0xc0, 0x21, 0x9e, 0x27, 0x28, 0x80, 0x6d, 0xad, 0x59, 0x37,
+	0xce, 0x6f, 0xdb, 0xbf, 0xfe, 0x72, 0x6b, 0x51, 0x97, 0xd9, 0x8a, 0x9e, 0xec, 0x49, 0xc1, 0xfc,
+	0x5e, 0x7b, 0xc1, 0xa4, 0xe8, 0xfb, 0xb8, 0x85, 0x16, 0x0e, 0x74, 0x59, 0x53, 0xa5, 0xfc, 0x8c,
+	0x2a, 0x17, 0xe3, 0x0c, 0x7d, 0xfb, 0xce, 0xdc, 0xa3, 0xc3, 0x7a, 0xe9, 0x9f, 0xc3, 0x7a, 0x69,
+	0xfd, 0xb1, 0x85, 0xea, 0x13, 0x7c, 0x75, 0xd8, 0x3e, 0x65, 0xbd, 0xbe, 0x84, 0xb3, 0x62, 0xde,
+	0x46, 0xe7, 0x0e, 0xa2, 0x8a, 0x76, 0x79, 0xad, 0x72, 0xa3, 0xba, 0xb9, 0xd9, 0x28, 0x18, 0x65,
+	0x23, 0x97, 0xcc, 0xf6, 0xcc, 0x93, 0x3f, 0xea, 0xa5, 0x76, 0x5c, 0x28, 0x25, 0xe4, 0x5f, 0x0b,
+	0x5d, 0xfb, 0x84, 0x0c, 0x98, 0x17, 0x42, 0xde, 0x0f, 0x24, 0x48, 0xe2, 0x7b, 0x21, 0x11, 0x7a,
+	0x40, 0x84, 0x07, 0x6d, 0xea, 0x72, 0xe1, 0x85, 0x52, 0xc6, 0x71, 0xd0, 0xf4, 0x52, 0x4c, 0x4a,
+	0x2c, 0xe5, 0x6b, 0x0b, 0x5d, 0xe2, 0x09, 0x86, 0x23, 0x22, 0x10, 0xad, 0xeb, 0x6a, 0xac, 0x2b,
+	0xdc, 0x37, 0xa3, 0xe7, 0x2e, 0x75, 0x5b, 0x9c, 0xf9, 0xdb, 0xb7, 0x43, 0x05, 0x3f, 0xfd, 0x59,
+	0xbf, 0xd9, 0x63, 0xb2, 0x1f, 0x74, 0x1a, 0x2e, 0x1f, 0xea, 0x15, 0xd2, 0x7f, 0x6e, 0x81, 0xf7,
+	0xa0, 0x29, 0xbf, 0x1c, 0x51, 0x88, 0x73, 0xa0, 0x8d, 0xf9, 0x84, 0xa2, 0x94, 0xf6, 0xdf, 0x2d,
+	0x74, 0xdd, 0x68, 0xdf, 0x72, 0xdd, 0x60, 0x18, 0x0c, 0x88, 0xa4, 0x5e, 0x8b, 0x0f, 0x87, 0x0c,
+	0x80, 0x71, 0xff, 0x6c, 0xe5, 0xbb, 0xa8, 0x4a, 0x12, 0x14, 0xb5, 0x7e, 0xd5, 0xcd, 0x77, 0x0a,
+	0xa7, 0x59, 0x4c, 0x4f, 0x8f, 0x35, 0x5d, 0x35, 0x25, 0xef, 0x6f, 0x0b, 0xad, 0x99, 0xfc, 0x0f,
+	0x19, 0x48, 0x2e, 0x98, 0x4b, 0x06, 0x2f, 0x64, 0xb2, 0xcb, 0x68, 0x76, 0x44, 0x05, 0xe3, 0x91,
+	0xaa, 0x99, 0xb6, 0xbe, 0xc2, 0xfb, 0xe8, 0x5c, 0x3c, 0xe4, 0x8a, 0x92, 0xfb, 0xd6, 0x74, 0x72,
+	0x27, 0xe8, 0xc6, 0x1b, 0x2c, 0x26, 0xa6, 0xf8, 0xd8, 0x42, 0xaf, 0x98, 0xbc, 0x56, 0x20, 0x04,
+	0xf5, 0xe5, 0x0b, 0xd1, 0xf8, 0x71, 0xa2, 0x25, 0x1a, 0xdd, 0x1b, 0xd3, 0x69, 0xc9, 0x72, 0x3a,
+	0x59, 0xc8, 0x0f, 0x65, 0x74, 0xc5, 0x78, 0xca, 0x9e, 0x24, 0x42, 0x32, 0xbf, 0x17, 0x7a, 0x60,
+	0x22, 0xe3, 0x2c, 0xfc, 0x24, 0xb7, 0x1b, 0xe5, 0x53, 0x77, 0xe3, 0x73, 0x74, 0x01, 0x34, 0x47,
+	0x87, 0xf9, 0x5d, 0xae, 0xe7, 0x5b, 0x6c, 0x4e, 0xb9, 0xf2, 0x74, 0x47, 0xe6, 0x21, 0x75, 0x2f,
+	0xd5, 0x96, 0x47, 0x65, 0xb4, 0x62, 0x7a, 0xb9, 0x37, 0x20, 0xd0, 0xdf, 0x19, 0xab, 0x76, 0x9e,
+	0xf1, 0xfe, 0xf6, 0x95, 0x37, 0xc6, 0xfb, 0x1b, 0x5d, 0xa5, 0xf6, 0xba, 0x92, 0xd9, 0xeb, 0x2f,
+	0xd0, 0x52, 0x02, 0x0b, 0x21, 0x29, 0x87, 0x86, 0xac, 0xec, 0x19, 0xd5, 0x85, 0xd7, 0xa7, 0xdb,
+	0x8c, 0x44, 0x8d, 0xee, 0xc1, 0xa5, 0xf1, 0xe4, 0xa3, 0x54, 0x2b, 0x0e, 0xab, 0x68, 0xfe, 0x83,
+	0xe8, 0x54, 0xdf, 0x93, 0x44, 0x52, 0xbc, 0x85, 0x66, 0x47, 0x44, 0x90, 0x61, 0x24, 0xb9, 0xba,
+	0xf9, 0x6a, 0x21, 0xee, 0xae, 0x0a, 0xd5, 0x50, 0x3a, 0x11, 0xef, 0xa0, 0xb9, 0x2e, 0xa5, 0xce,
+	0x88, 0xf3, 0x81, 0x5e, 0xeb, 0xeb, 0x85, 0x45, 0xee, 0x51, 0xba, 0xcb, 0xf9, 0x20, 0x5e, 0xe3,
+	0x6e, 0x74, 0x89, 0x05, 0xb2, 0x93, 0xe5, 0x34, 0x27, 0x6d, 0xb8, 0x18, 0xe1, 0x9b, 0x5f, 0x99,
+	0x7e, 0x33, 0xd2, 0x87, 0xbf, 0x06, 0x59, 0xf6, 0xf2, 0x1e, 0xaa, 0x4d, 0x1e, 0x09, 0x3a, 0x66,
+	0x3c, 0x50, 0xdf, 0x14, 0x23, 0x0e, 0x54, 0xd8, 0x33, 0xcf, 0x9a, 0x7d, 0x9c, 0xb2, 0xab, 0x33,
+	0x70, 0x90, 0x7f, 0x28, 0xbd, 0xa4, 0x58, 0xbf, 0x3f, 0xdd, 0x24, 0x4f, 0x3a, 0x39, 0xb5, 0x82,
+	0x9c, 0x73, 0x08, 0x7f, 0x6f, 0xa1, 0x6b, 0xa9, 0xd5, 0x4d, 0x2c, 0xdc, 0x71, 0x8d, 0xc1, 0x83,
+	0x3d, 0xab, 0x58, 0x6c, 0xfd, 0x8f, 0x43, 0x22, 0x43, 0xa4, 0x3e, 0x2e, 0x8c, 0x05, 0xfc, 0x8d,
+	0x85, 0xae, 0x26, 0xac, 0xfa, 0xc6, 0x86, 0x4d, 0x5b, 0xce, 0x29, 0x42, 0xef, 0x3d, 0xa7, 0x8d,
+	0x67, 0xc8, 0xac, 0x8e, 0x4f, 0x8c, 0xc3, 0x5f, 0xa1, 0x95, 0x84, 0x86, 0x1b, 0x39, 0xa8, 0xe1,
+	0x30, 0xa7, 0x38, 0xdc, 0x79, 0x1e, 0xfb, 0xcd, 0x10, 0xb8, 0x3c, 0xce, 0x0f, 0xc2, 0x0f, 0xd3,
+	0xdb, 0x9c, 0xb1, 0x39, 0xb0, 0xcf, 0x2b, 0xf0, 0xb7, 0x4f, 0xef, 0x73, 0x19, 0xe8, 0x65, 0x2f,
+	0x2f, 0x04, 0xb0, 0x40, 0xcb, 0xb9, 0xc6, 0x02, 0x36, 0x52, 0xb8, 0x6f, 0x9e, 0xd6, 0x59, 0x32,
+	0xa8, 0x8b, 0x39, 0xfe, 0x02, 0xf8, 0x33, 0xb4, 0xe0, 0x72, 0x5f, 0x32, 0x3f, 0x08, 0xdf, 0xa4,
+	0x6e, 0xe0, 0x7b, 0x60, 0x57, 0x15, 0xda, 0xcd, 0x42, 0xb4, 0x96, 0x49, 0xba, 0x17, 0xf8, 0x31,
+	0xc4, 0x45, 0x37, 0x73, 0x17, 0xf0, 0x2e, 0xba, 0x4c, 0x02, 0xc9, 0x1d, 0x41, 0x41, 0x92, 0x07,
+	0xd4, 0x31, 0xc2, 0xc1, 0x9e, 0x5f, 0xab, 0x14, 0xbe, 0xab, 0x4b, 0x61, 0x62, 0x3b, 0xca, 0x33,
+	0x2d, 0x05, 0xfc, 0xad, 0x85, 0xd6, 0x73, 0xcc, 0x46, 0xbb, 0xbf, 0x13, 0x7f, 0x2d, 0x5f, 0x50,
+	0x12, 0xde, 0x3d, 0x9d, 0xed, 0x64, 0xbf, 0xe1, 0xe3, 0xb7, 0xc6, 0x2b, 0x0e, 0x4b, 0x2c, 0x7a,
+	0xfb, 0xfe, 0x8f, 0x47, 0x35, 0xeb, 0xc9, 0x51, 0xcd, 0x7a, 0x7a, 0x54, 0xb3, 0xfe, 0x3a, 0xaa,
+	0x59, 0xdf, 0x1d, 0xd7, 0x4a, 0x4f, 0x8f, 0x6b, 0xa5, 0xdf, 0x8e, 0x6b, 0xa5, 0x4f, 0x37, 0x0a,
+	0x3f, 0x5e, 0x1f, 0x66, 0x7f, 0x49, 0xa9, 0x6f, 0xd9, 0xce, 0xac, 0xfa, 0x81, 0x74, 0xfb, 0xbf,
+	0x01, 0x00, 0x00, 0x94, 0x19, 0x58, 0xeb, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -494,6 +563,50 @@ func (m *DelegatorWithdrawInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegatorWithdrawAddressWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorWithdrawAddressWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorWithdrawAddressWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -780,6 +893,43 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelegatorWithdrawAddressWeights) > 0 {
+		for iNdEx := len(m.DelegatorWithdrawAddressWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorWithdrawAddressWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AutoRestakeDelegators) > 0 {
+		for iNdEx := len(m.AutoRestakeDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoRestakeDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoRestakeDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoRestakeDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ContinuousFunds) > 0 {
+		for iNdEx := len(m.ContinuousFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContinuousFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -936,6 +1086,25 @@ func (m *DelegatorWithdrawInfo) Size() (n int) {
 	return n
 }
 
+func (m *DelegatorWithdrawAddressWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ValidatorOutstandingRewardsRecord) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1099,6 +1268,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ContinuousFunds) > 0 {
+		for _, e := range m.ContinuousFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoRestakeDelegators) > 0 {
+		for _, s := range m.AutoRestakeDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorWithdrawAddressWeights) > 0 {
+		for _, e := range m.DelegatorWithdrawAddressWeights {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1222,6 +1409,122 @@ func (m *DelegatorWithdrawInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelegatorWithdrawAddressWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawAddressWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawAddressWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, WithdrawAddressWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ValidatorOutstandingRewardsRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2367,6 +2670,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinuousFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContinuousFunds = append(m.ContinuousFunds, ContinuousFund{})
+			if err := m.ContinuousFunds[len(m.ContinuousFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRestakeDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoRestakeDelegators = append(m.AutoRestakeDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorWithdrawAddressWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorWithdrawAddressWeights = append(m.DelegatorWithdrawAddressWeights, DelegatorWithdrawAddressWeights{})
+			if err := m.DelegatorWithdrawAddressWeights[len(m.DelegatorWithdrawAddressWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
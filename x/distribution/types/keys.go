@@ -42,6 +42,16 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09: Params
+//
+// - 0x0A<accAddrLen (1 Byte)><accAddr_Bytes>: ContinuousFund
+//
+// - 0x0B<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{0x01} (auto-restake enabled)
+//
+// - 0x0C: sdk.AccAddress (next auto-restake delegator)
+//
+// - 0x0D<accAddrLen (1 Byte)><accAddr_Bytes>: WithdrawAddressWeights
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +63,14 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	ParamsKey = []byte{0x09} // key for distribution module params
+
+	ContinuousFundPrefix = []byte{0x0A} // key for the continuous funds of the community pool
+	AutoRestakePrefix    = []byte{0x0B} // key for the delegators with auto-restake enabled
+	AutoRestakeCursorKey = []byte{0x0C} // key for the next auto-restake delegation to process
+
+	WithdrawAddressWeightsPrefix = []byte{0x0D} // key for the weighted withdraw addresses of a delegator
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -213,3 +231,64 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetContinuousFundKey creates the key for the continuous fund of a recipient.
+func GetContinuousFundKey(recipient sdk.AccAddress) []byte {
+	return append(ContinuousFundPrefix, address.MustLengthPrefix(recipient.Bytes())...)
+}
+
+// GetAutoRestakeKey creates the key for the auto-restake setting of a delegator.
+func GetAutoRestakeKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoRestakePrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoRestakeAddress creates an address from a delegator's auto-restake key.
+func GetAutoRestakeAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0B<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
+
+// GetAutoRestakeCursor creates the auto-restake cursor value of the next
+// delegation to process.
+func GetAutoRestakeCursor(delAddr sdk.AccAddress, valAddr sdk.ValAddress) []byte {
+	return append(address.MustLengthPrefix(delAddr.Bytes()), valAddr.Bytes()...)
+}
+
+// ParseAutoRestakeCursor returns the delegation of an auto-restake cursor value.
+func ParseAutoRestakeCursor(bz []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	// value is in the format:
+	// <accAddrLen (1 Byte)><accAddr_Bytes><valAddr_Bytes>
+
+	kv.AssertKeyAtLeastLength(bz, 2)
+	addrLen := int(bz[0])
+	kv.AssertKeyAtLeastLength(bz, 1+addrLen)
+
+	return sdk.AccAddress(bz[1 : 1+addrLen]), sdk.ValAddress(bz[1+addrLen:])
+}
+
+// GetWithdrawAddressWeightsKey creates the key for the weighted withdraw
+// addresses of a delegator.
+func GetWithdrawAddressWeightsKey(delAddr sdk.AccAddress) []byte {
+	return append(WithdrawAddressWeightsPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetWithdrawAddressWeightsAddress creates an address from a delegator's
+// weighted withdraw addresses key.
+func GetWithdrawAddressWeightsAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x0D<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
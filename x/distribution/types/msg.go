@@ -1,21 +1,38 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // distribution message types
 const (
-	TypeMsgSetWithdrawAddress          = "set_withdraw_address"
-	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
-	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
-	TypeMsgFundCommunityPool           = "fund_community_pool"
+	TypeMsgSetWithdrawAddress                = "set_withdraw_address"
+	TypeMsgWithdrawDelegatorReward           = "withdraw_delegator_reward"
+	TypeMsgWithdrawValidatorCommission       = "withdraw_validator_commission"
+	TypeMsgFundCommunityPool                 = "fund_community_pool"
+	TypeMsgWithdrawAllDelegatorRewards       = "withdraw_all_delegator_rewards"
+	TypeMsgSetAutoRestake                    = "set_auto_restake"
+	TypeMsgSetWithdrawAddressWeights         = "set_withdraw_address_weights"
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
 )
 
 // Verify interface at compile time
 var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
 
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCommunityPoolSpend{}
+	_ sdk.Msg = &MsgCreateContinuousFund{}
+	_ sdk.Msg = &MsgCancelContinuousFund{}
+	_ sdk.Msg = &MsgWithdrawAllDelegatorRewards{}
+	_ sdk.Msg = &MsgSetAutoRestake{}
+	_ sdk.Msg = &MsgSetWithdrawAddressWeights{}
+	_ sdk.Msg = &MsgWithdrawTokenizeShareRecordReward{}
+)
+
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
 		DelegatorAddress: delAddr.String(),
@@ -151,3 +168,273 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	}
 	return nil
 }
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.ValidateBasic()
+}
+
+// NewMsgCommunityPoolSpend returns a new MsgCommunityPoolSpend instance.
+//
+//nolint:interfacer
+func NewMsgCommunityPoolSpend(authority string, recipient sdk.AccAddress, amount sdk.Coins) *MsgCommunityPoolSpend {
+	return &MsgCommunityPoolSpend{
+		Authority: authority,
+		Recipient: recipient.String(),
+		Amount:    amount,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgCommunityPoolSpend) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgCommunityPoolSpend) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCommunityPoolSpend) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCommunityPoolSpend message.
+func (msg *MsgCommunityPoolSpend) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgCommunityPoolSpend) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+// NewMsgCreateContinuousFund returns a new MsgCreateContinuousFund instance.
+//
+//nolint:interfacer
+func NewMsgCreateContinuousFund(authority string, recipient sdk.AccAddress, percentage sdk.Dec, expiry *time.Time) *MsgCreateContinuousFund {
+	return &MsgCreateContinuousFund{
+		Authority:  authority,
+		Recipient:  recipient.String(),
+		Percentage: percentage,
+		Expiry:     expiry,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgCreateContinuousFund) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgCreateContinuousFund) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCreateContinuousFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCreateContinuousFund message.
+func (msg *MsgCreateContinuousFund) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgCreateContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return ContinuousFund{Recipient: msg.Recipient, Percentage: msg.Percentage, Expiry: msg.Expiry}.Validate()
+}
+
+// NewMsgCancelContinuousFund returns a new MsgCancelContinuousFund instance.
+//
+//nolint:interfacer
+func NewMsgCancelContinuousFund(authority string, recipient sdk.AccAddress) *MsgCancelContinuousFund {
+	return &MsgCancelContinuousFund{
+		Authority: authority,
+		Recipient: recipient.String(),
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgCancelContinuousFund) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgCancelContinuousFund) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgCancelContinuousFund) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgCancelContinuousFund message.
+func (msg *MsgCancelContinuousFund) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgCancelContinuousFund) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	return nil
+}
+
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+	}
+}
+
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+func (msg MsgWithdrawAllDelegatorRewards) Type() string  { return TypeMsgWithdrawAllDelegatorRewards }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
+
+func NewMsgSetAutoRestake(delAddr sdk.AccAddress, enabled bool) *MsgSetAutoRestake {
+	return &MsgSetAutoRestake{
+		DelegatorAddress: delAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+func (msg MsgSetAutoRestake) Route() string { return ModuleName }
+func (msg MsgSetAutoRestake) Type() string  { return TypeMsgSetAutoRestake }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetAutoRestake) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetAutoRestake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetAutoRestake) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
+
+func NewMsgSetWithdrawAddressWeights(delAddr sdk.AccAddress, weights []WithdrawAddressWeight) *MsgSetWithdrawAddressWeights {
+	return &MsgSetWithdrawAddressWeights{
+		DelegatorAddress: delAddr.String(),
+		Weights:          weights,
+	}
+}
+
+func (msg MsgSetWithdrawAddressWeights) Route() string { return ModuleName }
+func (msg MsgSetWithdrawAddressWeights) Type() string  { return TypeMsgSetWithdrawAddressWeights }
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgSetWithdrawAddressWeights) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetWithdrawAddressWeights) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgSetWithdrawAddressWeights) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return ValidateWithdrawAddressWeights(msg.Weights)
+}
+
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress, recordID uint64) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+		RecordId:     recordID,
+	}
+}
+
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// Return address that must sign over msg.GetSignBytes()
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	if msg.RecordId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("record id must be positive")
+	}
+	return nil
+}
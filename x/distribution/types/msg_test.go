@@ -93,3 +93,139 @@ func TestMsgDepositIntoCommunityPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCommunityPoolSpend(t *testing.T) {
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{"invalid", delAddr1, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), false},
+		{delAddr1.String(), sdk.AccAddress{}, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), false},
+		{delAddr1.String(), delAddr2, sdk.Coins{}, false},
+		{delAddr1.String(), delAddr2, sdk.Coins{sdk.NewInt64Coin("uatom", 10), sdk.NewInt64Coin("uatom", 10)}, false},
+		{delAddr1.String(), delAddr2, sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), true},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCommunityPoolSpend(tc.authority, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgCreateContinuousFund(t *testing.T) {
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		percentage sdk.Dec
+		expectPass bool
+	}{
+		{"invalid", delAddr1, sdk.NewDecWithPrec(1, 1), false},
+		{delAddr1.String(), sdk.AccAddress{}, sdk.NewDecWithPrec(1, 1), false},
+		{delAddr1.String(), delAddr2, sdk.Dec{}, false},
+		{delAddr1.String(), delAddr2, sdk.ZeroDec(), false},
+		{delAddr1.String(), delAddr2, sdk.NewDecWithPrec(-1, 1), false},
+		{delAddr1.String(), delAddr2, sdk.NewDecWithPrec(11, 1), false},
+		{delAddr1.String(), delAddr2, sdk.OneDec(), true},
+		{delAddr1.String(), delAddr2, sdk.NewDecWithPrec(1, 1), true},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCreateContinuousFund(tc.authority, tc.recipient, tc.percentage, nil)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgCancelContinuousFund(t *testing.T) {
+	tests := []struct {
+		authority  string
+		recipient  sdk.AccAddress
+		expectPass bool
+	}{
+		{"invalid", delAddr1, false},
+		{delAddr1.String(), sdk.AccAddress{}, false},
+		{delAddr1.String(), delAddr2, true},
+	}
+	for i, tc := range tests {
+		msg := NewMsgCancelContinuousFund(tc.authority, tc.recipient)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgWithdrawAllDelegatorRewards
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		expectPass    bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetAutoRestake
+func TestMsgSetAutoRestake(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoRestake(tc.delegatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetWithdrawAddressWeights
+func TestMsgSetWithdrawAddressWeights(t *testing.T) {
+	weights := []WithdrawAddressWeight{
+		NewWithdrawAddressWeight(delAddr2, sdk.NewDecWithPrec(7, 1)),
+		NewWithdrawAddressWeight(delAddr3, sdk.NewDecWithPrec(3, 1)),
+	}
+
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		weights       []WithdrawAddressWeight
+		expectPass    bool
+	}{
+		{delAddr1, weights, true},
+		{delAddr1, nil, true},
+		{delAddr1, weights[:1], false},
+		{emptyDelAddr, weights, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetWithdrawAddressWeights(tc.delegatorAddr, tc.weights)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
@@ -17,6 +17,10 @@ var (
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
 )
 
+// DefaultMaxAutoRestakePerBlock is the default maximum number of auto-restaking
+// delegations processed in a single block.
+const DefaultMaxAutoRestakePerBlock uint32 = 100
+
 // ParamKeyTable returns the parameter key table.
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -25,10 +29,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        sdk.NewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  sdk.NewDecWithPrec(1, 2), // 1%
-		BonusProposerReward: sdk.NewDecWithPrec(4, 2), // 4%
-		WithdrawAddrEnabled: true,
+		CommunityTax:           sdk.NewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:     sdk.NewDecWithPrec(1, 2), // 1%
+		BonusProposerReward:    sdk.NewDecWithPrec(4, 2), // 4%
+		WithdrawAddrEnabled:    true,
+		MaxAutoRestakePerBlock: DefaultMaxAutoRestakePerBlock,
 	}
 }
 
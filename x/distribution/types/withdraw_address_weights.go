@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxWithdrawAddressWeights is the maximum number of weighted withdraw
+// addresses of a delegator.
+const MaxWithdrawAddressWeights = 10
+
+// NewWithdrawAddressWeight creates a new WithdrawAddressWeight instance.
+//
+//nolint:interfacer
+func NewWithdrawAddressWeight(addr sdk.AccAddress, weight sdk.Dec) WithdrawAddressWeight {
+	return WithdrawAddressWeight{
+		Address: addr.String(),
+		Weight:  weight,
+	}
+}
+
+// ValidateWithdrawAddressWeights validates a list of weighted withdraw
+// addresses, the addresses must be unique and the weights must be positive
+// and sum up to one. An empty list is valid.
+func ValidateWithdrawAddressWeights(weights []WithdrawAddressWeight) error {
+	if len(weights) == 0 {
+		return nil
+	}
+
+	if len(weights) > MaxWithdrawAddressWeights {
+		return ErrInvalidWithdrawWeights.Wrapf("too many withdraw addresses: %d > %d", len(weights), MaxWithdrawAddressWeights)
+	}
+
+	total := sdk.ZeroDec()
+	addrs := make(map[string]bool, len(weights))
+	for _, w := range weights {
+		if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid withdraw address: %s", err)
+		}
+
+		if addrs[w.Address] {
+			return ErrInvalidWithdrawWeights.Wrapf("duplicate withdraw address %s", w.Address)
+		}
+		addrs[w.Address] = true
+
+		if w.Weight.IsNil() || !w.Weight.IsPositive() {
+			return ErrInvalidWithdrawWeights.Wrapf("weight must be positive: %s", w.Weight)
+		}
+		total = total.Add(w.Weight)
+	}
+
+	if !total.Equal(sdk.OneDec()) {
+		return ErrInvalidWithdrawWeights.Wrapf("weights must sum up to one: %s", total)
+	}
+
+	return nil
+}
+
+// SplitCoinsByWeights splits coins between weighted withdraw addresses. The
+// shares are truncated and the last address receives what is left, so that
+// the shares always add up to the coins.
+func SplitCoinsByWeights(coins sdk.Coins, weights []WithdrawAddressWeight) []sdk.Coins {
+	shares := make([]sdk.Coins, len(weights))
+	remaining := coins
+	for i, w := range weights {
+		if i == len(weights)-1 {
+			shares[i] = remaining
+			break
+		}
+
+		share, _ := sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(w.Weight).TruncateDecimal()
+		shares[i] = share
+		remaining = remaining.Sub(share...)
+	}
+
+	return shares
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateWithdrawAddressWeights(t *testing.T) {
+	tooMany := make([]WithdrawAddressWeight, MaxWithdrawAddressWeights+1)
+	for i := range tooMany {
+		tooMany[i] = NewWithdrawAddressWeight(sdk.AccAddress([]byte{byte(i)}), sdk.NewDecWithPrec(1, 1))
+	}
+
+	tests := []struct {
+		name       string
+		weights    []WithdrawAddressWeight
+		expectPass bool
+	}{
+		{"empty", nil, true},
+		{"too many addresses", tooMany, false},
+		{"invalid address", []WithdrawAddressWeight{{Address: "invalid", Weight: sdk.OneDec()}}, false},
+		{"nil weight", []WithdrawAddressWeight{{Address: delAddr1.String()}}, false},
+		{
+			"zero weight",
+			[]WithdrawAddressWeight{
+				NewWithdrawAddressWeight(delAddr1, sdk.OneDec()),
+				NewWithdrawAddressWeight(delAddr2, sdk.ZeroDec()),
+			},
+			false,
+		},
+		{
+			"duplicate address",
+			[]WithdrawAddressWeight{
+				NewWithdrawAddressWeight(delAddr1, sdk.NewDecWithPrec(5, 1)),
+				NewWithdrawAddressWeight(delAddr1, sdk.NewDecWithPrec(5, 1)),
+			},
+			false,
+		},
+		{
+			"weights below one",
+			[]WithdrawAddressWeight{
+				NewWithdrawAddressWeight(delAddr1, sdk.NewDecWithPrec(5, 1)),
+				NewWithdrawAddressWeight(delAddr2, sdk.NewDecWithPrec(4, 1)),
+			},
+			false,
+		},
+		{
+			"weights above one",
+			[]WithdrawAddressWeight{
+				NewWithdrawAddressWeight(delAddr1, sdk.NewDecWithPrec(5, 1)),
+				NewWithdrawAddressWeight(delAddr2, sdk.NewDecWithPrec(6, 1)),
+			},
+			false,
+		},
+		{
+			"valid",
+			[]WithdrawAddressWeight{
+				NewWithdrawAddressWeight(delAddr1, sdk.NewDecWithPrec(7, 1)),
+				NewWithdrawAddressWeight(delAddr2, sdk.NewDecWithPrec(3, 1)),
+			},
+			true,
+		},
+	}
+	for _, tc := range tests {
+		err := ValidateWithdrawAddressWeights(tc.weights)
+		if tc.expectPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSplitCoinsByWeights(t *testing.T) {
+	weights := []WithdrawAddressWeight{
+		NewWithdrawAddressWeight(delAddr1, sdk.NewDecWithPrec(7, 1)),
+		NewWithdrawAddressWeight(delAddr2, sdk.NewDecWithPrec(3, 1)),
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 15), sdk.NewInt64Coin("atom", 1))
+
+	// the truncated remainder goes to the last address
+	shares := SplitCoinsByWeights(coins, weights)
+	require.Equal(t, []sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("atom", 1)),
+	}, shares)
+}
@@ -27,8 +27,10 @@ func GetQueryCmd() *cobra.Command {
 Example:
 $ %s query %s DF0C23E8634E480F84B9D5674A7CDC9816466DEC28A3358F73260F68D28D7660
 $ %s query %s --page=2 --limit=50
+$ %s query %s params
 `,
 				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		Args:                       cobra.MaximumNArgs(1),
@@ -40,6 +42,41 @@ $ %s query %s --page=2 --limit=50
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "evidence")
 
+	cmd.AddCommand(GetCmdQueryParams())
+
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to return the current evidence
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current evidence parameters",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query genesis parameters for the evidence module:
+
+$ <appd> query evidence params
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
 	return cmd
 }
 
@@ -55,6 +55,11 @@ func (s *IntegrationTestSuite) TestGetQueryCmd() {
 			"evidence: []\npagination:\n  next_key: null\n  total: \"0\"",
 			false,
 		},
+		"params": {
+			[]string{"params"},
+			"max_age_duration: 172800s\nmax_age_num_blocks: \"100000\"",
+			false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -13,7 +13,18 @@ Handler for a given Evidence type can perform any arbitrary state transitions
 such as slashing, jailing, and tombstoning. This provides developers with great
 flexibility in designing evidence handling.
 
-A full setup of the evidence module may look something as follows:
+With app wiring, the module registers the handler of the LightClientAttack evidence
+type, and any module may provide a HandlerRoute to register the handler of its own
+evidence type:
+
+	func ProvideEvidenceHandler(k keeper.Keeper) evidencetypes.HandlerRoute {
+	  return evidencetypes.NewHandlerRoute(types.RouteMisbehavior, NewMisbehaviorHandler(k))
+	}
+
+Submitted evidence older than the MaxAgeNumBlocks or MaxAgeDuration parameters
+is rejected as expired.
+
+Without app wiring, a full setup of the evidence module may look something as follows:
 
 	ModuleBasics = module.NewBasicManager(
 	  // ...,
@@ -23,9 +34,11 @@ A full setup of the evidence module may look something as follows:
 	// First, create the keeper
 	evidenceKeeper := evidence.NewKeeper(
 	  appCodec, keys[evidence.StoreKey], &app.StakingKeeper, app.SlashingKeeper,
+	  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	// Second, create the evidence Handler and register all desired routes.
+	// Second, create the evidence Handler and register all desired routes. The
+	// handler of the LightClientAttack evidence is registered by SetRouter.
 	evidenceRouter := evidence.NewRouter().
 	  AddRoute(evidenceRoute, evidenceHandler).
 	  AddRoute(..., ...)
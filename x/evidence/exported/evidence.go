@@ -1,6 +1,8 @@
 package exported
 
 import (
+	"time"
+
 	"github.com/gogo/protobuf/proto"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 
@@ -22,6 +24,15 @@ type Evidence interface {
 	GetHeight() int64
 }
 
+// TimedEvidence extends Evidence interface to define contract for evidence
+// carrying the time of the infraction, which allows it to expire by age
+type TimedEvidence interface {
+	Evidence
+
+	// Time at which the infraction occurred
+	GetTime() time.Time
+}
+
 // ValidatorEvidence extends Evidence interface to define contract
 // for evidence against malicious validators
 type ValidatorEvidence interface {
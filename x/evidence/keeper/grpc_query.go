@@ -85,3 +85,15 @@ func (k Keeper) AllEvidence(c context.Context, req *types.QueryAllEvidenceReques
 
 	return &types.QueryAllEvidenceResponse{Evidence: evidence, Pagination: pageRes}, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -139,3 +139,9 @@ func (suite *KeeperTestSuite) TestQueryAllEvidence() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	res, err := suite.queryClient.Params(sdk.WrapSDKContext(suite.ctx), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
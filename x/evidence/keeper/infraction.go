@@ -10,7 +10,8 @@ import (
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
 // evidence is valid, the validator committing the misbehavior will be slashed,
-// jailed and tombstoned. Once tombstoned, the validator will not be able to
+// jailed and tombstoned, or only jailed for the double sign jail duration of
+// x/slashing when it is set. Once tombstoned, the validator will not be able to
 // recover. Note, the evidence contains the block time and height at the time of
 // the equivocation.
 //
@@ -19,6 +20,7 @@ import (
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
+// - was already punished for double signing in the current block
 //
 // TODO: Some of the invalid constraints listed above may need to be reconsidered
 // in the case of a lunatic attack.
@@ -85,6 +87,18 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		return
 	}
 
+	// ignore if the validator was already punished for double signing in this
+	// block, which is possible when it is not tombstoned for it
+	if k.slashingKeeper.HasDoubleSignInfraction(ctx, consAddr, ctx.BlockHeight()) {
+		logger.Info(
+			"ignored equivocation; validator already punished in this block",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return
+	}
+
 	logger.Info(
 		"confirmed equivocation",
 		"validator", consAddr,
@@ -117,7 +131,6 @@ func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equi
 		k.slashingKeeper.Jail(ctx, consAddr)
 	}
 
-	k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime)
-	k.slashingKeeper.Tombstone(ctx, consAddr)
+	k.slashingKeeper.ApplyDoubleSignJailPolicy(ctx, consAddr)
 	k.SetEvidence(ctx, evidence)
 }
@@ -75,6 +75,60 @@ func (suite *KeeperTestSuite) TestHandleDoubleSign() {
 	suite.Len(evidences, 1)
 }
 
+func (suite *KeeperTestSuite) TestHandleDoubleSign_JailDuration() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Unix(1000, 0).UTC())
+	suite.populateValidators(ctx)
+
+	slashingParams := suite.slashingKeeper.GetParams(ctx)
+	slashingParams.DoubleSignJailDuration = time.Hour
+	suite.Require().NoError(suite.slashingKeeper.SetParams(ctx, slashingParams))
+
+	power := int64(100)
+	operatorAddr, val := valAddresses[0], pubkeys[0]
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.stakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(operatorAddr, val, power, true)
+	staking.EndBlocker(ctx, suite.stakingKeeper)
+
+	// handle a signature to set signing info
+	suite.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+
+	oldTokens := suite.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	evidence := &types.Equivocation{
+		Height:           0,
+		Time:             time.Unix(0, 0),
+		Power:            power,
+		ConsensusAddress: sdk.ConsAddress(val.Address()).String(),
+	}
+	suite.evidenceKeeper.HandleEquivocationEvidence(ctx, evidence)
+
+	// should be jailed for the double sign jail duration but not tombstoned
+	consAddr := sdk.ConsAddress(val.Address())
+	suite.True(suite.stakingKeeper.Validator(ctx, operatorAddr).IsJailed())
+	suite.False(suite.slashingKeeper.IsTombstoned(ctx, consAddr))
+	info, found := suite.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.True(found)
+	suite.Equal(ctx.BlockTime().Add(time.Hour), info.JailedUntil)
+
+	newTokens := suite.stakingKeeper.Validator(ctx, operatorAddr).GetTokens()
+	suite.True(newTokens.LT(oldTokens))
+
+	// evidence of another double sign in the same block is ignored
+	evidence2 := &types.Equivocation{
+		Height:           1,
+		Time:             time.Unix(1, 0),
+		Power:            power,
+		ConsensusAddress: consAddr.String(),
+	}
+	suite.evidenceKeeper.HandleEquivocationEvidence(ctx, evidence2)
+	suite.True(suite.stakingKeeper.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
+	suite.Len(suite.evidenceKeeper.GetAllEvidence(ctx), 1)
+
+	// the validator can unjail once the jail period is over
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(info.JailedUntil.Add(time.Second))
+	suite.NoError(suite.slashingKeeper.Unjail(ctx, operatorAddr))
+}
+
 func (suite *KeeperTestSuite) TestHandleDoubleSign_TooOld() {
 	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(1).WithBlockTime(time.Now())
 	suite.populateValidators(ctx)
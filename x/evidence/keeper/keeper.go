@@ -24,20 +24,30 @@ type Keeper struct {
 	router         types.Router
 	stakingKeeper  types.StakingKeeper
 	slashingKeeper types.SlashingKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, stakingKeeper types.StakingKeeper,
-	slashingKeeper types.SlashingKeeper,
+	slashingKeeper types.SlashingKeeper, authority string,
 ) *Keeper {
 	return &Keeper{
 		cdc:            cdc,
 		storeKey:       storeKey,
 		stakingKeeper:  stakingKeeper,
 		slashingKeeper: slashingKeeper,
+		authority:      authority,
 	}
 }
 
+// GetAuthority returns the x/evidence module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -47,7 +57,15 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // we allow the ability to set the router after the Keeper is constructed as a
 // given Handler may need access the Keeper before being constructed. The router
 // may only be set once and will be sealed if it's not already sealed.
+//
+// The handler of the LightClientAttack evidence of the x/evidence module is
+// added to the router when it is not sealed yet and has no route for it, so
+// that applications only have to register the routes of other modules.
 func (k *Keeper) SetRouter(rtr types.Router) {
+	if !rtr.Sealed() && !rtr.HasRoute(types.RouteLightClientAttack) {
+		rtr.AddRoute(types.RouteLightClientAttack, NewLightClientAttackHandler(*k))
+	}
+
 	// It is vital to seal the Evidence Handler router as to not allow further
 	// handlers to be registered after the keeper is created since this
 	// could create invalid or non-deterministic behavior.
@@ -72,13 +90,16 @@ func (k Keeper) GetEvidenceHandler(evidenceRoute string) (types.Handler, error)
 }
 
 // SubmitEvidence attempts to match evidence against the keepers router and execute
-// the corresponding registered Evidence Handler. An error is returned if no
-// registered Handler exists or if the Handler fails. Otherwise, the evidence is
-// persisted.
+// the corresponding registered Evidence Handler. An error is returned if the
+// evidence has expired, if no registered Handler exists or if the Handler fails.
+// Otherwise, the evidence is persisted.
 func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) error {
 	if _, ok := k.GetEvidence(ctx, evidence.Hash()); ok {
 		return sdkerrors.Wrap(types.ErrEvidenceExists, evidence.Hash().String())
 	}
+	if k.IsExpired(ctx, evidence) {
+		return sdkerrors.Wrap(types.ErrEvidenceExpired, evidence.Hash().String())
+	}
 	if !k.router.HasRoute(evidence.Route()) {
 		return sdkerrors.Wrap(types.ErrNoEvidenceHandlerExists, evidence.Route())
 	}
@@ -99,6 +120,22 @@ func (k Keeper) SubmitEvidence(ctx sdk.Context, evidence exported.Evidence) erro
 	return nil
 }
 
+// IsExpired returns true if the evidence is older than the maximum age in blocks
+// or, for evidence carrying the time of the infraction, older than the maximum
+// age duration defined by the module parameters.
+func (k Keeper) IsExpired(ctx sdk.Context, evidence exported.Evidence) bool {
+	params := k.GetParams(ctx)
+	if params.MaxAgeNumBlocks > 0 && ctx.BlockHeight()-evidence.GetHeight() > params.MaxAgeNumBlocks {
+		return true
+	}
+
+	if timed, ok := evidence.(exported.TimedEvidence); ok && params.MaxAgeDuration > 0 {
+		return ctx.BlockTime().Sub(timed.GetTime()) > params.MaxAgeDuration
+	}
+
+	return false
+}
+
 // SetEvidence sets Evidence by hash in the module's KVStore.
 func (k Keeper) SetEvidence(ctx sdk.Context, evidence exported.Evidence) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEvidence)
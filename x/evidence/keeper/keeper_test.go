@@ -14,7 +14,9 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/depinject"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -99,7 +101,15 @@ func (suite *KeeperTestSuite) SetupTest() {
 		evidenceKeeper keeper.Keeper
 	)
 
-	app, err := simtestutil.Setup(testutil.AppConfig,
+	// register the test handler the way a module providing an evidence type would
+	testConfig := depinject.Configs(
+		testutil.AppConfig,
+		depinject.Provide(func() types.HandlerRoute {
+			return types.NewHandlerRoute(types.RouteEquivocation, testEquivocationHandler(nil))
+		}),
+	)
+
+	app, err := simtestutil.Setup(testConfig,
 		&legacyAmino,
 		&evidenceKeeper,
 		&suite.interfaceRegistry,
@@ -110,10 +120,6 @@ func (suite *KeeperTestSuite) SetupTest() {
 	)
 	require.NoError(suite.T(), err)
 
-	router := types.NewRouter()
-	router = router.AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper))
-	evidenceKeeper.SetRouter(router)
-
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
 	suite.querier = keeper.NewQuerier(evidenceKeeper, legacyAmino)
 	suite.app = app
@@ -231,3 +237,91 @@ func (suite *KeeperTestSuite) TestGetEvidenceHandler() {
 	suite.Error(err)
 	suite.Nil(handler)
 }
+
+func (suite *KeeperTestSuite) TestSubmitExpiredEvidence() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithBlockHeight(200).WithBlockTime(time.Unix(100000, 0).UTC())
+	pk := ed25519.GenPrivKey()
+	consAddr := sdk.ConsAddress(pk.PubKey().Address().Bytes()).String()
+
+	// expiration by number of blocks only
+	suite.Require().NoError(suite.evidenceKeeper.SetParams(ctx, types.NewParams(100, 0)))
+
+	e := &types.Equivocation{Height: 99, Power: 100, Time: ctx.BlockTime(), ConsensusAddress: consAddr}
+	suite.True(suite.evidenceKeeper.IsExpired(ctx, e))
+	suite.ErrorIs(suite.evidenceKeeper.SubmitEvidence(ctx, e), types.ErrEvidenceExpired)
+
+	e = &types.Equivocation{Height: 101, Power: 100, Time: ctx.BlockTime(), ConsensusAddress: consAddr}
+	suite.False(suite.evidenceKeeper.IsExpired(ctx, e))
+	suite.NoError(suite.evidenceKeeper.SubmitEvidence(ctx, e))
+
+	// expiration by duration only
+	suite.Require().NoError(suite.evidenceKeeper.SetParams(ctx, types.NewParams(0, time.Hour)))
+
+	e = &types.Equivocation{Height: 1, Power: 100, Time: ctx.BlockTime().Add(-2 * time.Hour), ConsensusAddress: consAddr}
+	suite.True(suite.evidenceKeeper.IsExpired(ctx, e))
+	suite.ErrorIs(suite.evidenceKeeper.SubmitEvidence(ctx, e), types.ErrEvidenceExpired)
+
+	e = &types.Equivocation{Height: 1, Power: 100, Time: ctx.BlockTime().Add(-30 * time.Minute), ConsensusAddress: consAddr}
+	suite.False(suite.evidenceKeeper.IsExpired(ctx, e))
+	suite.NoError(suite.evidenceKeeper.SubmitEvidence(ctx, e))
+}
+
+func (suite *KeeperTestSuite) TestGetLightClientAttackHandler() {
+	handler, err := suite.evidenceKeeper.GetEvidenceHandler((&types.LightClientAttack{}).Route())
+	suite.NoError(err)
+	suite.NotNil(handler)
+}
+
+func (suite *KeeperTestSuite) TestSetRouterRegistersLightClientAttack() {
+	// an app wiring the keeper by hand only routes its own evidence types
+	evidenceKeeper := keeper.NewKeeper(
+		codec.NewProtoCodec(suite.interfaceRegistry), sdk.NewKVStoreKey(types.StoreKey),
+		suite.stakingKeeper, suite.slashingKeeper, authtypes.NewModuleAddress("gov").String(),
+	)
+	evidenceKeeper.SetRouter(types.NewRouter().AddRoute(types.RouteEquivocation, testEquivocationHandler(evidenceKeeper)))
+
+	handler, err := evidenceKeeper.GetEvidenceHandler((&types.LightClientAttack{}).Route())
+	suite.NoError(err)
+	suite.NotNil(handler)
+
+	handler, err = evidenceKeeper.GetEvidenceHandler((&types.Equivocation{}).Route())
+	suite.NoError(err)
+	suite.NotNil(handler)
+}
+
+func (suite *KeeperTestSuite) TestGetParamsDefault() {
+	// the params are not stored before the module is initialized or migrated
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := sdktestutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	evidenceKeeper := keeper.NewKeeper(
+		codec.NewProtoCodec(suite.interfaceRegistry), storeKey,
+		suite.stakingKeeper, suite.slashingKeeper, authtypes.NewModuleAddress("gov").String(),
+	)
+
+	suite.Require().Equal(types.DefaultParams(), evidenceKeeper.GetParams(ctx))
+	suite.Require().False(evidenceKeeper.IsExpired(ctx, &types.Equivocation{Height: 1, Time: ctx.BlockTime()}))
+}
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(suite.evidenceKeeper)
+	params := types.NewParams(500, 24*time.Hour)
+
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: sdk.AccAddress(valAddresses[0]).String(),
+		Params:    params,
+	})
+	suite.Require().Error(err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: suite.evidenceKeeper.GetAuthority(),
+		Params:    types.NewParams(-1, 24*time.Hour),
+	})
+	suite.Require().Error(err)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: suite.evidenceKeeper.GetAuthority(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.evidenceKeeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// NewLightClientAttackHandler returns the Handler of LightClientAttack evidence
+// submitted through MsgSubmitEvidence.
+func NewLightClientAttackHandler(k Keeper) types.Handler {
+	return func(ctx sdk.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.LightClientAttack)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "unexpected evidence type: %T", e)
+		}
+
+		return k.HandleLightClientAttackEvidence(ctx, evidence)
+	}
+}
+
+// HandleLightClientAttackEvidence implements a light client attack evidence
+// handler. The conflicting block is compared to the header committed by the
+// chain at the same height, as recorded in the historical info of the staking
+// module. Only lunatic attacks are handled, in which the conflicting header
+// commits to a state other than the one of the chain: no correct validator signs
+// such a header, so every validator of the chain at that height which signed it
+// is slashed, jailed and tombstoned, or only jailed for the double sign jail
+// duration of x/slashing when it is set, as for an equivocation.
+//
+// The evidence is considered invalid if:
+// - the conflicting block belongs to another chain
+// - the historical info at its height does not exist
+// - the conflicting header commits to the same state as the chain
+// - a signature of a validator of the chain is invalid
+// - no validator of the chain signed the conflicting block
+func (k Keeper) HandleLightClientAttackEvidence(ctx sdk.Context, evidence *types.LightClientAttack) error {
+	logger := k.Logger(ctx)
+
+	conflicting, err := tmtypes.LightBlockFromProto(evidence.ConflictingBlock)
+	if err != nil {
+		return err
+	}
+	if err := conflicting.ValidateBasic(ctx.ChainID()); err != nil {
+		return err
+	}
+
+	height := conflicting.Height
+	histInfo, found := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if !found {
+		return fmt.Errorf("no historical info found at height %d", height)
+	}
+
+	if !isLunaticHeader(conflicting.Header, histInfo.Header) {
+		return fmt.Errorf("conflicting block at height %d commits to the same state as the chain", height)
+	}
+
+	trusted := make(map[string]stakingtypes.Validator, len(histInfo.Valset))
+	for _, val := range histInfo.Valset {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+
+		trusted[string(consAddr)] = val
+	}
+
+	// only the signatures of the validators of the chain are verified, the
+	// validator set of the conflicting block cannot be trusted
+	var byzantine []stakingtypes.Validator
+	for idx, sig := range conflicting.Commit.Signatures {
+		if sig.BlockIDFlag != tmtypes.BlockIDFlagCommit {
+			continue
+		}
+
+		val, ok := trusted[string(sig.ValidatorAddress)]
+		if !ok {
+			continue
+		}
+
+		pk, err := val.ConsPubKey()
+		if err != nil {
+			return err
+		}
+
+		signBytes := conflicting.Commit.VoteSignBytes(ctx.ChainID(), int32(idx))
+		if !pk.VerifySignature(signBytes, sig.Signature) {
+			return fmt.Errorf("invalid signature of validator %s", sdk.ConsAddress(sig.ValidatorAddress))
+		}
+
+		byzantine = append(byzantine, val)
+	}
+
+	if len(byzantine) == 0 {
+		return fmt.Errorf("no validator of the chain signed the conflicting block at height %d", height)
+	}
+
+	logger.Info(
+		"confirmed light client attack",
+		"infraction_height", height,
+		"byzantine_validators", len(byzantine),
+	)
+
+	// We need to retrieve the stake distribution which signed the block, so we
+	// subtract ValidatorUpdateDelay from the evidence height.
+	distributionHeight := height - sdk.ValidatorUpdateDelay
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+	for _, val := range byzantine {
+		consAddr, _ := val.GetConsAddr()
+
+		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+		if validator == nil || validator.IsUnbonded() || !k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr) {
+			continue
+		}
+
+		// ignore if the validator is already tombstoned
+		if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+			logger.Info(
+				"ignored light client attack; validator already tombstoned",
+				"validator", consAddr,
+				"infraction_height", height,
+			)
+			continue
+		}
+
+		// ignore if the validator was already punished for double signing in
+		// this block, which is possible when it is not tombstoned for it
+		if k.slashingKeeper.HasDoubleSignInfraction(ctx, consAddr, ctx.BlockHeight()) {
+			logger.Info(
+				"ignored light client attack; validator already punished in this block",
+				"validator", consAddr,
+				"infraction_height", height,
+			)
+			continue
+		}
+
+		k.slashingKeeper.Slash(
+			ctx,
+			consAddr,
+			k.slashingKeeper.SlashFractionDoubleSign(ctx),
+			val.GetConsensusPower(powerReduction), distributionHeight,
+		)
+
+		if !validator.IsJailed() {
+			k.slashingKeeper.Jail(ctx, consAddr)
+		}
+
+		k.slashingKeeper.ApplyDoubleSignJailPolicy(ctx, consAddr)
+	}
+
+	return nil
+}
+
+// isLunaticHeader returns true if the conflicting header commits to a state other
+// than the one committed by the trusted header of the chain at the same height.
+func isLunaticHeader(conflicting *tmtypes.Header, trusted tmproto.Header) bool {
+	return !bytes.Equal(conflicting.ValidatorsHash, trusted.ValidatorsHash) ||
+		!bytes.Equal(conflicting.NextValidatorsHash, trusted.NextValidatorsHash) ||
+		!bytes.Equal(conflicting.ConsensusHash, trusted.ConsensusHash) ||
+		!bytes.Equal(conflicting.AppHash, trusted.AppHash) ||
+		!bytes.Equal(conflicting.LastResultsHash, trusted.LastResultsHash)
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+// makeConflictingBlock returns a block at the given height committing to the
+// given app hash, signed by all the given validators.
+func (suite *KeeperTestSuite) makeConflictingBlock(ctx sdk.Context, privs []*ed25519.PrivKey, power int64, appHash []byte) *tmproto.LightBlock {
+	vals := make([]*tmtypes.Validator, len(privs))
+	for i, priv := range privs {
+		pk, err := cryptocodec.ToTmPubKeyInterface(priv.PubKey())
+		suite.Require().NoError(err)
+		vals[i] = tmtypes.NewValidator(pk, power)
+	}
+	valSet := tmtypes.NewValidatorSet(vals)
+
+	header := &tmtypes.Header{
+		Version:            version.Consensus{Block: version.BlockProtocol},
+		ChainID:            ctx.ChainID(),
+		Height:             ctx.BlockHeight(),
+		Time:               ctx.BlockTime(),
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    valSet.Proposer.Address,
+	}
+	blockID := tmtypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+
+	sigs := make([]tmtypes.CommitSig, len(valSet.Validators))
+	for i, val := range valSet.Validators {
+		for _, priv := range privs {
+			if !bytes.Equal(priv.PubKey().Address(), val.Address) {
+				continue
+			}
+
+			vote := &tmtypes.Vote{
+				Type:             tmproto.PrecommitType,
+				Height:           header.Height,
+				BlockID:          blockID,
+				Timestamp:        header.Time,
+				ValidatorAddress: val.Address,
+				ValidatorIndex:   int32(i),
+			}
+			sig, err := priv.Sign(tmtypes.VoteSignBytes(ctx.ChainID(), vote.ToProto()))
+			suite.Require().NoError(err)
+			sigs[i] = tmtypes.NewCommitSigForBlock(sig, val.Address, header.Time)
+		}
+	}
+
+	lb := tmtypes.LightBlock{
+		SignedHeader: &tmtypes.SignedHeader{
+			Header: header,
+			Commit: tmtypes.NewCommit(header.Height, 0, blockID, sigs),
+		},
+		ValidatorSet: valSet,
+	}
+	pb, err := lb.ToProto()
+	suite.Require().NoError(err)
+
+	return pb
+}
+
+func (suite *KeeperTestSuite) TestHandleLightClientAttack() {
+	ctx := suite.ctx.WithIsCheckTx(false).WithChainID("evidence-chain").WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	suite.populateValidators(ctx)
+
+	power := int64(100)
+	privs := []*ed25519.PrivKey{ed25519.GenPrivKey(), ed25519.GenPrivKey()}
+	tstaking := teststaking.NewHelper(suite.T(), ctx, suite.stakingKeeper)
+	for i, priv := range privs {
+		tstaking.CreateValidatorWithValPower(valAddresses[i], priv.PubKey(), power, true)
+	}
+	staking.EndBlocker(ctx, suite.stakingKeeper)
+	for _, priv := range privs {
+		suite.slashingKeeper.HandleValidatorSignature(ctx, priv.PubKey().Address(), power, true)
+	}
+
+	// record the header committed by the chain at the current height
+	evidence := &types.LightClientAttack{ConflictingBlock: suite.makeConflictingBlock(ctx, privs, power, tmhash.Sum([]byte("trusted")))}
+	header := ctx.BlockHeader()
+	header.ValidatorsHash = evidence.ConflictingBlock.SignedHeader.Header.ValidatorsHash
+	header.NextValidatorsHash = evidence.ConflictingBlock.SignedHeader.Header.NextValidatorsHash
+	header.AppHash = evidence.ConflictingBlock.SignedHeader.Header.AppHash
+	suite.stakingKeeper.TrackHistoricalInfo(ctx.WithBlockHeader(header))
+
+	// a header committing to the same state as the chain is not attributable
+	suite.Require().NoError(evidence.ValidateBasic())
+	suite.Require().ErrorContains(suite.evidenceKeeper.SubmitEvidence(ctx, evidence), "same state")
+
+	// the signatures of the validators of the chain must be valid
+	evidence = &types.LightClientAttack{ConflictingBlock: suite.makeConflictingBlock(ctx, privs, power, tmhash.Sum([]byte("lunatic")))}
+	evidence.ConflictingBlock.SignedHeader.Commit.Signatures[0].Signature[0] ^= 0xFF
+	suite.Require().ErrorContains(suite.evidenceKeeper.SubmitEvidence(ctx, evidence), "invalid signature")
+
+	// the chain must know the header committed at the height of the attack
+	evidence = &types.LightClientAttack{ConflictingBlock: suite.makeConflictingBlock(ctx.WithBlockHeight(9), privs, power, tmhash.Sum([]byte("lunatic")))}
+	suite.Require().ErrorContains(suite.evidenceKeeper.SubmitEvidence(ctx, evidence), "no historical info")
+
+	oldTokens := make([]sdk.Int, len(privs))
+	for i := range privs {
+		oldTokens[i] = suite.stakingKeeper.Validator(ctx, valAddresses[i]).GetTokens()
+	}
+
+	evidence = &types.LightClientAttack{ConflictingBlock: suite.makeConflictingBlock(ctx, privs, power, tmhash.Sum([]byte("lunatic")))}
+	suite.Require().NoError(suite.evidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// all the signers should be slashed, jailed and tombstoned
+	for i, priv := range privs {
+		validator := suite.stakingKeeper.Validator(ctx, valAddresses[i])
+		suite.True(validator.IsJailed())
+		suite.True(validator.GetTokens().LT(oldTokens[i]))
+		suite.True(suite.slashingKeeper.IsTombstoned(ctx, sdk.ConsAddress(priv.PubKey().Address())))
+	}
+
+	res, ok := suite.evidenceKeeper.GetEvidence(ctx, evidence.Hash())
+	suite.True(ok)
+	suite.Equal(evidence.Hash(), res.Hash())
+}
@@ -30,6 +30,16 @@ func NewDecodeStore(cdc EvidenceUnmarshaler) func(kvA, kvB kv.Pair) string {
 			}
 
 			return fmt.Sprintf("%v\n%v", evidenceA, evidenceB)
+		case bytes.Equal(kvA.Key, types.ParamsKey):
+			var paramsA, paramsB types.Params
+			if err := paramsA.Unmarshal(kvA.Value); err != nil {
+				panic(fmt.Sprintf("cannot unmarshal params: %s", err.Error()))
+			}
+			if err := paramsB.Unmarshal(kvB.Value); err != nil {
+				panic(fmt.Sprintf("cannot unmarshal params: %s", err.Error()))
+			}
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -14,13 +15,27 @@ import (
 )
 
 // Simulation parameter constants
-const evidence = "evidence"
+const (
+	evidence        = "evidence"
+	MaxAgeNumBlocks = "max_age_num_blocks"
+	MaxAgeDuration  = "max_age_duration"
+)
 
 // GenEvidences returns an empty slice of evidences.
 func GenEvidences(_ *rand.Rand, _ []simtypes.Account) []exported.Evidence {
 	return []exported.Evidence{}
 }
 
+// GenMaxAgeNumBlocks randomized MaxAgeNumBlocks
+func GenMaxAgeNumBlocks(r *rand.Rand) int64 {
+	return int64(simtypes.RandIntBetween(r, 100, 100000))
+}
+
+// GenMaxAgeDuration randomized MaxAgeDuration
+func GenMaxAgeDuration(r *rand.Rand) time.Duration {
+	return time.Duration(simtypes.RandIntBetween(r, 1, 72)) * time.Hour
+}
+
 // RandomizedGenState generates a random GenesisState for evidence
 func RandomizedGenState(simState *module.SimulationState) {
 	var ev []exported.Evidence
@@ -30,7 +45,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { ev = GenEvidences(r, simState.Accounts) },
 	)
 
-	evidenceGenesis := types.NewGenesisState(ev)
+	var maxAgeNumBlocks int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAgeNumBlocks, &maxAgeNumBlocks, simState.Rand,
+		func(r *rand.Rand) { maxAgeNumBlocks = GenMaxAgeNumBlocks(r) },
+	)
+
+	var maxAgeDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxAgeDuration, &maxAgeDuration, simState.Rand,
+		func(r *rand.Rand) { maxAgeDuration = GenMaxAgeDuration(r) },
+	)
+
+	params := types.NewParams(maxAgeNumBlocks, maxAgeDuration)
+	evidenceGenesis := types.NewGenesisState(params, ev)
 
 	bz, err := json.MarshalIndent(&evidenceGenesis, "", " ")
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -41,4 +42,6 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &evidenceGenesis)
 
 	require.Len(t, evidenceGenesis.Evidence, 0)
+	require.Equal(t, int64(59040), evidenceGenesis.Params.MaxAgeNumBlocks)
+	require.Equal(t, 13*time.Hour, evidenceGenesis.Params.MaxAgeDuration)
 }
@@ -7,6 +7,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,11 +16,18 @@ import (
 
 // Evidence type constants
 const (
-	RouteEquivocation = "equivocation"
-	TypeEquivocation  = "equivocation"
+	RouteEquivocation      = "equivocation"
+	TypeEquivocation       = "equivocation"
+	RouteLightClientAttack = "lightclientattack"
+	TypeLightClientAttack  = "light_client_attack"
 )
 
-var _ exported.Evidence = &Equivocation{}
+var (
+	_ exported.Evidence      = &Equivocation{}
+	_ exported.TimedEvidence = &Equivocation{}
+	_ exported.Evidence      = &LightClientAttack{}
+	_ exported.TimedEvidence = &LightClientAttack{}
+)
 
 // Route returns the Evidence Handler route for an Equivocation type.
 func (e *Equivocation) Route() string { return RouteEquivocation }
@@ -85,6 +93,57 @@ func (e Equivocation) GetValidatorPower() int64 {
 // GetTotalPower is a no-op for the Equivocation type.
 func (e Equivocation) GetTotalPower() int64 { return 0 }
 
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Type returns the Evidence Handler type for a LightClientAttack type.
+func (e *LightClientAttack) Type() string { return TypeLightClientAttack }
+
+func (e *LightClientAttack) String() string {
+	bz, _ := yaml.Marshal(e)
+	return string(bz)
+}
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() tmbytes.HexBytes {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack
+// object. The signatures of the conflicting block are verified by its handler
+// against the validator set of the chain.
+func (e *LightClientAttack) ValidateBasic() error {
+	lb, err := tmtypes.LightBlockFromProto(e.ConflictingBlock)
+	if err != nil {
+		return fmt.Errorf("invalid light client attack conflicting block: %w", err)
+	}
+	if err := lb.ValidateBasic(lb.ChainID); err != nil {
+		return fmt.Errorf("invalid light client attack conflicting block: %w", err)
+	}
+
+	return nil
+}
+
+// GetHeight returns the height of the conflicting block of the LightClientAttack.
+func (e LightClientAttack) GetHeight() int64 {
+	if e.ConflictingBlock == nil || e.ConflictingBlock.SignedHeader == nil || e.ConflictingBlock.SignedHeader.Header == nil {
+		return 0
+	}
+	return e.ConflictingBlock.SignedHeader.Header.Height
+}
+
+// GetTime returns the time of the conflicting block of the LightClientAttack.
+func (e LightClientAttack) GetTime() time.Time {
+	if e.ConflictingBlock == nil || e.ConflictingBlock.SignedHeader == nil || e.ConflictingBlock.SignedHeader.Header == nil {
+		return time.Time{}
+	}
+	return e.ConflictingBlock.SignedHeader.Header.Time
+}
+
 // FromABCIEvidence converts a Tendermint concrete Evidence type to
 // SDK Evidence using Equivocation as the concrete type.
 func FromABCIEvidence(e abci.Evidence) exported.Evidence {
@@ -9,6 +9,8 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	types "github.com/tendermint/tendermint/proto/tendermint/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -69,8 +71,113 @@ func (m *Equivocation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Equivocation proto.InternalMessageInfo
 
+// LightClientAttack implements the Evidence interface and defines evidence of a
+// light client attack: a block header conflicting with the header committed by
+// the chain at the same height, signed by validators of the chain.
+//
+// Since: cosmos-sdk 0.47
+type LightClientAttack struct {
+	// conflicting_block is the signed header conflicting with the header
+	// committed at its height, along with the validator set which signed it.
+	ConflictingBlock *types.LightBlock `protobuf:"bytes,1,opt,name=conflicting_block,json=conflictingBlock,proto3" json:"conflicting_block,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()      { *m = LightClientAttack{} }
+func (*LightClientAttack) ProtoMessage() {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{1}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// Params defines the parameters for the x/evidence module.
+//
+// Since: cosmos-sdk 0.47
+type Params struct {
+	// max_age_num_blocks is the maximum age in blocks of evidence submitted
+	// through MsgSubmitEvidence. No limit is applied when it is zero.
+	MaxAgeNumBlocks int64 `protobuf:"varint,1,opt,name=max_age_num_blocks,json=maxAgeNumBlocks,proto3" json:"max_age_num_blocks,omitempty"`
+	// max_age_duration is the maximum age of evidence submitted through
+	// MsgSubmitEvidence carrying the time of the infraction. No limit is applied
+	// when it is zero.
+	MaxAgeDuration time.Duration `protobuf:"bytes,2,opt,name=max_age_duration,json=maxAgeDuration,proto3,stdduration" json:"max_age_duration"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd143e71a177f0dd, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMaxAgeNumBlocks() int64 {
+	if m != nil {
+		return m.MaxAgeNumBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAgeDuration() time.Duration {
+	if m != nil {
+		return m.MaxAgeDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Equivocation)(nil), "cosmos.evidence.v1beta1.Equivocation")
+	proto.RegisterType((*LightClientAttack)(nil), "cosmos.evidence.v1beta1.LightClientAttack")
+	proto.RegisterType((*Params)(nil), "cosmos.evidence.v1beta1.Params")
 }
 
 func init() {
@@ -78,30 +185,66 @@ func init() {
 }
 
 var fileDescriptor_dd143e71a177f0dd = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x51, 0xbb, 0x4e, 0xf3, 0x30,
-	0x18, 0xb5, 0xff, 0xf6, 0xaf, 0x20, 0x74, 0x80, 0xa8, 0x82, 0xd0, 0xc1, 0xa9, 0x18, 0x50, 0x97,
-	0x26, 0x2a, 0x2c, 0x88, 0x8d, 0x4a, 0x9d, 0xd8, 0x0a, 0x13, 0x4b, 0x95, 0x8b, 0x71, 0x2d, 0x48,
-	0xbe, 0x10, 0x3b, 0x05, 0xde, 0x80, 0xb1, 0x23, 0x63, 0x47, 0x1e, 0x80, 0x87, 0xa8, 0xc4, 0x52,
-	0x31, 0x31, 0x01, 0x4a, 0x17, 0x1e, 0x03, 0xd5, 0x76, 0xcb, 0x64, 0x9f, 0xe3, 0x73, 0xb1, 0xfd,
-	0x59, 0x87, 0x11, 0x88, 0x04, 0x84, 0x4f, 0xc7, 0x3c, 0xa6, 0x69, 0x44, 0xfd, 0x71, 0x37, 0xa4,
-	0x32, 0xe8, 0xae, 0x09, 0x2f, 0xcb, 0x41, 0x82, 0xbd, 0xa7, 0x75, 0xde, 0x9a, 0x36, 0xba, 0x66,
-	0x83, 0x01, 0x03, 0xa5, 0xf1, 0x97, 0x3b, 0x2d, 0x6f, 0xba, 0x0c, 0x80, 0xdd, 0x52, 0x5f, 0xa1,
-	0xb0, 0xb8, 0xf6, 0x25, 0x4f, 0xa8, 0x90, 0x41, 0x92, 0x19, 0xc1, 0xbe, 0xce, 0x1b, 0x6a, 0xa7,
-	0x09, 0x57, 0xe0, 0xe0, 0x0d, 0x5b, 0xf5, 0xfe, 0x5d, 0xc1, 0xc7, 0x10, 0x05, 0x92, 0x43, 0x6a,
-	0xef, 0x5a, 0xb5, 0x11, 0xe5, 0x6c, 0x24, 0x1d, 0xdc, 0xc2, 0xed, 0xca, 0xc0, 0x20, 0xfb, 0xc4,
-	0xaa, 0x2e, 0x63, 0x9d, 0x7f, 0x2d, 0xdc, 0xde, 0x3a, 0x6a, 0x7a, 0xba, 0xd3, 0x5b, 0x75, 0x7a,
-	0x97, 0xab, 0xce, 0xde, 0xc6, 0xec, 0xd3, 0x45, 0x93, 0x2f, 0x17, 0x0f, 0x94, 0xc3, 0x6e, 0x58,
-	0xff, 0x33, 0xb8, 0xa7, 0xb9, 0x53, 0x51, 0x81, 0x1a, 0xd8, 0x7d, 0x6b, 0x27, 0x82, 0x54, 0xd0,
-	0x54, 0x14, 0x62, 0x18, 0xc4, 0x71, 0x4e, 0x85, 0x70, 0xaa, 0x2d, 0xdc, 0xde, 0xec, 0x39, 0xef,
-	0xaf, 0x9d, 0x86, 0xb9, 0xe5, 0x99, 0x3e, 0xb9, 0x90, 0x39, 0x4f, 0xd9, 0x60, 0x7b, 0x6d, 0x31,
-	0xfc, 0x69, 0xfd, 0x69, 0xea, 0xa2, 0xe7, 0xa9, 0x8b, 0x7e, 0xa6, 0x2e, 0xea, 0x9d, 0xbf, 0x94,
-	0x04, 0xcf, 0x4a, 0x82, 0xe7, 0x25, 0xc1, 0xdf, 0x25, 0xc1, 0x93, 0x05, 0x41, 0xf3, 0x05, 0x41,
-	0x1f, 0x0b, 0x82, 0xae, 0x3a, 0x8c, 0xcb, 0x51, 0x11, 0x7a, 0x11, 0x24, 0xe6, 0x13, 0xcc, 0xd2,
-	0x11, 0xf1, 0x8d, 0xff, 0xf0, 0x37, 0x16, 0xf9, 0x98, 0x51, 0x11, 0xd6, 0xd4, 0xdb, 0x8e, 0x7f,
-	0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x32, 0xf0, 0x2c, 0xb6, 0x01, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0xd1, 0x12, 0xc1, 0xb5, 0x82, 0xf4, 0x14, 0x81, 0x1b, 0x55, 0x76, 0xd4, 0x01, 0x45,
+	0x42, 0xb1, 0xd5, 0xb2, 0x20, 0xb6, 0x04, 0x3a, 0x20, 0x7e, 0x08, 0x05, 0x26, 0x16, 0xeb, 0x6c,
+	0x5f, 0x2f, 0xa7, 0xf8, 0xee, 0x82, 0xef, 0x1c, 0xc2, 0xce, 0xc0, 0xd8, 0xb1, 0x63, 0x46, 0xfe,
+	0x00, 0xfe, 0x88, 0x4a, 0x2c, 0x15, 0x13, 0x13, 0xa0, 0x64, 0xe1, 0xcf, 0x40, 0xbe, 0xbb, 0xa4,
+	0x28, 0x5d, 0x6c, 0xbf, 0xf7, 0x7d, 0xef, 0x7d, 0xef, 0x7d, 0x7e, 0xf0, 0x41, 0x26, 0x15, 0x97,
+	0x2a, 0x26, 0x53, 0x96, 0x13, 0x91, 0x91, 0x78, 0x7a, 0x94, 0x12, 0x8d, 0x8f, 0xd6, 0x89, 0x68,
+	0x52, 0x4a, 0x2d, 0xd1, 0x7d, 0xcb, 0x8b, 0xd6, 0x69, 0xc7, 0x6b, 0xb7, 0xa8, 0xa4, 0xd2, 0x70,
+	0xe2, 0xfa, 0xcb, 0xd2, 0xdb, 0x01, 0x95, 0x92, 0x16, 0x24, 0x36, 0x51, 0x5a, 0x9d, 0xc6, 0x79,
+	0x55, 0x62, 0xcd, 0xa4, 0x70, 0x78, 0xb8, 0x89, 0x6b, 0xc6, 0x89, 0xd2, 0x98, 0x4f, 0x1c, 0x61,
+	0xdf, 0xea, 0x25, 0xb6, 0xb3, 0x13, 0xb7, 0xd0, 0x81, 0x26, 0x22, 0x27, 0x25, 0x67, 0x42, 0xc7,
+	0xfa, 0xd3, 0x84, 0x28, 0xfb, 0xb4, 0xe8, 0xe1, 0x77, 0x00, 0x77, 0x4f, 0x3e, 0x54, 0x6c, 0x2a,
+	0x33, 0x23, 0x88, 0xee, 0xc1, 0xc6, 0x88, 0x30, 0x3a, 0xd2, 0x3e, 0xe8, 0x80, 0xee, 0xd6, 0xd0,
+	0x45, 0xe8, 0x31, 0xdc, 0xae, 0x45, 0xfd, 0x1b, 0x1d, 0xd0, 0xdd, 0x39, 0x6e, 0x47, 0x76, 0xa2,
+	0x68, 0x35, 0x51, 0xf4, 0x6e, 0x35, 0xd1, 0xe0, 0xd6, 0xc5, 0xaf, 0xd0, 0x3b, 0xfb, 0x1d, 0x82,
+	0xa1, 0xa9, 0x40, 0x2d, 0x78, 0x73, 0x22, 0x3f, 0x92, 0xd2, 0xdf, 0x32, 0x0d, 0x6d, 0x80, 0x4e,
+	0xe0, 0x5e, 0x26, 0x85, 0x22, 0x42, 0x55, 0x2a, 0xc1, 0x79, 0x5e, 0x12, 0xa5, 0xfc, 0xed, 0x0e,
+	0xe8, 0xde, 0x1e, 0xf8, 0x3f, 0xbe, 0xf5, 0x5a, 0x6e, 0x87, 0xbe, 0x45, 0xde, 0xea, 0x92, 0x09,
+	0x3a, 0x6c, 0xae, 0x4b, 0x5c, 0xfe, 0xc9, 0xee, 0x97, 0x79, 0xe8, 0x9d, 0xcf, 0x43, 0xef, 0xef,
+	0x3c, 0xf4, 0x0e, 0x0b, 0xb8, 0xf7, 0xb2, 0x9e, 0xf6, 0x69, 0xc1, 0x88, 0xd0, 0x7d, 0xad, 0x71,
+	0x36, 0x46, 0xcf, 0x8d, 0xd2, 0x69, 0xc1, 0x32, 0xcd, 0x04, 0x4d, 0xd2, 0x42, 0x66, 0x63, 0xb3,
+	0xdc, 0xce, 0xf1, 0x41, 0x74, 0x65, 0x4e, 0x64, 0x6d, 0x31, 0xf5, 0x83, 0x9a, 0x33, 0x6c, 0xfe,
+	0x57, 0x66, 0x32, 0x1b, 0x6a, 0x9f, 0x01, 0x6c, 0xbc, 0xc1, 0x25, 0xe6, 0x0a, 0x3d, 0x84, 0x88,
+	0xe3, 0x59, 0x82, 0x29, 0x49, 0x44, 0xc5, 0xad, 0x86, 0x72, 0x0e, 0xde, 0xe5, 0x78, 0xd6, 0xa7,
+	0xe4, 0x75, 0xc5, 0x4d, 0x13, 0x85, 0x5e, 0xc1, 0xe6, 0x8a, 0xbc, 0xfa, 0xcf, 0xce, 0xd6, 0xfd,
+	0x6b, 0xb6, 0x3e, 0x73, 0x04, 0xeb, 0xea, 0x79, 0xed, 0xea, 0x1d, 0xdb, 0x6f, 0x8d, 0xbc, 0xf8,
+	0xba, 0x08, 0xc0, 0xc5, 0x22, 0x00, 0x97, 0x8b, 0x00, 0xfc, 0x59, 0x04, 0xe0, 0x6c, 0x19, 0x78,
+	0x97, 0xcb, 0xc0, 0xfb, 0xb9, 0x0c, 0xbc, 0xf7, 0x3d, 0xca, 0xf4, 0xa8, 0x4a, 0xa3, 0x4c, 0x72,
+	0x77, 0x17, 0xee, 0xd5, 0x53, 0xf9, 0x38, 0x9e, 0x5d, 0x5d, 0xb2, 0x59, 0x3f, 0x6d, 0x18, 0xe5,
+	0x47, 0xff, 0x06, 0x00, 0x31, 0x69, 0xab, 0x0c, 0xe9, 0x02, 0x00, 0x00,
 }
 
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAgeNumBlocks != that1.MaxAgeNumBlocks {
+		return false
+	}
+	if this.MaxAgeDuration != that1.MaxAgeDuration {
+		return false
+	}
+	return true
+}
 func (m *Equivocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -150,6 +293,77 @@ func (m *Equivocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConflictingBlock != nil {
+		{
+			size, err := m.ConflictingBlock.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvidence(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAgeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintEvidence(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxAgeNumBlocks != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.MaxAgeNumBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvidence(v)
 	base := offset
@@ -182,6 +396,33 @@ func (m *Equivocation) Size() (n int) {
 	return n
 }
 
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConflictingBlock != nil {
+		l = m.ConflictingBlock.Size()
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAgeNumBlocks != 0 {
+		n += 1 + sovEvidence(uint64(m.MaxAgeNumBlocks))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAgeDuration)
+	n += 1 + l + sovEvidence(uint64(l))
+	return n
+}
+
 func sovEvidence(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -341,6 +582,194 @@ func (m *Equivocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConflictingBlock == nil {
+				m.ConflictingBlock = &types.LightBlock{}
+			}
+			if err := m.ConflictingBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeNumBlocks", wireType)
+			}
+			m.MaxAgeNumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeNumBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAgeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvidence(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
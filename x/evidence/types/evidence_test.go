@@ -88,3 +88,12 @@ func TestEvidenceAddressConversion(t *testing.T) {
 	require.Equal(t, tmEvidence.Validator.Address, consAddr.Bytes())
 	sdk.GetConfig().SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 }
+
+func TestLightClientAttackValidateBasic(t *testing.T) {
+	e := types.LightClientAttack{}
+	require.Equal(t, types.TypeLightClientAttack, e.Type())
+	require.Equal(t, types.RouteLightClientAttack, e.Route())
+	require.Equal(t, int64(0), e.GetHeight())
+	require.True(t, e.GetTime().IsZero())
+	require.Error(t, e.ValidateBasic())
+}
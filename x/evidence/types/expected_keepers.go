@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -13,6 +15,8 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+		PowerReduction(ctx sdk.Context) math.Int
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -26,5 +30,7 @@ type (
 		SlashFractionDoubleSign(sdk.Context) sdk.Dec
 		Jail(sdk.Context, sdk.ConsAddress)
 		JailUntil(sdk.Context, sdk.ConsAddress, time.Time)
+		ApplyDoubleSignJailPolicy(sdk.Context, sdk.ConsAddress)
+		HasDoubleSignInfraction(sdk.Context, sdk.ConsAddress, int64) bool
 	}
 )
@@ -18,6 +18,7 @@ const (
 
 var (
 	_ sdk.Msg                       = &MsgSubmitEvidence{}
+	_ sdk.Msg                       = &MsgUpdateParams{}
 	_ types.UnpackInterfacesMessage = MsgSubmitEvidence{}
 	_ exported.MsgSubmitEvidenceI   = &MsgSubmitEvidence{}
 )
@@ -91,3 +92,29 @@ func (m MsgSubmitEvidence) UnpackInterfaces(ctx types.AnyUnpacker) error {
 	var evi exported.Evidence
 	return ctx.UnpackAny(m.Evidence, &evi)
 }
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
@@ -58,3 +58,26 @@ func TestMsgSubmitEvidence(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress("authority___________")
+
+	testCases := []struct {
+		msg       *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{&types.MsgUpdateParams{Authority: authority.String(), Params: types.DefaultParams()}, false},
+		{&types.MsgUpdateParams{Authority: authority.String(), Params: types.NewParams(0, 0)}, false},
+		{&types.MsgUpdateParams{Authority: "", Params: types.DefaultParams()}, true},
+		{&types.MsgUpdateParams{Authority: authority.String(), Params: types.NewParams(-1, 0)}, true},
+		{&types.MsgUpdateParams{Authority: authority.String(), Params: types.NewParams(0, -time.Hour)}, true},
+	}
+
+	for i, tc := range testCases {
+		require.Equal(t, tc.expectErr, tc.msg.ValidateBasic() != nil, "unexpected result for tc #%d", i)
+
+		if !tc.expectErr {
+			require.Equal(t, []sdk.AccAddress{authority}, tc.msg.GetSigners(), "unexpected result for tc #%d", i)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"time"
 )
 
@@ -9,3 +10,50 @@ import (
 // DoubleSignJailEndTime period ends at Max Time supported by Amino
 // (Dec 31, 9999 - 23:59:59 GMT).
 var DoubleSignJailEndTime = time.Unix(253402300799, 0)
+
+// Default parameter values
+const (
+	DefaultMaxAgeNumBlocks int64 = 100000
+	DefaultMaxAgeDuration        = time.Hour * 48
+)
+
+// NewParams creates a new Params object
+func NewParams(maxAgeNumBlocks int64, maxAgeDuration time.Duration) Params {
+	return Params{
+		MaxAgeNumBlocks: maxAgeNumBlocks,
+		MaxAgeDuration:  maxAgeDuration,
+	}
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return NewParams(DefaultMaxAgeNumBlocks, DefaultMaxAgeDuration)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateMaxAgeNumBlocks(p.MaxAgeNumBlocks); err != nil {
+		return err
+	}
+	if err := validateMaxAgeDuration(p.MaxAgeDuration); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateMaxAgeNumBlocks(v int64) error {
+	if v < 0 {
+		return fmt.Errorf("max age num blocks cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxAgeDuration(v time.Duration) error {
+	if v < 0 {
+		return fmt.Errorf("max age duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
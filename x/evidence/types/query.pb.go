@@ -227,11 +227,100 @@ func (m *QueryAllEvidenceResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{4}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+//
+// Since: cosmos-sdk 0.47
+type QueryParamsResponse struct {
+	// params holds all the parameters of the evidence module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07043de1a84d215a, []int{5}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryEvidenceRequest")
 	proto.RegisterType((*QueryEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryEvidenceResponse")
 	proto.RegisterType((*QueryAllEvidenceRequest)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceRequest")
 	proto.RegisterType((*QueryAllEvidenceResponse)(nil), "cosmos.evidence.v1beta1.QueryAllEvidenceResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.evidence.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.evidence.v1beta1.QueryParamsResponse")
 }
 
 func init() {
@@ -239,37 +328,41 @@ func init() {
 }
 
 var fileDescriptor_07043de1a84d215a = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0x22, 0xd0, 0xe4, 0x8d, 0x8b, 0x55, 0xb4, 0x11, 0xa1, 0x00, 0x99, 0xc4, 0x2f,
-	0xa9, 0xf6, 0xb2, 0x71, 0x80, 0xe3, 0x2a, 0xc1, 0xc6, 0x0d, 0x72, 0x44, 0x42, 0xc8, 0x69, 0x4d,
-	0x12, 0x91, 0xda, 0x59, 0xed, 0x4c, 0x8d, 0x10, 0x17, 0xfe, 0x02, 0x24, 0xc4, 0x91, 0x1b, 0x7f,
-	0x0c, 0x27, 0x54, 0x89, 0x0b, 0x27, 0x84, 0x5a, 0xfe, 0x0a, 0x4e, 0x28, 0xb6, 0xd3, 0xa6, 0xbf,
-	0x28, 0x9c, 0xf2, 0x62, 0xbf, 0xf7, 0xfd, 0x7e, 0xfc, 0xde, 0x83, 0xfb, 0x5d, 0x21, 0xfb, 0x42,
-	0x12, 0x76, 0x9e, 0xf4, 0x18, 0xef, 0x32, 0x72, 0xee, 0x87, 0x4c, 0x51, 0x9f, 0x9c, 0xe5, 0x6c,
-	0x50, 0xe0, 0x6c, 0x20, 0x94, 0x40, 0xbb, 0x26, 0x09, 0x57, 0x49, 0xd8, 0x26, 0x39, 0xf7, 0x6c,
-	0x75, 0x48, 0x25, 0x33, 0x15, 0xd3, 0xfa, 0x8c, 0x46, 0x09, 0xa7, 0x2a, 0x11, 0xdc, 0x88, 0x38,
-	0xad, 0x48, 0x44, 0x42, 0x87, 0xa4, 0x8c, 0xec, 0xe9, 0xd5, 0x48, 0x88, 0x28, 0x65, 0x44, 0xff,
-	0x85, 0xf9, 0x2b, 0x42, 0xb9, 0x75, 0x75, 0xae, 0xd9, 0x2b, 0x9a, 0x25, 0x84, 0x72, 0x2e, 0x94,
-	0x56, 0x93, 0xe6, 0xd6, 0xcb, 0x61, 0xeb, 0x59, 0x69, 0xf8, 0xc8, 0x32, 0x05, 0xec, 0x2c, 0x67,
-	0x52, 0xa1, 0x17, 0xf0, 0x72, 0x85, 0xf9, 0x32, 0xa6, 0x32, 0xde, 0x03, 0x37, 0xc0, 0x9d, 0x9d,
-	0xce, 0x83, 0xdf, 0x3f, 0xae, 0xdf, 0x8f, 0x12, 0x15, 0xe7, 0x21, 0xee, 0x8a, 0x3e, 0x51, 0x8c,
-	0xf7, 0xd8, 0xa0, 0x9f, 0x70, 0x55, 0x0f, 0xd3, 0x24, 0x94, 0x24, 0x2c, 0x14, 0x93, 0xf8, 0x94,
-	0x0d, 0x3b, 0x65, 0x10, 0xec, 0x54, 0x72, 0xa7, 0x54, 0xc6, 0xde, 0x13, 0x78, 0x65, 0xc1, 0x56,
-	0x66, 0x82, 0x4b, 0x86, 0x0e, 0xe0, 0x56, 0x95, 0xa8, 0x2d, 0xb7, 0x0f, 0x5b, 0xd8, 0x3c, 0x00,
-	0x57, 0x6f, 0xc3, 0xc7, 0xbc, 0x08, 0xa6, 0x59, 0x1e, 0x85, 0xbb, 0x5a, 0xea, 0x38, 0x4d, 0x17,
-	0x1f, 0xf1, 0x18, 0xc2, 0x59, 0xff, 0xac, 0xdc, 0x2d, 0x6c, 0xa7, 0x50, 0x36, 0x1b, 0x9b, 0xf1,
-	0xd8, 0x66, 0xe3, 0xa7, 0x34, 0xaa, 0x6a, 0x83, 0x5a, 0xa5, 0xf7, 0x11, 0xc0, 0xbd, 0x65, 0x8f,
-	0x95, 0xc4, 0x17, 0x36, 0x13, 0xa3, 0x93, 0x39, 0xac, 0xa6, 0xc6, 0xba, 0xbd, 0x11, 0xcb, 0xd8,
-	0xd5, 0xb9, 0x0e, 0xbf, 0x36, 0xe1, 0x45, 0xcd, 0x85, 0x3e, 0x03, 0xb8, 0x55, 0x91, 0xa1, 0x36,
-	0x5e, 0xb3, 0x68, 0x78, 0xd5, 0xa8, 0x1d, 0xfc, 0xaf, 0xe9, 0x86, 0xc0, 0x7b, 0xf8, 0xee, 0xdb,
-	0xaf, 0x0f, 0xcd, 0x23, 0xe4, 0x93, 0x75, 0x4b, 0x3f, 0x3d, 0x78, 0x33, 0xb7, 0x43, 0x6f, 0xd1,
-	0x27, 0x00, 0xb7, 0x6b, 0x3d, 0x44, 0x07, 0x7f, 0xb7, 0x5e, 0x1e, 0xa9, 0xe3, 0xff, 0x47, 0x85,
-	0xe5, 0xbd, 0xab, 0x79, 0xf7, 0xd1, 0xcd, 0x8d, 0xbc, 0x9d, 0x93, 0x2f, 0x63, 0x17, 0x8c, 0xc6,
-	0x2e, 0xf8, 0x39, 0x76, 0xc1, 0xfb, 0x89, 0xdb, 0x18, 0x4d, 0xdc, 0xc6, 0xf7, 0x89, 0xdb, 0x78,
-	0xde, 0xae, 0x2d, 0xbd, 0x95, 0x31, 0x9f, 0xb6, 0xec, 0xbd, 0x26, 0xc3, 0x99, 0xa6, 0x2a, 0x32,
-	0x26, 0xc3, 0x4b, 0x7a, 0xf4, 0x47, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x50, 0xfb, 0x8a, 0x39,
-	0x18, 0x04, 0x00, 0x00,
+	// 540 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbf, 0x6f, 0x13, 0x31,
+	0x14, 0xc7, 0xe3, 0x16, 0xa2, 0xca, 0x2d, 0x8b, 0x09, 0x6a, 0x39, 0xa1, 0x0b, 0xbd, 0x4a, 0x2d,
+	0xbf, 0x62, 0x37, 0x2d, 0x03, 0x0c, 0x0c, 0x8d, 0x04, 0x2d, 0x5b, 0x89, 0x98, 0x90, 0x10, 0xf2,
+	0x25, 0xe6, 0x72, 0x22, 0xb1, 0xaf, 0xb1, 0xaf, 0x6a, 0x84, 0x58, 0x98, 0x19, 0x90, 0x10, 0x23,
+	0x1b, 0x7f, 0x4c, 0xc7, 0x4a, 0x2c, 0x2c, 0x54, 0x28, 0xe1, 0xaf, 0x60, 0x42, 0xb1, 0xdf, 0xa5,
+	0xb9, 0xb6, 0x69, 0xca, 0x14, 0xc7, 0xf7, 0xfd, 0x7e, 0xdf, 0xe7, 0xde, 0x7b, 0x87, 0x57, 0x1a,
+	0x4a, 0x77, 0x94, 0x66, 0x62, 0x3f, 0x6e, 0x0a, 0xd9, 0x10, 0x6c, 0xbf, 0x1a, 0x0a, 0xc3, 0xab,
+	0x6c, 0x2f, 0x15, 0xdd, 0x1e, 0x4d, 0xba, 0xca, 0x28, 0xb2, 0xe8, 0x44, 0x34, 0x13, 0x51, 0x10,
+	0x79, 0xf7, 0xc0, 0x1d, 0x72, 0x2d, 0x9c, 0x63, 0xe4, 0x4f, 0x78, 0x14, 0x4b, 0x6e, 0x62, 0x25,
+	0x5d, 0x88, 0x57, 0x8a, 0x54, 0xa4, 0xec, 0x91, 0x0d, 0x4f, 0x70, 0x7b, 0x33, 0x52, 0x2a, 0x6a,
+	0x0b, 0x66, 0xff, 0x85, 0xe9, 0x5b, 0xc6, 0x25, 0x54, 0xf5, 0x6e, 0xc1, 0x23, 0x9e, 0xc4, 0x8c,
+	0x4b, 0xa9, 0x8c, 0x4d, 0xd3, 0xf0, 0x74, 0x75, 0x12, 0xf8, 0x08, 0xd2, 0xea, 0x82, 0x14, 0x97,
+	0x5e, 0x0c, 0xc1, 0x9e, 0xc2, 0x75, 0x5d, 0xec, 0xa5, 0x42, 0x1b, 0xf2, 0x1a, 0x5f, 0xcb, 0x94,
+	0x6f, 0x5a, 0x5c, 0xb7, 0x96, 0xd0, 0x6d, 0x74, 0x67, 0xa1, 0xf6, 0xe8, 0xef, 0x71, 0xf9, 0x61,
+	0x14, 0x9b, 0x56, 0x1a, 0xd2, 0x86, 0xea, 0x30, 0x23, 0x64, 0x53, 0x74, 0x3b, 0xb1, 0x34, 0xe3,
+	0xc7, 0x76, 0x1c, 0x6a, 0x16, 0xf6, 0x8c, 0xd0, 0x74, 0x47, 0x1c, 0xd4, 0x86, 0x87, 0xfa, 0x42,
+	0x16, 0xb7, 0xc3, 0x75, 0x2b, 0x78, 0x8e, 0x6f, 0x9c, 0x2a, 0xab, 0x13, 0x25, 0xb5, 0x20, 0xeb,
+	0x78, 0x2e, 0x13, 0xda, 0x92, 0xf3, 0x1b, 0x25, 0xea, 0x5e, 0x94, 0x66, 0x3d, 0xa0, 0x5b, 0xb2,
+	0x57, 0x1f, 0xa9, 0x02, 0x8e, 0x17, 0x6d, 0xd4, 0x56, 0xbb, 0x7d, 0xfa, 0x25, 0x9e, 0x61, 0x7c,
+	0xd2, 0x67, 0x88, 0x5b, 0xa5, 0x30, 0xad, 0xe1, 0x50, 0xa8, 0x1b, 0x23, 0xf4, 0x86, 0xee, 0xf2,
+	0x28, 0xf3, 0xd6, 0xc7, 0x9c, 0xc1, 0x57, 0x84, 0x97, 0xce, 0xd6, 0x38, 0x97, 0x78, 0x76, 0x3a,
+	0x31, 0xd9, 0xce, 0x61, 0xcd, 0x58, 0xac, 0xb5, 0xa9, 0x58, 0xae, 0x5c, 0x8e, 0xab, 0x84, 0x89,
+	0xc5, 0xda, 0xe5, 0x5d, 0xde, 0xd1, 0x40, 0x1e, 0xbc, 0xc4, 0xd7, 0x73, 0xb7, 0xc0, 0xf9, 0x04,
+	0x17, 0x13, 0x7b, 0x03, 0x8d, 0x28, 0xd3, 0x09, 0x6b, 0x4b, 0x9d, 0xb1, 0x76, 0xe5, 0xf0, 0xb8,
+	0x5c, 0xa8, 0x83, 0x69, 0xe3, 0xd7, 0x2c, 0xbe, 0x6a, 0x63, 0xc9, 0x77, 0x84, 0xe7, 0xb2, 0x2e,
+	0x90, 0xca, 0xc4, 0x94, 0xf3, 0xd6, 0xca, 0xa3, 0x97, 0x95, 0x3b, 0xe8, 0xe0, 0xf1, 0xc7, 0x1f,
+	0x7f, 0xbe, 0xcc, 0x6c, 0x92, 0x2a, 0x9b, 0xb6, 0xcf, 0xec, 0x7d, 0x6e, 0x5f, 0x3f, 0x90, 0x6f,
+	0x08, 0xcf, 0x8f, 0xcd, 0x8b, 0xac, 0x5f, 0x5c, 0xfa, 0xec, 0xfa, 0x78, 0xd5, 0xff, 0x70, 0x00,
+	0xef, 0x5d, 0xcb, 0xbb, 0x42, 0x96, 0xa7, 0xf2, 0x92, 0x4f, 0x08, 0x17, 0x5d, 0xa7, 0xc9, 0xfd,
+	0x8b, 0x0b, 0xe5, 0xc6, 0xeb, 0x3d, 0xb8, 0x9c, 0x18, 0x80, 0xd6, 0x2c, 0xd0, 0x32, 0x29, 0x4f,
+	0x04, 0x72, 0xf3, 0xad, 0x6d, 0x1f, 0xf6, 0x7d, 0x74, 0xd4, 0xf7, 0xd1, 0xef, 0xbe, 0x8f, 0x3e,
+	0x0f, 0xfc, 0xc2, 0xd1, 0xc0, 0x2f, 0xfc, 0x1c, 0xf8, 0x85, 0x57, 0x95, 0xb1, 0xef, 0x1d, 0x42,
+	0xdc, 0x4f, 0x45, 0x37, 0xdf, 0xb1, 0x83, 0x93, 0x44, 0xd3, 0x4b, 0x84, 0x0e, 0x8b, 0x76, 0xeb,
+	0x37, 0xff, 0x0d, 0x00, 0xe6, 0xdb, 0x70, 0x3d, 0x3b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -288,6 +381,10 @@ type QueryClient interface {
 	Evidence(ctx context.Context, in *QueryEvidenceRequest, opts ...grpc.CallOption) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(ctx context.Context, in *QueryAllEvidenceRequest, opts ...grpc.CallOption) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	//
+	// Since: cosmos-sdk 0.47
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -316,12 +413,25 @@ func (c *queryClient) AllEvidence(ctx context.Context, in *QueryAllEvidenceReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.evidence.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Evidence queries evidence based on evidence hash.
 	Evidence(context.Context, *QueryEvidenceRequest) (*QueryEvidenceResponse, error)
 	// AllEvidence queries all evidence.
 	AllEvidence(context.Context, *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error)
+	// Params queries the parameters of the evidence module.
+	//
+	// Since: cosmos-sdk 0.47
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -334,6 +444,9 @@ func (*UnimplementedQueryServer) Evidence(ctx context.Context, req *QueryEvidenc
 func (*UnimplementedQueryServer) AllEvidence(ctx context.Context, req *QueryAllEvidenceRequest) (*QueryAllEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllEvidence not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -375,6 +488,24 @@ func _Query_AllEvidence_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.evidence.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.evidence.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -387,6 +518,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllEvidence",
 			Handler:    _Query_AllEvidence_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/evidence/v1beta1/query.proto",
@@ -541,6 +676,62 @@ func (m *QueryAllEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -610,6 +801,26 @@ func (m *QueryAllEvidenceResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -992,6 +1203,139 @@ func (m *QueryAllEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
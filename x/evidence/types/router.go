@@ -28,8 +28,27 @@ type (
 		routes map[string]Handler
 		sealed bool
 	}
+
+	// HandlerRoute is a wrapper around an evidence Handler and the route it
+	// should be registered under, so that modules can provide handlers for
+	// their own Evidence types to the evidence module using depinject.
+	HandlerRoute struct {
+		Handler  Handler
+		RouteKey string
+	}
 )
 
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
+// NewHandlerRoute returns a HandlerRoute for the given route key and Handler.
+func NewHandlerRoute(routeKey string, handler Handler) HandlerRoute {
+	return HandlerRoute{
+		Handler:  handler,
+		RouteKey: routeKey,
+	}
+}
+
 func NewRouter() Router {
 	return &router{
 		routes: make(map[string]Handler),
@@ -5,6 +5,8 @@ import (
 	bankv047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/genutil/types"
+	v047gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 // Migrate migrates exported state from v0.46 to a v0.47 genesis state.
@@ -17,5 +19,24 @@ func Migrate(appState types.AppMap, clientCtx client.Context) types.AppMap {
 		newBankState := bankv047.MigrateGenState(oldBankState)
 		appState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(newBankState)
 	}
+
+	// Migrate x/gov.
+	if appState[v047gov.ModuleName] != nil {
+		// unmarshal relative source genesis application state
+		var old govv1.GenesisState
+		clientCtx.Codec.MustUnmarshalJSON(appState[v047gov.ModuleName], &old)
+
+		// delete deprecated x/gov genesis state
+		delete(appState, v047gov.ModuleName)
+
+		// Migrate relative source genesis application state and marshal it into
+		// the respective key.
+		new, err := v047gov.MigrateJSON(&old)
+		if err != nil {
+			panic(err)
+		}
+		appState[v047gov.ModuleName] = clientCtx.Codec.MustMarshalJSON(new)
+	}
+
 	return appState
 }
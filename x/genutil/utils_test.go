@@ -25,9 +25,9 @@ func TestExportGenesisFileWithTime(t *testing.T) {
 func TestInitializeNodeValidatorFilesFromMnemonic(t *testing.T) {
 	t.Parallel()
 
-	cfg := config.TestConfig()
-	cfg.RootDir = t.TempDir()
+	cfg := config.TestConfig().SetRoot(t.TempDir())
 	require.NoError(t, os.MkdirAll(filepath.Join(cfg.RootDir, "config"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(cfg.RootDir, "data"), 0o755))
 
 	tests := []struct {
 		name     string
@@ -37,7 +37,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", sdk.NewCoins(proposal.GetMinDepositFromParams(keeper.GetParams(ctx))...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -48,15 +48,29 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	keeper.IterateActiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		var tagValue, logMsg string
 
-		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
+		// Tally deletes the votes it counts, they are kept for an expedited
+		// proposal which fails as it is converted to a regular proposal.
+		tallyCtx, writeTally := ctx.CacheContext()
+		passes, burnDeposits, tallyResults := keeper.Tally(tallyCtx, proposal)
+		converted := !passes && proposal.Expedited
 
-		if burnDeposits {
-			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
-		} else {
-			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+		// A converted proposal keeps its deposits as well, so they are only
+		// settled once it is tallied again at the end of the regular voting
+		// period.
+		if !converted {
+			writeTally()
+
+			if burnDeposits {
+				keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+			} else {
+				keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+			}
 		}
 
-		if passes {
+		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+		switch {
+		case passes:
 			var (
 				idx int
 				msg sdk.Msg
@@ -98,19 +112,34 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				tagValue = types.AttributeValueProposalFailed
 				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
 			}
-		} else {
+		case converted:
+			// The proposal stays in its voting period, which is extended to the
+			// regular voting period, counted from the original voting start time.
+			// Once it ends, the proposal is tallied again with the regular rules,
+			// counting the votes cast so far as well as the new ones.
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(proposal.GetVotingPeriodFromParams(keeper.GetParams(ctx)))
+			proposal.VotingEndTime = &endTime
+			keeper.InsertActiveProposalQueue(ctx, proposal.Id, endTime)
+			tagValue = types.AttributeValueExpeditedProposalRejected
+			logMsg = "expedited proposal converted to regular"
+		default:
 			proposal.Status = v1.StatusRejected
 			tagValue = types.AttributeValueProposalRejected
 			logMsg = "rejected"
 		}
 
-		proposal.FinalTallyResult = &tallyResults
+		// the voting period of a converted proposal goes on
+		if !converted {
+			proposal.FinalTallyResult = &tallyResults
+		}
 
 		keeper.SetProposal(ctx, proposal)
-		keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 
 		// when proposal become active
-		keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		if !converted {
+			keeper.AfterProposalVotingPeriodEnded(ctx, proposal.Id)
+		}
 
 		logger.Info(
 			"proposal tallied",
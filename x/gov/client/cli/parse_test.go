@@ -154,7 +154,8 @@ func TestParseSubmitProposal(t *testing.T) {
 		}
   	],
 	"metadata": "%s",
-	"deposit": "1000test"
+	"deposit": "1000test",
+	"expedited": true
 }
 `, addr, addr, addr, addr, addr, base64.StdEncoding.EncodeToString(expectedMetadata)))
 
@@ -169,10 +170,11 @@ func TestParseSubmitProposal(t *testing.T) {
 	require.Error(t, err)
 
 	// ok json
-	msgs, metadata, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
+	proposal, msgs, deposit, err := parseSubmitProposal(cdc, okJSON.Name())
 	require.NoError(t, err, "unexpected error")
 	require.Equal(t, sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))), deposit)
-	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), metadata)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expectedMetadata), proposal.Metadata)
+	require.True(t, proposal.Expedited)
 	require.Len(t, msgs, 3)
 	msg1, ok := msgs[0].(*banktypes.MsgSend)
 	require.True(t, ok)
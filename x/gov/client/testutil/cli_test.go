@@ -17,11 +17,14 @@ func TestIntegrationTestSuite(t *testing.T) {
 	cfg.NumValidators = 1
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
-	dp := v1.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens)), time.Duration(15)*time.Second)
-	vp := v1.NewVotingParams(time.Duration(5) * time.Second)
 	genesisState := v1.DefaultGenesisState()
-	genesisState.DepositParams = &dp
-	genesisState.VotingParams = &vp
+	genesisState.Params.MinDeposit = sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1.DefaultMinDepositTokens))
+	maxDepositPeriod := time.Duration(15) * time.Second
+	votingPeriod := time.Duration(5) * time.Second
+	expeditedVotingPeriod := time.Duration(2) * time.Second
+	genesisState.Params.MaxDepositPeriod = &maxDepositPeriod
+	genesisState.Params.VotingPeriod = &votingPeriod
+	genesisState.Params.ExpeditedVotingPeriod = &expeditedVotingPeriod
 	bz, err := cfg.Codec.MarshalJSON(genesisState)
 	require.NoError(t, err)
 	cfg.GenesisState["gov"] = bz
@@ -357,9 +357,10 @@ func (s *IntegrationTestSuite) TestGetTallyGRPC() {
 func (s *IntegrationTestSuite) TestGetParamsGRPC() {
 	val := s.network.Validators[0]
 
-	dp := v1.DefaultDepositParams()
-	vp := v1.DefaultVotingParams()
-	tp := v1.DefaultTallyParams()
+	params := v1.DefaultParams()
+	dp := params.DepositParams()
+	vp := params.VotingParams()
+	tp := params.TallyParams()
 
 	testCases := []struct {
 		name       string
@@ -378,21 +379,21 @@ func (s *IntegrationTestSuite) TestGetParamsGRPC() {
 			fmt.Sprintf("%s/cosmos/gov/v1/params/%s", val.APIAddress, v1.ParamDeposit),
 			false,
 			&v1.QueryParamsResponse{},
-			&v1.QueryParamsResponse{DepositParams: &dp},
+			&v1.QueryParamsResponse{DepositParams: &dp, Params: &params},
 		},
 		{
 			"get vote params",
 			fmt.Sprintf("%s/cosmos/gov/v1/params/%s", val.APIAddress, v1.ParamVoting),
 			false,
 			&v1.QueryParamsResponse{},
-			&v1.QueryParamsResponse{VotingParams: &vp},
+			&v1.QueryParamsResponse{VotingParams: &vp, Params: &params},
 		},
 		{
 			"get tally params",
 			fmt.Sprintf("%s/cosmos/gov/v1/params/%s", val.APIAddress, v1.ParamTallying),
 			false,
 			&v1.QueryParamsResponse{},
-			&v1.QueryParamsResponse{TallyParams: &tp},
+			&v1.QueryParamsResponse{TallyParams: &tp, Params: &params},
 		},
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -68,6 +69,70 @@ func (keeper Keeper) DeleteAndBurnDeposits(ctx sdk.Context, proposalID uint64) {
 	})
 }
 
+// ChargeDeposit deletes all the deposits on a specific proposal, charging a
+// proportion of each deposit given by rate. The charged amount is sent to the
+// destAddress account, or burned if destAddress is empty, and the remaining
+// amount is refunded to the depositors.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress, rate string) error {
+	rateDec, err := sdk.NewDecFromStr(rate)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	var cancellationCharges sdk.Coins
+
+	for _, deposit := range keeper.GetDeposits(ctx, proposalID) {
+		depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+
+		var remainingAmount sdk.Coins
+		for _, coin := range deposit.Amount {
+			burnAmount := sdk.NewDecFromInt(coin.Amount).Mul(rateDec).TruncateInt()
+			// remaining amount = deposit amount - burn amount
+			remainingAmount = remainingAmount.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(burnAmount)))
+			cancellationCharges = cancellationCharges.Add(sdk.NewCoin(coin.Denom, burnAmount))
+		}
+
+		if !remainingAmount.IsZero() {
+			err := keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, remainingAmount)
+			if err != nil {
+				return err
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+	}
+
+	if cancellationCharges.IsZero() {
+		return nil
+	}
+
+	// burn the cancellation charges, or send them to the destination address
+	if destAddress == "" {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges)
+	}
+
+	destAccAddress, err := sdk.AccAddressFromBech32(destAddress)
+	if err != nil {
+		return err
+	}
+
+	// the distribution module account is a blocked address, the charges sent to
+	// it fund the community pool instead
+	if destAccAddress.Equals(keeper.authKeeper.GetModuleAddress(disttypes.ModuleName)) {
+		if keeper.distrKeeper == nil {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot fund the community pool without the distribution keeper")
+		}
+
+		return keeper.distrKeeper.FundCommunityPool(ctx, cancellationCharges, keeper.authKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, destAccAddress, cancellationCharges)
+}
+
 // IterateAllDeposits iterates over all the stored deposits and performs a callback function
 func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, cb func(deposit v1.Deposit) (stop bool)) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -131,7 +196,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(proposal.GetMinDepositFromParams(keeper.GetParams(ctx))) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -8,6 +8,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 func TestDeposits(t *testing.T) {
@@ -17,7 +21,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", govAcct, false)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -101,7 +105,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", govAcct, false)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -111,3 +115,126 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestChargeDeposit(t *testing.T) {
+	testCases := []struct {
+		name               string
+		proposalCancelRate string
+		burned             bool
+		expRefund          int64
+		expCharge          int64
+	}{
+		{
+			name:               "half of the deposits burned",
+			proposalCancelRate: "0.5",
+			burned:             true,
+			expRefund:          2000000,
+			expCharge:          2000000,
+		},
+		{
+			name:               "half of the deposits sent to the destination address",
+			proposalCancelRate: "0.5",
+			expRefund:          2000000,
+			expCharge:          2000000,
+		},
+		{
+			name:               "all the deposits refunded",
+			proposalCancelRate: "0",
+			expRefund:          4000000,
+		},
+		{
+			name:               "all the deposits burned",
+			proposalCancelRate: "1",
+			burned:             true,
+			expCharge:          4000000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000000))
+
+			destAddress := ""
+			if !tc.burned {
+				destAddress = TestAddrs[2].String()
+			}
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", govAcct, false)
+			require.NoError(t, err)
+
+			fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], fourStake)
+			require.NoError(t, err)
+
+			addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			destInitial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[2])
+			supplyInitial := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+			err = app.GovKeeper.ChargeDeposit(ctx, proposal.Id, destAddress, tc.proposalCancelRate)
+			require.NoError(t, err)
+			require.Len(t, app.GovKeeper.GetDeposits(ctx, proposal.Id), 0)
+
+			refund := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.expRefund))
+			require.Equal(t, addr0Initial.Add(refund...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+
+			charge := sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.expCharge)
+			if tc.burned {
+				require.Equal(t, supplyInitial.Sub(charge), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+				require.Equal(t, destInitial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[2]))
+			} else {
+				require.Equal(t, supplyInitial, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+				require.Equal(t, destInitial.Add(charge), app.BankKeeper.GetAllBalances(ctx, TestAddrs[2]))
+			}
+		})
+	}
+}
+
+func TestChargeDepositCommunityPool(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", govAcct, false)
+	require.NoError(t, err)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+
+	communityPoolInitial := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// the distribution module account is a blocked address, so the charges
+	// fund the community pool
+	distrAddress := app.AccountKeeper.GetModuleAddress(disttypes.ModuleName)
+	err = app.GovKeeper.ChargeDeposit(ctx, proposal.Id, distrAddress.String(), "0.5")
+	require.NoError(t, err)
+
+	charge := sdk.NewDecCoinsFromCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000000))
+	require.Equal(t, communityPoolInitial.Add(charge...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+func TestChargeDepositCommunityPoolWithoutDistribution(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
+
+	// a gov keeper wired without the distribution keeper
+	govKeeper := keeper.NewKeeper(
+		app.AppCodec(), app.GetKey(types.StoreKey), app.AccountKeeper, app.BankKeeper, app.StakingKeeper, nil,
+		v1beta1.NewRouter(), app.MsgServiceRouter(), types.DefaultConfig(), govAcct.String(),
+	)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", govAcct, false)
+	require.NoError(t, err)
+
+	fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+	_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], fourStake)
+	require.NoError(t, err)
+
+	// the charges can't fund the community pool
+	distrAddress := app.AccountKeeper.GetModuleAddress(disttypes.ModuleName)
+	err = govKeeper.ChargeDeposit(ctx, proposal.Id, distrAddress.String(), "0.5")
+	require.ErrorContains(t, err, "distribution keeper")
+}
@@ -18,15 +18,15 @@ import (
 
 // Keeper defines the governance module Keeper
 type Keeper struct {
-	// The reference to the Paramstore to get and set gov specific params
-	paramSpace types.ParamSubspace
-
 	authKeeper types.AccountKeeper
 	bankKeeper types.BankKeeper
 
 	// The reference to the DelegationSet and ValidatorSet to get information about validators and delegators
 	sk types.StakingKeeper
 
+	// The distribution keeper funding the community pool with the cancellation charges
+	distrKeeper types.DistributionKeeper
+
 	// GovHooks
 	hooks types.GovHooks
 
@@ -43,6 +43,10 @@ type Keeper struct {
 	router *baseapp.MsgServiceRouter
 
 	config types.Config
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -51,12 +55,13 @@ type Keeper struct {
 // - users voting on proposals, with weight proportional to stake in the system
 // - and tallying the result of the vote.
 //
-// CONTRACT: the parameter Subspace must have the param key table already initialized
+// The distribution keeper is only used to fund the community pool with the
+// cancellation charges of proposals and may be nil.
 func NewKeeper(
-	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace types.ParamSubspace,
-	authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, sk types.StakingKeeper,
+	cdc codec.BinaryCodec, key storetypes.StoreKey, authKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper, sk types.StakingKeeper, distrKeeper types.DistributionKeeper,
 	legacyRouter v1beta1.Router, router *baseapp.MsgServiceRouter,
-	config types.Config,
+	config types.Config, authority string,
 ) Keeper {
 	// ensure governance module account is set
 	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -75,17 +80,23 @@ func NewKeeper(
 
 	return Keeper{
 		storeKey:     key,
-		paramSpace:   paramSpace,
 		authKeeper:   authKeeper,
 		bankKeeper:   bankKeeper,
 		sk:           sk,
+		distrKeeper:  distrKeeper,
 		cdc:          cdc,
 		legacyRouter: legacyRouter,
 		router:       router,
 		config:       config,
+		authority:    authority,
 	}
 }
 
+// GetAuthority returns the x/gov module's authority.
+func (keeper Keeper) GetAuthority() string {
+	return keeper.authority
+}
+
 // SetHooks sets the hooks for governance
 func (keeper *Keeper) SetHooks(gh types.GovHooks) *Keeper {
 	if keeper.hooks != nil {
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,7 +18,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
 	initialDeposit := coins
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -36,6 +37,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					strings.Repeat("1", 300),
+					false,
 				)
 			},
 			expErr:    true,
@@ -48,6 +50,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -60,6 +63,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -72,6 +76,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr:    true,
@@ -84,6 +89,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					initialDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -95,6 +101,7 @@ func (suite *KeeperTestSuite) TestSubmitProposalReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 			},
 			expErr: false,
@@ -123,7 +130,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -135,6 +142,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -158,6 +166,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -199,6 +208,7 @@ func (suite *KeeperTestSuite) TestVoteReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -235,7 +245,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -247,6 +257,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -271,6 +282,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -312,6 +324,7 @@ func (suite *KeeperTestSuite) TestVoteWeightedReq() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -348,7 +361,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -360,6 +373,7 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -410,6 +424,86 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		coins,
+		proposer.String(),
+		"",
+		false,
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ProposalId)
+	proposalID := res.ProposalId
+
+	// the cases run in order, as the proposal is canceled by "all good"
+	cases := []struct {
+		name       string
+		proposalID uint64
+		proposer   string
+		expErr     bool
+	}{
+		{
+			name:       "wrong proposal id",
+			proposalID: 0,
+			proposer:   proposer.String(),
+			expErr:     true,
+		},
+		{
+			name:       "invalid proposer address",
+			proposalID: proposalID,
+			proposer:   "invalid",
+			expErr:     true,
+		},
+		{
+			name:       "not the proposer",
+			proposalID: proposalID,
+			proposer:   addrs[1].String(),
+			expErr:     true,
+		},
+		{
+			name:       "all good",
+			proposalID: proposalID,
+			proposer:   proposer.String(),
+			expErr:     false,
+		},
+		{
+			name:       "already canceled",
+			proposalID: proposalID,
+			proposer:   proposer.String(),
+			expErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			cancelReq := v1.NewMsgCancelProposal(tc.proposalID, tc.proposer)
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, cancelReq)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.proposalID, res.ProposalId)
+				suite.Require().Equal(uint64(suite.ctx.BlockHeight()), res.CanceledHeight)
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -417,7 +511,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
 	initialDeposit := coins
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 
 	cases := map[string]struct {
 		preRun func() (*v1beta1.MsgSubmitProposal, error)
@@ -466,7 +560,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -478,6 +572,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -501,6 +596,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -532,6 +628,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgVote() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -568,7 +665,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -580,6 +677,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 		minDeposit,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -604,6 +702,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					coins,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -635,6 +734,7 @@ func (suite *KeeperTestSuite) TestLegacyVoteWeighted() {
 					minDeposit,
 					proposer.String(),
 					"",
+					false,
 				)
 				suite.Require().NoError(err)
 
@@ -671,7 +771,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 	proposer := addrs[0]
 
 	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
-	minDeposit := suite.app.GovKeeper.GetDepositParams(suite.ctx).MinDeposit
+	minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
 	bankMsg := &banktypes.MsgSend{
 		FromAddress: govAcct.String(),
 		ToAddress:   proposer.String(),
@@ -683,6 +783,7 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		coins,
 		proposer.String(),
 		"",
+		false,
 	)
 	suite.Require().NoError(err)
 
@@ -732,3 +833,80 @@ func (suite *KeeperTestSuite) TestLegacyMsgDeposit() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	authority := suite.app.GovKeeper.GetAuthority()
+	params := v1.DefaultParams()
+	testCases := []struct {
+		name      string
+		input     func() *v1.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *v1.MsgUpdateParams {
+				return &v1.MsgUpdateParams{
+					Authority: "authority",
+					Params:    params,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "invalid quorum",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				params1.Quorum = "-0.1"
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "quorum",
+		},
+		{
+			name: "negative voting period",
+			input: func() *v1.MsgUpdateParams {
+				params1 := params
+				d := time.Duration(-1)
+				params1.VotingPeriod = &d
+
+				return &v1.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "voting period",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg := tc.input()
+			_, err := suite.msgSrvr.UpdateParams(suite.ctx, msg)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(msg.Params, suite.app.GovKeeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
@@ -10,8 +10,10 @@ import (
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
-// SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+// SubmitProposal creates a new proposal given an array of messages. An expedited
+// proposal has a shorter voting period, but requires a higher deposit and
+// threshold to pass.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -66,9 +68,9 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	}
 
 	submitTime := ctx.BlockHeader().Time
-	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
+	depositPeriod := keeper.GetParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), proposer, expedited)
 	if err != nil {
 		return v1.Proposal{}, err
 	}
@@ -91,6 +93,60 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal on behalf of its proposer, as long as its
+// voting period hasn't ended. A ProposalCancelRatio share of its deposits is
+// burned, or sent to ProposalCancelDest if set, and the rest is refunded to the
+// depositors. The proposal and its votes are then deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer sdk.AccAddress) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	// only the proposer of a proposal can cancel it
+	if proposal.Proposer != proposer.String() {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "invalid proposer %s", proposer)
+	}
+
+	// only proposals in their deposit or voting period can be canceled
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	// a proposal can't be canceled once its voting period has ended, even if
+	// it hasn't been tallied yet
+	if proposal.VotingEndTime != nil && !proposal.VotingEndTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "voting period of proposal %d ended at %s", proposalID, proposal.VotingEndTime)
+	}
+
+	params := keeper.GetParams(ctx)
+	if err := keeper.ChargeDeposit(ctx, proposalID, params.ProposalCancelDest, params.ProposalCancelRatio); err != nil {
+		return err
+	}
+
+	if proposal.VotingStartTime != nil {
+		keeper.deleteVotes(ctx, proposalID)
+	}
+
+	keeper.DeleteProposal(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal is canceled by proposer",
+		"proposal", proposalID,
+		"proposer", proposer.String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer.String()),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal gets a proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1.Proposal, bool) {
@@ -237,8 +293,8 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	votingPeriod := proposal.GetVotingPeriodFromParams(keeper.GetParams(ctx))
+	endTime := proposal.VotingStartTime.Add(votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -18,7 +18,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", govAcct, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +30,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", govAcct, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -81,7 +81,7 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, randomAddr, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
@@ -94,7 +94,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now())
+			p, err := v1.NewProposal(TestProposal, proposalID, "", time.Now(), time.Now(), govAcct, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -152,3 +152,97 @@ func TestMigrateProposalMessages(t *testing.T) {
 	require.Equal(t, "Test", content.GetTitle())
 	require.Equal(t, "description", content.GetDescription())
 }
+
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposer := suite.addrs[0]
+
+	submitProposal := func() uint64 {
+		proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, "", proposer, false)
+		suite.Require().NoError(err)
+		return proposal.Id
+	}
+
+	activateProposal := func() uint64 {
+		proposalID := submitProposal()
+		minDeposit := suite.app.GovKeeper.GetParams(suite.ctx).MinDeposit
+		votingStarted, err := suite.app.GovKeeper.AddDeposit(suite.ctx, proposalID, proposer, minDeposit)
+		suite.Require().NoError(err)
+		suite.Require().True(votingStarted)
+
+		err = suite.app.GovKeeper.AddVote(suite.ctx, proposalID, suite.addrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+		suite.Require().NoError(err)
+		return proposalID
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() (proposalID uint64, proposer sdk.AccAddress, ctx sdk.Context)
+		expectedErr error
+	}{
+		{
+			name: "unknown proposal",
+			malleate: func() (uint64, sdk.AccAddress, sdk.Context) {
+				return 10000, proposer, suite.ctx
+			},
+			expectedErr: types.ErrUnknownProposal,
+		},
+		{
+			name: "not the proposer",
+			malleate: func() (uint64, sdk.AccAddress, sdk.Context) {
+				return submitProposal(), suite.addrs[1], suite.ctx
+			},
+			expectedErr: types.ErrInvalidProposer,
+		},
+		{
+			name: "proposal in deposit period",
+			malleate: func() (uint64, sdk.AccAddress, sdk.Context) {
+				return submitProposal(), proposer, suite.ctx
+			},
+		},
+		{
+			name: "proposal in voting period",
+			malleate: func() (uint64, sdk.AccAddress, sdk.Context) {
+				return activateProposal(), proposer, suite.ctx
+			},
+		},
+		{
+			name: "voting period ended",
+			malleate: func() (uint64, sdk.AccAddress, sdk.Context) {
+				proposalID := activateProposal()
+				proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(ok)
+				return proposalID, proposer, suite.ctx.WithBlockTime(*proposal.VotingEndTime)
+			},
+			expectedErr: types.ErrVotingPeriodEnded,
+		},
+		{
+			name: "proposal already tallied",
+			malleate: func() (uint64, sdk.AccAddress, sdk.Context) {
+				proposalID := submitProposal()
+				proposal, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+				suite.Require().True(ok)
+				proposal.Status = v1.StatusPassed
+				suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
+				return proposalID, proposer, suite.ctx
+			},
+			expectedErr: types.ErrInactiveProposal,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposalID, proposer, ctx := tc.malleate()
+			err := suite.app.GovKeeper.CancelProposal(ctx, proposalID, proposer)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			_, ok := suite.app.GovKeeper.GetProposal(ctx, proposalID)
+			suite.Require().False(ok)
+			suite.Require().Empty(suite.app.GovKeeper.GetDeposits(ctx, proposalID))
+			suite.Require().Empty(suite.app.GovKeeper.GetVotes(ctx, proposalID))
+		})
+	}
+}
@@ -48,23 +48,25 @@ func NewQuerier(keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier
 }
 
 func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+
 	switch path[0] {
 	case v1.ParamDeposit:
-		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetDepositParams(ctx))
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, params.DepositParams())
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
 		return bz, nil
 
 	case v1.ParamVoting:
-		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetVotingParams(ctx))
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, params.VotingParams())
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
 		return bz, nil
 
 	case v1.ParamTallying:
-		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, keeper.GetTallyParams(ctx))
+		bz, err := codec.MarshalJSONIndent(legacyQuerierCdc, params.TallyParams())
 		if err != nil {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 		}
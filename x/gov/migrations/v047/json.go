@@ -0,0 +1,30 @@
+package v047
+
+import (
+	"fmt"
+
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateJSON accepts exported v0.46 x/gov genesis state and migrates it to
+// v0.47 x/gov genesis state. The migration includes:
+//
+// - Merging the deprecated deposit, voting and tally parameters into a single
+// Params object.
+func MigrateJSON(oldState *v1.GenesisState) (*v1.GenesisState, error) {
+	//nolint:staticcheck
+	if oldState.DepositParams == nil || oldState.VotingParams == nil || oldState.TallyParams == nil {
+		return nil, fmt.Errorf("x/gov genesis state is missing deposit, voting or tally params")
+	}
+
+	//nolint:staticcheck
+	params := toParams(*oldState.DepositParams, *oldState.VotingParams, *oldState.TallyParams)
+
+	return &v1.GenesisState{
+		StartingProposalId: oldState.StartingProposalId,
+		Deposits:           oldState.Deposits,
+		Votes:              oldState.Votes,
+		Proposals:          oldState.Proposals,
+		Params:             &params,
+	}, nil
+}
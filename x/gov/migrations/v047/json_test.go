@@ -0,0 +1,37 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v047 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestMigrateJSON(t *testing.T) {
+	depositParams := v1.DefaultDepositParams()
+	votingParams := v1.DefaultVotingParams()
+	tallyParams := v1.DefaultTallyParams()
+
+	oldState := &v1.GenesisState{
+		StartingProposalId: 3,
+		DepositParams:      &depositParams,
+		VotingParams:       &votingParams,
+		TallyParams:        &tallyParams,
+	}
+
+	migrated, err := v047.MigrateJSON(oldState)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), migrated.StartingProposalId)
+	require.Nil(t, migrated.DepositParams)
+	require.Nil(t, migrated.VotingParams)
+	require.Nil(t, migrated.TallyParams)
+	require.Equal(t, v1.DefaultParams(), *migrated.Params)
+	require.NoError(t, v1.ValidateGenesis(migrated))
+
+	// missing legacy params cannot be migrated
+	oldState.TallyParams = nil
+	_, err = v047.MigrateJSON(oldState)
+	require.Error(t, err)
+}
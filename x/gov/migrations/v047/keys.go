@@ -0,0 +1,6 @@
+package v047
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "gov"
+)
@@ -0,0 +1,76 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/exported"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+// - Migrate the x/gov deposit, voting and tally parameters from the x/params
+// module into a single Params object in the x/gov module store.
+// - Initialize the expedited proposal parameters from the migrated ones.
+// - Initialize the proposal cancellation parameters with their default values.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams v1.DepositParams
+		votingParams  v1.VotingParams
+		tallyParams   v1.TallyParams
+	)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	legacySubspace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	params := toParams(depositParams, votingParams, tallyParams)
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+func toParams(depositParams v1.DepositParams, votingParams v1.VotingParams, tallyParams v1.TallyParams) v1.Params {
+	params := v1.Params{
+		MinDeposit:       depositParams.MinDeposit,
+		MaxDepositPeriod: depositParams.MaxDepositPeriod,
+		VotingPeriod:     votingParams.VotingPeriod,
+		Quorum:           tallyParams.Quorum,
+		Threshold:        tallyParams.Threshold,
+		VetoThreshold:    tallyParams.VetoThreshold,
+	}
+
+	// expedited proposals require a larger deposit, a shorter voting period
+	// and a higher threshold than regular ones
+	expeditedMinDeposit := sdk.NewCoins()
+	for _, coin := range depositParams.MinDeposit {
+		expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5)))
+	}
+	params.ExpeditedMinDeposit = expeditedMinDeposit
+
+	expeditedVotingPeriod := v1.DefaultExpeditedPeriod
+	if votingParams.VotingPeriod != nil && expeditedVotingPeriod >= *votingParams.VotingPeriod {
+		expeditedVotingPeriod = *votingParams.VotingPeriod / 2
+	}
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+
+	params.ExpeditedThreshold = v1.DefaultExpeditedThreshold.String()
+	if threshold, err := sdk.NewDecFromStr(tallyParams.Threshold); err == nil && v1.DefaultExpeditedThreshold.LTE(threshold) {
+		params.ExpeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2).String()
+	}
+
+	params.ProposalCancelRatio = v1.DefaultProposalCancelRatio.String()
+
+	return params
+}
@@ -0,0 +1,59 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+type mockSubspace struct {
+	depositParams v1.DepositParams
+	votingParams  v1.VotingParams
+	tallyParams   v1.TallyParams
+}
+
+func newMockSubspace() mockSubspace {
+	return mockSubspace{
+		depositParams: v1.DefaultDepositParams(),
+		votingParams:  v1.DefaultVotingParams(),
+		tallyParams:   v1.DefaultTallyParams(),
+	}
+}
+
+func (ms mockSubspace) Get(ctx sdk.Context, key []byte, ptr interface{}) {
+	switch string(key) {
+	case string(v1.ParamStoreKeyDepositParams):
+		*ptr.(*v1.DepositParams) = ms.depositParams
+	case string(v1.ParamStoreKeyVotingParams):
+		*ptr.(*v1.VotingParams) = ms.votingParams
+	case string(v1.ParamStoreKeyTallyParams):
+		*ptr.(*v1.TallyParams) = ms.tallyParams
+	}
+}
+
+func TestMigrateStore(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	legacySubspace := newMockSubspace()
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+
+	var res v1.Params
+	bz := store.Get(types.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, v1.DefaultParams(), res)
+
+	// invalid legacy params are rejected
+	legacySubspace.votingParams = v1.VotingParams{}
+	require.Error(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+}
@@ -8,23 +8,33 @@ import (
 	"fmt"
 	"math/rand"
 
-	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	modulev1 "cosmossdk.io/api/cosmos/gov/module/v1"
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/depinject"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
 	"github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/gov/exported"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
@@ -74,7 +84,7 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the gov module.
-func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
 	if err := v1.RegisterQueryHandlerClient(context.Background(), mux, v1.NewQueryClient(clientCtx)); err != nil {
 		panic(err)
 	}
@@ -113,18 +123,25 @@ func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry
 type AppModule struct {
 	AppModuleBasic
 
-	keeper        keeper.Keeper
+	keeper        *keeper.Keeper
 	accountKeeper types.AccountKeeper
 	bankKeeper    types.BankKeeper
+
+	// legacySubspace is used solely for migration of x/params managed parameters
+	legacySubspace exported.Subspace
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak types.AccountKeeper, bk types.BankKeeper) AppModule {
+func NewAppModule(
+	cdc codec.Codec, keeper *keeper.Keeper,
+	ak types.AccountKeeper, bk types.BankKeeper, ss exported.Subspace,
+) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{cdc: cdc},
 		keeper:         keeper,
 		accountKeeper:  ak,
 		bankKeeper:     bk,
+		legacySubspace: ss,
 	}
 }
 
@@ -135,7 +152,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants registers module invariants
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	keeper.RegisterInvariants(ir, am.keeper, am.bankKeeper)
+	keeper.RegisterInvariants(ir, *am.keeper, am.bankKeeper)
 }
 
 // Deprecated: Route returns the message routing key for the gov module.
@@ -150,20 +167,20 @@ func (AppModule) QuerierRoute() string {
 
 // LegacyQuerierHandler returns no sdk.Querier.
 func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
-	return keeper.NewQuerier(am.keeper, legacyQuerierCdc)
+	return keeper.NewQuerier(*am.keeper, legacyQuerierCdc)
 }
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
-	msgServer := keeper.NewMsgServerImpl(am.keeper)
+	msgServer := keeper.NewMsgServerImpl(*am.keeper)
 	v1beta1.RegisterMsgServer(cfg.MsgServer(), keeper.NewLegacyMsgServerImpl(am.accountKeeper.GetModuleAddress(types.ModuleName).String(), msgServer))
 	v1.RegisterMsgServer(cfg.MsgServer(), msgServer)
 
-	legacyQueryServer := keeper.NewLegacyQueryServer(am.keeper)
+	legacyQueryServer := keeper.NewLegacyQueryServer(*am.keeper)
 	v1beta1.RegisterQueryServer(cfg.QueryServer(), legacyQueryServer)
 	v1.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-	m := keeper.NewMigrator(am.keeper)
+	m := keeper.NewMigrator(*am.keeper, am.legacySubspace)
 	err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
 	if err != nil {
 		panic(err)
@@ -172,6 +189,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(err)
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // InitGenesis performs genesis initialization for the gov module. It returns
@@ -179,19 +200,19 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState v1.GenesisState
 	cdc.MustUnmarshalJSON(data, &genesisState)
-	InitGenesis(ctx, am.accountKeeper, am.bankKeeper, am.keeper, &genesisState)
+	InitGenesis(ctx, am.accountKeeper, am.bankKeeper, *am.keeper, &genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the gov
 // module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := ExportGenesis(ctx, am.keeper)
+	gs := ExportGenesis(ctx, *am.keeper)
 	return cdc.MustMarshalJSON(gs)
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock performs a no-op.
 func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
@@ -199,10 +220,101 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // EndBlock returns the end blocker for the gov module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	EndBlocker(ctx, am.keeper)
+	EndBlocker(ctx, *am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
+func init() {
+	appmodule.Register(
+		&modulev1.Module{},
+		appmodule.Provide(provideModuleBasic, provideModule),
+		appmodule.Invoke(invokeSetHooks),
+	)
+}
+
+func provideModuleBasic() runtime.AppModuleBasicWrapper {
+	return runtime.WrapAppModuleBasic(AppModuleBasic{})
+}
+
+type govInputs struct {
+	depinject.In
+
+	Config           *modulev1.Module
+	Cdc              codec.Codec
+	Key              *store.KVStoreKey
+	MsgServiceRouter *baseapp.MsgServiceRouter
+
+	AccountKeeper types.AccountKeeper
+	BankKeeper    types.BankKeeper
+	StakingKeeper types.StakingKeeper
+
+	// DistributionKeeper is used to fund the community pool with the
+	// cancellation charges of proposals, it is unavailable without it
+	DistributionKeeper types.DistributionKeeper `optional:"true"`
+
+	// LegacyRoutes are the legacy v1beta1 proposal handlers provided by other modules
+	LegacyRoutes []v1beta1.HandlerRoute
+
+	// LegacySubspace is used solely for migration of x/params managed parameters
+	LegacySubspace paramstypes.Subspace
+}
+
+type govOutputs struct {
+	depinject.Out
+
+	GovKeeper *keeper.Keeper
+	Module    runtime.AppModuleWrapper
+}
+
+func provideModule(in govInputs) govOutputs {
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.ModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	config := types.DefaultConfig()
+	if in.Config.MaxMetadataLen != 0 {
+		config.MaxMetadataLen = in.Config.MaxMetadataLen
+	}
+
+	// set KeyTable if it has not already been set
+	if !in.LegacySubspace.HasKeyTable() {
+		in.LegacySubspace = in.LegacySubspace.WithKeyTable(v1.ParamKeyTable())
+	}
+
+	legacyRouter := v1beta1.NewRouter()
+	legacyRouter.AddRoute(types.RouterKey, v1beta1.ProposalHandler)
+	for _, route := range in.LegacyRoutes {
+		legacyRouter.AddRoute(route.RouteKey, route.Handler)
+	}
+
+	k := keeper.NewKeeper(
+		in.Cdc, in.Key, in.AccountKeeper, in.BankKeeper, in.StakingKeeper, in.DistributionKeeper,
+		legacyRouter, in.MsgServiceRouter, config, authority.String(),
+	)
+	m := NewAppModule(in.Cdc, &k, in.AccountKeeper, in.BankKeeper, in.LegacySubspace)
+	return govOutputs{GovKeeper: &k, Module: runtime.WrapAppModule(m)}
+}
+
+// invokeSetHooks sets the gov hooks provided by the other modules. depinject
+// resolves them in the order their providers were registered, that is the order
+// of the modules in the app config, so the hooks run in a deterministic order.
+func invokeSetHooks(keeper *keeper.Keeper, govHooks []types.GovHooksWrapper) error {
+	// all arguments to invokers are optional
+	if keeper == nil || len(govHooks) == 0 {
+		return nil
+	}
+
+	multiHooks := make(types.MultiGovHooks, len(govHooks))
+	for i, hooks := range govHooks {
+		multiHooks[i] = hooks
+	}
+
+	keeper.SetHooks(multiHooks)
+	return nil
+}
+
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the gov module.
@@ -216,9 +328,15 @@ func (AppModule) ProposalContents(simState module.SimulationState) []simtypes.We
 	return simulation.ProposalContents()
 }
 
-// RandomizedParams creates randomized gov param changes for the simulator.
+// ProposalMsgs returns the gov messages used to simulate governance proposals.
+func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs(*am.keeper)
+}
+
+// RandomizedParams returns nil, the gov params are simulated by proposals
+// executing MsgUpdateParams.
 func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
-	return simulation.ParamChanges(r)
+	return nil
 }
 
 // RegisterStoreDecoder registers a decoder for gov module's types
@@ -230,6 +348,6 @@ func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	return simulation.WeightedOperations(
 		simState.AppParams, simState.Cdc,
-		am.accountKeeper, am.bankKeeper, am.keeper, simState.Contents,
+		am.accountKeeper, am.bankKeeper, *am.keeper, simState.Contents, simState.ProposalMsgs,
 	)
 }
@@ -25,25 +25,29 @@ var (
 	TypeMsgVote           = sdk.MsgTypeURL(&v1.MsgVote{})
 	TypeMsgVoteWeighted   = sdk.MsgTypeURL(&v1.MsgVoteWeighted{})
 	TypeMsgSubmitProposal = sdk.MsgTypeURL(&v1.MsgSubmitProposal{})
+	TypeMsgCancelProposal = sdk.MsgTypeURL(&v1.MsgCancelProposal{})
 )
 
 // Simulation operation weights constants
 //nolint:gosec // these are not hard-coded credentials.
 const (
-	OpWeightMsgDeposit      = "op_weight_msg_deposit"
-	OpWeightMsgVote         = "op_weight_msg_vote"
-	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote"
+	OpWeightMsgDeposit        = "op_weight_msg_deposit"
+	OpWeightMsgVote           = "op_weight_msg_vote"
+	OpWeightMsgVoteWeighted   = "op_weight_msg_weighted_vote"
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
 	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper,
 	bk types.BankKeeper, k keeper.Keeper, wContents []simtypes.WeightedProposalContent,
+	wMsgs []simtypes.WeightedProposalMsg,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgDeposit      int
-		weightMsgVote         int
-		weightMsgVoteWeighted int
+		weightMsgDeposit        int
+		weightMsgVote           int
+		weightMsgVoteWeighted   int
+		weightMsgCancelProposal int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -64,6 +68,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelProposal = simappparams.DefaultWeightMsgCancelProposal
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -82,6 +92,22 @@ func WeightedOperations(
 		)
 	}
 
+	// generate the weighted operations for the proposal msgs
+	for _, wMsg := range wMsgs {
+		wMsg := wMsg // pin variable
+		var weight int
+		appParams.GetOrGenerate(cdc, wMsg.AppParamsKey(), &weight, nil,
+			func(_ *rand.Rand) { weight = wMsg.DefaultWeight() })
+
+		wProposalOps = append(
+			wProposalOps,
+			simulation.NewWeightedOperation(
+				weight,
+				SimulateMsgSubmitProposalWithMsg(ak, bk, k, wMsg.MsgSimulatorFn()),
+			),
+		)
+	}
+
 	wGovOps := simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgDeposit,
@@ -95,6 +121,10 @@ func WeightedOperations(
 			weightMsgVoteWeighted,
 			SimulateMsgVoteWeighted(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -105,6 +135,35 @@ func WeightedOperations(
 // future operations.
 func SimulateMsgSubmitProposal(
 	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, contentSim simtypes.ContentSimulatorFn,
+) simtypes.Operation {
+	return simulateMsgSubmitProposal(ak, bk, k, func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) (sdk.Msg, error) {
+		content := contentSim(r, ctx, accs)
+		if content == nil {
+			return nil, nil
+		}
+
+		macc := k.GetGovernanceAccount(ctx)
+		return v1.NewLegacyContent(content, macc.GetAddress().String())
+	})
+}
+
+// SimulateMsgSubmitProposalWithMsg simulates submitting a proposal executing
+// the message generated by msgSim, such as the MsgUpdateParams of a module, and
+// voting on it like SimulateMsgSubmitProposal.
+func SimulateMsgSubmitProposalWithMsg(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, msgSim simtypes.MsgSimulatorFn,
+) simtypes.Operation {
+	return simulateMsgSubmitProposal(ak, bk, k, func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) (sdk.Msg, error) {
+		return msgSim(r, ctx, accs), nil
+	})
+}
+
+// simulateMsgSubmitProposal simulates submitting a proposal executing the
+// message generated by proposalMsgSim, which returns a nil message when it
+// can't generate one.
+func simulateMsgSubmitProposal(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+	proposalMsgSim func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) (sdk.Msg, error),
 ) simtypes.Operation {
 	// The states are:
 	// column 1: All validators vote
@@ -132,13 +191,17 @@ func SimulateMsgSubmitProposal(
 		accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		// 1) submit proposal now
-		content := contentSim(r, ctx, accs)
-		if content == nil {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "content is nil"), nil, nil
+		proposalMsg, err := proposalMsgSim(r, ctx, accs)
+		switch {
+		case err != nil:
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "unable to generate proposal message"), nil, err
+		case proposalMsg == nil:
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "proposal message is nil"), nil, nil
 		}
 
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address)
+		expedited := r.Intn(2) == 0
+		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address, expedited)
 		switch {
 		case skip:
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "skip deposit"), nil, nil
@@ -146,13 +209,7 @@ func SimulateMsgSubmitProposal(
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "unable to generate deposit"), nil, err
 		}
 
-		macc := k.GetGovernanceAccount(ctx)
-		contentMsg, err := v1.NewLegacyContent(content, macc.GetAddress().String())
-		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSubmitProposal, "error converting legacy content into proposal message"), nil, err
-		}
-
-		msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{contentMsg}, deposit, simAccount.Address.String(), "")
+		msg, err := v1.NewMsgSubmitProposal([]sdk.Msg{proposalMsg}, deposit, simAccount.Address.String(), "", expedited)
 		if err != nil {
 			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate a submit proposal msg"), nil, err
 		}
@@ -207,7 +264,11 @@ func SimulateMsgSubmitProposal(
 
 		// didntVote := whoVotes[numVotes:]
 		whoVotes = whoVotes[:numVotes]
-		votingPeriod := k.GetVotingParams(ctx).VotingPeriod
+		params := k.GetParams(ctx)
+		votingPeriod := params.VotingPeriod
+		if expedited {
+			votingPeriod = params.ExpeditedVotingPeriod
+		}
 
 		fops := make([]simtypes.FutureOperation, numVotes+1)
 		for i := 0; i < numVotes; i++ {
@@ -234,7 +295,12 @@ func SimulateMsgDeposit(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Ke
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDeposit, "unable to generate proposalID"), nil, nil
 		}
 
-		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address)
+		proposal, ok := k.GetProposal(ctx, proposalID)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDeposit, "unable to get proposal"), nil, nil
+		}
+
+		deposit, skip, err := randomDeposit(r, ctx, ak, bk, k, simAccount.Address, proposal.Expedited)
 		switch {
 		case skip:
 			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDeposit, "skip deposit"), nil, nil
@@ -380,12 +446,69 @@ func operationSimulateMsgVoteWeighted(ak types.AccountKeeper, bk types.BankKeepe
 	}
 }
 
+// SimulateMsgCancelProposal generates a MsgCancelProposal from the proposer of
+// a random proposal which is still in its deposit or voting period.
+func SimulateMsgCancelProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		status := v1.StatusDepositPeriod
+		if r.Intn(2) == 0 {
+			status = v1.StatusVotingPeriod
+		}
+
+		proposalID, ok := randomProposalID(r, k, ctx, status)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "unable to generate proposalID"), nil, nil
+		}
+
+		proposal, ok := k.GetProposal(ctx, proposalID)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "unable to get proposal"), nil, nil
+		}
+
+		if proposal.VotingEndTime != nil && !proposal.VotingEndTime.After(ctx.BlockTime()) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "voting period ended"), nil, nil
+		}
+
+		proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "invalid proposer"), nil, err
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, proposer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "proposer account not found"), nil, nil
+		}
+
+		msg := v1.NewMsgCancelProposal(proposalID, simAccount.Address.String())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // Pick a random deposit with a random denomination with a
 // deposit amount between (0, min(balance, minDepositAmount))
 // This is to simulate multiple users depositing to get the
 // proposal above the minimum deposit amount
 func randomDeposit(r *rand.Rand, ctx sdk.Context,
-	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, addr sdk.AccAddress,
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, addr sdk.AccAddress, expedited bool,
 ) (deposit sdk.Coins, skip bool, err error) {
 	account := ak.GetAccount(ctx, addr)
 	spendable := bk.SpendableCoins(ctx, account.GetAddress())
@@ -394,7 +517,11 @@ func randomDeposit(r *rand.Rand, ctx sdk.Context,
 		return nil, true, nil // skip
 	}
 
-	minDeposit := k.GetDepositParams(ctx).MinDeposit
+	params := k.GetParams(ctx)
+	minDeposit := params.MinDeposit
+	if expedited {
+		minDeposit = params.ExpeditedMinDeposit
+	}
 	denomIndex := r.Intn(len(minDeposit))
 	denom := minDeposit[denomIndex].Denom
 
@@ -6,12 +6,19 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 )
 
-// OpWeightSubmitTextProposal app params key for text proposal
-const OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
+// Simulation proposal weights keys
+const (
+	// OpWeightSubmitTextProposal app params key for text proposal
+	OpWeightSubmitTextProposal = "op_weight_submit_text_proposal"
+	// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+)
 
 // ProposalContents defines the module weighted proposals' contents
 func ProposalContents() []simtypes.WeightedProposalContent {
@@ -31,3 +38,29 @@ func SimulateTextProposalContent(r *rand.Rand, _ sdk.Context, _ []simtypes.Accou
 		simtypes.RandStringOfLength(r, 5000),
 	)
 }
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams with random gov params,
+// keeping the current proposal cancellation destination.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := v1.NewParams(
+			GenDepositParamsMinDeposit(r), GenDepositParamsExpeditedMinDeposit(r), GenDepositParamsDepositPeriod(r),
+			GenVotingParamsVotingPeriod(r), GenVotingParamsExpeditedVotingPeriod(r),
+			GenTallyParamsQuorum(r).String(), GenTallyParamsThreshold(r).String(), GenTallyParamsExpeditedThreshold(r).String(),
+			GenTallyParamsVeto(r).String(), GenProposalCancelRatio(r).String(), k.GetParams(ctx).ProposalCancelDest,
+		)
+
+		return &v1.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/gov/simulation"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
 
 func TestProposalContents(t *testing.T) {
@@ -38,3 +39,25 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, "gov", content.ProposalRoute())
 	require.Equal(t, "Text", content.ProposalType())
 }
+
+func TestProposalMsgs(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	// execute ProposalMsgs function
+	weightedProposalMsgs := simulation.ProposalMsgs(app.GovKeeper)
+	require.Len(t, weightedProposalMsgs, 1)
+
+	w0 := weightedProposalMsgs[0]
+
+	// tests w0 interface:
+	require.Equal(t, simulation.OpWeightMsgUpdateParams, w0.AppParamsKey())
+	require.Equal(t, simtestutil.DefaultWeightMsgUpdateParams, w0.DefaultWeight())
+
+	msg, ok := w0.MsgSimulatorFn()(r, ctx, nil).(*v1.MsgUpdateParams)
+	require.True(t, ok)
+	require.Equal(t, app.GovKeeper.GetAuthority(), msg.Authority)
+	require.Equal(t, app.GovKeeper.GetParams(ctx).ProposalCancelDest, msg.Params.ProposalCancelDest)
+	require.NoError(t, msg.ValidateBasic())
+}
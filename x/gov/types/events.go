@@ -8,10 +8,12 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
+	AttributeKeyProposer           = "proposer"
 	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
 	AttributeValueCategory         = "governance"
@@ -22,4 +24,6 @@ const (
 	AttributeKeyProposalType       = "proposal_type"
 	AttributeSignalTitle           = "signal_title"
 	AttributeSignalDescription     = "signal_description"
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't pass as expedited, converted to a regular proposal
 )
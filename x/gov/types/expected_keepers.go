@@ -7,12 +7,6 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// ParamSubspace defines the expected Subspace interface for parameters (noalias)
-type ParamSubspace interface {
-	Get(ctx sdk.Context, key []byte, ptr interface{})
-	Set(ctx sdk.Context, key []byte, param interface{})
-}
-
 // StakingKeeper expected staking keeper (Validator and Delegator sets) (noalias)
 type StakingKeeper interface {
 	// iterate through bonded validators by operator address, execute func for each validator
@@ -50,6 +44,11 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
 
+// DistributionKeeper defines the expected distribution keeper (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // Event Hooks
 // These can be utilized to communicate between a governance keeper and another
 // keepers.
@@ -62,3 +61,10 @@ type GovHooks interface {
 	AfterProposalFailedMinDeposit(ctx sdk.Context, proposalID uint64)                      // Must be called when proposal fails to reach min deposit
 	AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64)                     // Must be called when proposal's finishes it's voting period
 }
+
+// GovHooksWrapper is a wrapper for modules to inject GovHooks using depinject.
+// A module can provide any number of them.
+type GovHooksWrapper struct{ GovHooks }
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (GovHooksWrapper) IsManyPerContainerType() {}
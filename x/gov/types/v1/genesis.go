@@ -8,12 +8,10 @@ import (
 )
 
 // NewGenesisState creates a new genesis state for the governance module
-func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParams, tp TallyParams) *GenesisState {
+func NewGenesisState(startingProposalID uint64, params Params) *GenesisState {
 	return &GenesisState{
 		StartingProposalId: startingProposalID,
-		DepositParams:      &dp,
-		VotingParams:       &vp,
-		TallyParams:        &tp,
+		Params:             &params,
 	}
 }
 
@@ -21,18 +19,13 @@ func NewGenesisState(startingProposalID uint64, dp DepositParams, vp VotingParam
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultStartingProposalID,
-		DefaultDepositParams(),
-		DefaultVotingParams(),
-		DefaultTallyParams(),
+		DefaultParams(),
 	)
 }
 
 // Empty returns true if a GenesisState is empty
 func (data GenesisState) Empty() bool {
-	return data.StartingProposalId == 0 ||
-		data.DepositParams == nil ||
-		data.VotingParams == nil ||
-		data.TallyParams == nil
+	return data.StartingProposalId == 0 || data.Params == nil
 }
 
 // ValidateGenesis checks if parameters are within valid ranges
@@ -41,16 +34,12 @@ func ValidateGenesis(data *GenesisState) error {
 		return errors.New("starting proposal id must be greater than 0")
 	}
 
-	if err := validateTallyParams(*data.TallyParams); err != nil {
-		return fmt.Errorf("invalid tally params: %w", err)
+	if data.Params == nil {
+		return errors.New("params must not be nil")
 	}
 
-	if err := validateVotingParams(*data.VotingParams); err != nil {
-		return fmt.Errorf("invalid voting params: %w", err)
-	}
-
-	if err := validateDepositParams(*data.DepositParams); err != nil {
-		return fmt.Errorf("invalid deposit params: %w", err)
+	if err := data.Params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
 	}
 
 	return nil
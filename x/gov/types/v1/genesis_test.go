@@ -16,56 +16,100 @@ func TestEmptyGenesis(t *testing.T) {
 }
 
 func TestValidateGenesis(t *testing.T) {
-	depositParams := v1.DefaultDepositParams()
-	votingParams := v1.DefaultVotingParams()
-	tallyParams := v1.DefaultTallyParams()
+	params := v1.DefaultParams()
 
 	testCases := []struct {
 		name         string
-		genesisState *v1.GenesisState
+		genesisState func() *v1.GenesisState
 		expErr       bool
 	}{
 		{
 			name:         "valid",
-			genesisState: v1.DefaultGenesisState(),
+			genesisState: v1.DefaultGenesisState,
 		},
 		{
 			name: "invalid StartingProposalId",
-			genesisState: &v1.GenesisState{
-				StartingProposalId: 0,
-				DepositParams:      &depositParams,
-				VotingParams:       &votingParams,
-				TallyParams:        &tallyParams,
+			genesisState: func() *v1.GenesisState {
+				return v1.NewGenesisState(0, params)
 			},
 			expErr: true,
 		},
 		{
-			name: "invalid TallyParams",
-			genesisState: &v1.GenesisState{
-				StartingProposalId: v1.DefaultStartingProposalID,
-				DepositParams:      &depositParams,
-				VotingParams:       &votingParams,
-				TallyParams:        &v1.TallyParams{},
+			name: "nil params",
+			genesisState: func() *v1.GenesisState {
+				return &v1.GenesisState{StartingProposalId: v1.DefaultStartingProposalID}
 			},
 			expErr: true,
 		},
 		{
-			name: "invalid VotingParams",
-			genesisState: &v1.GenesisState{
-				StartingProposalId: v1.DefaultStartingProposalID,
-				DepositParams:      &depositParams,
-				VotingParams:       &v1.VotingParams{},
-				TallyParams:        &tallyParams,
+			name: "invalid tally params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.Quorum = "-0.1"
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
 			},
 			expErr: true,
 		},
 		{
-			name: "invalid DepositParams",
-			genesisState: &v1.GenesisState{
-				StartingProposalId: v1.DefaultStartingProposalID,
-				DepositParams:      &v1.DepositParams{},
-				VotingParams:       &votingParams,
-				TallyParams:        &tallyParams,
+			name: "invalid voting params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.VotingPeriod = nil
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid deposit params",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.MaxDepositPeriod = nil
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid expedited min deposit",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedMinDeposit = params1.MinDeposit
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid expedited voting period",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedVotingPeriod = params1.VotingPeriod
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid expedited threshold",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ExpeditedThreshold = params1.Threshold
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid proposal cancel ratio",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ProposalCancelRatio = "1.1"
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid proposal cancel destination",
+			genesisState: func() *v1.GenesisState {
+				params1 := params
+				params1.ProposalCancelDest = "invalid"
+				return v1.NewGenesisState(v1.DefaultStartingProposalID, params1)
 			},
 			expErr: true,
 		},
@@ -74,7 +118,7 @@ func TestValidateGenesis(t *testing.T) {
 	for _, tc := range testCases {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
-			err := v1.ValidateGenesis(tc.genesisState)
+			err := v1.ValidateGenesis(tc.genesisState())
 			if tc.expErr {
 				require.Error(t, err)
 			} else {
@@ -251,6 +251,13 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited. An expedited proposal
+	// which doesn't pass its voting period is converted to a regular proposal.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter.
+	//
+	// Since: cosmos-sdk 0.47
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +363,20 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -664,6 +685,151 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+// Params defines the parameters for the x/gov module.
+//
+// Since: cosmos-sdk 0.47
+type Params struct {
+	//  Minimum deposit for a proposal to enter voting period.
+	MinDeposit []types.Coin `protobuf:"bytes,1,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
+	//  months.
+	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  Length of the voting period.
+	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
+	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,7,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
+	//  Length of the voting period of an expedited proposal.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,8,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass.
+	//  Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,9,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Proportion of the deposits which is burned, or sent to proposal_cancel_dest,
+	//  when a proposal is canceled. The rest is refunded to the depositors.
+	//  Default value: 0.5.
+	ProposalCancelRatio string `protobuf:"bytes,10,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	//  Address which receives the charged deposits of canceled proposals. If
+	//  empty, they are burned.
+	ProposalCancelDest string `protobuf:"bytes,11,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinDeposit() []types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDepositPeriod() *time.Duration {
+	if m != nil {
+		return m.MaxDepositPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *Params) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *Params) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
+func (m *Params) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *Params) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *Params) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -675,81 +841,94 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0xb6, 0x30, 0xe6, 0x19, 0x1b, 0x75, 0xa1, 0x45, 0x90, 0x60, 0x13, 0x4f, 0xdb, 0xa1,
-	0xa4, 0xd8, 0x25, 0x99, 0xb6, 0x33, 0xcd, 0xc9, 0xc6, 0x4a, 0x31, 0xc3, 0x60, 0x57, 0x52, 0xcc,
-	0xa4, 0x17, 0x8d, 0x8c, 0x14, 0x5b, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x0e, 0xfe, 0x08, 0xbd, 0xe5,
-	0xd8, 0x99, 0x7e, 0x8d, 0x4c, 0x3f, 0x43, 0x4e, 0x9d, 0x4c, 0x0e, 0xfd, 0x73, 0x71, 0x5b, 0xb8,
-	0xf1, 0x29, 0x32, 0x5a, 0xad, 0xb0, 0x2d, 0xc8, 0xc0, 0x49, 0xd2, 0x7b, 0xbf, 0xdf, 0xef, 0xbd,
-	0xdd, 0xf7, 0xd3, 0x4a, 0xb0, 0x76, 0x8a, 0x89, 0x8b, 0x49, 0xb9, 0x83, 0x87, 0xe5, 0xe1, 0x5e,
-	0x70, 0x29, 0xf5, 0x7d, 0x4c, 0x31, 0xca, 0x86, 0x89, 0x52, 0x10, 0x19, 0xee, 0x6d, 0xe4, 0x39,
-	0xae, 0x6d, 0x12, 0xbb, 0x3c, 0xdc, 0x6b, 0xdb, 0xd4, 0xdc, 0x2b, 0x9f, 0x62, 0xc7, 0x0b, 0xe1,
-	0x1b, 0xab, 0x1d, 0xdc, 0xc1, 0xec, 0xb6, 0x1c, 0xdc, 0xf1, 0x68, 0xa1, 0x83, 0x71, 0xa7, 0x67,
-	0x97, 0xd9, 0x53, 0x7b, 0xf0, 0xa2, 0x4c, 0x1d, 0xd7, 0x26, 0xd4, 0x74, 0xfb, 0x1c, 0xb0, 0x1e,
-	0x07, 0x98, 0xde, 0x88, 0xa7, 0xf2, 0xf1, 0x94, 0x35, 0xf0, 0x4d, 0xea, 0xe0, 0xa8, 0xe2, 0x7a,
-	0xd8, 0x91, 0x11, 0x16, 0xe5, 0xdd, 0xb2, 0x87, 0x22, 0x06, 0x74, 0x62, 0x3b, 0x9d, 0x2e, 0xb5,
-	0xad, 0x16, 0xa6, 0x76, 0xa3, 0x1f, 0xd0, 0xd0, 0x1e, 0xa4, 0x30, 0xbb, 0x93, 0x85, 0x2d, 0x61,
-	0x3b, 0xf7, 0x68, 0xbd, 0x34, 0xb3, 0xc4, 0xd2, 0x04, 0xaa, 0x72, 0x20, 0xfa, 0x1c, 0x52, 0x2f,
-	0x99, 0x90, 0x9c, 0xd8, 0x12, 0xb6, 0x17, 0xab, 0xb9, 0x77, 0xaf, 0x77, 0x81, 0xb3, 0x6a, 0xf6,
-	0xa9, 0xca, 0xb3, 0xc5, 0xdf, 0x04, 0x58, 0xa8, 0xd9, 0x7d, 0x4c, 0x1c, 0x8a, 0x0a, 0x90, 0xe9,
-	0xfb, 0xb8, 0x8f, 0x89, 0xd9, 0x33, 0x1c, 0x8b, 0xd5, 0x12, 0x55, 0x88, 0x42, 0x75, 0x0b, 0x7d,
-	0x03, 0x8b, 0x56, 0x88, 0xc5, 0x3e, 0xd7, 0x95, 0xdf, 0xbd, 0xde, 0x5d, 0xe5, 0xba, 0x15, 0xcb,
-	0xf2, 0x6d, 0x42, 0x34, 0xea, 0x3b, 0x5e, 0x47, 0x9d, 0x40, 0xd1, 0xb7, 0x90, 0x32, 0x5d, 0x3c,
-	0xf0, 0xa8, 0x9c, 0xdc, 0x4a, 0x6e, 0x67, 0x26, 0xfd, 0x07, 0x33, 0x29, 0xf1, 0x99, 0x94, 0xf6,
-	0xb1, 0xe3, 0x55, 0xc5, 0x37, 0xe3, 0xc2, 0x9c, 0xca, 0xe1, 0xc5, 0x3f, 0x45, 0x48, 0x37, 0x79,
-	0x7d, 0x94, 0x83, 0xc4, 0x55, 0x57, 0x09, 0xc7, 0x42, 0x5f, 0x41, 0xda, 0xb5, 0x09, 0x31, 0x3b,
-	0x36, 0x91, 0x13, 0x4c, 0x77, 0xb5, 0x14, 0xee, 0x7c, 0x29, 0xda, 0xf9, 0x52, 0xc5, 0x1b, 0xa9,
-	0x57, 0x28, 0xf4, 0x35, 0xa4, 0x08, 0x35, 0xe9, 0x80, 0xc8, 0x49, 0xb6, 0x8f, 0x9b, 0xb1, 0x7d,
-	0x8c, 0x4a, 0x69, 0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x00, 0xf4, 0xc2, 0xf1, 0xcc, 0x9e, 0x41, 0xcd,
-	0x5e, 0x6f, 0x64, 0xf8, 0x36, 0x19, 0xf4, 0xa8, 0x2c, 0x6e, 0x09, 0xdb, 0x99, 0x47, 0x1b, 0x31,
-	0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x4a, 0x8c, 0x35, 0x15, 0x41, 0x15, 0xc8, 0x90, 0x41, 0xdb,
-	0x75, 0xa8, 0x11, 0xd8, 0x49, 0x9e, 0xe7, 0x12, 0xf1, 0xae, 0xf5, 0xc8, 0x6b, 0x55, 0xf1, 0xd5,
-	0xbf, 0x05, 0x41, 0x85, 0x90, 0x14, 0x84, 0xd1, 0x21, 0x48, 0x7c, 0x63, 0x0d, 0xdb, 0xb3, 0x42,
-	0x9d, 0xd4, 0x1d, 0x75, 0x72, 0x9c, 0xa9, 0x78, 0x16, 0xd3, 0xaa, 0x41, 0x96, 0x62, 0x6a, 0xf6,
-	0x0c, 0x1e, 0x97, 0x17, 0xee, 0x36, 0x9e, 0x25, 0xc6, 0x8a, 0x6c, 0x73, 0x04, 0x1f, 0x0d, 0x31,
-	0x75, 0xbc, 0x8e, 0x41, 0xa8, 0xe9, 0xf3, 0xa5, 0xa5, 0xef, 0xd8, 0xd2, 0x72, 0x48, 0xd5, 0x02,
-	0x26, 0xeb, 0xe9, 0x00, 0x78, 0x68, 0xb2, 0xbc, 0xc5, 0x3b, 0x6a, 0x65, 0x43, 0x62, 0xb4, 0xba,
-	0x8d, 0xc0, 0x1f, 0xd4, 0xb4, 0x4c, 0x6a, 0xca, 0x10, 0x98, 0x55, 0xbd, 0x7a, 0x2e, 0xfe, 0x25,
-	0x40, 0x66, 0x7a, 0x30, 0x0f, 0x61, 0x71, 0x64, 0x13, 0xe3, 0x94, 0x99, 0x54, 0xb8, 0xf6, 0xc6,
-	0xd4, 0x3d, 0xaa, 0xa6, 0x47, 0x36, 0xd9, 0x0f, 0xf2, 0xe8, 0x31, 0x64, 0xcd, 0x36, 0xa1, 0xa6,
-	0xe3, 0x71, 0x42, 0xe2, 0x46, 0xc2, 0x12, 0x07, 0x85, 0xa4, 0x2f, 0x20, 0xed, 0x61, 0x8e, 0x4f,
-	0xde, 0x88, 0x5f, 0xf0, 0x70, 0x08, 0x7d, 0x02, 0xc8, 0xc3, 0xc6, 0x4b, 0x87, 0x76, 0x8d, 0xa1,
-	0x4d, 0x23, 0x92, 0x78, 0x23, 0x69, 0xd9, 0xc3, 0x27, 0x0e, 0xed, 0xb6, 0x6c, 0x1a, 0x92, 0x8b,
-	0xbf, 0x0b, 0x20, 0x06, 0xe7, 0xc1, 0xed, 0x6f, 0x73, 0x09, 0xe6, 0x87, 0x98, 0xda, 0xb7, 0xbf,
-	0xc9, 0x21, 0x0c, 0x3d, 0x81, 0x85, 0xf0, 0x70, 0x21, 0xb2, 0xc8, 0x7c, 0xf2, 0x20, 0xe6, 0xfd,
-	0xeb, 0x27, 0x97, 0x1a, 0x31, 0x66, 0x86, 0x31, 0x3f, 0x3b, 0x8c, 0x43, 0x31, 0x9d, 0x94, 0xc4,
-	0xe2, 0x3f, 0x02, 0x64, 0xb9, 0xa5, 0x9a, 0xa6, 0x6f, 0xba, 0x04, 0x3d, 0x87, 0x8c, 0xeb, 0x78,
-	0x57, 0xe6, 0x14, 0x6e, 0x33, 0xe7, 0x66, 0x60, 0xce, 0xcb, 0x71, 0xe1, 0xe3, 0x29, 0xd6, 0x97,
-	0xd8, 0x75, 0xa8, 0xed, 0xf6, 0xe9, 0x48, 0x05, 0xd7, 0xf1, 0x22, 0xcf, 0xba, 0x80, 0x5c, 0xf3,
-	0x2c, 0x02, 0x19, 0x7d, 0xdb, 0x77, 0xb0, 0xc5, 0x36, 0x22, 0xa8, 0x10, 0x37, 0x5a, 0x8d, 0x9f,
-	0xdf, 0xd5, 0x4f, 0x2f, 0xc7, 0x85, 0xfb, 0xd7, 0x89, 0x93, 0x22, 0xbf, 0x06, 0x3e, 0x94, 0x5c,
-	0xf3, 0x2c, 0x5a, 0x09, 0xcb, 0x17, 0x75, 0x58, 0x6a, 0x31, 0x6f, 0xf2, 0x95, 0xd5, 0x80, 0x7b,
-	0x35, 0xaa, 0x2c, 0xdc, 0x56, 0x59, 0x64, 0xca, 0x4b, 0x21, 0x8b, 0xab, 0xfe, 0x1f, 0x99, 0x98,
-	0xab, 0x7e, 0x07, 0xa9, 0x9f, 0x07, 0xd8, 0x1f, 0xb8, 0xdc, 0xc1, 0xc5, 0xcb, 0x71, 0x41, 0x0a,
-	0x23, 0x93, 0x0e, 0xe3, 0xdf, 0x81, 0x30, 0x8f, 0xf6, 0x61, 0x91, 0x76, 0x7d, 0x9b, 0x74, 0x71,
-	0xcf, 0xe2, 0x86, 0xf8, 0xec, 0x72, 0x5c, 0x58, 0xb9, 0x0a, 0x7e, 0x50, 0x61, 0xc2, 0x43, 0x3f,
-	0x40, 0x8e, 0x19, 0x76, 0xa2, 0x14, 0x3a, 0x7d, 0xe7, 0x72, 0x5c, 0x90, 0x67, 0x33, 0x1f, 0x94,
-	0xcb, 0x06, 0x38, 0x3d, 0x82, 0xed, 0xfc, 0x22, 0x00, 0x4c, 0x7d, 0x09, 0xef, 0xc1, 0x5a, 0xab,
-	0xa1, 0x2b, 0x46, 0xa3, 0xa9, 0xd7, 0x1b, 0xc7, 0xc6, 0xb3, 0x63, 0xad, 0xa9, 0xec, 0xd7, 0x9f,
-	0xd6, 0x95, 0x9a, 0x34, 0x87, 0x56, 0x60, 0x79, 0x3a, 0xf9, 0x5c, 0xd1, 0x24, 0x01, 0xad, 0xc1,
-	0xca, 0x74, 0xb0, 0x52, 0xd5, 0xf4, 0x4a, 0xfd, 0x58, 0x4a, 0x20, 0x04, 0xb9, 0xe9, 0xc4, 0x71,
-	0x43, 0x4a, 0xa2, 0xfb, 0x20, 0xcf, 0xc6, 0x8c, 0x93, 0xba, 0x7e, 0x60, 0xb4, 0x14, 0xbd, 0x21,
-	0x89, 0x3b, 0x7f, 0x08, 0x90, 0x9b, 0xfd, 0x44, 0xa0, 0x02, 0xdc, 0x6b, 0xaa, 0x8d, 0x66, 0x43,
-	0xab, 0x1c, 0x19, 0x9a, 0x5e, 0xd1, 0x9f, 0x69, 0xb1, 0x9e, 0x8a, 0x90, 0x8f, 0x03, 0x6a, 0x4a,
-	0xb3, 0xa1, 0xd5, 0x75, 0xa3, 0xa9, 0xa8, 0xf5, 0x46, 0x4d, 0x12, 0xd0, 0x03, 0xd8, 0x8c, 0x63,
-	0x5a, 0x0d, 0xbd, 0x7e, 0xfc, 0x7d, 0x04, 0x49, 0xa0, 0x0d, 0xf8, 0x24, 0x0e, 0x69, 0x56, 0x34,
-	0x4d, 0xa9, 0x85, 0x4d, 0xc7, 0x73, 0xaa, 0x72, 0xa8, 0xec, 0xeb, 0x4a, 0x4d, 0x12, 0x6f, 0x62,
-	0x3e, 0xad, 0xd4, 0x8f, 0x94, 0x9a, 0x34, 0x5f, 0x55, 0xde, 0x9c, 0xe7, 0x85, 0xb7, 0xe7, 0x79,
-	0xe1, 0xbf, 0xf3, 0xbc, 0xf0, 0xea, 0x22, 0x3f, 0xf7, 0xf6, 0x22, 0x3f, 0xf7, 0xf7, 0x45, 0x7e,
-	0xee, 0xc7, 0x87, 0x1d, 0x87, 0x76, 0x07, 0xed, 0xd2, 0x29, 0x76, 0xf9, 0x0f, 0x0a, 0xbf, 0xec,
-	0x12, 0xeb, 0xa7, 0xf2, 0x19, 0xfb, 0xe9, 0xa2, 0xa3, 0xbe, 0x4d, 0x82, 0x3f, 0xaa, 0x14, 0xb3,
-	0xeb, 0xe3, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0x09, 0x8c, 0x92, 0x09, 0x00, 0x00,
+	// 1283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4d, 0x73, 0xda, 0x56,
+	0x17, 0xb6, 0x00, 0x63, 0x38, 0xd8, 0x84, 0xf7, 0xda, 0x79, 0xad, 0x38, 0x31, 0x38, 0xcc, 0xfb,
+	0xe1, 0x26, 0x0d, 0xd4, 0x49, 0x3f, 0x66, 0x9a, 0x15, 0x18, 0xa5, 0xc1, 0x93, 0x1a, 0x2a, 0x14,
+	0x3c, 0xe9, 0x46, 0x95, 0xd1, 0x0d, 0xd6, 0x14, 0xe9, 0x52, 0xdd, 0x0b, 0x31, 0x3f, 0xa1, 0xbb,
+	0x2c, 0x3b, 0xd3, 0x7f, 0xd0, 0x75, 0xa6, 0xbf, 0x21, 0xab, 0x4e, 0x26, 0x9b, 0xb6, 0x1b, 0xda,
+	0x26, 0x3b, 0xff, 0x8a, 0x8e, 0xae, 0xae, 0x10, 0xc8, 0x78, 0xf0, 0x34, 0x5d, 0x75, 0x25, 0xe9,
+	0x9c, 0xe7, 0x39, 0xe7, 0xdc, 0x73, 0x1e, 0xdd, 0x2b, 0xc1, 0x66, 0x87, 0x50, 0x9b, 0xd0, 0x72,
+	0x97, 0x0c, 0xcb, 0xc3, 0x3d, 0xef, 0x52, 0xea, 0xbb, 0x84, 0x11, 0xb4, 0xe6, 0x3b, 0x4a, 0x9e,
+	0x65, 0xb8, 0xb7, 0x95, 0x17, 0xb8, 0x63, 0x83, 0xe2, 0xf2, 0x70, 0xef, 0x18, 0x33, 0x63, 0xaf,
+	0xdc, 0x21, 0x96, 0xe3, 0xc3, 0xb7, 0x36, 0xba, 0xa4, 0x4b, 0xf8, 0x6d, 0xd9, 0xbb, 0x13, 0xd6,
+	0x42, 0x97, 0x90, 0x6e, 0x0f, 0x97, 0xf9, 0xd3, 0xf1, 0xe0, 0x69, 0x99, 0x59, 0x36, 0xa6, 0xcc,
+	0xb0, 0xfb, 0x02, 0x70, 0x2d, 0x0a, 0x30, 0x9c, 0x91, 0x70, 0xe5, 0xa3, 0x2e, 0x73, 0xe0, 0x1a,
+	0xcc, 0x22, 0x41, 0xc6, 0x6b, 0x7e, 0x45, 0xba, 0x9f, 0x54, 0x54, 0xcb, 0x1f, 0x8a, 0x04, 0xd0,
+	0x11, 0xb6, 0xba, 0x27, 0x0c, 0x9b, 0x6d, 0xc2, 0x70, 0xa3, 0xef, 0xd1, 0xd0, 0x1e, 0x24, 0x09,
+	0xbf, 0x93, 0xa5, 0x1d, 0x69, 0x37, 0x7b, 0xf7, 0x5a, 0x69, 0x66, 0x89, 0xa5, 0x10, 0xaa, 0x0a,
+	0x20, 0xfa, 0x1f, 0x24, 0x9f, 0xf1, 0x40, 0x72, 0x6c, 0x47, 0xda, 0x4d, 0x57, 0xb3, 0xaf, 0x5f,
+	0xdc, 0x01, 0xc1, 0xaa, 0xe1, 0x8e, 0x2a, 0xbc, 0xc5, 0xef, 0x25, 0x58, 0xa9, 0xe1, 0x3e, 0xa1,
+	0x16, 0x43, 0x05, 0xc8, 0xf4, 0x5d, 0xd2, 0x27, 0xd4, 0xe8, 0xe9, 0x96, 0xc9, 0x73, 0x25, 0x54,
+	0x08, 0x4c, 0x75, 0x13, 0x7d, 0x0c, 0x69, 0xd3, 0xc7, 0x12, 0x57, 0xc4, 0x95, 0x5f, 0xbf, 0xb8,
+	0xb3, 0x21, 0xe2, 0x56, 0x4c, 0xd3, 0xc5, 0x94, 0xb6, 0x98, 0x6b, 0x39, 0x5d, 0x35, 0x84, 0xa2,
+	0x4f, 0x20, 0x69, 0xd8, 0x64, 0xe0, 0x30, 0x39, 0xbe, 0x13, 0xdf, 0xcd, 0x84, 0xf5, 0x7b, 0x33,
+	0x29, 0x89, 0x99, 0x94, 0xf6, 0x89, 0xe5, 0x54, 0x13, 0x2f, 0xc7, 0x85, 0x25, 0x55, 0xc0, 0x8b,
+	0x3f, 0x2c, 0x43, 0xaa, 0x29, 0xf2, 0xa3, 0x2c, 0xc4, 0x26, 0x55, 0xc5, 0x2c, 0x13, 0x7d, 0x00,
+	0x29, 0x1b, 0x53, 0x6a, 0x74, 0x31, 0x95, 0x63, 0x3c, 0xee, 0x46, 0xc9, 0xef, 0x7c, 0x29, 0xe8,
+	0x7c, 0xa9, 0xe2, 0x8c, 0xd4, 0x09, 0x0a, 0x7d, 0x04, 0x49, 0xca, 0x0c, 0x36, 0xa0, 0x72, 0x9c,
+	0xf7, 0x71, 0x3b, 0xd2, 0xc7, 0x20, 0x55, 0x8b, 0x83, 0x54, 0x01, 0x46, 0x0f, 0x01, 0x3d, 0xb5,
+	0x1c, 0xa3, 0xa7, 0x33, 0xa3, 0xd7, 0x1b, 0xe9, 0x2e, 0xa6, 0x83, 0x1e, 0x93, 0x13, 0x3b, 0xd2,
+	0x6e, 0xe6, 0xee, 0x56, 0x24, 0x84, 0xe6, 0x41, 0x54, 0x8e, 0x50, 0x73, 0x9c, 0x35, 0x65, 0x41,
+	0x15, 0xc8, 0xd0, 0xc1, 0xb1, 0x6d, 0x31, 0xdd, 0x93, 0x93, 0xbc, 0x2c, 0x42, 0x44, 0xab, 0xd6,
+	0x02, 0xad, 0x55, 0x13, 0xcf, 0x7f, 0x2b, 0x48, 0x2a, 0xf8, 0x24, 0xcf, 0x8c, 0x0e, 0x20, 0x27,
+	0x1a, 0xab, 0x63, 0xc7, 0xf4, 0xe3, 0x24, 0x2f, 0x19, 0x27, 0x2b, 0x98, 0x8a, 0x63, 0xf2, 0x58,
+	0x35, 0x58, 0x63, 0x84, 0x19, 0x3d, 0x5d, 0xd8, 0xe5, 0x95, 0xcb, 0x8d, 0x67, 0x95, 0xb3, 0x02,
+	0xd9, 0x3c, 0x82, 0x7f, 0x0d, 0x09, 0xb3, 0x9c, 0xae, 0x4e, 0x99, 0xe1, 0x8a, 0xa5, 0xa5, 0x2e,
+	0x59, 0xd2, 0x15, 0x9f, 0xda, 0xf2, 0x98, 0xbc, 0xa6, 0x87, 0x20, 0x4c, 0xe1, 0xf2, 0xd2, 0x97,
+	0x8c, 0xb5, 0xe6, 0x13, 0x83, 0xd5, 0x6d, 0x79, 0xfa, 0x60, 0x86, 0x69, 0x30, 0x43, 0x06, 0x4f,
+	0xac, 0xea, 0xe4, 0x19, 0xdd, 0x80, 0x34, 0x3e, 0xed, 0x63, 0xd3, 0x62, 0xd8, 0x94, 0x33, 0x3b,
+	0xd2, 0x6e, 0x4a, 0x0d, 0x0d, 0xe8, 0x43, 0x48, 0xf9, 0xaa, 0xc7, 0xae, 0xbc, 0xba, 0x40, 0xe6,
+	0x13, 0x64, 0xf1, 0x67, 0x09, 0x32, 0xd3, 0xc3, 0xbe, 0x0d, 0xe9, 0x11, 0xa6, 0x7a, 0x87, 0x0b,
+	0x5f, 0x3a, 0xf7, 0x16, 0xd6, 0x1d, 0xa6, 0xa6, 0x46, 0x98, 0xee, 0x7b, 0x7e, 0x74, 0x0f, 0xd6,
+	0x8c, 0x63, 0xca, 0x0c, 0xcb, 0x11, 0x84, 0xd8, 0x5c, 0xc2, 0xaa, 0x00, 0xf9, 0xa4, 0xf7, 0x20,
+	0xe5, 0x10, 0x81, 0x8f, 0xcf, 0xc5, 0xaf, 0x38, 0xc4, 0x87, 0xde, 0x07, 0xe4, 0x10, 0xfd, 0x99,
+	0xc5, 0x4e, 0xf4, 0x21, 0x66, 0x01, 0x29, 0x31, 0x97, 0x74, 0xc5, 0x21, 0x47, 0x16, 0x3b, 0x69,
+	0x63, 0xe6, 0x93, 0x8b, 0x3f, 0x4a, 0x90, 0xf0, 0xf6, 0x98, 0xc5, 0x3b, 0x44, 0x09, 0x96, 0x87,
+	0x84, 0xe1, 0xc5, 0xbb, 0x83, 0x0f, 0x43, 0xf7, 0x61, 0xc5, 0xdf, 0xb0, 0xa8, 0x9c, 0xe0, 0xda,
+	0xbb, 0x19, 0x79, 0x9f, 0xce, 0xef, 0x86, 0x6a, 0xc0, 0x98, 0x19, 0xf0, 0xf2, 0xec, 0x80, 0x0f,
+	0x12, 0xa9, 0x78, 0x2e, 0x51, 0xfc, 0x55, 0x82, 0x35, 0x21, 0xd3, 0xa6, 0xe1, 0x1a, 0x36, 0x45,
+	0x4f, 0x20, 0x63, 0x5b, 0xce, 0x44, 0xf0, 0xd2, 0x22, 0xc1, 0x6f, 0x7b, 0x82, 0x3f, 0x1b, 0x17,
+	0xae, 0x4e, 0xb1, 0xde, 0x27, 0xb6, 0xc5, 0xb0, 0xdd, 0x67, 0x23, 0x15, 0x6c, 0xcb, 0x09, 0xde,
+	0x03, 0x1b, 0x90, 0x6d, 0x9c, 0x06, 0x20, 0xbd, 0x8f, 0x5d, 0x8b, 0x98, 0xbc, 0x11, 0x5e, 0x86,
+	0xa8, 0x78, 0x6b, 0xe2, 0x4c, 0xa8, 0xfe, 0xe7, 0x6c, 0x5c, 0xb8, 0x71, 0x9e, 0x18, 0x26, 0xf9,
+	0xce, 0xd3, 0x76, 0xce, 0x36, 0x4e, 0x83, 0x95, 0x70, 0x7f, 0x51, 0x83, 0xd5, 0x36, 0xd7, 0xbb,
+	0x58, 0x59, 0x0d, 0x84, 0xfe, 0x83, 0xcc, 0xd2, 0xa2, 0xcc, 0x09, 0x1e, 0x79, 0xd5, 0x67, 0x89,
+	0xa8, 0x7f, 0x04, 0x22, 0x16, 0x51, 0x3f, 0x85, 0xe4, 0x37, 0x03, 0xe2, 0x0e, 0x6c, 0xa1, 0xe0,
+	0xe2, 0xd9, 0xb8, 0x90, 0xf3, 0x2d, 0x61, 0x85, 0xd1, 0xb3, 0xc5, 0xf7, 0xa3, 0x7d, 0x48, 0xb3,
+	0x13, 0x17, 0xd3, 0x13, 0xd2, 0x33, 0x85, 0x20, 0xfe, 0x7b, 0x36, 0x2e, 0xac, 0x4f, 0x8c, 0x17,
+	0x46, 0x08, 0x79, 0xe8, 0x0b, 0xc8, 0x72, 0xc1, 0x86, 0x91, 0x7c, 0xa5, 0xdf, 0x3a, 0x1b, 0x17,
+	0xe4, 0x59, 0xcf, 0x85, 0xe1, 0xd6, 0x3c, 0x9c, 0x16, 0xc0, 0x8a, 0xaf, 0x57, 0x20, 0xf9, 0x4f,
+	0x93, 0xc3, 0xf9, 0xf1, 0xc7, 0xff, 0xc2, 0xf8, 0xa7, 0xc6, 0x9d, 0x78, 0xb7, 0x71, 0x2f, 0xff,
+	0x6d, 0xe3, 0x4e, 0xbe, 0xe3, 0xb8, 0xd1, 0x10, 0xae, 0x4e, 0xb6, 0x76, 0x7d, 0x7a, 0xda, 0x0b,
+	0x4f, 0xbb, 0xff, 0x8b, 0x69, 0x17, 0xe6, 0xf2, 0xa7, 0xe6, 0xbe, 0x3e, 0x01, 0x7c, 0x1e, 0x0a,
+	0xe0, 0x08, 0x36, 0x43, 0xde, 0xec, 0x6c, 0x52, 0x97, 0x9b, 0x4d, 0x58, 0x77, 0x7b, 0x7a, 0x48,
+	0x5f, 0x41, 0x98, 0x6f, 0xaa, 0x51, 0x69, 0xde, 0xa8, 0xf2, 0xd9, 0xb8, 0xb0, 0x3d, 0xc7, 0x7d,
+	0x61, 0xb7, 0xd0, 0x04, 0x1c, 0xb6, 0x0c, 0xc3, 0xd5, 0xc9, 0x3e, 0xdf, 0x31, 0x9c, 0x0e, 0xee,
+	0xe9, 0xbc, 0x30, 0xff, 0x1c, 0xad, 0xee, 0x79, 0x3d, 0x99, 0x0b, 0xb8, 0x30, 0xcb, 0x7a, 0x00,
+	0xdf, 0xe7, 0x68, 0xd5, 0x03, 0xa3, 0x03, 0xd8, 0x88, 0x46, 0x31, 0x31, 0x65, 0x72, 0x66, 0xc1,
+	0xe1, 0x81, 0x66, 0x83, 0xd5, 0x30, 0x65, 0xb7, 0xbe, 0x95, 0x00, 0xa6, 0x3e, 0x99, 0xaf, 0xc3,
+	0x66, 0xbb, 0xa1, 0x29, 0x7a, 0xa3, 0xa9, 0xd5, 0x1b, 0x87, 0xfa, 0xe3, 0xc3, 0x56, 0x53, 0xd9,
+	0xaf, 0x3f, 0xa8, 0x2b, 0xb5, 0xdc, 0x12, 0x5a, 0x87, 0x2b, 0xd3, 0xce, 0x27, 0x4a, 0x2b, 0x27,
+	0xa1, 0x4d, 0x58, 0x9f, 0x36, 0x56, 0xaa, 0x2d, 0xad, 0x52, 0x3f, 0xcc, 0xc5, 0x10, 0x82, 0xec,
+	0xb4, 0xe3, 0xb0, 0x91, 0x8b, 0xa3, 0x1b, 0x20, 0xcf, 0xda, 0xf4, 0xa3, 0xba, 0xf6, 0x50, 0x6f,
+	0x2b, 0x5a, 0x23, 0x97, 0xb8, 0xf5, 0x93, 0x04, 0xd9, 0xd9, 0x6f, 0x49, 0x54, 0x80, 0xeb, 0x4d,
+	0xb5, 0xd1, 0x6c, 0xb4, 0x2a, 0x8f, 0xf4, 0x96, 0x56, 0xd1, 0x1e, 0xb7, 0x22, 0x35, 0x15, 0x21,
+	0x1f, 0x05, 0xd4, 0x94, 0x66, 0xa3, 0x55, 0xd7, 0xf4, 0xa6, 0xa2, 0xd6, 0x1b, 0xb5, 0x9c, 0x84,
+	0x6e, 0xc2, 0x76, 0x14, 0xd3, 0x6e, 0x68, 0xf5, 0xc3, 0xcf, 0x02, 0x48, 0x0c, 0x6d, 0xc1, 0xbf,
+	0xa3, 0x90, 0x66, 0xa5, 0xd5, 0x52, 0x6a, 0x7e, 0xd1, 0x51, 0x9f, 0xaa, 0x1c, 0x28, 0xfb, 0x9a,
+	0x52, 0xcb, 0x25, 0xe6, 0x31, 0x1f, 0x54, 0xea, 0x8f, 0x94, 0x5a, 0x6e, 0xb9, 0xaa, 0xbc, 0x7c,
+	0x93, 0x97, 0x5e, 0xbd, 0xc9, 0x4b, 0xbf, 0xbf, 0xc9, 0x4b, 0xcf, 0xdf, 0xe6, 0x97, 0x5e, 0xbd,
+	0xcd, 0x2f, 0xfd, 0xf2, 0x36, 0xbf, 0xf4, 0xe5, 0xed, 0xae, 0xc5, 0x4e, 0x06, 0xc7, 0xa5, 0x0e,
+	0xb1, 0xc5, 0x9f, 0x8c, 0xb8, 0xdc, 0xa1, 0xe6, 0xd7, 0xe5, 0x53, 0xfe, 0x77, 0xc6, 0x46, 0x7d,
+	0x4c, 0xbd, 0x5f, 0xaf, 0x24, 0x17, 0xfa, 0xbd, 0x3f, 0x07, 0x00, 0xb3, 0x9d, 0x09, 0x29, 0xbb,
+	0x0d, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -856,6 +1035,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1187,6 +1383,129 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.VotingPeriod != nil {
+		n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintGov(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDepositPeriod != nil {
+		n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintGov(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1284,6 +1603,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1391,6 +1717,63 @@ func (m *TallyParams) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.MaxDepositPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.VotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1980,16 +2363,68 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -2698,6 +3133,424 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDepositPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDepositPeriod == nil {
+				m.MaxDepositPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.MaxDepositPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VotingPeriod == nil {
+				m.VotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.VotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,15 +11,19 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultMinExpeditedDepositTokens = DefaultMinDepositTokens.Mul(sdk.NewInt(5))
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store key
@@ -105,10 +109,10 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("invalid quorum string: %w", err)
 	}
 	if quorum.IsNegative() {
-		return fmt.Errorf("quorom cannot be negative: %s", quorum)
+		return fmt.Errorf("quorum cannot be negative: %s", quorum)
 	}
 	if quorum.GT(sdk.OneDec()) {
-		return fmt.Errorf("quorom too large: %s", v)
+		return fmt.Errorf("quorum too large: %s", v)
 	}
 
 	threshold, err := sdk.NewDecFromStr(v.Threshold)
@@ -170,28 +174,159 @@ func validateVotingParams(i interface{}) error {
 	return nil
 }
 
-// Params returns all of the governance params
-type Params struct {
-	VotingParams  VotingParams  `json:"voting_params" yaml:"voting_params"`
-	TallyParams   TallyParams   `json:"tally_params" yaml:"tally_params"`
-	DepositParams DepositParams `json:"deposit_params" yaml:"deposit_params"`
+// NewParams creates a new Params instance with given values.
+func NewParams(
+	minDeposit, expeditedMinDeposit sdk.Coins, maxDepositPeriod, votingPeriod, expeditedVotingPeriod time.Duration,
+	quorum, threshold, expeditedThreshold, vetoThreshold, proposalCancelRatio, proposalCancelDest string,
+) Params {
+	return Params{
+		MinDeposit:            minDeposit,
+		ExpeditedMinDeposit:   expeditedMinDeposit,
+		MaxDepositPeriod:      &maxDepositPeriod,
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
+		Quorum:                quorum,
+		Threshold:             threshold,
+		ExpeditedThreshold:    expeditedThreshold,
+		VetoThreshold:         vetoThreshold,
+		ProposalCancelRatio:   proposalCancelRatio,
+		ProposalCancelDest:    proposalCancelDest,
+	}
 }
 
-func (gp Params) String() string {
-	return gp.VotingParams.String() + "\n" +
-		gp.TallyParams.String() + "\n" + gp.DepositParams.String()
+// DefaultParams returns the default governance params
+func DefaultParams() Params {
+	return NewParams(
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinExpeditedDepositTokens)),
+		DefaultPeriod,
+		DefaultPeriod,
+		DefaultExpeditedPeriod,
+		DefaultQuorum.String(),
+		DefaultThreshold.String(),
+		DefaultExpeditedThreshold.String(),
+		DefaultVetoThreshold.String(),
+		DefaultProposalCancelRatio.String(),
+		"",
+	)
 }
 
-// NewParams creates a new gov Params instance
-func NewParams(vp VotingParams, tp TallyParams, dp DepositParams) Params {
-	return Params{
-		VotingParams:  vp,
-		DepositParams: dp,
-		TallyParams:   tp,
+// ValidateBasic performs basic validation on governance parameters.
+func (p Params) ValidateBasic() error {
+	if err := validateDepositParams(NewDepositParams(p.MinDeposit, durationOrZero(p.MaxDepositPeriod))); err != nil {
+		return err
+	}
+
+	if err := validateVotingParams(NewVotingParams(durationOrZero(p.VotingPeriod))); err != nil {
+		return err
+	}
+
+	if err := validateTallyParams(TallyParams{
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}); err != nil {
+		return err
+	}
+
+	if err := p.validateExpeditedParams(); err != nil {
+		return err
 	}
+
+	return p.validateProposalCancelParams()
 }
 
-// DefaultParams default governance params
-func DefaultParams() Params {
-	return NewParams(DefaultVotingParams(), DefaultTallyParams(), DefaultDepositParams())
+// validateExpeditedParams checks that expedited proposals are strictly harder
+// to pass than regular ones: they require a larger deposit and a higher
+// threshold, in exchange for a shorter voting period.
+func (p Params) validateExpeditedParams() error {
+	expeditedMinDeposit := sdk.Coins(p.ExpeditedMinDeposit)
+	if !expeditedMinDeposit.IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", expeditedMinDeposit)
+	}
+	if !expeditedMinDeposit.IsAllGT(p.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit must be greater than the minimum deposit: %s <= %s", expeditedMinDeposit, sdk.Coins(p.MinDeposit))
+	}
+
+	expeditedVotingPeriod := durationOrZero(p.ExpeditedVotingPeriod)
+	if expeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", expeditedVotingPeriod)
+	}
+	if expeditedVotingPeriod >= durationOrZero(p.VotingPeriod) {
+		return fmt.Errorf("expedited voting period must be strictly less than the voting period: %s >= %s", expeditedVotingPeriod, p.VotingPeriod)
+	}
+
+	expeditedThreshold, err := sdk.NewDecFromStr(p.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+
+	threshold, err := sdk.NewDecFromStr(p.Threshold)
+	if err != nil {
+		return fmt.Errorf("invalid threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold must be greater than the vote threshold: %s <= %s", expeditedThreshold, threshold)
+	}
+
+	return nil
+}
+
+// validateProposalCancelParams checks the share of the deposits charged when a
+// proposal is canceled, and the address which receives it.
+func (p Params) validateProposalCancelParams() error {
+	proposalCancelRatio, err := sdk.NewDecFromStr(p.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio must be positive: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+
+	if p.ProposalCancelDest != "" {
+		if _, err := sdk.AccAddressFromBech32(p.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// DepositParams returns the deposit related parameters of the governance params.
+//
+// NOTE: This is used to populate the legacy query and genesis fields.
+func (p Params) DepositParams() DepositParams {
+	return NewDepositParams(p.MinDeposit, durationOrZero(p.MaxDepositPeriod))
+}
+
+// VotingParams returns the voting related parameters of the governance params.
+//
+// NOTE: This is used to populate the legacy query and genesis fields.
+func (p Params) VotingParams() VotingParams {
+	return NewVotingParams(durationOrZero(p.VotingPeriod))
+}
+
+// TallyParams returns the tally related parameters of the governance params.
+//
+// NOTE: This is used to populate the legacy query and genesis fields.
+func (p Params) TallyParams() TallyParams {
+	return TallyParams{
+		Quorum:        p.Quorum,
+		Threshold:     p.Threshold,
+		VetoThreshold: p.VetoThreshold,
+	}
+}
+
+func durationOrZero(d *time.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+
+	return *d
 }
@@ -23,7 +23,7 @@ const (
 )
 
 // NewProposal creates a new Proposal instance
-func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time) (Proposal, error) {
+func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, depositEndTime time.Time, proposer sdk.AccAddress, expedited bool) (Proposal, error) {
 	msgs, err := sdktx.SetMsgs(messages)
 	if err != nil {
 		return Proposal{}, err
@@ -39,6 +39,8 @@ func NewProposal(messages []sdk.Msg, id uint64, metadata string, submitTime, dep
 		FinalTallyResult: &tally,
 		SubmitTime:       &submitTime,
 		DepositEndTime:   &depositEndTime,
+		Expedited:        expedited,
+		Proposer:         proposer.String(),
 	}
 
 	return p, nil
@@ -49,6 +51,36 @@ func (p Proposal) GetMsgs() ([]sdk.Msg, error) {
 	return sdktx.GetMsgs(p.Messages, "sdk.MsgProposal")
 }
 
+// GetMinDepositFromParams returns the minimum deposit required for the proposal
+// to enter its voting period, depending on whether it is expedited.
+func (p Proposal) GetMinDepositFromParams(params Params) sdk.Coins {
+	if p.Expedited {
+		return params.ExpeditedMinDeposit
+	}
+
+	return params.MinDeposit
+}
+
+// GetVotingPeriodFromParams returns the length of the voting period of the
+// proposal, depending on whether it is expedited.
+func (p Proposal) GetVotingPeriodFromParams(params Params) time.Duration {
+	if p.Expedited {
+		return durationOrZero(params.ExpeditedVotingPeriod)
+	}
+
+	return durationOrZero(params.VotingPeriod)
+}
+
+// GetThresholdFromParams returns the minimum proportion of Yes votes for the
+// proposal to pass, depending on whether it is expedited.
+func (p Proposal) GetThresholdFromParams(params Params) string {
+	if p.Expedited {
+		return params.ExpeditedThreshold
+	}
+
+	return params.Threshold
+}
+
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
 func (p Proposal) UnpackInterfaces(unpacker types.AnyUnpacker) error {
 	return sdktx.UnpackInterfaces(unpacker, p.Messages)
@@ -509,12 +509,19 @@ func (m *QueryParamsRequest) GetParamsType() string {
 
 // QueryParamsResponse is the response type for the Query/Params RPC method.
 type QueryParamsResponse struct {
+	// Deprecated: Prefer to use `params` instead.
 	// voting_params defines the parameters related to voting.
-	VotingParams *VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"`
+	VotingParams *VotingParams `protobuf:"bytes,1,opt,name=voting_params,json=votingParams,proto3" json:"voting_params,omitempty"` // Deprecated: Do not use.
+	// Deprecated: Prefer to use `params` instead.
 	// deposit_params defines the parameters related to deposit.
-	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"`
+	DepositParams *DepositParams `protobuf:"bytes,2,opt,name=deposit_params,json=depositParams,proto3" json:"deposit_params,omitempty"` // Deprecated: Do not use.
+	// Deprecated: Prefer to use `params` instead.
 	// tally_params defines the parameters related to tally.
-	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"`
+	TallyParams *TallyParams `protobuf:"bytes,3,opt,name=tally_params,json=tallyParams,proto3" json:"tally_params,omitempty"` // Deprecated: Do not use.
+	// params defines all the paramaters of x/gov module.
+	//
+	// Since: cosmos-sdk 0.47
+	Params *Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
 }
 
 func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
@@ -550,6 +557,7 @@ func (m *QueryParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *QueryParamsResponse) GetVotingParams() *VotingParams {
 	if m != nil {
 		return m.VotingParams
@@ -557,6 +565,7 @@ func (m *QueryParamsResponse) GetVotingParams() *VotingParams {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryParamsResponse) GetDepositParams() *DepositParams {
 	if m != nil {
 		return m.DepositParams
@@ -564,6 +573,7 @@ func (m *QueryParamsResponse) GetDepositParams() *DepositParams {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	if m != nil {
 		return m.TallyParams
@@ -571,6 +581,13 @@ func (m *QueryParamsResponse) GetTallyParams() *TallyParams {
 	return nil
 }
 
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
 // QueryDepositRequest is the request type for the Query/Deposit RPC method.
 type QueryDepositRequest struct {
 	// proposal_id defines the unique id of the proposal.
@@ -895,67 +912,67 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/query.proto", fileDescriptor_46a436d1109b50d0) }
 
 var fileDescriptor_46a436d1109b50d0 = []byte{
-	// 945 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
-	0x14, 0xcd, 0x6c, 0x92, 0x76, 0xf7, 0xa6, 0x09, 0x70, 0x5b, 0x9a, 0xc5, 0x94, 0x6d, 0x70, 0x68,
-	0x12, 0x28, 0xf5, 0xb0, 0xe9, 0x97, 0x04, 0xad, 0x04, 0x2d, 0x04, 0x90, 0x78, 0x08, 0xdb, 0x8a,
-	0x07, 0x5e, 0x22, 0x27, 0x6b, 0x19, 0x8b, 0x8d, 0xc7, 0xdd, 0x99, 0x5d, 0x11, 0xd2, 0x08, 0xa9,
-	0x12, 0x82, 0x27, 0x40, 0xa2, 0x12, 0xfc, 0x10, 0x7e, 0x04, 0x8f, 0x15, 0xbc, 0x20, 0x78, 0x41,
-	0x09, 0x3f, 0x04, 0x79, 0xe6, 0xda, 0x6b, 0x3b, 0xde, 0xcd, 0xa6, 0x8a, 0xfa, 0x14, 0x8d, 0x7d,
-	0xee, 0xb9, 0xe7, 0x7e, 0xf8, 0x4c, 0x16, 0x5e, 0xda, 0x12, 0x72, 0x5b, 0x48, 0xee, 0x8b, 0x3e,
-	0xef, 0x37, 0xf9, 0x83, 0x9e, 0xd7, 0xdd, 0x71, 0xa2, 0xae, 0x50, 0x02, 0x67, 0xcd, 0x2b, 0xc7,
-	0x17, 0x7d, 0xa7, 0xdf, 0xb4, 0xde, 0x20, 0xe4, 0xa6, 0x2b, 0x3d, 0x83, 0xe3, 0xfd, 0xe6, 0xa6,
-	0xa7, 0xdc, 0x26, 0x8f, 0x5c, 0x3f, 0x08, 0x5d, 0x15, 0x88, 0xd0, 0x84, 0x5a, 0x17, 0x7c, 0x21,
-	0xfc, 0x8e, 0xc7, 0xdd, 0x28, 0xe0, 0x6e, 0x18, 0x0a, 0xa5, 0x5f, 0x4a, 0x7a, 0x3b, 0x9f, 0xcf,
-	0x19, 0xf3, 0x9b, 0x17, 0x24, 0x66, 0x43, 0x9f, 0x38, 0xa5, 0xd7, 0x07, 0xfb, 0x26, 0x9c, 0xfb,
-	0x34, 0xce, 0xb9, 0xde, 0x15, 0x91, 0x90, 0x6e, 0xa7, 0xe5, 0x3d, 0xe8, 0x79, 0x52, 0xe1, 0x45,
-	0x98, 0x89, 0xe8, 0xd1, 0x46, 0xd0, 0xae, 0xb3, 0x05, 0xb6, 0x32, 0xd5, 0x82, 0xe4, 0xd1, 0xc7,
-	0x6d, 0xfb, 0x13, 0x78, 0xb1, 0x10, 0x28, 0x23, 0x11, 0x4a, 0x0f, 0xaf, 0x42, 0x35, 0x81, 0xe9,
-	0xb0, 0x99, 0xd5, 0x79, 0x27, 0x57, 0xb1, 0x93, 0x86, 0xa4, 0x40, 0xfb, 0xc7, 0x4a, 0x81, 0x4e,
-	0x26, 0x42, 0xd6, 0xe0, 0xb9, 0x54, 0x88, 0x54, 0xae, 0xea, 0x49, 0xcd, 0x3a, 0xb7, 0xfa, 0xca,
-	0x10, 0xd6, 0x7b, 0x1a, 0xd4, 0x9a, 0x8b, 0x72, 0x67, 0x74, 0x60, 0xba, 0x2f, 0x94, 0xd7, 0xad,
-	0x57, 0x16, 0xd8, 0x4a, 0xed, 0x4e, 0xfd, 0x8f, 0xdf, 0xae, 0x9c, 0x23, 0x82, 0xf7, 0xda, 0xed,
-	0xae, 0x27, 0xe5, 0x3d, 0xd5, 0x0d, 0x42, 0xbf, 0x65, 0x60, 0x78, 0x03, 0x6a, 0x6d, 0x2f, 0x12,
-	0x32, 0x50, 0xa2, 0x5b, 0x9f, 0x3c, 0x22, 0x66, 0x00, 0xc5, 0x35, 0x80, 0xc1, 0xd8, 0xea, 0x53,
-	0xba, 0x01, 0x4b, 0x89, 0xd4, 0x78, 0xc6, 0x8e, 0xd9, 0x05, 0x9a, 0xb1, 0xb3, 0xee, 0xfa, 0x1e,
-	0xd5, 0xda, 0xca, 0x44, 0xda, 0xbf, 0x32, 0x38, 0x5f, 0xec, 0x08, 0x75, 0xf8, 0x3a, 0xd4, 0x92,
-	0xe2, 0xe2, 0x66, 0x4c, 0x8e, 0x6a, 0xf1, 0x00, 0x89, 0x1f, 0xe6, 0x94, 0x55, 0xb4, 0xb2, 0xe5,
-	0x23, 0x95, 0x99, 0x9c, 0x39, 0x69, 0x5b, 0xf0, 0xbc, 0x56, 0xf6, 0x99, 0x50, 0xde, 0xb8, 0xfb,
-	0x72, 0xdc, 0xfe, 0xdb, 0xb7, 0xe0, 0x85, 0x4c, 0x12, 0xaa, 0x7c, 0x19, 0xa6, 0xe2, 0xb7, 0xb4,
-	0x57, 0x67, 0x0b, 0x45, 0x6b, 0xa8, 0x06, 0xd8, 0x0f, 0x33, 0xd1, 0x72, 0x6c, 0x8d, 0x6b, 0x25,
-	0x1d, 0x7a, 0x9a, 0xd9, 0x7d, 0xcf, 0x00, 0xb3, 0xe9, 0x49, 0xfd, 0xeb, 0xa6, 0x05, 0xc9, 0xcc,
-	0x4a, 0xe5, 0x1b, 0xc4, 0xc9, 0xcd, 0xea, 0x3a, 0x29, 0x59, 0x77, 0xbb, 0xee, 0x76, 0xae, 0x13,
-	0xfa, 0xc1, 0x86, 0xda, 0x89, 0x4c, 0x3b, 0x6b, 0x71, 0x58, 0xfc, 0xe8, 0xfe, 0x4e, 0xe4, 0xd9,
-	0xff, 0x30, 0x38, 0x9b, 0x8b, 0xa3, 0x12, 0xde, 0x85, 0xd9, 0xbe, 0x50, 0x41, 0xe8, 0x6f, 0x18,
-	0x30, 0x4d, 0xe2, 0xe5, 0xc3, 0xa5, 0x04, 0xa1, 0x4f, 0xb1, 0x67, 0xfa, 0x99, 0x13, 0xde, 0x85,
-	0x39, 0xfa, 0x58, 0x12, 0x0a, 0x53, 0xdd, 0x85, 0x02, 0xc5, 0xfb, 0x06, 0x44, 0x1c, 0xb3, 0xed,
-	0xec, 0x11, 0x6f, 0xc3, 0x19, 0xe5, 0x76, 0x3a, 0x3b, 0x09, 0xc5, 0xa4, 0xa6, 0xb0, 0x0a, 0x14,
-	0xf7, 0x63, 0x08, 0x11, 0xcc, 0xa8, 0xc1, 0xc1, 0x0e, 0xa9, 0x38, 0xca, 0x31, 0xf6, 0x7e, 0xe4,
-	0x3c, 0xa1, 0x32, 0xb6, 0x27, 0xd8, 0x1f, 0x91, 0xc9, 0xa6, 0xf9, 0xa8, 0x9b, 0x6f, 0xc1, 0x69,
-	0x02, 0x51, 0x1f, 0xcf, 0x97, 0x37, 0xa1, 0x95, 0xc0, 0xec, 0x6f, 0xf2, 0x4c, 0xcf, 0x7e, 0xb5,
-	0x1f, 0x33, 0x32, 0xea, 0x81, 0x02, 0x2a, 0x66, 0x15, 0xaa, 0xa4, 0x32, 0x59, 0xf0, 0x61, 0xd5,
-	0xa4, 0xb8, 0x93, 0x5b, 0xf3, 0xb7, 0x61, 0x5e, 0xab, 0xd2, 0x23, 0x6f, 0x79, 0xb2, 0xd7, 0x51,
-	0xc7, 0xb8, 0xc9, 0xea, 0x87, 0x63, 0xd3, 0x09, 0x4d, 0xeb, 0xc5, 0xa1, 0xf9, 0x94, 0x6e, 0x18,
-	0x85, 0x18, 0xe0, 0xea, 0xdf, 0x55, 0x98, 0xd6, 0x74, 0xf8, 0x2d, 0x83, 0x6a, 0xe2, 0xc3, 0xb8,
-	0x58, 0x88, 0x2c, 0xbb, 0x74, 0xad, 0xd7, 0x46, 0x83, 0x8c, 0x26, 0xdb, 0x79, 0xf4, 0xe7, 0x7f,
-	0x3f, 0x57, 0x56, 0x70, 0x89, 0xe7, 0xef, 0xfb, 0xd4, 0xe9, 0xf9, 0x6e, 0xa6, 0xe0, 0x3d, 0xfc,
-	0x1a, 0x6a, 0xe9, 0x1d, 0x82, 0x23, 0x53, 0x24, 0xeb, 0x64, 0x5d, 0x3a, 0x02, 0x45, 0x4a, 0x16,
-	0xb4, 0x12, 0x0b, 0xeb, 0xc3, 0x94, 0xe0, 0x77, 0x0c, 0xa6, 0x62, 0x5f, 0xc3, 0x8b, 0x65, 0x8c,
-	0x99, 0x0b, 0xc4, 0x5a, 0x18, 0x0e, 0xa0, 0x6c, 0xb7, 0x74, 0xb6, 0x1b, 0x78, 0x6d, 0xbc, 0xba,
-	0xb9, 0x76, 0x52, 0xbe, 0xab, 0xaf, 0x93, 0x3d, 0x7c, 0xc4, 0x60, 0x5a, 0xdb, 0x31, 0x0e, 0xcd,
-	0x94, 0x96, 0xff, 0xea, 0x08, 0x04, 0x89, 0xb9, 0xa6, 0xc5, 0x38, 0xf8, 0xe6, 0x71, 0xc4, 0xe0,
-	0x43, 0x38, 0x45, 0x0e, 0x56, 0x9a, 0x22, 0x67, 0xd2, 0x96, 0x3d, 0x0a, 0x42, 0x32, 0x2e, 0x6b,
-	0x19, 0x97, 0x70, 0xb1, 0x28, 0x43, 0xc3, 0xf8, 0x6e, 0xc6, 0xe5, 0xf7, 0xf0, 0x17, 0x06, 0xa7,
-	0xe9, 0x1b, 0xc4, 0x52, 0xf2, 0xbc, 0x1f, 0x5a, 0x8b, 0x23, 0x31, 0xa4, 0xe0, 0xae, 0x56, 0x70,
-	0x1b, 0xdf, 0x19, 0xb3, 0x11, 0xc9, 0xb7, 0xcf, 0x77, 0x53, 0x7f, 0xdc, 0xc3, 0x1f, 0x18, 0x54,
-	0x13, 0x43, 0xc1, 0x51, 0x69, 0xe5, 0xc8, 0x4f, 0xa5, 0xe8, 0x49, 0xf6, 0x4d, 0x2d, 0xae, 0x89,
-	0xfc, 0x98, 0xe2, 0xf0, 0x31, 0x83, 0x99, 0xcc, 0xc7, 0x8d, 0x4b, 0x65, 0xe9, 0x0e, 0x9b, 0x8d,
-	0xb5, 0x7c, 0x24, 0xee, 0x29, 0xf7, 0x47, 0x9b, 0xcb, 0x9d, 0x0f, 0x7e, 0xdf, 0x6f, 0xb0, 0x27,
-	0xfb, 0x0d, 0xf6, 0xef, 0x7e, 0x83, 0xfd, 0x74, 0xd0, 0x98, 0x78, 0x72, 0xd0, 0x98, 0xf8, 0xeb,
-	0xa0, 0x31, 0xf1, 0xf9, 0x65, 0x3f, 0x50, 0x5f, 0xf4, 0x36, 0x9d, 0x2d, 0xb1, 0x9d, 0x30, 0x9a,
-	0x3f, 0x57, 0x64, 0xfb, 0x4b, 0xfe, 0x95, 0xa6, 0x8f, 0xb7, 0x40, 0xc6, 0x3f, 0x2e, 0x4e, 0xe9,
-	0xff, 0xfd, 0xaf, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xce, 0x57, 0x85, 0xcf, 0xa5, 0x0c, 0x00,
-	0x00,
+	// 958 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0xdc, 0x54,
+	0x14, 0xce, 0x9d, 0xfc, 0x74, 0xe6, 0xa4, 0x09, 0x70, 0x9a, 0x34, 0xc6, 0x94, 0x69, 0x70, 0x68,
+	0x12, 0x28, 0xb1, 0x99, 0xf4, 0x4f, 0x82, 0xb2, 0x68, 0x28, 0x29, 0x48, 0x2c, 0x82, 0x5b, 0xb1,
+	0x60, 0x13, 0x39, 0x19, 0xcb, 0x58, 0x4c, 0x7c, 0x5d, 0xdf, 0x3b, 0x23, 0x42, 0x1a, 0x21, 0x55,
+	0x42, 0xb0, 0x02, 0x24, 0x2a, 0xe0, 0x41, 0x78, 0x08, 0x96, 0x15, 0x6c, 0x10, 0x2b, 0x94, 0xf0,
+	0x20, 0xc8, 0xf7, 0x1e, 0x4f, 0x6c, 0x67, 0x66, 0x32, 0x53, 0x55, 0xac, 0x46, 0xbe, 0xf7, 0x3b,
+	0xdf, 0xf9, 0xce, 0xaf, 0xc7, 0xf0, 0xf2, 0x2e, 0x17, 0x7b, 0x5c, 0x38, 0x01, 0xef, 0x38, 0x9d,
+	0x86, 0xf3, 0xb0, 0xed, 0x27, 0xfb, 0x76, 0x9c, 0x70, 0xc9, 0x71, 0x46, 0x5f, 0xd9, 0x01, 0xef,
+	0xd8, 0x9d, 0x86, 0xf9, 0x26, 0x21, 0x77, 0x3c, 0xe1, 0x6b, 0x9c, 0xd3, 0x69, 0xec, 0xf8, 0xd2,
+	0x6b, 0x38, 0xb1, 0x17, 0x84, 0x91, 0x27, 0x43, 0x1e, 0x69, 0x53, 0xf3, 0x52, 0xc0, 0x79, 0xd0,
+	0xf2, 0x1d, 0x2f, 0x0e, 0x1d, 0x2f, 0x8a, 0xb8, 0x54, 0x97, 0x82, 0x6e, 0x17, 0x8a, 0x3e, 0x53,
+	0x7e, 0x7d, 0x41, 0x62, 0xb6, 0xd5, 0x93, 0x43, 0xee, 0xd5, 0x83, 0x75, 0x0b, 0xe6, 0x3e, 0x49,
+	0x7d, 0x6e, 0x25, 0x3c, 0xe6, 0xc2, 0x6b, 0xb9, 0xfe, 0xc3, 0xb6, 0x2f, 0x24, 0x5e, 0x86, 0xe9,
+	0x98, 0x8e, 0xb6, 0xc3, 0xa6, 0xc1, 0x16, 0xd9, 0xea, 0x84, 0x0b, 0xd9, 0xd1, 0x47, 0x4d, 0xeb,
+	0x63, 0x98, 0x2f, 0x19, 0x8a, 0x98, 0x47, 0xc2, 0xc7, 0x6b, 0x50, 0xcd, 0x60, 0xca, 0x6c, 0x7a,
+	0x7d, 0xc1, 0x2e, 0x44, 0x6c, 0x77, 0x4d, 0xba, 0x40, 0xeb, 0x87, 0x4a, 0x89, 0x4e, 0x64, 0x42,
+	0x36, 0xe1, 0x85, 0xae, 0x10, 0x21, 0x3d, 0xd9, 0x16, 0x8a, 0x75, 0x76, 0xfd, 0xd5, 0x3e, 0xac,
+	0xf7, 0x15, 0xc8, 0x9d, 0x8d, 0x0b, 0xcf, 0x68, 0xc3, 0x64, 0x87, 0x4b, 0x3f, 0x31, 0x2a, 0x8b,
+	0x6c, 0xb5, 0xb6, 0x61, 0xfc, 0xf1, 0xdb, 0xda, 0x1c, 0x11, 0xdc, 0x69, 0x36, 0x13, 0x5f, 0x88,
+	0xfb, 0x32, 0x09, 0xa3, 0xc0, 0xd5, 0x30, 0xbc, 0x09, 0xb5, 0xa6, 0x1f, 0x73, 0x11, 0x4a, 0x9e,
+	0x18, 0xe3, 0x67, 0xd8, 0x9c, 0x40, 0x71, 0x13, 0xe0, 0xa4, 0x6c, 0xc6, 0x84, 0x4a, 0xc0, 0x72,
+	0x26, 0x35, 0xad, 0xb1, 0xad, 0x7b, 0x81, 0x6a, 0x6c, 0x6f, 0x79, 0x81, 0x4f, 0xb1, 0xba, 0x39,
+	0x4b, 0xeb, 0x57, 0x06, 0x17, 0xcb, 0x19, 0xa1, 0x0c, 0xdf, 0x80, 0x5a, 0x16, 0x5c, 0x9a, 0x8c,
+	0xf1, 0x41, 0x29, 0x3e, 0x41, 0xe2, 0xbd, 0x82, 0xb2, 0x8a, 0x52, 0xb6, 0x72, 0xa6, 0x32, 0xed,
+	0xb3, 0x20, 0x6d, 0x17, 0x5e, 0x54, 0xca, 0x3e, 0xe5, 0xd2, 0x1f, 0xb6, 0x5f, 0x46, 0xcd, 0xbf,
+	0x75, 0x1b, 0x5e, 0xca, 0x39, 0xa1, 0xc8, 0x57, 0x60, 0x22, 0xbd, 0xa5, 0xbe, 0xba, 0x50, 0x0a,
+	0x5a, 0x41, 0x15, 0xc0, 0x7a, 0x94, 0xb3, 0x16, 0x43, 0x6b, 0xdc, 0xec, 0x91, 0xa1, 0x67, 0xa9,
+	0xdd, 0x77, 0x0c, 0x30, 0xef, 0x9e, 0xd4, 0xbf, 0xa1, 0x53, 0x90, 0xd5, 0xac, 0xa7, 0x7c, 0x8d,
+	0x78, 0x7e, 0xb5, 0xba, 0x41, 0x4a, 0xb6, 0xbc, 0xc4, 0xdb, 0x2b, 0x64, 0x42, 0x1d, 0x6c, 0xcb,
+	0xfd, 0x58, 0xa7, 0xb3, 0xe6, 0x82, 0x3e, 0x7a, 0xb0, 0x1f, 0xfb, 0xd6, 0xcf, 0x15, 0xb8, 0x50,
+	0xb0, 0xa3, 0x10, 0xee, 0xc2, 0x4c, 0x87, 0xcb, 0x30, 0x0a, 0xb6, 0x35, 0x98, 0x2a, 0xf1, 0xca,
+	0xe9, 0x50, 0xc2, 0x28, 0xd0, 0xb6, 0x1b, 0x15, 0x83, 0xb9, 0xe7, 0x3b, 0xb9, 0x13, 0xbc, 0x07,
+	0xb3, 0x34, 0x30, 0x19, 0x8d, 0x8e, 0xf0, 0x52, 0x89, 0xe6, 0xae, 0x06, 0xe5, 0x78, 0x66, 0x9a,
+	0xf9, 0x23, 0xbc, 0x03, 0xe7, 0xa5, 0xd7, 0x6a, 0xed, 0x67, 0x34, 0xe3, 0x8a, 0xc6, 0x2c, 0xd1,
+	0x3c, 0x48, 0x21, 0x39, 0x92, 0x69, 0x79, 0x72, 0x80, 0x6b, 0x30, 0x45, 0xc6, 0x7a, 0x56, 0xe7,
+	0xcb, 0x93, 0xa4, 0x13, 0x40, 0x20, 0x2b, 0xa2, 0xbc, 0x90, 0xb4, 0xa1, 0x5b, 0xab, 0xb0, 0x4e,
+	0x2a, 0x43, 0xaf, 0x13, 0xeb, 0x43, 0x98, 0x2b, 0xfa, 0xa3, 0x42, 0xbc, 0x0d, 0xe7, 0x08, 0x44,
+	0x25, 0xb8, 0xd8, 0x3b, 0x77, 0x6e, 0x06, 0xb3, 0xbe, 0x2e, 0x32, 0xfd, 0xff, 0x53, 0xf1, 0x84,
+	0xc1, 0x7c, 0x49, 0x01, 0x05, 0xb3, 0x0e, 0x55, 0x52, 0x99, 0xcd, 0x46, 0xbf, 0x68, 0xba, 0xb8,
+	0xe7, 0x37, 0x21, 0xef, 0xc0, 0x82, 0x52, 0xa5, 0xba, 0xc4, 0xf5, 0x45, 0xbb, 0x25, 0x47, 0x78,
+	0x09, 0x1a, 0xa7, 0x6d, 0xbb, 0x15, 0x9a, 0x54, 0x7d, 0x66, 0xb0, 0xfe, 0x4d, 0x49, 0x26, 0x1a,
+	0xb8, 0xfe, 0x77, 0x15, 0x26, 0x15, 0x1d, 0x7e, 0xc3, 0xa0, 0x9a, 0xad, 0x70, 0x5c, 0x2a, 0x59,
+	0xf6, 0x7a, 0x5f, 0x9b, 0xaf, 0x0f, 0x06, 0x69, 0x4d, 0x96, 0xfd, 0xf8, 0xcf, 0x7f, 0x7f, 0xaa,
+	0xac, 0xe2, 0xb2, 0x53, 0xfc, 0xab, 0x90, 0x85, 0x24, 0x9c, 0x83, 0x5c, 0xc0, 0x87, 0xf8, 0x15,
+	0xd4, 0x32, 0x0e, 0x81, 0x03, 0x5d, 0x64, 0xed, 0x64, 0x5e, 0x39, 0x03, 0x45, 0x4a, 0x16, 0x95,
+	0x12, 0x13, 0x8d, 0x7e, 0x4a, 0xf0, 0x5b, 0x06, 0x13, 0xe9, 0x4a, 0xc4, 0xcb, 0xbd, 0x18, 0x73,
+	0xef, 0x1e, 0x73, 0xb1, 0x3f, 0x80, 0xbc, 0xdd, 0x56, 0xde, 0x6e, 0xe2, 0xf5, 0xe1, 0xe2, 0x76,
+	0xd4, 0x12, 0x76, 0x0e, 0xd2, 0x9f, 0xe4, 0x10, 0x1f, 0x33, 0x98, 0x4c, 0xe9, 0x04, 0xf6, 0xf5,
+	0xd4, 0x0d, 0xff, 0xb5, 0x01, 0x08, 0x12, 0x73, 0x5d, 0x89, 0xb1, 0xf1, 0xad, 0x51, 0xc4, 0xe0,
+	0x23, 0x98, 0xa2, 0x8d, 0xd5, 0xd3, 0x45, 0x61, 0xbf, 0x9b, 0xd6, 0x20, 0x08, 0xc9, 0xb8, 0xaa,
+	0x64, 0x5c, 0xc1, 0xa5, 0xb2, 0x0c, 0x05, 0x73, 0x0e, 0x72, 0x2f, 0x88, 0x43, 0xfc, 0x85, 0xc1,
+	0x39, 0x9a, 0x41, 0xec, 0x49, 0x5e, 0xdc, 0x87, 0xe6, 0xd2, 0x40, 0x0c, 0x29, 0x78, 0x5f, 0x29,
+	0x78, 0x0f, 0xdf, 0x1d, 0x32, 0x11, 0xd9, 0xec, 0x3b, 0x07, 0xdd, 0xfd, 0x78, 0x88, 0xdf, 0x33,
+	0xa8, 0x12, 0xb1, 0xc0, 0x41, 0x6e, 0xc5, 0xc0, 0x51, 0x29, 0xef, 0x24, 0xeb, 0x96, 0x12, 0xd7,
+	0x40, 0x67, 0x44, 0x71, 0xf8, 0x84, 0xc1, 0x74, 0x6e, 0xb8, 0x71, 0xb9, 0x97, 0xbb, 0xd3, 0xcb,
+	0xc6, 0x5c, 0x39, 0x13, 0xf7, 0x8c, 0xfd, 0xa3, 0x96, 0xcb, 0xc6, 0x07, 0xbf, 0x1f, 0xd5, 0xd9,
+	0xd3, 0xa3, 0x3a, 0xfb, 0xe7, 0xa8, 0xce, 0x7e, 0x3c, 0xae, 0x8f, 0x3d, 0x3d, 0xae, 0x8f, 0xfd,
+	0x75, 0x5c, 0x1f, 0xfb, 0xec, 0x6a, 0x10, 0xca, 0xcf, 0xdb, 0x3b, 0xf6, 0x2e, 0xdf, 0xcb, 0x18,
+	0xf5, 0xcf, 0x9a, 0x68, 0x7e, 0xe1, 0x7c, 0xa9, 0xe8, 0xd3, 0x2e, 0x10, 0xe9, 0x77, 0xc9, 0x94,
+	0xfa, 0x6c, 0xb8, 0xf6, 0xdf, 0x00, 0x9f, 0xe4, 0xf6, 0xe9, 0xe0, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1681,6 +1698,18 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.TallyParams != nil {
 		{
 			size, err := m.TallyParams.MarshalToSizedBuffer(dAtA[:i])
@@ -2116,6 +2145,10 @@ func (m *QueryParamsResponse) Size() (n int) {
 		l = m.TallyParams.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3289,6 +3322,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -18,3 +18,22 @@ type Content interface {
 // Handler defines a function that handles a proposal after it has passed the
 // governance process.
 type Handler func(ctx sdk.Context, content Content) error
+
+// HandlerRoute is a wrapper around a legacy proposal Handler and the route it
+// should be registered under, so that modules can provide their proposal
+// handlers to the governance module using depinject.
+type HandlerRoute struct {
+	Handler  Handler
+	RouteKey string
+}
+
+// IsManyPerContainerType implements the depinject.ManyPerContainerType interface.
+func (HandlerRoute) IsManyPerContainerType() {}
+
+// NewHandlerRoute returns a HandlerRoute for the given route key and Handler.
+func NewHandlerRoute(routeKey string, handler Handler) HandlerRoute {
+	return HandlerRoute{
+		Handler:  handler,
+		RouteKey: routeKey,
+	}
+}
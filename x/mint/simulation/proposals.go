@@ -0,0 +1,40 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+const OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams randomizing the inflation
+// and bonded ratio goal of the current mint params.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := k.GetParams(ctx)
+		params.InflationRateChange = GenInflationRateChange(r)
+		params.InflationMax = GenInflationMax(r)
+		params.InflationMin = GenInflationMin(r)
+		params.GoalBonded = GenGoalBonded(r)
+
+		return &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+	}
+}
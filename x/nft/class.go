@@ -0,0 +1,63 @@
+package nft
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsMinter returns whether the given account is allowed to mint NFTs of the
+// class through Msg/MintNFT, according to the class mint policy.
+func (c Class) IsMinter(minter sdk.AccAddress) bool {
+	switch c.MintPolicy {
+	case MINT_POLICY_OPEN:
+		return true
+	case MINT_POLICY_ISSUER_ONLY:
+		return c.IsIssuer(minter)
+	case MINT_POLICY_ALLOWLIST:
+		if c.IsIssuer(minter) {
+			return true
+		}
+		for _, m := range c.Minters {
+			if m == minter.String() {
+				return true
+			}
+		}
+		return false
+	default:
+		return false
+	}
+}
+
+// IsIssuer returns whether the given account is the issuer of the class.
+func (c Class) IsIssuer(addr sdk.AccAddress) bool {
+	return c.Issuer != "" && c.Issuer == addr.String()
+}
+
+// ValidateTransfer returns an error if the transfer policy of the class
+// forbids sending its NFTs to the given receiver.
+func (c Class) ValidateTransfer(receiver sdk.AccAddress) error {
+	if c.Soulbound {
+		return sdkerrors.Wrapf(ErrNonTransferable, "nfts of class %s are soulbound", c.Id)
+	}
+
+	if len(c.TransferAllowlist) == 0 {
+		return nil
+	}
+	for _, r := range c.TransferAllowlist {
+		if r == receiver.String() {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrTransferNotAllowed, "%s is not in the transfer allowlist of class %s", receiver, c.Id)
+}
+
+// RoyaltyAmount returns the royalty owed to the class creator when one of its
+// NFTs is sold for the given price, rounded down. It is zero if the class
+// has no royalty.
+func (c Class) RoyaltyAmount(salePrice sdk.Coin) sdk.Coin {
+	if c.Royalty == nil {
+		return sdk.NewCoin(salePrice.Denom, sdk.ZeroInt())
+	}
+	amount := salePrice.Amount.MulRaw(int64(c.Royalty.BasisPoints)).QuoRaw(MaxRoyaltyBasisPoints)
+	return sdk.NewCoin(salePrice.Denom, amount)
+}
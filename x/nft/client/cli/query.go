@@ -39,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryRoyalty(),
 	)
 	return nftQueryCmd
 }
@@ -69,6 +70,41 @@ func GetCmdQueryClass() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryRoyalty implements the query royalty command.
+func GetCmdQueryRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "royalty [class-id] [sale-price]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "query the royalty of an NFT class, and the royalty amount owed for an optional sale price",
+		Example: fmt.Sprintf(`$ %s query %s royalty <class-id>
+$ %s query %s royalty <class-id> 1000stake`, version.AppName, nft.ModuleName, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &nft.QueryRoyaltyRequest{ClassId: args[0]}
+			if len(args) == 2 {
+				salePrice, err := sdk.ParseCoinNormalized(args[1])
+				if err != nil {
+					return err
+				}
+				req.SalePrice = &salePrice
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Royalty(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryClasses implements the query classes command.
 func GetCmdQueryClasses() *cobra.Command {
 	cmd := &cobra.Command{
@@ -13,6 +13,30 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values for the tx commands
+const (
+	FlagName        = "name"
+	FlagSymbol      = "symbol"
+	FlagDescription = "description"
+	FlagURI         = "uri"
+	FlagURIHash     = "uri-hash"
+	FlagMintPolicy  = "mint-policy"
+	FlagMinters     = "minters"
+	FlagReceiver    = "receiver"
+
+	FlagSoulbound          = "soulbound"
+	FlagRoyaltyRecipient   = "royalty-recipient"
+	FlagRoyaltyBasisPoints = "royalty-basis-points"
+	FlagTransferAllowlist  = "transfer-allowlist"
+)
+
+// mintPolicies maps the CLI names of the mint policies to their values
+var mintPolicies = map[string]nft.MintPolicy{
+	"issuer-only": nft.MINT_POLICY_ISSUER_ONLY,
+	"open":        nft.MINT_POLICY_OPEN,
+	"allowlist":   nft.MINT_POLICY_ALLOWLIST,
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +50,10 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdSaveClass(),
+		NewCmdMintNFT(),
+		NewCmdBurnNFT(),
+		NewCmdUpdateNFT(),
 	)
 
 	return nftTxCmd
@@ -58,3 +86,185 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdSaveClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class issued by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`Create a new nft class issued by the sender. The mint policy defines who can mint
+nfts of the class: only the issuer (issuer-only), anyone (open), or the issuer and the given minters (allowlist).
+The nfts of a soulbound class cannot be transferred, and those of a class with a transfer allowlist can only
+be transferred to the listed accounts. The royalty is informational, for marketplaces to pay on secondary sales.
+
+Example:
+$ %s tx %s save-class <class-id> --name <name> --mint-policy allowlist --minters <minter1>,<minter2> --from <issuer> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			symbol, _ := cmd.Flags().GetString(FlagSymbol)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			minters, _ := cmd.Flags().GetStringSlice(FlagMinters)
+
+			policyName, _ := cmd.Flags().GetString(FlagMintPolicy)
+			policy, ok := mintPolicies[policyName]
+			if !ok {
+				return fmt.Errorf("invalid mint policy %q, must be one of issuer-only, open or allowlist", policyName)
+			}
+
+			soulbound, _ := cmd.Flags().GetBool(FlagSoulbound)
+			allowlist, _ := cmd.Flags().GetStringSlice(FlagTransferAllowlist)
+
+			var royalty *nft.Royalty
+			recipient, _ := cmd.Flags().GetString(FlagRoyaltyRecipient)
+			basisPoints, _ := cmd.Flags().GetUint32(FlagRoyaltyBasisPoints)
+			if recipient != "" || basisPoints != 0 {
+				royalty = &nft.Royalty{Recipient: recipient, BasisPoints: basisPoints}
+			}
+
+			msg := nft.MsgSaveClass{
+				ClassId:     args[0],
+				Name:        name,
+				Symbol:      symbol,
+				Description: description,
+				Uri:         uri,
+				UriHash:     uriHash,
+				Issuer:      clientCtx.GetFromAddress().String(),
+				MintPolicy:  policy,
+				Minters:     minters,
+
+				Soulbound:         soulbound,
+				Royalty:           royalty,
+				TransferAllowlist: allowlist,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "The description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the class metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagMintPolicy, "issuer-only", "Who can mint nfts of the class (issuer-only|open|allowlist)")
+	cmd.Flags().StringSlice(FlagMinters, []string{}, "The accounts allowed to mint with the allowlist mint policy")
+	cmd.Flags().Bool(FlagSoulbound, false, "Make the nfts of the class non-transferable")
+	cmd.Flags().String(FlagRoyaltyRecipient, "", "The account receiving the royalties on secondary sales")
+	cmd.Flags().Uint32(FlagRoyaltyBasisPoints, 0, "The royalty owed on secondary sales, in basis points of the sale price")
+	cmd.Flags().StringSlice(FlagTransferAllowlist, []string{}, "The only accounts the nfts of the class can be transferred to")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMintNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] --from [minter]",
+		Args:  cobra.ExactArgs(2),
+		Short: "mint a new nft of a class",
+		Long: strings.TrimSpace(fmt.Sprintf(`Mint a new nft of a class, which the sender must be allowed to mint by the class mint policy.
+The nft is minted to the sender unless a receiver is given.
+
+Example:
+$ %s tx %s mint <class-id> <nft-id> --uri <uri> --receiver <receiver> --from <minter> --chain-id <chain-id>`,
+			version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			receiver, _ := cmd.Flags().GetString(FlagReceiver)
+			if receiver == "" {
+				receiver = clientCtx.GetFromAddress().String()
+			}
+
+			msg := nft.MsgMintNFT{
+				ClassId:  args[0],
+				Id:       args[1],
+				Uri:      uri,
+				UriHash:  uriHash,
+				Minter:   clientCtx.GetFromAddress().String(),
+				Receiver: receiver,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagReceiver, "", "The receiver of the nft, defaults to the sender")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurnNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurnNFT{
+				ClassId: args[0],
+				Id:      args[1],
+				Owner:   clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdateNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft, signed by the class issuer",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgUpdateNFT{
+				ClassId: args[0],
+				Id:      args[1],
+				Uri:     uri,
+				UriHash: uriHash,
+				Issuer:  clientCtx.GetFromAddress().String(),
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The new uri of the nft metadata stored off chain")
+	cmd.Flags().String(FlagURIHash, "", "The new hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -435,3 +436,55 @@ func (s *IntegrationTestSuite) TestQuerySupply() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryRoyalty() {
+	val := s.network.Validators[0]
+	salePrice := sdk.NewInt64Coin(s.cfg.BondDenom, 1000)
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		errorMsg     string
+		expectResult nft.QueryRoyaltyResponse
+	}{
+		{
+			name:      "class id does not exist",
+			args:      []string{"class"},
+			expectErr: true,
+			errorMsg:  "not found class",
+		},
+		{
+			name:      "invalid sale price",
+			args:      []string{testClassID, "1000"},
+			expectErr: true,
+		},
+		{
+			name:         "without sale price",
+			args:         []string{testClassID},
+			expectResult: nft.QueryRoyaltyResponse{Royalty: ExpClass.Royalty},
+		},
+		{
+			name: "with sale price",
+			args: []string{testClassID, salePrice.String()},
+			expectResult: nft.QueryRoyaltyResponse{
+				Royalty: ExpClass.Royalty,
+				Amount:  &sdk.Coin{Denom: s.cfg.BondDenom, Amount: sdk.NewInt(25)},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			resp, err := ExecQueryRoyalty(val, tc.args...)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errorMsg)
+			} else {
+				s.Require().NoError(err)
+				var result nft.QueryRoyaltyResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(resp.Bytes(), &result)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectResult, result)
+			}
+		})
+	}
+}
@@ -16,6 +16,21 @@ func ExecSend(val *network.Validator, args []string) (testutil.BufferWriter, err
 	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
 }
 
+func ExecSaveClass(val *network.Validator, args []string) (testutil.BufferWriter, error) {
+	cmd := cli.NewCmdSaveClass()
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
+
+func ExecMintNFT(val *network.Validator, args []string) (testutil.BufferWriter, error) {
+	cmd := cli.NewCmdMintNFT()
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
+
+func ExecBurnNFT(val *network.Validator, args []string) (testutil.BufferWriter, error) {
+	cmd := cli.NewCmdBurnNFT()
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
+
 func ExecQueryClass(val *network.Validator, classID string) (testutil.BufferWriter, error) {
 	cmd := cli.GetCmdQueryClass()
 	var args []string
@@ -74,3 +89,9 @@ func ExecQuerySupply(val *network.Validator, classID string) (testutil.BufferWri
 	args = append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
 	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
 }
+
+func ExecQueryRoyalty(val *network.Validator, args ...string) (testutil.BufferWriter, error) {
+	cmd := cli.GetCmdQueryRoyalty()
+	args = append(args, fmt.Sprintf("--%s=json", tmcli.OutputFlag))
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 
 	"github.com/cosmos/cosmos-sdk/x/nft"
@@ -42,6 +44,14 @@ var (
 		Symbol:      testClassSymbol,
 		Description: testClassDescription,
 		Uri:         testClassURI,
+		Issuer:      Owner,
+		MintPolicy:  nft.MINT_POLICY_ISSUER_ONLY,
+		Minters:     []string{},
+		Royalty: &nft.Royalty{
+			Recipient:   Owner,
+			BasisPoints: 250,
+		},
+		TransferAllowlist: []string{},
 	}
 
 	ExpNFT = nft.NFT{
@@ -142,6 +152,79 @@ func (s *IntegrationTestSuite) TestCLITxSend() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLITxSaveClassMintBurn() {
+	val := s.network.Validators[0]
+	ownerArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	valArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	testCases := []struct {
+		name         string
+		exec         func(val *network.Validator, args []string) (testutil.BufferWriter, error)
+		args         []string
+		expectedCode uint32
+	}{
+		{
+			"save existing class",
+			ExecSaveClass,
+			append([]string{testClassID}, ownerArgs...),
+			nft.ErrClassExists.ABCICode(),
+		},
+		{
+			"mint by non issuer",
+			ExecMintNFT,
+			append([]string{testClassID, "kitty2"}, valArgs...),
+			sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"mint by issuer",
+			ExecMintNFT,
+			append([]string{testClassID, "kitty2"}, ownerArgs...),
+			0,
+		},
+		{
+			"mint existing nft",
+			ExecMintNFT,
+			append([]string{testClassID, "kitty2"}, ownerArgs...),
+			nft.ErrNFTExists.ABCICode(),
+		},
+		{
+			"burn by non owner",
+			ExecBurnNFT,
+			append([]string{testClassID, "kitty2"}, valArgs...),
+			sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			"burn by owner",
+			ExecBurnNFT,
+			append([]string{testClassID, "kitty2"}, ownerArgs...),
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+			out, err := tc.exec(val, tc.args)
+			s.Require().NoError(err)
+
+			var txResp sdk.TxResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+			s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) initAccount() {
 	val := s.network.Validators[0]
 	ctx := val.ClientCtx
@@ -9,6 +9,10 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgSaveClass{},
+		&MsgMintNFT{},
+		&MsgBurnNFT{},
+		&MsgUpdateNFT{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
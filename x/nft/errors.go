@@ -13,4 +13,10 @@ var (
 	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 6, "nft does not exist")
 	ErrInvalidID      = sdkerrors.Register(ModuleName, 7, "invalid id")
 	ErrInvalidClassID = sdkerrors.Register(ModuleName, 8, "invalid class id")
+	ErrInvalidPolicy  = sdkerrors.Register(ModuleName, 9, "invalid mint policy")
+	ErrInvalidRoyalty = sdkerrors.Register(ModuleName, 10, "invalid royalty")
+
+	ErrInvalidTransferPolicy = sdkerrors.Register(ModuleName, 11, "invalid transfer policy")
+	ErrNonTransferable       = sdkerrors.Register(ModuleName, 12, "nft is not transferable")
+	ErrTransferNotAllowed    = sdkerrors.Register(ModuleName, 13, "nft transfer not allowed")
 )
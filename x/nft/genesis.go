@@ -10,6 +10,20 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if class.Issuer != "" {
+			if _, err := sdk.AccAddressFromBech32(class.Issuer); err != nil {
+				return err
+			}
+		}
+		if err := ValidateMintPolicy(class.MintPolicy, class.Minters); err != nil {
+			return err
+		}
+		if err := ValidateRoyalty(class.Royalty); err != nil {
+			return err
+		}
+		if err := ValidateTransferPolicy(class.Soulbound, class.TransferAllowlist); err != nil {
+			return err
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
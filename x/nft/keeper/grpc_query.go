@@ -171,6 +171,37 @@ func (k Keeper) Class(goCtx context.Context, r *nft.QueryClassRequest) (*nft.Que
 	return &nft.QueryClassResponse{Class: &class}, nil
 }
 
+// Royalty return the royalty of an NFT class, and the royalty amount owed for the sale price if given,
+// similar to royaltyInfo in ERC2981
+func (k Keeper) Royalty(goCtx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if r.SalePrice != nil {
+		if err := r.SalePrice.Validate(); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassNotExists.Wrapf("not found class: %s", r.ClassId)
+	}
+
+	res := &nft.QueryRoyaltyResponse{Royalty: class.Royalty}
+	if r.SalePrice != nil {
+		amount := class.RoyaltyAmount(*r.SalePrice)
+		res.Amount = &amount
+	}
+	return res, nil
+}
+
 // Classes return all NFT classes
 func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft.QueryClassesResponse, error) {
 	if r == nil {
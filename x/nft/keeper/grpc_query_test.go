@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -590,3 +591,103 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestRoyalty() {
+	var req *nft.QueryRoyaltyRequest
+	royalty := &nft.Royalty{Recipient: s.addrs[0].String(), BasisPoints: 250}
+	testCases := []struct {
+		msg      string
+		malleate func(index int, require *require.Assertions)
+		expError string
+		postTest func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse)
+	}{
+		{
+			"fail empty ClassId",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyRequest{}
+			},
+			"invalid class id",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse) {},
+		},
+		{
+			"fail ClassId not exist",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyRequest{
+					ClassId: "kitty1",
+				}
+			},
+			"not found class",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse) {},
+		},
+		{
+			"fail invalid sale price",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyRequest{
+					ClassId:   testClassID,
+					SalePrice: &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)},
+				}
+			},
+			"invalid sale price",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse) {},
+		},
+		{
+			"success class without royalty",
+			func(index int, require *require.Assertions) {
+				err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "kitty2"})
+				require.NoError(err, "the error occurred on:%d", index)
+				req = &nft.QueryRoyaltyRequest{
+					ClassId:   "kitty2",
+					SalePrice: &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(1000)},
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse) {
+				require.Nil(res.Royalty, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 0), *res.Amount, "the error occurred on:%d", index)
+			},
+		},
+		{
+			"success without sale price",
+			func(index int, require *require.Assertions) {
+				err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Royalty: royalty})
+				require.NoError(err, "the error occurred on:%d", index)
+				req = &nft.QueryRoyaltyRequest{
+					ClassId: testClassID,
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse) {
+				require.Equal(royalty, res.Royalty, "the error occurred on:%d", index)
+				require.Nil(res.Amount, "the error occurred on:%d", index)
+			},
+		},
+		{
+			"success with sale price",
+			func(index int, require *require.Assertions) {
+				req = &nft.QueryRoyaltyRequest{
+					ClassId:   testClassID,
+					SalePrice: &sdk.Coin{Denom: "stake", Amount: sdk.NewInt(1999)},
+				}
+			},
+			"",
+			func(index int, require *require.Assertions, res *nft.QueryRoyaltyResponse) {
+				require.Equal(royalty, res.Royalty, "the error occurred on:%d", index)
+				require.Equal(sdk.NewInt64Coin("stake", 49), *res.Amount, "the error occurred on:%d", index)
+			},
+		},
+	}
+	for index, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			require := s.Require()
+			tc.malleate(index, require)
+			result, err := s.queryClient.Royalty(gocontext.Background(), req)
+			if tc.expError == "" {
+				require.NoError(err)
+			} else {
+				require.Error(err)
+				require.Contains(err.Error(), tc.expError)
+			}
+			tc.postTest(index, require, result)
+		})
+	}
+}
@@ -317,6 +317,31 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+func (s *TestSuite) TestTransferPolicy() {
+	soulbound := nft.Class{Id: testClassID, Soulbound: true}
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, soulbound))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	err := s.nftKeeper.Transfer(s.ctx, testClassID, testID, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// soulbound nfts can still be burned
+	s.Require().NoError(s.nftKeeper.Burn(s.ctx, testClassID, testID))
+
+	allowlisted := nft.Class{Id: "kitty2", TransferAllowlist: []string{s.addrs[1].String()}}
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, allowlisted))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: "kitty2", Id: testID}, s.addrs[0]))
+
+	err = s.nftKeeper.Transfer(s.ctx, "kitty2", testID, s.addrs[2])
+	s.Require().ErrorIs(err, nft.ErrTransferNotAllowed)
+	s.Require().Equal(s.addrs[0], s.nftKeeper.GetOwner(s.ctx, "kitty2", testID))
+
+	err = s.nftKeeper.Transfer(s.ctx, "kitty2", testID, s.addrs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, "kitty2", testID))
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
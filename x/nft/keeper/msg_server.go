@@ -8,10 +8,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ nft.MsgServer = msgServer{}
 
 // Send implement Send method of the types.MsgServer.
-func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
@@ -40,3 +50,125 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SaveClass implement SaveClass method of the types.MsgServer.
+func (k msgServer) SaveClass(goCtx context.Context, msg *nft.MsgSaveClass) (*nft.MsgSaveClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	class := nft.Class{
+		Id:          msg.ClassId,
+		Name:        msg.Name,
+		Symbol:      msg.Symbol,
+		Description: msg.Description,
+		Uri:         msg.Uri,
+		UriHash:     msg.UriHash,
+		Issuer:      msg.Issuer,
+		MintPolicy:  msg.MintPolicy,
+		Minters:     msg.Minters,
+
+		Soulbound:         msg.Soulbound,
+		Royalty:           msg.Royalty,
+		TransferAllowlist: msg.TransferAllowlist,
+	}
+	if err := k.Keeper.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventSaveClass{
+		ClassId: msg.ClassId,
+		Issuer:  msg.Issuer,
+	})
+	return &nft.MsgSaveClassResponse{}, nil
+}
+
+// MintNFT implement MintNFT method of the types.MsgServer.
+func (k msgServer) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	minter, err := sdk.AccAddressFromBech32(msg.Minter)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if !class.IsMinter(minter) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nft of class %s", minter, msg.ClassId)
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+	}
+	if err := k.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implement BurnNFT method of the types.MsgServer.
+func (k msgServer) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	issuer, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if !class.IsIssuer(issuer) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", issuer, msg.ClassId)
+	}
+
+	token, has := k.GetNFT(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	token.Uri = msg.Uri
+	token.UriHash = msg.UriHash
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdateNFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
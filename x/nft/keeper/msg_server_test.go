@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/keeper"
+)
+
+func (s *TestSuite) TestMsgSaveClass() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	msg := &nft.MsgSaveClass{
+		ClassId:    testClassID,
+		Name:       testClassName,
+		Symbol:     testClassSymbol,
+		Uri:        testClassURI,
+		UriHash:    testClassURIHash,
+		Issuer:     s.addrs[0].String(),
+		MintPolicy: nft.MINT_POLICY_ALLOWLIST,
+		Minters:    []string{s.addrs[1].String()},
+	}
+	_, err := msgServer.SaveClass(goCtx, msg)
+	s.Require().NoError(err)
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(s.addrs[0].String(), class.Issuer)
+	s.Require().Equal(nft.MINT_POLICY_ALLOWLIST, class.MintPolicy)
+	s.Require().Equal([]string{s.addrs[1].String()}, class.Minters)
+
+	// saving the same class twice fails
+	_, err = msgServer.SaveClass(goCtx, msg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestMsgMintNFT() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := msgServer.SaveClass(goCtx, &nft.MsgSaveClass{
+		ClassId:    testClassID,
+		Issuer:     s.addrs[0].String(),
+		MintPolicy: nft.MINT_POLICY_ALLOWLIST,
+		Minters:    []string{s.addrs[1].String()},
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		msg    string
+		req    *nft.MsgMintNFT
+		expErr bool
+	}{
+		{
+			"class does not exist",
+			&nft.MsgMintNFT{ClassId: "kitty2", Id: testID, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			true,
+		},
+		{
+			"minter is not allowed",
+			&nft.MsgMintNFT{ClassId: testClassID, Id: testID, Minter: s.addrs[2].String(), Receiver: s.addrs[2].String()},
+			true,
+		},
+		{
+			"issuer can always mint",
+			&nft.MsgMintNFT{ClassId: testClassID, Id: testID, Uri: testURI, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			false,
+		},
+		{
+			"allowlisted minter",
+			&nft.MsgMintNFT{ClassId: testClassID, Id: "kitty2", Minter: s.addrs[1].String(), Receiver: s.addrs[1].String()},
+			false,
+		},
+		{
+			"nft already exists",
+			&nft.MsgMintNFT{ClassId: testClassID, Id: testID, Minter: s.addrs[0].String(), Receiver: s.addrs[2].String()},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			_, err := msgServer.MintNFT(goCtx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			receiver := s.nftKeeper.GetOwner(s.ctx, tc.req.ClassId, tc.req.Id)
+			s.Require().Equal(tc.req.Receiver, receiver.String())
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgBurnNFT() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	_, err := msgServer.BurnNFT(goCtx, &nft.MsgBurnNFT{ClassId: testClassID, Id: testID, Owner: s.addrs[1].String()})
+	s.Require().Error(err)
+	s.Require().True(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+
+	_, err = msgServer.BurnNFT(goCtx, &nft.MsgBurnNFT{ClassId: testClassID, Id: testID, Owner: s.addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestMsgUpdateNFT() {
+	msgServer := keeper.NewMsgServerImpl(s.nftKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := msgServer.SaveClass(goCtx, &nft.MsgSaveClass{
+		ClassId:    testClassID,
+		Issuer:     s.addrs[0].String(),
+		MintPolicy: nft.MINT_POLICY_OPEN,
+	})
+	s.Require().NoError(err)
+	s.Require().NoError(s.nftKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, s.addrs[1]))
+
+	// only the class issuer can update an nft, not its owner
+	_, err = msgServer.UpdateNFT(goCtx, &nft.MsgUpdateNFT{ClassId: testClassID, Id: testID, Uri: "new uri", Issuer: s.addrs[1].String()})
+	s.Require().Error(err)
+
+	_, err = msgServer.UpdateNFT(goCtx, &nft.MsgUpdateNFT{ClassId: testClassID, Id: "kitty2", Uri: "new uri", Issuer: s.addrs[0].String()})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	_, err = msgServer.UpdateNFT(goCtx, &nft.MsgUpdateNFT{ClassId: testClassID, Id: testID, Uri: "new uri", UriHash: testURIHash, Issuer: s.addrs[0].String()})
+	s.Require().NoError(err)
+
+	token, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal("new uri", token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+}
@@ -91,16 +91,23 @@ func (k Keeper) updateWithNoCheck(ctx sdk.Context, token nft.NFT) {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// The transfer fails if the class is soulbound, or if the receiver is not in the
+// transfer allowlist of the class.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx sdk.Context,
 	classID string,
 	nftID string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
 	}
 
+	if err := class.ValidateTransfer(receiver); err != nil {
+		return err
+	}
+
 	if !k.HasNFT(ctx, classID, nftID) {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
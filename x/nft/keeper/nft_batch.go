@@ -63,15 +63,20 @@ func (k Keeper) BatchUpdate(ctx sdk.Context, tokens []nft.NFT) error {
 }
 
 // BatchTransfer defines a method for sending a batch of nfts from one account to another account from a specific classID.
+// The transfer policy of the class is enforced as in Transfer.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) BatchTransfer(ctx sdk.Context,
 	classID string,
 	nftIDs []string,
 	receiver sdk.AccAddress,
 ) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
 	}
+	if err := class.ValidateTransfer(receiver); err != nil {
+		return err
+	}
 	for _, nftID := range nftIDs {
 		if !k.HasNFT(ctx, classID, nftID) {
 			return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
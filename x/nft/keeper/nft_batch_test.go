@@ -314,6 +314,17 @@ func (s *TestSuite) TestBatchTransfer() {
 			[]string{"nftID1", "nftID2"},
 			false,
 		},
+		{
+			"failed with soulbound class",
+			func() {
+				err := s.nftKeeper.SaveClass(s.ctx, nft.Class{Id: "classID1", Soulbound: true})
+				s.Require().NoError(err)
+				s.nftKeeper.BatchMint(s.ctx, tokens[:2], owner)
+			},
+			"classID1",
+			[]string{"nftID1", "nftID2"},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
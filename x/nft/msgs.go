@@ -7,10 +7,20 @@ import (
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend      = "send"
+	TypeMsgSaveClass = "save_class"
+	TypeMsgMintNFT   = "mint_nft"
+	TypeMsgBurnNFT   = "burn_nft"
+	TypeMsgUpdateNFT = "update_nft"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgSaveClass{}
+	_ sdk.Msg = &MsgMintNFT{}
+	_ sdk.Msg = &MsgBurnNFT{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +49,107 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSaveClass) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	if m.MintPolicy == MINT_POLICY_UNSPECIFIED {
+		return sdkerrors.Wrap(ErrInvalidPolicy, "mint policy must be specified")
+	}
+	if err := ValidateMintPolicy(m.MintPolicy, m.Minters); err != nil {
+		return err
+	}
+
+	if err := ValidateRoyalty(m.Royalty); err != nil {
+		return err
+	}
+	return ValidateTransferPolicy(m.Soulbound, m.TransferAllowlist)
+}
+
+// GetSigners implements Msg
+func (m MsgSaveClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMintNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Minter)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", m.Minter)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Receiver)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMintNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Minter)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurnNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	_, err := sdk.AccAddressFromBech32(m.Issuer)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
@@ -6,6 +6,7 @@ package nft
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -23,6 +24,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines which accounts are allowed to mint NFTs of a class through Msg/MintNFT.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_UNSPECIFIED defines an unspecified mint policy. NFTs of a class with an unspecified
+	// mint policy can only be minted by other modules through the keeper.
+	MINT_POLICY_UNSPECIFIED MintPolicy = 0
+	// MINT_POLICY_ISSUER_ONLY only allows the class issuer to mint.
+	MINT_POLICY_ISSUER_ONLY MintPolicy = 1
+	// MINT_POLICY_OPEN allows any account to mint.
+	MINT_POLICY_OPEN MintPolicy = 2
+	// MINT_POLICY_ALLOWLIST allows the class issuer and the class minters to mint.
+	MINT_POLICY_ALLOWLIST MintPolicy = 3
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_UNSPECIFIED",
+	1: "MINT_POLICY_ISSUER_ONLY",
+	2: "MINT_POLICY_OPEN",
+	3: "MINT_POLICY_ALLOWLIST",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_UNSPECIFIED": 0,
+	"MINT_POLICY_ISSUER_ONLY": 1,
+	"MINT_POLICY_OPEN":        2,
+	"MINT_POLICY_ALLOWLIST":   3,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -39,6 +77,22 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// issuer is the address of the account which created the class through Msg/SaveClass. Optional
+	Issuer string `protobuf:"bytes,8,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// mint_policy defines which accounts are allowed to mint NFTs of the class through Msg/MintNFT.
+	MintPolicy MintPolicy `protobuf:"varint,9,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+	// minters is the list of accounts, besides the issuer, allowed to mint NFTs of the class
+	// when mint_policy is MINT_POLICY_ALLOWLIST.
+	Minters []string `protobuf:"bytes,10,rep,name=minters,proto3" json:"minters,omitempty"`
+	// soulbound, if true, makes the NFTs of the class non-transferable: they stay with the account they are
+	// minted to until burned. Optional
+	Soulbound bool `protobuf:"varint,11,opt,name=soulbound,proto3" json:"soulbound,omitempty"`
+	// royalty defines the royalty owed to the class creator on secondary sales of the NFTs of the class. It is
+	// not enforced by the module, marketplaces read it through Query/Royalty. Optional
+	Royalty *Royalty `protobuf:"bytes,12,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// transfer_allowlist, if not empty, restricts the transfers of the NFTs of the class to the listed receivers.
+	// Optional
+	TransferAllowlist []string `protobuf:"bytes,13,rep,name=transfer_allowlist,json=transferAllowlist,proto3" json:"transfer_allowlist,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +177,103 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Class) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MINT_POLICY_UNSPECIFIED
+}
+
+func (m *Class) GetMinters() []string {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *Class) GetSoulbound() bool {
+	if m != nil {
+		return m.Soulbound
+	}
+	return false
+}
+
+func (m *Class) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *Class) GetTransferAllowlist() []string {
+	if m != nil {
+		return m.TransferAllowlist
+	}
+	return nil
+}
+
+// Royalty defines the royalty owed to the creator of an NFT class on secondary sales, similar to ERC2981.
+type Royalty struct {
+	// recipient is the address of the account receiving the royalties
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// basis_points is the share of the sale price owed as royalty, in basis points (1/10000)
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+func (m *Royalty) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Royalty) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -141,7 +292,7 @@ func (m *NFT) Reset()         { *m = NFT{} }
 func (m *NFT) String() string { return proto.CompactTextString(m) }
 func (*NFT) ProtoMessage()    {}
 func (*NFT) Descriptor() ([]byte, []int) {
-	return fileDescriptor_eb8ebf8e8053172c, []int{1}
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
 }
 func (m *NFT) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,34 +357,52 @@ func (m *NFT) GetData() *types.Any {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
+	proto.RegisterType((*Royalty)(nil), "cosmos.nft.v1beta1.Royalty")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 572 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0x8e, 0xe3, 0xb4, 0x6e, 0x4f, 0xda, 0xca, 0xff, 0xa8, 0x3f, 0x4c, 0x2f, 0xb2, 0x4c, 0x56,
+	0x11, 0x52, 0x6d, 0xb5, 0x88, 0x1d, 0x12, 0x2a, 0x25, 0x15, 0x46, 0x69, 0x12, 0x39, 0xad, 0x50,
+	0xd9, 0x58, 0xe3, 0x4b, 0x93, 0x11, 0xb6, 0x27, 0xf2, 0x8c, 0x01, 0x2f, 0x58, 0xc3, 0x92, 0x77,
+	0xe0, 0x11, 0x78, 0x09, 0x96, 0x5d, 0xb2, 0x44, 0xed, 0x8b, 0x20, 0x8f, 0x9d, 0x36, 0x82, 0x48,
+	0xac, 0x7c, 0xbe, 0x8b, 0x3c, 0x9f, 0xfd, 0x9d, 0x81, 0xfd, 0x80, 0xf1, 0x84, 0x71, 0x3b, 0xbd,
+	0x12, 0xf6, 0xfb, 0x43, 0x3f, 0x12, 0xe4, 0xb0, 0x9c, 0xad, 0x59, 0xc6, 0x04, 0x43, 0xa8, 0x52,
+	0xad, 0x92, 0xa9, 0xd5, 0xdd, 0xed, 0x09, 0x9b, 0x30, 0x29, 0xdb, 0xe5, 0x54, 0x39, 0x77, 0x77,
+	0x26, 0x8c, 0x4d, 0xe2, 0xc8, 0x96, 0xc8, 0xcf, 0xaf, 0x6c, 0x92, 0x16, 0x95, 0xd4, 0xf9, 0xae,
+	0xc2, 0xca, 0x49, 0x4c, 0x38, 0x47, 0x5b, 0xd0, 0xa4, 0x21, 0x56, 0x4c, 0xa5, 0xbb, 0xee, 0x36,
+	0x69, 0x88, 0x10, 0xb4, 0x52, 0x92, 0x44, 0xb8, 0x29, 0x19, 0x39, 0xa3, 0x07, 0xb0, 0xca, 0x8b,
+	0xc4, 0x67, 0x31, 0x56, 0x25, 0x5b, 0x23, 0x64, 0x42, 0x3b, 0x8c, 0x78, 0x90, 0xd1, 0x99, 0xa0,
+	0x2c, 0xc5, 0x2d, 0x29, 0x2e, 0x52, 0x48, 0x07, 0x35, 0xcf, 0x28, 0x5e, 0x91, 0x4a, 0x39, 0xa2,
+	0x1d, 0x58, 0xcb, 0x33, 0xea, 0x4d, 0x09, 0x9f, 0xe2, 0x55, 0x49, 0x6b, 0x79, 0x46, 0x5f, 0x11,
+	0x3e, 0x45, 0x5d, 0x68, 0x85, 0x44, 0x10, 0xac, 0x99, 0x4a, 0xb7, 0x7d, 0xb4, 0x6d, 0x55, 0xf1,
+	0xad, 0x79, 0x7c, 0xeb, 0x38, 0x2d, 0x5c, 0xe9, 0x28, 0x03, 0x51, 0xce, 0xf3, 0x28, 0xc3, 0x6b,
+	0x55, 0xa0, 0x0a, 0xa1, 0xe7, 0xd0, 0x4e, 0x68, 0x2a, 0xbc, 0x19, 0x8b, 0x69, 0x50, 0xe0, 0x75,
+	0x53, 0xe9, 0x6e, 0x1d, 0x19, 0xd6, 0xdf, 0x7f, 0xcc, 0x3a, 0xa3, 0xa9, 0x18, 0x49, 0x97, 0x0b,
+	0xc9, 0xdd, 0x8c, 0x30, 0x68, 0x25, 0x8a, 0x32, 0x8e, 0xc1, 0x54, 0xcb, 0x70, 0x35, 0x44, 0xfb,
+	0xb0, 0xce, 0x59, 0x1e, 0xfb, 0x2c, 0x4f, 0x43, 0xdc, 0x36, 0x95, 0xee, 0x9a, 0x7b, 0x4f, 0xa0,
+	0xa7, 0xa0, 0x65, 0xac, 0x20, 0xb1, 0x28, 0xf0, 0x86, 0x4c, 0xbf, 0xb7, 0xec, 0x50, 0xb7, 0xb2,
+	0xb8, 0x73, 0x2f, 0x3a, 0x00, 0x24, 0x32, 0x92, 0xf2, 0xab, 0x28, 0xf3, 0x48, 0x1c, 0xb3, 0x0f,
+	0x31, 0xe5, 0x02, 0x6f, 0xca, 0x93, 0xff, 0x9b, 0x2b, 0xc7, 0x73, 0xa1, 0xf3, 0x1a, 0xb4, 0xfa,
+	0x15, 0x65, 0x9c, 0x2c, 0x0a, 0xe8, 0x8c, 0x46, 0xa9, 0xa8, 0xdb, 0xbb, 0x27, 0xd0, 0x23, 0xd8,
+	0xf0, 0x09, 0xa7, 0xdc, 0x9b, 0x31, 0x9a, 0x0a, 0x2e, 0xcb, 0xdc, 0x74, 0xdb, 0x92, 0x1b, 0x49,
+	0xaa, 0xf3, 0x59, 0x01, 0x75, 0x70, 0x7a, 0x5e, 0xf6, 0x11, 0x94, 0x8b, 0xe0, 0xdd, 0x6d, 0x81,
+	0x26, 0xb1, 0x13, 0xd6, 0xab, 0xd1, 0xbc, 0x5b, 0x8d, 0xba, 0x4c, 0x75, 0x79, 0x99, 0xad, 0xe5,
+	0x65, 0xc2, 0xbf, 0xca, 0x7c, 0xfc, 0x09, 0xe0, 0xbe, 0x0d, 0xb4, 0x07, 0x0f, 0xcf, 0x9c, 0xc1,
+	0xb9, 0x37, 0x1a, 0xf6, 0x9d, 0x93, 0x4b, 0xef, 0x62, 0x30, 0x1e, 0xf5, 0x4e, 0x9c, 0x53, 0xa7,
+	0xf7, 0x52, 0x6f, 0xfc, 0x29, 0x3a, 0xe3, 0xf1, 0x45, 0xcf, 0xf5, 0x86, 0x83, 0xfe, 0xa5, 0xae,
+	0xa0, 0x6d, 0xd0, 0x17, 0xc5, 0xe1, 0xa8, 0x37, 0xd0, 0x9b, 0x68, 0x07, 0xfe, 0x5f, 0x64, 0x8f,
+	0xfb, 0xfd, 0xe1, 0x9b, 0xbe, 0x33, 0x3e, 0xd7, 0xd5, 0xdd, 0xd6, 0x97, 0x6f, 0x46, 0xe3, 0xc5,
+	0xb3, 0x1f, 0x37, 0x86, 0x72, 0x7d, 0x63, 0x28, 0xbf, 0x6e, 0x0c, 0xe5, 0xeb, 0xad, 0xd1, 0xb8,
+	0xbe, 0x35, 0x1a, 0x3f, 0x6f, 0x8d, 0xc6, 0xdb, 0xce, 0x84, 0x8a, 0x69, 0xee, 0x5b, 0x01, 0x4b,
+	0xec, 0xfa, 0x4a, 0x56, 0x8f, 0x03, 0x1e, 0xbe, 0xb3, 0x3f, 0x96, 0x77, 0xd2, 0x5f, 0x95, 0x1f,
+	0xf4, 0xe4, 0xf7, 0x00, 0x23, 0x21, 0x0d, 0xc2, 0xb4, 0x03, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +425,58 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TransferAllowlist) > 0 {
+		for iNdEx := len(m.TransferAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TransferAllowlist[iNdEx])
+			copy(dAtA[i:], m.TransferAllowlist[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.TransferAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Soulbound {
+		i--
+		if m.Soulbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Minters[iNdEx])
+			copy(dAtA[i:], m.Minters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.Minters[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -313,6 +534,41 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NFT) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -421,6 +677,48 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	if len(m.Minters) > 0 {
+		for _, s := range m.Minters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.Soulbound {
+		n += 2
+	}
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.TransferAllowlist) > 0 {
+		for _, s := range m.TransferAllowlist {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovNft(uint64(m.BasisPoints))
+	}
 	return n
 }
 
@@ -716,6 +1014,278 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soulbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Soulbound = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferAllowlist = append(m.TransferAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ package nft
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -716,6 +717,115 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryRoyaltyRequest is the request type for the Query/Royalty RPC method
+type QueryRoyaltyRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// sale_price is the price an NFT of the class is sold for. Optional
+	SalePrice *types.Coin `protobuf:"bytes,2,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+}
+
+func (m *QueryRoyaltyRequest) Reset()         { *m = QueryRoyaltyRequest{} }
+func (m *QueryRoyaltyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyRequest) ProtoMessage()    {}
+func (*QueryRoyaltyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{14}
+}
+func (m *QueryRoyaltyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyRequest.Merge(m, src)
+}
+func (m *QueryRoyaltyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyRequest proto.InternalMessageInfo
+
+func (m *QueryRoyaltyRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *QueryRoyaltyRequest) GetSalePrice() *types.Coin {
+	if m != nil {
+		return m.SalePrice
+	}
+	return nil
+}
+
+// QueryRoyaltyResponse is the response type for the Query/Royalty RPC method
+type QueryRoyaltyResponse struct {
+	// royalty is the royalty of the class, nil if the class has none
+	Royalty *Royalty `protobuf:"bytes,1,opt,name=royalty,proto3" json:"royalty,omitempty"`
+	// amount is the royalty owed for the sale price, nil if no sale price was given
+	Amount *types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *QueryRoyaltyResponse) Reset()         { *m = QueryRoyaltyResponse{} }
+func (m *QueryRoyaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoyaltyResponse) ProtoMessage()    {}
+func (*QueryRoyaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{15}
+}
+func (m *QueryRoyaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoyaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoyaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoyaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoyaltyResponse.Merge(m, src)
+}
+func (m *QueryRoyaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoyaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoyaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoyaltyResponse proto.InternalMessageInfo
+
+func (m *QueryRoyaltyResponse) GetRoyalty() *Royalty {
+	if m != nil {
+		return m.Royalty
+	}
+	return nil
+}
+
+func (m *QueryRoyaltyResponse) GetAmount() *types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.nft.v1beta1.QueryBalanceResponse")
@@ -731,58 +841,66 @@ func init() {
 	proto.RegisterType((*QueryClassResponse)(nil), "cosmos.nft.v1beta1.QueryClassResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryRoyaltyRequest)(nil), "cosmos.nft.v1beta1.QueryRoyaltyRequest")
+	proto.RegisterType((*QueryRoyaltyResponse)(nil), "cosmos.nft.v1beta1.QueryRoyaltyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0xc7, 0xbb, 0x49, 0xd3, 0xc0, 0x54, 0xe2, 0xb1, 0x54, 0x90, 0x1a, 0xb0, 0x22, 0xf7, 0x91,
-	0xb4, 0x55, 0x77, 0xfb, 0x90, 0x38, 0x15, 0x0e, 0x45, 0x04, 0x71, 0x29, 0x10, 0x7a, 0x42, 0x42,
-	0xc8, 0x49, 0x9c, 0x60, 0x91, 0xda, 0x69, 0xd7, 0x06, 0xaa, 0xaa, 0x07, 0x7a, 0x40, 0x54, 0x5c,
-	0x90, 0xe8, 0xe7, 0xe1, 0xcc, 0xb1, 0x12, 0x17, 0x8e, 0xa8, 0xe5, 0x83, 0x20, 0xef, 0x8e, 0x53,
-	0x9b, 0x3a, 0x76, 0x15, 0x71, 0xaa, 0xec, 0xfd, 0xcf, 0xfc, 0x7f, 0xde, 0x79, 0x34, 0xa0, 0x37,
-	0x5d, 0xb1, 0xe5, 0x0a, 0xee, 0xb4, 0x3d, 0xfe, 0x6e, 0xb9, 0x61, 0x79, 0xe6, 0x32, 0xdf, 0xf6,
-	0xad, 0x9d, 0x5d, 0xd6, 0xdb, 0x71, 0x3d, 0x97, 0x52, 0x75, 0xce, 0x9c, 0xb6, 0xc7, 0xf0, 0x5c,
-	0x9b, 0xc7, 0x98, 0x86, 0x29, 0x2c, 0x25, 0xee, 0x87, 0xf6, 0xcc, 0x8e, 0xed, 0x98, 0x9e, 0xed,
-	0x3a, 0x2a, 0x5e, 0xbb, 0xd3, 0x71, 0xdd, 0x4e, 0xd7, 0xe2, 0x66, 0xcf, 0xe6, 0xa6, 0xe3, 0xb8,
-	0x9e, 0x3c, 0x14, 0xe1, 0x69, 0x82, 0x7b, 0xe0, 0x24, 0x4f, 0x8d, 0x1a, 0xdc, 0x78, 0x1e, 0x64,
-	0x5f, 0x37, 0xbb, 0xa6, 0xd3, 0xb4, 0xea, 0xd6, 0xb6, 0x6f, 0x09, 0x8f, 0x4e, 0xc2, 0xa5, 0x66,
-	0xd7, 0x14, 0xe2, 0xb5, 0xdd, 0x2a, 0x91, 0x32, 0xa9, 0x5e, 0xae, 0x17, 0xe5, 0xf3, 0x93, 0x16,
-	0x9d, 0x80, 0x82, 0xfb, 0xde, 0xb1, 0x76, 0x4a, 0x39, 0xf9, 0x5e, 0x3d, 0x18, 0x0c, 0x26, 0xe2,
-	0x79, 0x44, 0xcf, 0x75, 0x84, 0x45, 0x6f, 0xc2, 0x98, 0xb9, 0xe5, 0xfa, 0x8e, 0x27, 0xd3, 0x8c,
-	0xd6, 0xf1, 0xc9, 0x78, 0x00, 0xd7, 0xa5, 0xfe, 0x69, 0x10, 0x7d, 0x01, 0xd7, 0x2b, 0x90, 0xb3,
-	0x5b, 0x68, 0x99, 0xb3, 0x5b, 0xc6, 0x3c, 0xd0, 0x68, 0x3c, 0xba, 0xf5, 0xd9, 0x48, 0x94, 0x8d,
-	0xa3, 0xf6, 0x85, 0xdf, 0xeb, 0x75, 0x77, 0xb3, 0xcd, 0x8c, 0x45, 0xbc, 0x94, 0x30, 0x20, 0xe3,
-	0x5b, 0xbe, 0x10, 0xb8, 0x26, 0xf5, 0x1b, 0xb5, 0x4d, 0x31, 0xec, 0x0d, 0xd2, 0x1a, 0xc0, 0x59,
-	0x65, 0x4b, 0xf9, 0x32, 0xa9, 0x8e, 0xaf, 0xcc, 0x32, 0x6c, 0x8d, 0xa0, 0x0d, 0x98, 0xea, 0x19,
-	0xac, 0x21, 0x7b, 0x66, 0x76, 0xc2, 0x72, 0xd5, 0x23, 0x91, 0xc6, 0x21, 0xc1, 0xab, 0x55, 0x34,
-	0xc8, 0xbe, 0x00, 0xa3, 0x4e, 0xdb, 0x13, 0x25, 0x52, 0xce, 0x57, 0xc7, 0x57, 0x6e, 0xb1, 0xf3,
-	0x2d, 0xc7, 0x36, 0x6a, 0x9b, 0x75, 0x29, 0xa2, 0x8f, 0x63, 0x28, 0x39, 0x89, 0x52, 0xc9, 0x44,
-	0x51, 0x4e, 0x31, 0x96, 0x35, 0xb8, 0x1a, 0xa2, 0x0c, 0x51, 0xe3, 0xfb, 0x67, 0xd7, 0xda, 0xff,
-	0x8e, 0x39, 0xc8, 0x3b, 0x6d, 0x55, 0x80, 0x94, 0xcf, 0x08, 0x34, 0x06, 0xc3, 0x7b, 0x78, 0x18,
-	0xa4, 0xbf, 0x40, 0xd5, 0x1f, 0x61, 0x9b, 0xa0, 0x1e, 0x0d, 0x39, 0x14, 0xa4, 0x00, 0x2d, 0x27,
-	0x93, 0x2c, 0x55, 0x84, 0xd2, 0x19, 0xaf, 0xb0, 0x79, 0xe4, 0x4b, 0xab, 0x6f, 0x1c, 0x2f, 0x2f,
-	0x19, 0xba, 0xbc, 0x47, 0x04, 0x27, 0xad, 0x9f, 0x1f, 0x41, 0x57, 0x41, 0x7d, 0x89, 0x15, 0x16,
-	0x39, 0x05, 0x35, 0x54, 0xfe, 0xb7, 0x4a, 0xaf, 0x7c, 0x2f, 0x42, 0x41, 0x62, 0xd1, 0x23, 0x02,
-	0x45, 0xdc, 0x02, 0xb4, 0x92, 0x84, 0x90, 0xb0, 0x6f, 0xb4, 0x6a, 0xb6, 0x50, 0x99, 0x1a, 0xf7,
-	0x0e, 0x7e, 0xfe, 0xf9, 0x96, 0x5b, 0xa2, 0x8c, 0x27, 0xec, 0xb5, 0x86, 0x12, 0xf3, 0x3d, 0x39,
-	0x52, 0xfb, 0x7c, 0x2f, 0xac, 0xf5, 0x3e, 0x3d, 0x24, 0x50, 0x90, 0xcb, 0x82, 0xce, 0x0c, 0xf4,
-	0x8a, 0x2e, 0x23, 0x6d, 0x36, 0x4b, 0x86, 0x40, 0xcb, 0x12, 0x68, 0x81, 0xce, 0x25, 0x01, 0x49,
-	0x8e, 0x08, 0x06, 0xdf, 0x0b, 0x58, 0x3e, 0x13, 0x18, 0x53, 0xbb, 0x85, 0x0e, 0x76, 0x89, 0x6d,
-	0x2b, 0xad, 0x92, 0xa9, 0x43, 0x9c, 0x45, 0x89, 0x53, 0xa1, 0x33, 0x49, 0x38, 0x42, 0x6a, 0xa3,
-	0xd7, 0xe2, 0xc3, 0x68, 0xb0, 0x27, 0xe8, 0xf4, 0xc0, 0xfc, 0x91, 0xa5, 0xa6, 0xcd, 0x64, 0xa8,
-	0x90, 0xa1, 0x2c, 0x19, 0x34, 0x5a, 0xe2, 0xc9, 0xff, 0x7b, 0x04, 0x3d, 0x20, 0x90, 0xdf, 0xa8,
-	0x6d, 0xd2, 0xa9, 0xb4, 0x84, 0xa1, 0xeb, 0x74, 0xba, 0x08, 0x4d, 0x97, 0xa4, 0xe9, 0x3c, 0xad,
-	0x0e, 0x32, 0x3d, 0x57, 0x86, 0x4f, 0x04, 0x0a, 0x72, 0x1e, 0x52, 0x5a, 0x22, 0xba, 0x3c, 0x52,
-	0x5a, 0x22, 0xb6, 0x33, 0x0c, 0x26, 0x51, 0xaa, 0x74, 0x36, 0x09, 0x05, 0x47, 0x2f, 0x5a, 0x84,
-	0x8f, 0x04, 0x8a, 0x38, 0xce, 0x29, 0x23, 0x13, 0x5f, 0x28, 0x29, 0x23, 0xf3, 0xcf, 0x66, 0x30,
-	0xa6, 0x24, 0xce, 0x5d, 0x7a, 0x3b, 0x05, 0x67, 0x7d, 0xed, 0xc7, 0x89, 0x4e, 0x8e, 0x4f, 0x74,
-	0xf2, 0xfb, 0x44, 0x27, 0x5f, 0x4f, 0xf5, 0x91, 0xe3, 0x53, 0x7d, 0xe4, 0xd7, 0xa9, 0x3e, 0xf2,
-	0xd2, 0xe8, 0xd8, 0xde, 0x1b, 0xbf, 0xc1, 0x9a, 0xee, 0x56, 0x98, 0x40, 0xfd, 0x59, 0x14, 0xad,
-	0xb7, 0xfc, 0x43, 0x90, 0xad, 0x31, 0x26, 0x7f, 0x4d, 0xac, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0x46, 0x70, 0x1f, 0xeb, 0x08, 0x00, 0x00,
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xd1, 0x4f, 0xd3, 0x5e,
+	0x14, 0xc7, 0xb9, 0x1b, 0x63, 0x70, 0x48, 0x7e, 0x3f, 0xb9, 0x10, 0x1d, 0x45, 0x9b, 0xa5, 0xc0,
+	0x56, 0x20, 0xb4, 0x0c, 0xa2, 0xf1, 0x01, 0x7d, 0xc0, 0x38, 0xe3, 0x0b, 0xe2, 0xe4, 0xc9, 0xc4,
+	0x90, 0x6e, 0xeb, 0x66, 0x75, 0xb4, 0x63, 0xb7, 0x53, 0x09, 0x21, 0x51, 0x1e, 0x8c, 0x44, 0x1f,
+	0x4c, 0xe4, 0x8f, 0xf2, 0xc5, 0x84, 0xc4, 0x17, 0x1f, 0x0d, 0xf8, 0x87, 0x98, 0xde, 0x7b, 0x3a,
+	0xda, 0xd0, 0xb5, 0x0b, 0xf1, 0x89, 0xb4, 0xf7, 0x7b, 0xee, 0xf7, 0x73, 0xcf, 0xb9, 0xfd, 0x32,
+	0x90, 0x6b, 0x0e, 0xdb, 0x75, 0x98, 0x6e, 0x37, 0x5c, 0xfd, 0x4d, 0xa9, 0x6a, 0xba, 0x46, 0x49,
+	0xdf, 0xeb, 0x9a, 0x9d, 0x7d, 0xad, 0xdd, 0x71, 0x5c, 0x87, 0x52, 0xb1, 0xae, 0xd9, 0x0d, 0x57,
+	0xc3, 0x75, 0x69, 0x11, 0x6b, 0xaa, 0x06, 0x33, 0x85, 0xb8, 0x57, 0xda, 0x36, 0x9a, 0x96, 0x6d,
+	0xb8, 0x96, 0x63, 0x8b, 0x7a, 0x49, 0x0e, 0x6a, 0x7d, 0x55, 0xcd, 0xb1, 0xfc, 0xf5, 0x9b, 0x4d,
+	0xc7, 0x69, 0xb6, 0x4c, 0xdd, 0x68, 0x5b, 0xba, 0x61, 0xdb, 0x8e, 0xcb, 0x8b, 0x99, 0xbf, 0x1a,
+	0x41, 0xe7, 0x91, 0xf0, 0x55, 0xa5, 0x0c, 0x93, 0x4f, 0x3d, 0xf7, 0x0d, 0xa3, 0x65, 0xd8, 0x35,
+	0xb3, 0x62, 0xee, 0x75, 0x4d, 0xe6, 0xd2, 0x69, 0x18, 0xad, 0xb5, 0x0c, 0xc6, 0x76, 0xac, 0x7a,
+	0x8e, 0xe4, 0x89, 0x3a, 0x56, 0xc9, 0xf2, 0xe7, 0xc7, 0x75, 0x3a, 0x05, 0x19, 0xe7, 0xad, 0x6d,
+	0x76, 0x72, 0x29, 0xfe, 0x5e, 0x3c, 0x28, 0x1a, 0x4c, 0x85, 0xf7, 0x61, 0x6d, 0xc7, 0x66, 0x26,
+	0xbd, 0x0e, 0x23, 0xc6, 0xae, 0xd3, 0xb5, 0x5d, 0xbe, 0xcd, 0x70, 0x05, 0x9f, 0x94, 0xfb, 0x30,
+	0xc1, 0xf5, 0x4f, 0xbc, 0xea, 0x01, 0x5c, 0xff, 0x83, 0x94, 0x55, 0x47, 0xcb, 0x94, 0x55, 0x57,
+	0x16, 0x81, 0x06, 0xeb, 0xd1, 0xad, 0xc7, 0x46, 0x82, 0x6c, 0x3a, 0x6a, 0x9f, 0x75, 0xdb, 0xed,
+	0xd6, 0x7e, 0xb2, 0x99, 0xb2, 0x0c, 0x93, 0xa1, 0x82, 0x84, 0xb3, 0x7c, 0x26, 0x70, 0x8d, 0xeb,
+	0x37, 0xcb, 0xdb, 0xec, 0xaa, 0x1d, 0xa4, 0x65, 0x80, 0x8b, 0xc9, 0xe7, 0xd2, 0x79, 0xa2, 0x8e,
+	0xaf, 0x16, 0x34, 0xbc, 0x3a, 0xde, 0xe8, 0x35, 0x71, 0xa7, 0x70, 0x86, 0xda, 0x96, 0xd1, 0xf4,
+	0xc7, 0x55, 0x09, 0x54, 0x2a, 0xc7, 0x04, 0x26, 0x02, 0x34, 0xc8, 0xbe, 0x04, 0xc3, 0x76, 0xc3,
+	0x65, 0x39, 0x92, 0x4f, 0xab, 0xe3, 0xab, 0x37, 0xb4, 0xcb, 0x57, 0x52, 0xdb, 0x2c, 0x6f, 0x57,
+	0xb8, 0x88, 0x3e, 0x0a, 0xa1, 0xa4, 0x38, 0x4a, 0x31, 0x11, 0x45, 0x38, 0x85, 0x58, 0xd6, 0xe1,
+	0x7f, 0x1f, 0xe5, 0x0a, 0x33, 0xbe, 0x77, 0xd1, 0xd6, 0xde, 0x39, 0x16, 0x20, 0x6d, 0x37, 0xc4,
+	0x00, 0x62, 0x8e, 0xe1, 0x69, 0x14, 0x0d, 0xfb, 0xf0, 0xc0, 0xdb, 0x7e, 0x80, 0xa9, 0x3f, 0x04,
+	0x1a, 0xd4, 0xa3, 0xa1, 0x0e, 0x19, 0x2e, 0x40, 0xcb, 0xe9, 0x28, 0x4b, 0x51, 0x21, 0x74, 0xca,
+	0x0b, 0xbc, 0x3c, 0xfc, 0xa5, 0xd9, 0x33, 0x0e, 0x8f, 0x97, 0x5c, 0x79, 0xbc, 0x27, 0x04, 0xa6,
+	0xc2, 0xfb, 0x23, 0xe8, 0x1a, 0x88, 0x93, 0x98, 0xfe, 0x90, 0x63, 0x50, 0x7d, 0xe5, 0xbf, 0x9b,
+	0xf4, 0x2b, 0x3c, 0x75, 0xc5, 0xd9, 0x37, 0x5a, 0xee, 0x00, 0x1f, 0x19, 0xbd, 0x0b, 0xc0, 0x8c,
+	0x96, 0xb9, 0xd3, 0xee, 0x58, 0x35, 0x13, 0xad, 0xa7, 0x43, 0xd6, 0x3d, 0x66, 0xc7, 0xb2, 0x2b,
+	0x63, 0x9e, 0x78, 0xcb, 0xd3, 0x2a, 0xef, 0xfd, 0x16, 0xf4, 0xcc, 0xb0, 0x05, 0xb7, 0x21, 0xdb,
+	0x11, 0xaf, 0xb0, 0xc1, 0x33, 0x51, 0x2d, 0xf0, 0xab, 0x7c, 0x2d, 0x2d, 0xf5, 0xbe, 0xeb, 0x44,
+	0x0a, 0x14, 0xae, 0xfe, 0x18, 0x85, 0x0c, 0x47, 0xa0, 0x27, 0x04, 0xb2, 0x18, 0x7a, 0xb4, 0x18,
+	0x65, 0x17, 0x11, 0xaf, 0x92, 0x9a, 0x2c, 0x14, 0x47, 0x52, 0xee, 0x1c, 0xfd, 0xfc, 0xf3, 0x2d,
+	0xb5, 0x42, 0x35, 0x3d, 0x22, 0xc6, 0xab, 0x42, 0xac, 0x1f, 0xf0, 0x04, 0x39, 0xd4, 0x0f, 0xfc,
+	0x5e, 0x1f, 0xd2, 0x63, 0x02, 0x19, 0x9e, 0x8d, 0x74, 0xbe, 0xaf, 0x57, 0x30, 0x7b, 0xa5, 0x42,
+	0x92, 0x0c, 0x81, 0x4a, 0x1c, 0x68, 0x89, 0x2e, 0x44, 0x01, 0x71, 0x8e, 0x00, 0x86, 0x7e, 0xe0,
+	0xb1, 0x7c, 0x22, 0x30, 0x22, 0xa2, 0x94, 0xf6, 0x77, 0x09, 0x85, 0xb3, 0x54, 0x4c, 0xd4, 0x21,
+	0xce, 0x32, 0xc7, 0x29, 0xd2, 0xf9, 0x28, 0x1c, 0xc6, 0xb5, 0xc1, 0xb6, 0x74, 0x61, 0xd8, 0x8b,
+	0x45, 0x3a, 0xd7, 0x77, 0xff, 0x40, 0x86, 0x4b, 0xf3, 0x09, 0x2a, 0x64, 0xc8, 0x73, 0x06, 0x89,
+	0xe6, 0xf4, 0xe8, 0x7f, 0xb5, 0x8c, 0x1e, 0x11, 0x48, 0x6f, 0x96, 0xb7, 0xe9, 0x6c, 0xdc, 0x86,
+	0xbe, 0xeb, 0x5c, 0xbc, 0x08, 0x4d, 0x57, 0xb8, 0xe9, 0x22, 0x55, 0xfb, 0x99, 0x5e, 0x1a, 0xc3,
+	0x47, 0x02, 0x19, 0xfe, 0xf9, 0xc7, 0x5c, 0x89, 0x60, 0x56, 0x4a, 0x85, 0x24, 0x19, 0xa2, 0x68,
+	0x1c, 0x45, 0xa5, 0x85, 0x28, 0x14, 0x4c, 0x9a, 0xe0, 0x10, 0x3e, 0x10, 0xc8, 0x62, 0x7a, 0xc5,
+	0x7c, 0x32, 0xe1, 0xfc, 0x94, 0xd4, 0x64, 0x21, 0xe2, 0xcc, 0x72, 0x9c, 0x5b, 0x74, 0x26, 0x06,
+	0x87, 0x7e, 0x21, 0x90, 0xc5, 0x20, 0x88, 0x61, 0x08, 0xa7, 0x99, 0xa4, 0x26, 0x0b, 0x07, 0x69,
+	0x09, 0xe6, 0x4e, 0xa0, 0x25, 0x1b, 0xeb, 0xdf, 0xcf, 0x64, 0x72, 0x7a, 0x26, 0x93, 0xdf, 0x67,
+	0x32, 0xf9, 0x7a, 0x2e, 0x0f, 0x9d, 0x9e, 0xcb, 0x43, 0xbf, 0xce, 0xe5, 0xa1, 0xe7, 0x4a, 0xd3,
+	0x72, 0x5f, 0x76, 0xab, 0x5a, 0xcd, 0xd9, 0xf5, 0xf7, 0x12, 0x7f, 0x96, 0x59, 0xfd, 0xb5, 0xfe,
+	0xce, 0xdb, 0xb8, 0x3a, 0xc2, 0x7f, 0xcb, 0xad, 0xfd, 0x1d, 0x00, 0x47, 0x35, 0xe5, 0x29, 0x89,
+	0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -812,6 +930,9 @@ type QueryClient interface {
 	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Royalty queries the royalty of an NFT class, and the royalty amount owed for a given sale price, similar to
+	// royaltyInfo in ERC2981
+	Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error)
 }
 
 type queryClient struct {
@@ -885,6 +1006,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) Royalty(ctx context.Context, in *QueryRoyaltyRequest, opts ...grpc.CallOption) (*QueryRoyaltyResponse, error) {
+	out := new(QueryRoyaltyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/Royalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -902,6 +1032,9 @@ type QueryServer interface {
 	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Royalty queries the royalty of an NFT class, and the royalty amount owed for a given sale price, similar to
+	// royaltyInfo in ERC2981
+	Royalty(context.Context, *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -929,6 +1062,9 @@ func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassReque
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) Royalty(ctx context.Context, req *QueryRoyaltyRequest) (*QueryRoyaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Royalty not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1060,6 +1196,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Royalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Royalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/Royalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Royalty(ctx, req.(*QueryRoyaltyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1092,6 +1246,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "Royalty",
+			Handler:    _Query_Royalty_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -1606,6 +1764,95 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryRoyaltyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SalePrice != nil {
+		{
+			size, err := m.SalePrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != nil {
+		{
+			size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Royalty != nil {
+		{
+			size, err := m.Royalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1829,6 +2076,40 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRoyaltyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SalePrice != nil {
+		l = m.SalePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Royalty != nil {
+		l = m.Royalty.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Amount != nil {
+		l = m.Amount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3209,6 +3490,246 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRoyaltyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SalePrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SalePrice == nil {
+				m.SalePrice = &types.Coin{}
+			}
+			if err := m.SalePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Royalty == nil {
+				m.Royalty = &Royalty{}
+			}
+			if err := m.Royalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Amount == nil {
+				m.Amount = &types.Coin{}
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
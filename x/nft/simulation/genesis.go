@@ -8,21 +8,64 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
-// genClasses returns a slice of nft class.
+// genClasses returns a slice of nft class, each issued by a different account.
 func genClasses(r *rand.Rand, accounts []simtypes.Account) []*nft.Class {
 	classes := make([]*nft.Class, len(accounts)-1)
 	for i := 0; i < len(accounts)-1; i++ {
+		policy, minters := genMintPolicy(r, accounts)
 		classes[i] = &nft.Class{
 			Id:          simtypes.RandStringOfLength(r, 10),
 			Name:        simtypes.RandStringOfLength(r, 10),
 			Symbol:      simtypes.RandStringOfLength(r, 10),
 			Description: simtypes.RandStringOfLength(r, 10),
 			Uri:         simtypes.RandStringOfLength(r, 10),
+			Issuer:      accounts[i].Address.String(),
+			MintPolicy:  policy,
+			Minters:     minters,
 		}
 	}
 	return classes
 }
 
+// genMintPolicy returns a random mint policy, along with a random minter for
+// the allowlist policy.
+func genMintPolicy(r *rand.Rand, accounts []simtypes.Account) (nft.MintPolicy, []string) {
+	policy := nft.MintPolicy(simtypes.RandIntBetween(r, int(nft.MINT_POLICY_ISSUER_ONLY), int(nft.MINT_POLICY_ALLOWLIST)+1))
+	if policy != nft.MINT_POLICY_ALLOWLIST {
+		return policy, nil
+	}
+
+	minter, _ := simtypes.RandomAcc(r, accounts)
+	return policy, []string{minter.Address.String()}
+}
+
+// genTransferPolicy returns whether a class is soulbound, or else a transfer
+// allowlist for the class, which are both rarely set.
+func genTransferPolicy(r *rand.Rand, accounts []simtypes.Account) (bool, []string) {
+	switch r.Intn(10) {
+	case 0:
+		return true, nil
+	case 1:
+		receiver, _ := simtypes.RandomAcc(r, accounts)
+		return false, []string{receiver.Address.String()}
+	default:
+		return false, nil
+	}
+}
+
+// genRoyalty returns a random royalty paid to a random account, or nil.
+func genRoyalty(r *rand.Rand, accounts []simtypes.Account) *nft.Royalty {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+
+	recipient, _ := simtypes.RandomAcc(r, accounts)
+	return &nft.Royalty{
+		Recipient:   recipient.Address.String(),
+		BasisPoints: uint32(simtypes.RandIntBetween(r, 1, nft.MaxRoyaltyBasisPoints+1)),
+	}
+}
+
 // genNFT returns a slice of nft.
 func genNFT(r *rand.Rand, classID string, accounts []simtypes.Account) []*nft.Entry {
 	entries := make([]*nft.Entry, len(accounts)-1)
@@ -19,15 +19,29 @@ import (
 //nolint:gosec // these are not hardcoded credentials.
 const (
 	// OpWeightMsgSend Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send"
+	OpWeightMsgSend      = "op_weight_msg_send"
+	OpWeightMsgSaveClass = "op_weight_msg_save_class"
+	OpWeightMsgMintNFT   = "op_weight_msg_mint_nft"
+	OpWeightMsgBurnNFT   = "op_weight_msg_burn_nft"
+	OpWeightMsgUpdateNFT = "op_weight_msg_update_nft"
 )
 
 const (
 	// WeightSend nft operations weights
-	WeightSend = 100
+	WeightSend      = 100
+	WeightSaveClass = 20
+	WeightMintNFT   = 50
+	WeightBurnNFT   = 20
+	WeightUpdateNFT = 20
 )
 
-var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+var (
+	TypeMsgSend      = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgSaveClass = sdk.MsgTypeURL(&nft.MsgSaveClass{})
+	TypeMsgMintNFT   = sdk.MsgTypeURL(&nft.MsgMintNFT{})
+	TypeMsgBurnNFT   = sdk.MsgTypeURL(&nft.MsgBurnNFT{})
+	TypeMsgUpdateNFT = sdk.MsgTypeURL(&nft.MsgUpdateNFT{})
+)
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
@@ -38,7 +52,13 @@ func WeightedOperations(
 	bk nft.BankKeeper,
 	k keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend int
+	var (
+		weightMsgSend      int
+		weightMsgSaveClass int
+		weightMsgMintNFT   int
+		weightMsgBurnNFT   int
+		weightMsgUpdateNFT int
+	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
@@ -46,10 +66,51 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgSaveClass, &weightMsgSaveClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgSaveClass = WeightSaveClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintNFT, &weightMsgMintNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNFT = WeightMintNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnNFT, &weightMsgBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnNFT = WeightBurnNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateNFT, &weightMsgUpdateNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateNFT = WeightUpdateNFT
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSaveClass,
+			SimulateMsgSaveClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNFT,
+			SimulateMsgMintNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnNFT,
+			SimulateMsgBurnNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateNFT,
+			SimulateMsgUpdateNFT(protoCdc, ak, bk, k),
 		),
 	}
 }
@@ -88,6 +149,11 @@ func SimulateMsgSend(
 			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
 		}
 
+		class, _ := k.GetClass(ctx, n.ClassId)
+		if err := class.ValidateTransfer(receiver.Address); err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "transfer not allowed by the class"), nil, nil
+		}
+
 		msg := &nft.MsgSend{
 			ClassId:  n.ClassId,
 			Id:       n.Id,
@@ -119,6 +185,202 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgSaveClass generates a MsgSaveClass with random values.
+func SimulateMsgSaveClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+
+		classID := simtypes.RandStringOfLength(r, 10)
+		if k.HasClass(ctx, classID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSaveClass, "class already exists"), nil, nil
+		}
+
+		policy, minters := genMintPolicy(r, accs)
+		soulbound, allowlist := genTransferPolicy(r, accs)
+
+		msg := &nft.MsgSaveClass{
+			ClassId:     classID,
+			Name:        simtypes.RandStringOfLength(r, 10),
+			Symbol:      simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 10),
+			Uri:         simtypes.RandStringOfLength(r, 10),
+			Issuer:      issuer.Address.String(),
+			MintPolicy:  policy,
+			Minters:     minters,
+
+			Soulbound:         soulbound,
+			Royalty:           genRoyalty(r, accs),
+			TransferAllowlist: allowlist,
+		}
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, issuer, msg, TypeMsgSaveClass)
+	}
+}
+
+// SimulateMsgMintNFT generates a MsgMintNFT with random values.
+func SimulateMsgMintNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		classes := k.GetClasses(ctx)
+		if len(classes) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "no class to mint"), nil, nil
+		}
+		class := classes[r.Intn(len(classes))]
+
+		minter, found := randMinter(r, class, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "no account allowed to mint"), nil, nil
+		}
+
+		nftID := simtypes.RandStringOfLength(r, 10)
+		if k.HasNFT(ctx, class.Id, nftID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "nft already exists"), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		msg := &nft.MsgMintNFT{
+			ClassId:  class.Id,
+			Id:       nftID,
+			Uri:      simtypes.RandStringOfLength(r, 10),
+			Minter:   minter.Address.String(),
+			Receiver: receiver.Address.String(),
+		}
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, minter, msg, TypeMsgMintNFT)
+	}
+}
+
+// SimulateMsgBurnNFT generates a MsgBurnNFT with random values.
+func SimulateMsgBurnNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		n, err := randNFT(ctx, r, k, owner.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurnNFT, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgBurnNFT{
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Owner:   owner.Address.String(),
+		}
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgBurnNFT)
+	}
+}
+
+// SimulateMsgUpdateNFT generates a MsgUpdateNFT with random values.
+func SimulateMsgUpdateNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var (
+			classes []*nft.Class
+			issuers []simtypes.Account
+		)
+		for _, c := range k.GetClasses(ctx) {
+			if k.GetTotalSupply(ctx, c.Id) == 0 {
+				continue
+			}
+			issuer, err := sdk.AccAddressFromBech32(c.Issuer)
+			if err != nil {
+				continue
+			}
+			if acc, found := simtypes.FindAccount(accs, issuer); found {
+				classes = append(classes, c)
+				issuers = append(issuers, acc)
+			}
+		}
+		if len(classes) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no nft issued by a simulation account"), nil, nil
+		}
+		i := r.Intn(len(classes))
+		class, issuer := classes[i], issuers[i]
+
+		nfts := k.GetNFTsOfClass(ctx, class.Id)
+		n := nfts[r.Intn(len(nfts))]
+
+		msg := &nft.MsgUpdateNFT{
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Uri:     simtypes.RandStringOfLength(r, 10),
+			Issuer:  issuer.Address.String(),
+		}
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, issuer, msg, TypeMsgUpdateNFT)
+	}
+}
+
+// deliverMsg signs the msg with the given account, pays random fees and delivers it.
+func deliverMsg(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	account simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           tx.NewTxConfig(cdc, tx.DefaultSignModes),
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      nft.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randMinter returns a random account allowed to mint NFTs of the class.
+func randMinter(r *rand.Rand, class *nft.Class, accs []simtypes.Account) (simtypes.Account, bool) {
+	var minters []simtypes.Account
+	for _, acc := range accs {
+		if class.IsMinter(acc.Address) {
+			minters = append(minters, acc)
+		}
+	}
+	if len(minters) == 0 {
+		return simtypes.Account{}, false
+	}
+	return minters[r.Intn(len(minters))], true
+}
+
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
 	if err != nil {
@@ -77,6 +77,10 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, simulation.TypeMsgSend, simulation.TypeMsgSend},
+		{simulation.WeightSaveClass, simulation.TypeMsgSaveClass, simulation.TypeMsgSaveClass},
+		{simulation.WeightMintNFT, simulation.TypeMsgMintNFT, simulation.TypeMsgMintNFT},
+		{simulation.WeightBurnNFT, simulation.TypeMsgBurnNFT, simulation.TypeMsgBurnNFT},
+		{simulation.WeightUpdateNFT, simulation.TypeMsgUpdateNFT, simulation.TypeMsgUpdateNFT},
 	}
 
 	for i, w := range weightedOps {
@@ -135,6 +139,120 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgSaveClass() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	op := simulation.SimulateMsgSaveClass(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgSaveClass
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	class, found := suite.nftKeeper.GetClass(suite.ctx, msg.ClassId)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Issuer, class.Issuer)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMintNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	class := nft.Class{
+		Id:         "kitty",
+		Issuer:     accounts[0].Address.String(),
+		MintPolicy: nft.MINT_POLICY_ISSUER_ONLY,
+	}
+	suite.Require().NoError(suite.nftKeeper.SaveClass(suite.ctx, class))
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	op := simulation.SimulateMsgMintNFT(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgMintNFT
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(class.Issuer, msg.Minter)
+	suite.Require().True(suite.nftKeeper.HasNFT(suite.ctx, class.Id, msg.Id))
+}
+
+func (suite *SimTestSuite) TestSimulateMsgBurnNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	op := simulation.SimulateMsgBurnNFT(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgBurnNFT
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().False(suite.nftKeeper.HasNFT(suite.ctx, msg.ClassId, msg.Id))
+}
+
+func (suite *SimTestSuite) TestSimulateMsgUpdateNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	class := nft.Class{
+		Id:         "kitty",
+		Issuer:     accounts[0].Address.String(),
+		MintPolicy: nft.MINT_POLICY_ISSUER_ONLY,
+	}
+	suite.Require().NoError(suite.nftKeeper.SaveClass(suite.ctx, class))
+	suite.Require().NoError(suite.nftKeeper.Mint(suite.ctx, nft.NFT{ClassId: class.Id, Id: "kitty1"}, accounts[1].Address))
+
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	op := simulation.SimulateMsgUpdateNFT(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.nftKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgUpdateNFT
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	n, found := suite.nftKeeper.GetNFT(suite.ctx, class.Id, "kitty1")
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Uri, n.Uri)
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
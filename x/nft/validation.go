@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+// MaxRoyaltyBasisPoints is the largest royalty, in basis points, a class can define: 100% of the sale price.
+const MaxRoyaltyBasisPoints = 10000
+
 var (
 	// reClassIDString can be 3 ~ 100 characters long and support letters, followed by either
 	// a letter, a number or a slash ('/') or a colon (':') or ('-').
@@ -33,3 +37,64 @@ func ValidateNFTID(id string) error {
 	}
 	return nil
 }
+
+// ValidateMintPolicy returns whether the mint policy is known, and whether the
+// minters are valid addresses which are only set for an allowlist policy
+func ValidateMintPolicy(policy MintPolicy, minters []string) error {
+	if _, ok := MintPolicy_name[int32(policy)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "unknown mint policy: %d", policy)
+	}
+
+	if policy != MINT_POLICY_ALLOWLIST && len(minters) > 0 {
+		return sdkerrors.Wrapf(ErrInvalidPolicy, "minters can only be set with %s", MINT_POLICY_ALLOWLIST)
+	}
+
+	seen := make(map[string]bool, len(minters))
+	for _, minter := range minters {
+		if _, err := sdk.AccAddressFromBech32(minter); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address: %s", minter)
+		}
+		if seen[minter] {
+			return sdkerrors.Wrapf(ErrInvalidPolicy, "duplicate minter: %s", minter)
+		}
+		seen[minter] = true
+	}
+	return nil
+}
+
+// ValidateRoyalty returns whether the royalty, if any, pays a valid recipient
+// a share of the sale price between 0 and 100%
+func ValidateRoyalty(royalty *Royalty) error {
+	if royalty == nil {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(royalty.Recipient); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid royalty recipient address: %s", royalty.Recipient)
+	}
+
+	if royalty.BasisPoints == 0 || royalty.BasisPoints > MaxRoyaltyBasisPoints {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "basis points must be between 1 and %d: %d", MaxRoyaltyBasisPoints, royalty.BasisPoints)
+	}
+	return nil
+}
+
+// ValidateTransferPolicy returns whether the transfer allowlist holds valid
+// addresses, and is not set for a soulbound class
+func ValidateTransferPolicy(soulbound bool, allowlist []string) error {
+	if soulbound && len(allowlist) > 0 {
+		return sdkerrors.Wrap(ErrInvalidTransferPolicy, "a soulbound class cannot have a transfer allowlist")
+	}
+
+	seen := make(map[string]bool, len(allowlist))
+	for _, receiver := range allowlist {
+		if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid transfer allowlist address: %s", receiver)
+		}
+		if seen[receiver] {
+			return sdkerrors.Wrapf(ErrInvalidTransferPolicy, "duplicate transfer allowlist address: %s", receiver)
+		}
+		seen[receiver] = true
+	}
+	return nil
+}
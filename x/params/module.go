@@ -22,6 +22,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/params/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/params/keeper"
 	"github.com/cosmos/cosmos-sdk/x/params/simulation"
@@ -187,6 +188,7 @@ type paramsOutputs struct {
 	ParamsKeeper  keeper.Keeper
 	BaseAppOption runtime.BaseAppOption
 	Module        runtime.AppModuleWrapper
+	GovHandler    govv1beta1.HandlerRoute
 }
 
 func provideModule(in paramsInputs) paramsOutputs {
@@ -196,7 +198,9 @@ func provideModule(in paramsInputs) paramsOutputs {
 	}
 	m := runtime.WrapAppModule(NewAppModule(k))
 
-	return paramsOutputs{ParamsKeeper: k, BaseAppOption: baseappOpt, Module: m}
+	govHandler := govv1beta1.NewHandlerRoute(proposal.RouterKey, NewParamChangeProposalHandler(k))
+
+	return paramsOutputs{ParamsKeeper: k, BaseAppOption: baseappOpt, Module: m, GovHandler: govHandler}
 }
 
 func provideSubSpace(key depinject.ModuleKey, k keeper.Keeper) types.Subspace {
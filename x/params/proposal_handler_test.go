@@ -17,30 +17,23 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// StakingKeeper defines the expected staking keeper
-type StakingKeeper interface {
-	MaxValidators(ctx sdk.Context) (res uint32)
-}
-
 type HandlerTestSuite struct {
 	suite.Suite
 
-	ctx           sdk.Context
-	govHandler    govv1beta1.Handler
-	stakingKeeper StakingKeeper
+	ctx          sdk.Context
+	govHandler   govv1beta1.Handler
+	paramsKeeper keeper.Keeper
 }
 
 func (suite *HandlerTestSuite) SetupTest() {
-	var paramsKeeper keeper.Keeper
 	app, err := simtestutil.Setup(
 		testutil.AppConfig,
-		&paramsKeeper,
-		&suite.stakingKeeper,
+		&suite.paramsKeeper,
 	)
 	suite.Require().NoError(err)
 
 	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
-	suite.govHandler = params.NewParamChangeProposalHandler(paramsKeeper)
+	suite.govHandler = params.NewParamChangeProposalHandler(suite.paramsKeeper)
 }
 
 func TestHandlerTestSuite(t *testing.T) {
@@ -62,7 +55,13 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 			"all fields",
 			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
 			func() {
-				maxVals := suite.stakingKeeper.MaxValidators(suite.ctx)
+				// x/staking no longer reads its parameters from x/params, so only
+				// the legacy subspace is updated.
+				subspace, ok := suite.paramsKeeper.GetSubspace(stakingtypes.ModuleName)
+				suite.Require().True(ok)
+
+				var maxVals uint32
+				subspace.Get(suite.ctx, stakingtypes.KeyMaxValidators, &maxVals)
 				suite.Require().Equal(uint32(1), maxVals)
 			},
 			false,
@@ -49,3 +49,7 @@ func TestProposalContents(t *testing.T) {
 	require.Equal(t, "test-value 2791 ", pcp.Changes[0].GetValue())
 	require.Equal(t, "test-Subspace2", pcp.Changes[0].GetSubspace())
 }
+
+func TestProposalContentsNoParamChanges(t *testing.T) {
+	require.Empty(t, simulation.ProposalContents(nil))
+}
@@ -147,6 +147,32 @@ func (w WeightedProposalContent) ContentSimulatorFn() simulation.ContentSimulato
 	return w.contentSimulatorFn
 }
 
+// Proposal Msgs
+
+// WeightedProposalMsg defines a common struct for the messages executed by
+// governance proposals defined by external modules (i.e outside gov)
+type WeightedProposalMsg struct {
+	appParamsKey   string                    // key used to retrieve the value of the weight from the simulation application params
+	defaultWeight  int                       // default weight
+	msgSimulatorFn simulation.MsgSimulatorFn // msg simulator function
+}
+
+func NewWeightedProposalMsg(appParamsKey string, defaultWeight int, msgSimulatorFn simulation.MsgSimulatorFn) simulation.WeightedProposalMsg {
+	return &WeightedProposalMsg{appParamsKey: appParamsKey, defaultWeight: defaultWeight, msgSimulatorFn: msgSimulatorFn}
+}
+
+func (w WeightedProposalMsg) AppParamsKey() string {
+	return w.appParamsKey
+}
+
+func (w WeightedProposalMsg) DefaultWeight() int {
+	return w.defaultWeight
+}
+
+func (w WeightedProposalMsg) MsgSimulatorFn() simulation.MsgSimulatorFn {
+	return w.msgSimulatorFn
+}
+
 // Param change proposals
 
 // randomConsensusParams returns random simulation consensus parameters, it extracts the Evidence from the Staking genesis state.
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
@@ -43,6 +44,23 @@ func TestNewWeightedProposalContent(t *testing.T) {
 	require.Equal(t, content, pContent.ContentSimulatorFn()(nil, ctx, nil))
 }
 
+func TestNewWeightedProposalMsg(t *testing.T) {
+	key := "theKey"
+	weight := 1
+	msg := testdata.NewTestMsg()
+	f := func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		return msg
+	}
+
+	pMsg := NewWeightedProposalMsg(key, weight, f)
+
+	require.Equal(t, key, pMsg.AppParamsKey())
+	require.Equal(t, weight, pMsg.DefaultWeight())
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, true, nil)
+	require.Equal(t, msg, pMsg.MsgSimulatorFn()(nil, ctx, nil))
+}
+
 type testContent struct{}
 
 func (t testContent) GetTitle() string       { return "" }
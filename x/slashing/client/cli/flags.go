@@ -2,4 +2,7 @@ package cli
 
 const (
 	FlagAddressValidator = "validator"
+	FlagFromHeight       = "from-height"
+	FlagToHeight         = "to-height"
+	FlagLimit            = "limit"
 )
@@ -27,6 +27,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryInfractions(),
+		GetCmdQueryValidatorUptime(),
+		GetCmdQueryWorstUptimeValidators(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +111,170 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryInfractions implements the command to query the infraction
+// history of a validator.
+func GetCmdQueryInfractions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "infractions [validator-conspub]",
+		Short: "Query a validator's infraction history",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the infraction history of that validator:
+
+$ <appd> query slashing infractions '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryInfractionsRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.Infractions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "infractions")
+
+	return cmd
+}
+
+// GetCmdQueryValidatorUptime implements the command to query the uptime
+// history of a validator.
+func GetCmdQueryValidatorUptime() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uptime [validator-conspub]",
+		Short: "Query a validator's uptime history",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the uptime history of that validator
+within a range of block heights. The history is only recorded when the uptime_bucket_size
+parameter is set:
+
+$ <appd> query slashing uptime '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}' --from-height 100 --to-height 200
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryValidatorUptimeRequest{
+				ConsAddress: consAddr.String(),
+				FromHeight:  fromHeight,
+				ToHeight:    toHeight,
+				Pagination:  pageReq,
+			}
+			res, err := queryClient.ValidatorUptime(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "Lowest start height of the returned buckets")
+	cmd.Flags().Int64(FlagToHeight, 0, "Highest start height of the returned buckets, no upper bound when zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "uptime buckets")
+
+	return cmd
+}
+
+// GetCmdQueryWorstUptimeValidators implements the command to query the
+// validators with the lowest uptime.
+func GetCmdQueryWorstUptimeValidators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "worst-uptime",
+		Short: "Query the validators with the lowest uptime",
+		Long: strings.TrimSpace(`Query the validators with the lowest uptime within a range of block heights:
+
+$ <appd> query slashing worst-uptime --from-height 100 --to-height 200 --limit 5
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryWorstUptimeValidatorsRequest{
+				FromHeight: fromHeight,
+				ToHeight:   toHeight,
+				Limit:      limit,
+			}
+			res, err := queryClient.WorstUptimeValidators(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "Lowest start height of the buckets taken into account")
+	cmd.Flags().Int64(FlagToHeight, 0, "Highest start height of the buckets taken into account, no upper bound when zero")
+	cmd.Flags().Uint32(FlagLimit, 0, "Maximum number of validators returned, 10 when zero")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
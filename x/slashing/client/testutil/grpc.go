@@ -70,6 +70,55 @@ func (s *IntegrationTestSuite) TestGRPCQueries() {
 			&types.QuerySigningInfoResponse{},
 			nil,
 		},
+		{
+			"get infractions (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/infractions/%s", baseURL, consAddr),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QueryInfractionsResponse{},
+			&types.QueryInfractionsResponse{
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"get infractions wrong address",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/infractions/%s", baseURL, "wrongAddress"),
+			map[string]string{},
+			true,
+			&types.QueryInfractionsResponse{},
+			nil,
+		},
+		{
+			"get uptime (height specific)",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/uptime/%s", baseURL, consAddr),
+			map[string]string{
+				grpctypes.GRPCBlockHeightHeader: "1",
+			},
+			false,
+			&types.QueryValidatorUptimeResponse{},
+			&types.QueryValidatorUptimeResponse{
+				Uptime:     types.NewValidatorUptime(sdk.ConsAddress(val.PubKey.Address()), 0, 0),
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			"get uptime wrong address",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/uptime/%s", baseURL, "wrongAddress"),
+			map[string]string{},
+			true,
+			&types.QueryValidatorUptimeResponse{},
+			nil,
+		},
+		{
+			"get worst uptime validators",
+			fmt.Sprintf("%s/cosmos/slashing/v1beta1/worst_uptime?limit=5", baseURL),
+			map[string]string{},
+			false,
+			&types.QueryWorstUptimeValidatorsResponse{},
+			&types.QueryWorstUptimeValidatorsResponse{},
+		},
 		{
 			"params",
 			fmt.Sprintf("%s/cosmos/slashing/v1beta1/params", baseURL),
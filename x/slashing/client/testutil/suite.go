@@ -104,6 +104,141 @@ tombstoned: false`, sdk.ConsAddress(val.PubKey.Address())),
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryInfractions() {
+	val := s.network.Validators[0]
+	pubKeyBz, err := s.cfg.Codec.MarshalInterfaceJSON(val.PubKey)
+	s.Require().NoError(err)
+	pubKeyStr := string(pubKeyBz)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{"invalid address", []string{"foo"}, true, ``},
+		{
+			"valid address (json output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			`{"infractions":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryInfractions()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorUptime() {
+	val := s.network.Validators[0]
+	pubKeyBz, err := s.cfg.Codec.MarshalInterfaceJSON(val.PubKey)
+	s.Require().NoError(err)
+	pubKeyStr := string(pubKeyBz)
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{"invalid address", []string{"foo"}, true, ``},
+		{
+			"invalid height range",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=20", cli.FlagFromHeight),
+				fmt.Sprintf("--%s=10", cli.FlagToHeight),
+			},
+			true,
+			``,
+		},
+		{
+			"valid address (json output)",
+			[]string{
+				pubKeyStr,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			fmt.Sprintf(`{"buckets":[],"uptime":{"address":"%s","signed_blocks":"0","missed_blocks":"0","uptime":"0.000000000000000000"},"pagination":{"next_key":null,"total":"0"}}`, sdk.ConsAddress(val.PubKey.Address())),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorUptime()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryWorstUptimeValidators() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectErr      bool
+		expectedOutput string
+	}{
+		{"limit too high", []string{fmt.Sprintf("--%s=101", cli.FlagLimit)}, true, ``},
+		{
+			"json output",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagHeight),
+			},
+			false,
+			`{"validators":[]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryWorstUptimeValidators()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 	val := s.network.Validators[0]
 
@@ -115,16 +250,20 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","uptime_bucket_size":"0","uptime_buckets_retained":"0","double_sign_jail_duration":"0s","downtime_tombstone":false}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`downtime_jail_duration: 600s
+			`double_sign_jail_duration: 0s
+downtime_jail_duration: 600s
+downtime_tombstone: false
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
-slash_fraction_downtime: "0.010000000000000000"`,
+slash_fraction_downtime: "0.010000000000000000"
+uptime_bucket_size: "0"
+uptime_buckets_retained: "0"`,
 		},
 	}
 
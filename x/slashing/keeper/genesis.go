@@ -38,7 +38,36 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
-	keeper.SetParams(ctx, data.Params)
+	for _, vi := range data.Infractions {
+		address, err := sdk.ConsAddressFromBech32(vi.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, infraction := range vi.Infractions {
+			keeper.SetInfraction(ctx, address, infraction)
+		}
+		for _, delegatorSlash := range vi.DelegatorSlashes {
+			delAddr, err := sdk.AccAddressFromBech32(delegatorSlash.DelegatorAddress)
+			if err != nil {
+				panic(err)
+			}
+			keeper.SetDelegatorSlash(ctx, address, delAddr, delegatorSlash)
+		}
+	}
+
+	for _, history := range data.UptimeHistory {
+		address, err := sdk.ConsAddressFromBech32(history.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, bucket := range history.Buckets {
+			keeper.SetUptimeBucket(ctx, address, bucket)
+		}
+	}
+
+	if err := keeper.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -65,5 +94,51 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	infractions := make([]types.ValidatorInfractions, 0)
+	infractionsIndex := make(map[string]int)
+	keeper.IterateInfractions(ctx, func(address sdk.ConsAddress, infraction types.Infraction) (stop bool) {
+		bechAddr := address.String()
+		if n := len(infractions); n > 0 && infractions[n-1].Address == bechAddr {
+			infractions[n-1].Infractions = append(infractions[n-1].Infractions, infraction)
+		} else {
+			infractionsIndex[bechAddr] = n
+			infractions = append(infractions, types.ValidatorInfractions{
+				Address:     bechAddr,
+				Infractions: []types.Infraction{infraction},
+			})
+		}
+
+		return false
+	})
+
+	// the delegator slashes are exported along with the infractions of their validator
+	keeper.IterateDelegatorSlashes(ctx, func(address sdk.ConsAddress, delegatorSlash types.DelegatorSlash) (stop bool) {
+		bechAddr := address.String()
+		i, ok := infractionsIndex[bechAddr]
+		if !ok {
+			i = len(infractions)
+			infractionsIndex[bechAddr] = i
+			infractions = append(infractions, types.ValidatorInfractions{Address: bechAddr})
+		}
+		infractions[i].DelegatorSlashes = append(infractions[i].DelegatorSlashes, delegatorSlash)
+
+		return false
+	})
+
+	uptimeHistory := make([]types.ValidatorUptimeHistory, 0)
+	keeper.IterateUptimeBuckets(ctx, func(address sdk.ConsAddress, bucket types.UptimeBucket) (stop bool) {
+		bechAddr := address.String()
+		if n := len(uptimeHistory); n > 0 && uptimeHistory[n-1].Address == bechAddr {
+			uptimeHistory[n-1].Buckets = append(uptimeHistory[n-1].Buckets, bucket)
+		} else {
+			uptimeHistory = append(uptimeHistory, types.ValidatorUptimeHistory{
+				Address: bechAddr,
+				Buckets: []types.UptimeBucket{bucket},
+			})
+		}
+
+		return false
+	})
+
+	return types.NewGenesisState(params, signingInfos, missedBlocks, infractions, uptimeHistory)
 }
@@ -71,4 +71,54 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
+
+	// The infraction history recorded by tombstoning is exported
+	genesisState = slashingKeeper.ExportGenesis(ctx)
+	require.Len(t, genesisState.Infractions, 2)
+	for _, vi := range genesisState.Infractions {
+		require.Len(t, vi.Infractions, 1)
+		require.Equal(t, types.InfractionDoubleSign, vi.Infractions[0].Type)
+		require.True(t, vi.Infractions[0].Tombstoned)
+	}
+
+	// The delegator slashes are exported with the infractions of their validator
+	// and imported
+	consAddr := sdk.ConsAddress(addrDels[0])
+	height := slashingKeeper.GetValidatorInfractions(ctx, consAddr)[0].Height
+	delegatorSlash := types.NewDelegatorSlash(addrDels[1], sdk.NewInt(5), height)
+	slashingKeeper.SetDelegatorSlash(ctx, consAddr, addrDels[1], delegatorSlash)
+	genesisState = slashingKeeper.ExportGenesis(ctx)
+	for _, vi := range genesisState.Infractions {
+		if vi.Address == consAddr.String() {
+			require.Equal(t, []types.DelegatorSlash{delegatorSlash}, vi.DelegatorSlashes)
+		} else {
+			require.Empty(t, vi.DelegatorSlashes)
+		}
+	}
+
+	slashingKeeper.InitGenesis(ctx, stakingKeeper, genesisState)
+	require.Equal(t, []types.DelegatorSlash{delegatorSlash}, slashingKeeper.GetInfractionDelegatorSlashes(ctx, consAddr, height))
+
+	// a delegator slash must belong to a double sign infraction of its validator
+	infractionsState := types.NewGenesisState(types.DefaultParams(), nil, nil, genesisState.Infractions, nil)
+	require.NoError(t, types.ValidateGenesis(*infractionsState))
+	for i, vi := range infractionsState.Infractions {
+		if vi.Address == consAddr.String() {
+			infractionsState.Infractions[i].DelegatorSlashes = []types.DelegatorSlash{types.NewDelegatorSlash(addrDels[1], sdk.NewInt(5), height+1)}
+		}
+	}
+	require.Error(t, types.ValidateGenesis(*infractionsState))
+
+	// The uptime history is exported and imported
+	bucket := types.UptimeBucket{StartHeight: 100, SignedBlocks: 9, MissedBlocks: 1}
+	slashingKeeper.SetUptimeBucket(ctx, sdk.ConsAddress(addrDels[0]), bucket)
+	genesisState = slashingKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.ValidatorUptimeHistory{
+		{Address: sdk.ConsAddress(addrDels[0]).String(), Buckets: []types.UptimeBucket{bucket}},
+	}, genesisState.UptimeHistory)
+
+	slashingKeeper.InitGenesis(ctx, stakingKeeper, genesisState)
+	newBucket, found := slashingKeeper.GetUptimeBucket(ctx, sdk.ConsAddress(addrDels[0]), 100)
+	require.True(t, found)
+	require.Equal(t, bucket, newBucket)
 }
@@ -14,6 +14,16 @@ import (
 
 var _ types.QueryServer = Keeper{}
 
+const (
+	// defaultWorstUptimeValidatorsLimit is the number of validators returned by
+	// the WorstUptimeValidators query when no limit is given
+	defaultWorstUptimeValidatorsLimit = 10
+
+	// maxWorstUptimeValidatorsLimit is the maximum number of validators returned
+	// by the WorstUptimeValidators query
+	maxWorstUptimeValidatorsLimit = 100
+)
+
 func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -72,3 +82,119 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) Infractions(c context.Context, req *types.QueryInfractionsRequest) (*types.QueryInfractionsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	infractions := []types.Infraction{}
+
+	infractionStore := prefix.NewStore(store, types.ValidatorInfractionsPrefixKey(consAddr))
+	pageRes, err := query.Paginate(infractionStore, req.Pagination, func(key []byte, value []byte) error {
+		var infraction types.Infraction
+		err := k.cdc.Unmarshal(value, &infraction)
+		if err != nil {
+			return err
+		}
+		infractions = append(infractions, infraction)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInfractionsResponse{Infractions: infractions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ValidatorUptime(c context.Context, req *types.QueryValidatorUptimeRequest) (*types.QueryValidatorUptimeResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := validateHeightRange(req.FromHeight, req.ToHeight); err != nil {
+		return nil, err
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	buckets := []types.UptimeBucket{}
+
+	bucketStore := prefix.NewStore(store, types.ValidatorUptimeBucketsPrefixKey(consAddr))
+	rangeStore := query.NewHeightRangeStore(bucketStore, req.FromHeight, req.ToHeight)
+
+	pageRes, err := query.Paginate(rangeStore, req.Pagination, func(key []byte, value []byte) error {
+		var bucket types.UptimeBucket
+		if err := k.cdc.Unmarshal(value, &bucket); err != nil {
+			return err
+		}
+
+		bucket.StartHeight = int64(sdk.BigEndianToUint64(key))
+		buckets = append(buckets, bucket)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorUptimeResponse{
+		Buckets:    buckets,
+		Uptime:     k.GetValidatorUptime(ctx, consAddr, req.FromHeight, req.ToHeight),
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) WorstUptimeValidators(c context.Context, req *types.QueryWorstUptimeValidatorsRequest) (*types.QueryWorstUptimeValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validateHeightRange(req.FromHeight, req.ToHeight); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	switch {
+	case limit == 0:
+		limit = defaultWorstUptimeValidatorsLimit
+	case limit > maxWorstUptimeValidatorsLimit:
+		return nil, status.Errorf(codes.InvalidArgument, "limit %d exceeds the maximum of %d", limit, maxWorstUptimeValidatorsLimit)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := k.GetWorstUptimeValidators(ctx, req.FromHeight, req.ToHeight, int(limit))
+
+	return &types.QueryWorstUptimeValidatorsResponse{Validators: validators}, nil
+}
+
+func validateHeightRange(fromHeight, toHeight int64) error {
+	if fromHeight < 0 || toHeight < 0 {
+		return status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	if toHeight > 0 && fromHeight > toHeight {
+		return status.Errorf(codes.InvalidArgument, "from height %d is greater than to height %d", fromHeight, toHeight)
+	}
+
+	return nil
+}
@@ -41,9 +41,11 @@ func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) e
 	return k.AddPubkey(ctx, consPk)
 }
 
-// AfterValidatorRemoved deletes the address-pubkey relation when a validator is removed,
+// AfterValidatorRemoved deletes the address-pubkey relation and the uptime
+// history when a validator is removed,
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) error {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.deleteUptimeBuckets(ctx, address)
 	return nil
 }
 
@@ -82,12 +84,16 @@ func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
 	return nil
 }
 
-func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationCreated settles the delegator slashes of a delegation created
+// to a tombstoned validator, so that it is not refunded for an earlier infraction.
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.settleDelegatorSlashes(ctx, delAddr, valAddr)
 }
 
-func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+// BeforeDelegationSharesModified settles the delegator slashes of a delegation
+// to a tombstoned validator while its shares are still the ones it was slashed for.
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.k.settleDelegatorSlashes(ctx, delAddr, valAddr)
 }
 
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
@@ -101,3 +107,7 @@ func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.Va
 func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
 	return nil
 }
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
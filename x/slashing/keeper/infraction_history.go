@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// GetInfraction returns the infraction of the given type a validator was
+// punished for at the given height
+func (k Keeper) GetInfraction(ctx sdk.Context, address sdk.ConsAddress, height int64, infractionType types.InfractionType) (infraction types.Infraction, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.InfractionKey(address, height, infractionType))
+	if bz == nil {
+		return infraction, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &infraction)
+	return infraction, true
+}
+
+// HasDoubleSignInfraction returns true if a validator was punished for double
+// signing at the given height
+func (k Keeper) HasDoubleSignInfraction(ctx sdk.Context, address sdk.ConsAddress, height int64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.InfractionKey(address, height, types.InfractionDoubleSign))
+}
+
+// SetInfraction stores an infraction in the history of a validator
+func (k Keeper) SetInfraction(ctx sdk.Context, address sdk.ConsAddress, infraction types.Infraction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&infraction)
+	store.Set(types.InfractionKey(address, infraction.Height, infraction.Type), bz)
+}
+
+// IterateValidatorInfractions iterates over the infraction history of a
+// validator, oldest first
+func (k Keeper) IterateValidatorInfractions(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(infraction types.Infraction) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorInfractionsPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var infraction types.Infraction
+		k.cdc.MustUnmarshal(iter.Value(), &infraction)
+		if handler(infraction) {
+			break
+		}
+	}
+}
+
+// IterateInfractions iterates over the infraction history of all validators
+func (k Keeper) IterateInfractions(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, infraction types.Infraction) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.InfractionKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.InfractionAddress(iter.Key())
+		var infraction types.Infraction
+		k.cdc.MustUnmarshal(iter.Value(), &infraction)
+		if handler(address, infraction) {
+			break
+		}
+	}
+}
+
+// GetValidatorInfractions returns the infraction history of a validator,
+// oldest first
+func (k Keeper) GetValidatorInfractions(ctx sdk.Context, address sdk.ConsAddress) []types.Infraction {
+	infractions := []types.Infraction{}
+	k.IterateValidatorInfractions(ctx, address, func(infraction types.Infraction) (stop bool) {
+		infractions = append(infractions, infraction)
+		return false
+	})
+
+	return infractions
+}
+
+// SetDelegatorSlash stores the tokens slashed from a delegator for a double sign
+// infraction of a validator
+func (k Keeper) SetDelegatorSlash(ctx sdk.Context, address sdk.ConsAddress, delAddr sdk.AccAddress, delegatorSlash types.DelegatorSlash) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&delegatorSlash)
+	store.Set(types.DelegatorSlashKey(address, delegatorSlash.Height, delAddr), bz)
+}
+
+// GetDelegatorSlash returns the tokens slashed from a delegator for the double
+// sign infraction of a validator at the given height
+func (k Keeper) GetDelegatorSlash(ctx sdk.Context, address sdk.ConsAddress, height int64, delAddr sdk.AccAddress) (delegatorSlash types.DelegatorSlash, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DelegatorSlashKey(address, height, delAddr))
+	if bz == nil {
+		return delegatorSlash, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &delegatorSlash)
+	return delegatorSlash, true
+}
+
+// GetInfractionDelegatorSlashes returns the tokens slashed from each delegator
+// for the double sign infraction of a validator at the given height
+func (k Keeper) GetInfractionDelegatorSlashes(ctx sdk.Context, address sdk.ConsAddress, height int64) []types.DelegatorSlash {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.InfractionDelegatorSlashesPrefixKey(address, height))
+	defer iter.Close()
+
+	delegatorSlashes := []types.DelegatorSlash{}
+	for ; iter.Valid(); iter.Next() {
+		var delegatorSlash types.DelegatorSlash
+		k.cdc.MustUnmarshal(iter.Value(), &delegatorSlash)
+		delegatorSlashes = append(delegatorSlashes, delegatorSlash)
+	}
+
+	return delegatorSlashes
+}
+
+// IterateDelegatorSlashes iterates over the delegator slashes of the
+// infractions of all validators
+func (k Keeper) IterateDelegatorSlashes(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, delegatorSlash types.DelegatorSlash) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DelegatorSlashKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address := types.DelegatorSlashAddress(iter.Key())
+		var delegatorSlash types.DelegatorSlash
+		k.cdc.MustUnmarshal(iter.Value(), &delegatorSlash)
+		if handler(address, delegatorSlash) {
+			break
+		}
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,6 +11,13 @@ import (
 
 // HandleValidatorSignature handles a validator signature, must be called once per validator per block.
 func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Address, power int64, signed bool) {
+	k.HandleValidatorSignatureWithParams(ctx, k.GetParams(ctx), addr, power, signed)
+}
+
+// HandleValidatorSignatureWithParams handles a validator signature like
+// HandleValidatorSignature with the given module parameters, read once per
+// block by the caller.
+func (k Keeper) HandleValidatorSignatureWithParams(ctx sdk.Context, params types.Params, addr cryptotypes.Address, power int64, signed bool) {
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 
@@ -27,7 +35,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 
 	// this is a relative index, so it counts blocks the validator *should* have signed
 	// will use the 0-value default signing info if not present, except for start height
-	index := signInfo.IndexOffset % k.SignedBlocksWindow(ctx)
+	index := signInfo.IndexOffset % params.SignedBlocksWindow
 	signInfo.IndexOffset++
 
 	// Update signed block bit array & counter
@@ -48,7 +56,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		// Array value at this index has not changed, no need to update counter
 	}
 
-	minSignedPerWindow := k.MinSignedPerWindow(ctx)
+	k.recordUptime(ctx, params, consAddr, height, signed)
+
+	minSignedPerWindow := params.MinSignedPerWindowInt()
 
 	if missed {
 		ctx.EventManager().EmitEvent(
@@ -69,8 +79,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 		)
 	}
 
-	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
-	maxMissed := k.SignedBlocksWindow(ctx) - minSignedPerWindow
+	minHeight := signInfo.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - minSignedPerWindow
 
 	// if we are past the minimum height and the validator has missed too many blocks, punish them
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
@@ -84,7 +94,8 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			slashFraction := params.SlashFractionDowntime
+			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
@@ -97,7 +108,20 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			)
 			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			infraction := types.NewInfraction(
+				types.InfractionDowntime, height, ctx.BlockHeader().Time, power,
+				slashFraction, coinsBurned, time.Time{},
+			)
+			if params.DowntimeTombstone {
+				// the validator is tombstoned and jailed forever, as for double signing
+				signInfo.JailedUntil = types.TombstoneJailEndTime
+				signInfo.Tombstoned = true
+				infraction.Tombstoned = true
+			} else {
+				signInfo.JailedUntil = ctx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+			}
+			infraction.JailedUntil = signInfo.JailedUntil
+			k.SetInfraction(ctx, consAddr, infraction)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -110,7 +134,7 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
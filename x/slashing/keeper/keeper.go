@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -14,27 +15,30 @@ import (
 
 // Keeper of the slashing store
 type Keeper struct {
-	storeKey   storetypes.StoreKey
-	cdc        codec.BinaryCodec
-	sk         types.StakingKeeper
-	paramspace types.ParamSubspace
+	storeKey  storetypes.StoreKey
+	cdc       codec.BinaryCodec
+	sk        types.StakingKeeper
+	dk        types.DistributionKeeper
+	authority string
 }
 
-// NewKeeper creates a slashing keeper
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, paramspace types.ParamSubspace) Keeper {
-	// set KeyTable if it has not already been set
-	if !paramspace.HasKeyTable() {
-		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
-	}
-
+// NewKeeper creates a slashing keeper. The distribution keeper may be nil, in
+// which case slashed stake cannot be refunded when untombstoning a validator.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, sk types.StakingKeeper, dk types.DistributionKeeper, authority string) Keeper {
 	return Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		sk:         sk,
-		paramspace: paramspace,
+		storeKey:  key,
+		cdc:       cdc,
+		sk:        sk,
+		dk:        dk,
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the x/slashing module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
@@ -64,9 +68,30 @@ func (k Keeper) GetPubkey(ctx sdk.Context, a cryptotypes.Address) (cryptotypes.P
 }
 
 // Slash attempts to slash a validator. The slash is delegated to the staking
-// module to make the necessary validator changes.
+// module to make the necessary validator changes. The slash is recorded as a
+// double sign infraction in the infraction history of the validator, and the
+// tokens slashed from each delegator are stored under their own keys.
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, power, distributionHeight int64) {
-	coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, fraction)
+	// the delegator shares are recorded before the slash so that the tokens
+	// burned from the bonded stake can be attributed to the delegators lazily
+	delegatorShares := sdk.ZeroDec()
+	if validator := k.sk.ValidatorByConsAddr(ctx, consAddr); validator != nil {
+		delegatorShares = validator.GetDelegatorShares()
+	}
+
+	coinsBurned, delegatorSlashes := k.sk.SlashWithDelegators(ctx, consAddr, distributionHeight, power, fraction)
+
+	infraction := types.NewInfraction(
+		types.InfractionDoubleSign, ctx.BlockHeight(), ctx.BlockHeader().Time, power,
+		fraction, coinsBurned, time.Time{},
+	)
+	infraction.DelegatorShares = delegatorShares
+	k.SetInfraction(ctx, consAddr, infraction)
+	for _, delegatorSlash := range delegatorSlashes {
+		k.SetDelegatorSlash(ctx, consAddr, delegatorSlash.DelegatorAddress,
+			types.NewDelegatorSlash(delegatorSlash.DelegatorAddress, delegatorSlash.Amount, infraction.Height))
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlash,
@@ -15,6 +15,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testutil"
@@ -33,6 +34,7 @@ type KeeperTestSuite struct {
 	slashingKeeper    slashingkeeper.Keeper
 	stakingKeeper     *stakingkeeper.Keeper
 	bankKeeper        bankkeeper.Keeper
+	distrKeeper       distrkeeper.Keeper
 	accountKeeper     authkeeper.AccountKeeper
 	interfaceRegistry codectypes.InterfaceRegistry
 	addrDels          []sdk.AccAddress
@@ -46,6 +48,7 @@ func (s *KeeperTestSuite) SetupTest() {
 		&s.accountKeeper,
 		&s.slashingKeeper,
 		&s.stakingKeeper,
+		&s.distrKeeper,
 		&s.interfaceRegistry,
 	)
 	s.Require().NoError(err)
@@ -230,6 +233,60 @@ func (s *KeeperTestSuite) TestHandleAlreadyJailed() {
 	// validator should not have been slashed twice
 	validator, _ = s.stakingKeeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(val))
 	s.Require().Equal(resultingTokens, validator.GetTokens())
+
+	// the slash should have been recorded once in the infraction history
+	infractions := s.slashingKeeper.GetValidatorInfractions(ctx, sdk.GetConsAddress(val))
+	s.Require().Len(infractions, 1)
+	s.Require().Equal(types.InfractionDowntime, infractions[0].Type)
+	s.Require().Equal(s.stakingKeeper.TokensFromConsensusPower(ctx, 1), infractions[0].SlashedTokens)
+	s.Require().False(infractions[0].Tombstoned)
+}
+
+// Test a validator being "down" with the downtime tombstone param set
+// Ensure that it's tombstoned and jailed forever
+func (s *KeeperTestSuite) TestHandleDowntimeTombstone() {
+	ctx := s.ctx
+
+	params := s.slashingKeeper.GetParams(ctx)
+	params.DowntimeTombstone = true
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	addrDels := simtestutil.AddTestAddrsIncremental(s.bankKeeper, s.stakingKeeper, ctx, 1, s.stakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	power := int64(100)
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+
+	tstaking.CreateValidatorWithValPower(addr, val, power, true)
+
+	staking.EndBlocker(ctx, s.stakingKeeper)
+
+	// 1000 first blocks OK
+	height := int64(0)
+	for ; height < s.slashingKeeper.SignedBlocksWindow(ctx); height++ {
+		ctx = ctx.WithBlockHeight(height)
+		s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, true)
+	}
+
+	// 501 blocks missed
+	for ; height < s.slashingKeeper.SignedBlocksWindow(ctx)+(s.slashingKeeper.SignedBlocksWindow(ctx)-s.slashingKeeper.MinSignedPerWindow(ctx))+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		s.slashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+	}
+
+	// validator should have been jailed forever and tombstoned
+	consAddr := sdk.GetConsAddress(val)
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().True(found)
+	s.Require().True(info.Tombstoned)
+	s.Require().Equal(types.TombstoneJailEndTime.UTC(), info.JailedUntil)
+
+	infractions := s.slashingKeeper.GetValidatorInfractions(ctx, consAddr)
+	s.Require().Len(infractions, 1)
+	s.Require().Equal(types.InfractionDowntime, infractions[0].Type)
+	s.Require().True(infractions[0].Tombstoned)
+	s.Require().Equal(info.JailedUntil, infractions[0].JailedUntil)
 }
 
 // Test a validator dipping in and out of the validator set
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func (s *KeeperTestSuite) TestMsgUpdateParams() {
+	msgServer := slashingkeeper.NewMsgServerImpl(s.slashingKeeper)
+	authority := s.slashingKeeper.GetAuthority()
+	params := types.DefaultParams()
+	testCases := []struct {
+		name      string
+		input     func() *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: "authority",
+					Params:    params,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "zero signed blocks window",
+			input: func() *types.MsgUpdateParams {
+				params1 := params
+				params1.SignedBlocksWindow = 0
+
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "signed blocks window",
+		},
+		{
+			name: "negative double sign jail duration",
+			input: func() *types.MsgUpdateParams {
+				params1 := params
+				params1.DoubleSignJailDuration = -time.Second
+
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "double sign jail duration cannot be negative",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			msg := tc.input()
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(s.ctx), msg)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(msg.Params, s.slashingKeeper.GetParams(s.ctx))
+			}
+		})
+	}
+}
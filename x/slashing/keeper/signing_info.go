@@ -113,8 +113,38 @@ func (k Keeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime ti
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
 
+// ApplyDoubleSignJailPolicy sets the jail period of a validator punished for
+// double signing in the current block. The validator is tombstoned and jailed
+// forever when the DoubleSignJailDuration parameter is zero, otherwise it is
+// jailed for that duration unless already jailed until later. It will panic if
+// the signing info does not exist for the validator.
+func (k Keeper) ApplyDoubleSignJailPolicy(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	jailDuration := k.DoubleSignJailDuration(ctx)
+	if jailDuration == 0 {
+		k.JailUntil(ctx, consAddr, types.TombstoneJailEndTime)
+		k.Tombstone(ctx, consAddr)
+		return
+	}
+
+	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !ok {
+		panic("cannot jail validator that does not have any signing information")
+	}
+
+	if jailedUntil := ctx.BlockHeader().Time.Add(jailDuration); jailedUntil.After(signInfo.JailedUntil) {
+		signInfo.JailedUntil = jailedUntil
+		k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	}
+
+	if infraction, found := k.GetInfraction(ctx, consAddr, ctx.BlockHeight(), types.InfractionDoubleSign); found {
+		infraction.JailedUntil = signInfo.JailedUntil
+		k.SetInfraction(ctx, consAddr, infraction)
+	}
+}
+
 // Tombstone attempts to tombstone a validator. It will panic if signing info for
-// the given validator does not exist.
+// the given validator does not exist. The double sign infraction of the current
+// block in the infraction history of the validator is marked as tombstoning it.
 func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	signInfo, ok := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !ok {
@@ -127,6 +157,17 @@ func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
 
 	signInfo.Tombstoned = true
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+
+	infraction, found := k.GetInfraction(ctx, consAddr, ctx.BlockHeight(), types.InfractionDoubleSign)
+	if !found {
+		infraction = types.NewInfraction(
+			types.InfractionDoubleSign, ctx.BlockHeight(), ctx.BlockHeader().Time, 0,
+			sdk.ZeroDec(), sdk.ZeroInt(), time.Time{},
+		)
+	}
+	infraction.Tombstoned = true
+	infraction.JailedUntil = signInfo.JailedUntil
+	k.SetInfraction(ctx, consAddr, infraction)
 }
 
 // IsTombstoned returns if a given validator by consensus address is tombstoned.
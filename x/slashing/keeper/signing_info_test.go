@@ -86,3 +86,51 @@ func (suite *KeeperTestSuite) TestJailUntil() {
 	suite.Require().True(ok)
 	suite.Require().Equal(time.Unix(253402300799, 0).UTC(), info.JailedUntil)
 }
+
+func (suite *KeeperTestSuite) TestApplyDoubleSignJailPolicy() {
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+	consAddr := sdk.ConsAddress(suite.addrDels[3])
+
+	suite.Require().Panics(func() { suite.slashingKeeper.ApplyDoubleSignJailPolicy(ctx, consAddr) })
+
+	newInfo := types.NewValidatorSigningInfo(consAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(10))
+	suite.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, newInfo)
+	suite.slashingKeeper.SetInfraction(ctx, consAddr, types.NewInfraction(
+		types.InfractionDoubleSign, 10, ctx.BlockTime(), 100, sdk.NewDecWithPrec(5, 2), sdk.NewInt(5), time.Time{},
+	))
+
+	// the validator is only jailed for the double sign jail duration when it is set
+	params := suite.slashingKeeper.GetParams(ctx)
+	params.DoubleSignJailDuration = time.Hour
+	suite.Require().NoError(suite.slashingKeeper.SetParams(ctx, params))
+
+	suite.slashingKeeper.ApplyDoubleSignJailPolicy(ctx, consAddr)
+	info, ok := suite.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.Require().True(ok)
+	suite.Require().False(info.Tombstoned)
+	suite.Require().Equal(time.Unix(1000, 0).Add(time.Hour).UTC(), info.JailedUntil)
+
+	infraction, found := suite.slashingKeeper.GetInfraction(ctx, consAddr, 10, types.InfractionDoubleSign)
+	suite.Require().True(found)
+	suite.Require().False(infraction.Tombstoned)
+	suite.Require().Equal(info.JailedUntil, infraction.JailedUntil)
+
+	// a shorter jail period doesn't release the validator earlier
+	params.DoubleSignJailDuration = time.Minute
+	suite.Require().NoError(suite.slashingKeeper.SetParams(ctx, params))
+	suite.slashingKeeper.ApplyDoubleSignJailPolicy(ctx, consAddr)
+	info, _ = suite.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.Require().Equal(time.Unix(1000, 0).Add(time.Hour).UTC(), info.JailedUntil)
+
+	// the validator is tombstoned and jailed forever when the duration is zero
+	params.DoubleSignJailDuration = 0
+	suite.Require().NoError(suite.slashingKeeper.SetParams(ctx, params))
+	suite.slashingKeeper.ApplyDoubleSignJailPolicy(ctx, consAddr)
+	info, _ = suite.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	suite.Require().True(info.Tombstoned)
+	suite.Require().Equal(types.TombstoneJailEndTime.UTC(), info.JailedUntil)
+
+	infraction, _ = suite.slashingKeeper.GetInfraction(ctx, consAddr, 10, types.InfractionDoubleSign)
+	suite.Require().True(infraction.Tombstoned)
+	suite.Require().Equal(info.JailedUntil, infraction.JailedUntil)
+}
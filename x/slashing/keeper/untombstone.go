@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// Untombstone reverses the tombstoning of a validator. The validator stays
+// jailed but may be unjailed by its operator from the current block on. The
+// infractions the validator was tombstoned for are marked as appealed with the
+// given reason and, if refund is set, the tokens slashed for double signing are
+// refunded from the community pool to the delegators they were slashed from, as
+// recorded in the delegator slashes of the infractions once settled for the
+// current delegations. It returns the amount of tokens refunded.
+func (k Keeper) Untombstone(ctx sdk.Context, validatorAddr sdk.ValAddress, reason string, refund bool) (math.Int, error) {
+	if reason == "" {
+		return math.ZeroInt(), sdkerrors.ErrInvalidRequest.Wrap("reason cannot be blank")
+	}
+
+	validator := k.sk.Validator(ctx, validatorAddr)
+	if validator == nil {
+		return math.ZeroInt(), types.ErrNoValidatorForAddress
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return math.ZeroInt(), types.ErrNoSigningInfoFound
+	}
+
+	if !info.Tombstoned {
+		return math.ZeroInt(), types.ErrValidatorNotTombstoned
+	}
+
+	if refund && k.dk == nil {
+		return math.ZeroInt(), types.ErrRefundUnavailable
+	}
+
+	info.Tombstoned = false
+	info.JailedUntil = ctx.BlockHeader().Time
+	k.SetValidatorSigningInfo(ctx, consAddr, info)
+
+	var appealed []types.Infraction
+	k.IterateValidatorInfractions(ctx, consAddr, func(infraction types.Infraction) (stop bool) {
+		if infraction.Tombstoned && !infraction.IsAppealed() {
+			appealed = append(appealed, infraction)
+		}
+		return false
+	})
+
+	totalRefunded := math.ZeroInt()
+	for _, infraction := range appealed {
+		infraction.AppealReason = reason
+		if refund && infraction.Type == types.InfractionDoubleSign {
+			for _, delegation := range k.sk.GetValidatorDelegations(ctx, validatorAddr) {
+				k.settleDelegatorSlash(ctx, consAddr, infraction, delegation.GetDelegatorAddr(), delegation.Shares)
+			}
+
+			refunded, err := k.refundDelegators(ctx, k.GetInfractionDelegatorSlashes(ctx, consAddr, infraction.Height))
+			if err != nil {
+				return math.ZeroInt(), err
+			}
+
+			infraction.RefundedTokens = refunded
+			totalRefunded = totalRefunded.Add(refunded)
+		}
+
+		k.SetInfraction(ctx, consAddr, infraction)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUntombstone,
+			sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+			sdk.NewAttribute(types.AttributeKeyRefunded, totalRefunded.String()),
+		),
+	)
+
+	k.Logger(ctx).Info(
+		"untombstoned validator",
+		"validator", consAddr.String(),
+		"reason", reason,
+		"refunded", totalRefunded.String(),
+	)
+
+	return totalRefunded, nil
+}
+
+// refundDelegators sends the tokens of the bond denom slashed from each
+// delegator from the community pool to the delegator.
+func (k Keeper) refundDelegators(ctx sdk.Context, delegatorSlashes []types.DelegatorSlash) (math.Int, error) {
+	bondDenom := k.sk.BondDenom(ctx)
+	refunded := math.ZeroInt()
+	for _, delegatorSlash := range delegatorSlashes {
+		if !delegatorSlash.Amount.IsPositive() {
+			continue
+		}
+
+		delAddr, err := sdk.AccAddressFromBech32(delegatorSlash.DelegatorAddress)
+		if err != nil {
+			return math.ZeroInt(), err
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatorSlash.Amount))
+		if err := k.dk.DistributeFromFeePool(ctx, coins, delAddr); err != nil {
+			return math.ZeroInt(), err
+		}
+
+		refunded = refunded.Add(delegatorSlash.Amount)
+	}
+
+	return refunded, nil
+}
+
+// settleDelegatorSlashes settles the delegator slashes of a delegator for the
+// double sign infractions its validator is tombstoned for, from the current
+// shares of its delegation. It must be called before the shares of the
+// delegation change.
+func (k Keeper) settleDelegatorSlashes(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	validator := k.sk.Validator(ctx, valAddr)
+	if validator == nil {
+		return nil
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+
+	if !k.IsTombstoned(ctx, consAddr) {
+		return nil
+	}
+
+	var infractions []types.Infraction
+	k.IterateValidatorInfractions(ctx, consAddr, func(infraction types.Infraction) (stop bool) {
+		if infraction.Type == types.InfractionDoubleSign && infraction.Tombstoned && !infraction.IsAppealed() {
+			infractions = append(infractions, infraction)
+		}
+		return false
+	})
+
+	shares := sdk.ZeroDec()
+	if delegation := k.sk.Delegation(ctx, delAddr, valAddr); delegation != nil {
+		shares = delegation.GetShares()
+	}
+
+	for _, infraction := range infractions {
+		k.settleDelegatorSlash(ctx, consAddr, infraction, delAddr, shares)
+	}
+
+	return nil
+}
+
+// settleDelegatorSlash adds the share of a delegator in the tokens slashed from
+// the bonded stake of its validator for an infraction, pro rata to the given
+// delegation shares and truncated, to its delegator slash unless already settled.
+func (k Keeper) settleDelegatorSlash(ctx sdk.Context, consAddr sdk.ConsAddress, infraction types.Infraction, delAddr sdk.AccAddress, shares sdk.Dec) {
+	delegatorSlash, found := k.GetDelegatorSlash(ctx, consAddr, infraction.Height, delAddr)
+	if found && delegatorSlash.Settled {
+		return
+	}
+
+	if !found {
+		delegatorSlash = types.NewDelegatorSlash(delAddr, math.ZeroInt(), infraction.Height)
+	}
+
+	if infraction.DelegatorShares.IsPositive() {
+		amount := shares.MulInt(infraction.SlashedTokens).QuoTruncate(infraction.DelegatorShares).TruncateInt()
+		delegatorSlash.Amount = delegatorSlash.Amount.Add(amount)
+	}
+
+	delegatorSlash.Settled = true
+	k.SetDelegatorSlash(ctx, consAddr, delAddr, delegatorSlash)
+}
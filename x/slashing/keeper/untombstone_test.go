@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"time"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func (s *KeeperTestSuite) TestUntombstoneValidator() {
+	ctx := s.ctx.WithBlockHeight(10).WithBlockTime(time.Unix(1000, 0).UTC())
+
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(s.addrDels)
+	pks := simtestutil.CreateTestPubKeys(1)
+	valAddr, val := valAddrs[0], pks[0]
+	consAddr := sdk.GetConsAddress(val)
+	bondDenom := s.stakingKeeper.BondDenom(ctx)
+
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, val, 100, true)
+	tstaking.DelegateWithPower(s.addrDels[1], valAddr, 100)
+	staking.EndBlocker(ctx, s.stakingKeeper)
+	s.slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, types.NewValidatorSigningInfo(consAddr, 0, 0, time.Unix(0, 0), false, 0))
+
+	// the second delegator starts unbonding half of its stake after the infraction
+	tstaking.Ctx = ctx.WithBlockHeight(9)
+	tstaking.Undelegate(s.addrDels[1], valAddr, s.stakingKeeper.TokensFromConsensusPower(ctx, 50), true)
+
+	// punish the validator for double signing at height 8 the way x/evidence does
+	s.slashingKeeper.Slash(ctx, consAddr, sdk.NewDecWithPrec(5, 2), 200, 8)
+	s.slashingKeeper.Jail(ctx, consAddr)
+	s.slashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+	s.slashingKeeper.Tombstone(ctx, consAddr)
+
+	// 10 tokens are slashed, 2.5 of them from the unbonding delegation and 7.5
+	// from the validator, to be split between its delegators pro rata to their
+	// shares once settled
+	slashedTokens := s.stakingKeeper.TokensFromConsensusPower(ctx, 10)
+	infractions := s.slashingKeeper.GetValidatorInfractions(ctx, consAddr)
+	s.Require().Len(infractions, 1)
+	s.Require().Equal(types.InfractionDoubleSign, infractions[0].Type)
+	s.Require().Equal(int64(10), infractions[0].Height)
+	s.Require().Equal(slashedTokens.MulRaw(3).QuoRaw(4), infractions[0].SlashedTokens)
+	s.Require().Equal(sdk.NewDecFromInt(s.stakingKeeper.TokensFromConsensusPower(ctx, 150)), infractions[0].DelegatorShares)
+	s.Require().Equal([]types.DelegatorSlash{
+		types.NewDelegatorSlash(s.addrDels[1], slashedTokens.QuoRaw(4), 10),
+	}, s.slashingKeeper.GetInfractionDelegatorSlashes(ctx, consAddr, 10))
+	s.Require().True(evidencetypes.DoubleSignJailEndTime.Equal(infractions[0].JailedUntil))
+	s.Require().True(infractions[0].Tombstoned)
+	s.Require().False(infractions[0].IsAppealed())
+
+	s.Require().Error(s.slashingKeeper.Unjail(ctx, valAddr))
+
+	// the share of the second delegator is settled before it unbonds more of its
+	// stake after the infraction
+	tstaking.Ctx = ctx
+	tstaking.Undelegate(s.addrDels[1], valAddr, s.stakingKeeper.TokensFromConsensusPower(ctx, 10), true)
+	delegatorSlash, found := s.slashingKeeper.GetDelegatorSlash(ctx, consAddr, 10, s.addrDels[1])
+	s.Require().True(found)
+	s.Require().True(delegatorSlash.Settled)
+	s.Require().Equal(slashedTokens.QuoRaw(2), delegatorSlash.Amount)
+
+	msgServer := slashingkeeper.NewMsgServerImpl(s.slashingKeeper)
+	authority := s.slashingKeeper.GetAuthority()
+
+	// only the authority can untombstone a validator
+	_, err := msgServer.UntombstoneValidator(sdk.WrapSDKContext(ctx), types.NewMsgUntombstoneValidator(s.addrDels[1].String(), valAddr, "reason", false))
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	// the refund fails if the community pool cannot cover it, the state changes
+	// are discarded along with the failed message
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.UntombstoneValidator(sdk.WrapSDKContext(cacheCtx), types.NewMsgUntombstoneValidator(authority, valAddr, "reason", true))
+	s.Require().Error(err)
+
+	// a delegator joining after the infraction is not refunded
+	tstaking.DelegateWithPower(s.addrDels[2], valAddr, 100)
+
+	s.Require().NoError(s.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin(bondDenom, slashedTokens)), s.addrDels[3]))
+	var balancesBefore []sdk.Coin
+	for _, addr := range s.addrDels[:3] {
+		balancesBefore = append(balancesBefore, s.bankKeeper.GetBalance(ctx, addr, bondDenom))
+	}
+
+	res, err := msgServer.UntombstoneValidator(sdk.WrapSDKContext(ctx), types.NewMsgUntombstoneValidator(authority, valAddr, "faulty sentry setup", true))
+	s.Require().NoError(err)
+	s.Require().Equal(slashedTokens, res.RefundedTokens)
+	refund := s.stakingKeeper.TokensFromConsensusPower(ctx, 5)
+	s.Require().Equal(balancesBefore[0].Amount.Add(refund), s.bankKeeper.GetBalance(ctx, s.addrDels[0], bondDenom).Amount)
+	s.Require().Equal(balancesBefore[1].Amount.Add(refund), s.bankKeeper.GetBalance(ctx, s.addrDels[1], bondDenom).Amount)
+	s.Require().Equal(balancesBefore[2], s.bankKeeper.GetBalance(ctx, s.addrDels[2], bondDenom))
+
+	info, found := s.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	s.Require().True(found)
+	s.Require().False(info.Tombstoned)
+	s.Require().Equal(ctx.BlockTime(), info.JailedUntil)
+
+	infraction, found := s.slashingKeeper.GetInfraction(ctx, consAddr, 10, types.InfractionDoubleSign)
+	s.Require().True(found)
+	s.Require().Equal("faulty sentry setup", infraction.AppealReason)
+	s.Require().Equal(slashedTokens, infraction.RefundedTokens)
+
+	res2, err := s.queryClient.Infractions(sdk.WrapSDKContext(ctx), &types.QueryInfractionsRequest{ConsAddress: consAddr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.Infraction{infraction}, res2.Infractions)
+
+	// the validator can be untombstoned only once and unjailed afterwards
+	_, err = msgServer.UntombstoneValidator(sdk.WrapSDKContext(ctx), types.NewMsgUntombstoneValidator(authority, valAddr, "reason", false))
+	s.Require().ErrorIs(err, types.ErrValidatorNotTombstoned)
+	s.Require().NoError(s.slashingKeeper.Unjail(ctx, valAddr))
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// UptimeBucketSize - number of blocks of each bucket of the uptime history
+func (k Keeper) UptimeBucketSize(ctx sdk.Context) (res int64) {
+	return k.GetParams(ctx).UptimeBucketSize
+}
+
+// UptimeBucketsRetained - number of buckets of the uptime history kept per validator
+func (k Keeper) UptimeBucketsRetained(ctx sdk.Context) (res int64) {
+	return k.GetParams(ctx).UptimeBucketsRetained
+}
+
+// GetUptimeBucket returns the bucket of the uptime history of a validator
+// starting at the given height
+func (k Keeper) GetUptimeBucket(ctx sdk.Context, address sdk.ConsAddress, startHeight int64) (bucket types.UptimeBucket, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.UptimeBucketKey(address, startHeight)
+	bz := store.Get(key)
+	if bz == nil {
+		return bucket, false
+	}
+
+	return k.unmarshalUptimeBucket(key, bz), true
+}
+
+// SetUptimeBucket stores a bucket of the uptime history of a validator. The
+// start height is only stored in the key to keep the history compact.
+func (k Keeper) SetUptimeBucket(ctx sdk.Context, address sdk.ConsAddress, bucket types.UptimeBucket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.UptimeBucketKey(address, bucket.StartHeight)
+	bucket.StartHeight = 0
+	store.Set(key, k.cdc.MustMarshal(&bucket))
+}
+
+// IterateValidatorUptimeBuckets iterates over the buckets of the uptime history
+// of a validator starting between the given block heights, both inclusive,
+// ordered by height. No upper bound is applied when toHeight is zero.
+func (k Keeper) IterateValidatorUptimeBuckets(ctx sdk.Context,
+	address sdk.ConsAddress, fromHeight, toHeight int64, handler func(bucket types.UptimeBucket) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.UptimeBucketKey(address, fromHeight)
+	end := sdk.PrefixEndBytes(types.ValidatorUptimeBucketsPrefixKey(address))
+	if toHeight > 0 {
+		end = types.UptimeBucketKey(address, toHeight+1)
+	}
+
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(k.unmarshalUptimeBucket(iter.Key(), iter.Value())) {
+			break
+		}
+	}
+}
+
+// IterateUptimeBuckets iterates over the uptime history of all validators
+func (k Keeper) IterateUptimeBuckets(ctx sdk.Context,
+	handler func(address sdk.ConsAddress, bucket types.UptimeBucket) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UptimeBucketKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		address, _ := types.SplitUptimeBucketKey(iter.Key())
+		if handler(address, k.unmarshalUptimeBucket(iter.Key(), iter.Value())) {
+			break
+		}
+	}
+}
+
+// GetValidatorUptime summarizes the uptime of a validator over the buckets
+// starting between the given block heights, both inclusive. No upper bound is
+// applied when toHeight is zero.
+func (k Keeper) GetValidatorUptime(ctx sdk.Context, address sdk.ConsAddress, fromHeight, toHeight int64) types.ValidatorUptime {
+	var signed, missed uint64
+	k.IterateValidatorUptimeBuckets(ctx, address, fromHeight, toHeight, func(bucket types.UptimeBucket) (stop bool) {
+		signed += bucket.SignedBlocks
+		missed += bucket.MissedBlocks
+		return false
+	})
+
+	return types.NewValidatorUptime(address, signed, missed)
+}
+
+// GetWorstUptimeValidators returns at most limit validators with the lowest
+// uptime over the buckets starting between the given block heights, both
+// inclusive. Validators with the same uptime are ordered by missed blocks,
+// then by address. No upper bound is applied when toHeight is zero.
+func (k Keeper) GetWorstUptimeValidators(ctx sdk.Context, fromHeight, toHeight int64, limit int) []types.ValidatorUptime {
+	type counts struct {
+		address        sdk.ConsAddress
+		signed, missed uint64
+	}
+
+	var validators []*counts
+	k.IterateUptimeBuckets(ctx, func(address sdk.ConsAddress, bucket types.UptimeBucket) (stop bool) {
+		if bucket.StartHeight < fromHeight || (toHeight > 0 && bucket.StartHeight > toHeight) {
+			return false
+		}
+
+		// the buckets of a validator are contiguous in the store
+		if n := len(validators); n == 0 || !validators[n-1].address.Equals(address) {
+			validators = append(validators, &counts{address: address})
+		}
+
+		c := validators[len(validators)-1]
+		c.signed += bucket.SignedBlocks
+		c.missed += bucket.MissedBlocks
+		return false
+	})
+
+	uptimes := make([]types.ValidatorUptime, 0, len(validators))
+	for _, c := range validators {
+		uptimes = append(uptimes, types.NewValidatorUptime(c.address, c.signed, c.missed))
+	}
+
+	sort.SliceStable(uptimes, func(i, j int) bool {
+		if !uptimes[i].Uptime.Equal(uptimes[j].Uptime) {
+			return uptimes[i].Uptime.LT(uptimes[j].Uptime)
+		}
+		if uptimes[i].MissedBlocks != uptimes[j].MissedBlocks {
+			return uptimes[i].MissedBlocks > uptimes[j].MissedBlocks
+		}
+		return uptimes[i].Address < uptimes[j].Address
+	})
+
+	if len(uptimes) > limit {
+		uptimes = uptimes[:limit]
+	}
+
+	return uptimes
+}
+
+// recordUptime counts a block signed or missed by a validator in the bucket of
+// its uptime history containing the given height, if the uptime history is
+// enabled. The oldest buckets are pruned whenever a new bucket is started.
+func (k Keeper) recordUptime(ctx sdk.Context, params types.Params, address sdk.ConsAddress, height int64, signed bool) {
+	if params.UptimeBucketSize <= 0 {
+		return
+	}
+
+	startHeight := height - height%params.UptimeBucketSize
+	bucket, found := k.GetUptimeBucket(ctx, address, startHeight)
+	if !found {
+		bucket = types.UptimeBucket{StartHeight: startHeight}
+		k.pruneUptimeBuckets(ctx, address, startHeight-(params.UptimeBucketsRetained-1)*params.UptimeBucketSize)
+	}
+
+	if signed {
+		bucket.SignedBlocks++
+	} else {
+		bucket.MissedBlocks++
+	}
+
+	k.SetUptimeBucket(ctx, address, bucket)
+}
+
+// pruneUptimeBuckets deletes the buckets of the uptime history of a validator
+// starting before the given height
+func (k Keeper) pruneUptimeBuckets(ctx sdk.Context, address sdk.ConsAddress, beforeHeight int64) {
+	if beforeHeight <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.ValidatorUptimeBucketsPrefixKey(address), types.UptimeBucketKey(address, beforeHeight))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// deleteUptimeBuckets deletes the whole uptime history of a validator
+func (k Keeper) deleteUptimeBuckets(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorUptimeBucketsPrefixKey(address))
+	defer iter.Close()
+
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// unmarshalUptimeBucket decodes an uptime bucket from the start height in its
+// key and the block counts in its value.
+func (k Keeper) unmarshalUptimeBucket(key, value []byte) types.UptimeBucket {
+	var bucket types.UptimeBucket
+	k.cdc.MustUnmarshal(value, &bucket)
+	_, bucket.StartHeight = types.SplitUptimeBucketKey(key)
+
+	return bucket
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func (s *KeeperTestSuite) TestUptimeHistory() {
+	ctx := s.ctx.WithBlockHeight(1)
+
+	valAddrs := simtestutil.ConvertAddrsToValAddrs(s.addrDels)
+	pks := simtestutil.CreateTestPubKeys(2)
+	tstaking := teststaking.NewHelper(s.T(), ctx, s.stakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddrs[0], pks[0], 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], pks[1], 100, true)
+	staking.EndBlocker(ctx, s.stakingKeeper)
+	goodAddr, badAddr := sdk.GetConsAddress(pks[0]), sdk.GetConsAddress(pks[1])
+
+	// the uptime history is not recorded by default
+	s.slashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 100, true)
+	_, found := s.slashingKeeper.GetUptimeBucket(ctx, goodAddr, 0)
+	s.Require().False(found)
+
+	params := s.slashingKeeper.GetParams(ctx)
+	params.UptimeBucketSize = 10
+	params.UptimeBucketsRetained = 0
+	s.Require().Error(s.slashingKeeper.SetParams(ctx, params))
+	params.UptimeBucketsRetained = 2
+	s.Require().NoError(s.slashingKeeper.SetParams(ctx, params))
+
+	// the bad validator misses every fifth block
+	for height := int64(2); height < 30; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		s.slashingKeeper.HandleValidatorSignature(ctx, pks[0].Address(), 100, true)
+		s.slashingKeeper.HandleValidatorSignature(ctx, pks[1].Address(), 100, height%5 != 0)
+	}
+
+	// the oldest bucket has been pruned
+	_, found = s.slashingKeeper.GetUptimeBucket(ctx, badAddr, 0)
+	s.Require().False(found)
+
+	bucket, found := s.slashingKeeper.GetUptimeBucket(ctx, badAddr, 20)
+	s.Require().True(found)
+	s.Require().Equal(types.UptimeBucket{StartHeight: 20, SignedBlocks: 8, MissedBlocks: 2}, bucket)
+
+	uptime := s.slashingKeeper.GetValidatorUptime(ctx, badAddr, 0, 0)
+	s.Require().Equal(types.NewValidatorUptime(badAddr, 16, 4), uptime)
+	s.Require().Equal(sdk.NewDecWithPrec(8, 1), uptime.Uptime)
+
+	res, err := s.queryClient.ValidatorUptime(sdk.WrapSDKContext(ctx), &types.QueryValidatorUptimeRequest{
+		ConsAddress: badAddr.String(),
+		FromHeight:  15,
+		Pagination:  &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.UptimeBucket{bucket}, res.Buckets)
+	s.Require().Equal(types.NewValidatorUptime(badAddr, 8, 2), res.Uptime)
+	s.Require().Equal(uint64(1), res.Pagination.Total)
+
+	// the pagination doesn't go past the end of the height range
+	res, err = s.queryClient.ValidatorUptime(sdk.WrapSDKContext(ctx), &types.QueryValidatorUptimeRequest{
+		ConsAddress: badAddr.String(),
+		ToHeight:    19,
+		Pagination:  &query.PageRequest{Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.UptimeBucket{{StartHeight: 10, SignedBlocks: 8, MissedBlocks: 2}}, res.Buckets)
+	s.Require().Nil(res.Pagination.NextKey)
+
+	_, err = s.queryClient.ValidatorUptime(sdk.WrapSDKContext(ctx), &types.QueryValidatorUptimeRequest{
+		ConsAddress: badAddr.String(),
+		FromHeight:  20,
+		ToHeight:    10,
+	})
+	s.Require().Error(err)
+
+	res2, err := s.queryClient.WorstUptimeValidators(sdk.WrapSDKContext(ctx), &types.QueryWorstUptimeValidatorsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ValidatorUptime{
+		types.NewValidatorUptime(badAddr, 16, 4),
+		types.NewValidatorUptime(goodAddr, 20, 0),
+	}, res2.Validators)
+
+	res2, err = s.queryClient.WorstUptimeValidators(sdk.WrapSDKContext(ctx), &types.QueryWorstUptimeValidatorsRequest{ToHeight: 19, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ValidatorUptime{types.NewValidatorUptime(badAddr, 8, 2)}, res2.Validators)
+
+	_, err = s.queryClient.WorstUptimeValidators(sdk.WrapSDKContext(ctx), &types.QueryWorstUptimeValidatorsRequest{Limit: 101})
+	s.Require().Error(err)
+
+	// the uptime history is deleted along with the validator
+	s.Require().NoError(s.slashingKeeper.Hooks().AfterValidatorRemoved(ctx, badAddr, valAddrs[1]))
+	s.slashingKeeper.IterateValidatorUptimeBuckets(ctx, badAddr, 0, 0, func(bucket types.UptimeBucket) (stop bool) {
+		s.Failf("uptime bucket not deleted", "bucket %v", bucket)
+		return true
+	})
+	_, found = s.slashingKeeper.GetUptimeBucket(ctx, goodAddr, 20)
+	s.Require().True(found)
+}
@@ -39,6 +39,24 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.InfractionKeyPrefix):
+			var infractionA, infractionB types.Infraction
+			cdc.MustUnmarshal(kvA.Value, &infractionA)
+			cdc.MustUnmarshal(kvB.Value, &infractionB)
+			return fmt.Sprintf("%v\n%v", infractionA, infractionB)
+
+		case bytes.Equal(kvA.Key[:1], types.UptimeBucketKeyPrefix):
+			var bucketA, bucketB types.UptimeBucket
+			cdc.MustUnmarshal(kvA.Value, &bucketA)
+			cdc.MustUnmarshal(kvB.Value, &bucketB)
+			return fmt.Sprintf("%v\n%v", bucketA, bucketB)
+
+		case bytes.Equal(kvA.Key[:1], types.DelegatorSlashKeyPrefix):
+			var delegatorSlashA, delegatorSlashB types.DelegatorSlash
+			cdc.MustUnmarshal(kvA.Value, &delegatorSlashA)
+			cdc.MustUnmarshal(kvB.Value, &delegatorSlashB)
+			return fmt.Sprintf("%v\n%v", delegatorSlashA, delegatorSlashB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -33,6 +33,9 @@ func TestDecodeStore(t *testing.T) {
 
 	info := types.NewValidatorSigningInfo(consAddr1, 0, 1, time.Now().UTC(), false, 0)
 	missed := gogotypes.BoolValue{Value: true}
+	bucket := types.UptimeBucket{SignedBlocks: 9, MissedBlocks: 1}
+	infraction := types.NewInfraction(types.InfractionDowntime, 10, time.Now().UTC(), 100, sdk.NewDecWithPrec(1, 2), sdk.NewInt(5), time.Now().UTC())
+	delegatorSlash := types.NewDelegatorSlash(delAddr1, sdk.NewInt(5), 10)
 	bz, err := cdc.MarshalInterface(delPk1)
 	require.NoError(t, err)
 
@@ -41,6 +44,9 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.ValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshal(&info)},
 			{Key: types.ValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshal(&missed)},
 			{Key: types.AddrPubkeyRelationKey(delAddr1), Value: bz},
+			{Key: types.InfractionKey(consAddr1, 10, types.InfractionDowntime), Value: cdc.MustMarshal(&infraction)},
+			{Key: types.UptimeBucketKey(consAddr1, 10), Value: cdc.MustMarshal(&bucket)},
+			{Key: types.DelegatorSlashKey(consAddr1, 10, delAddr1), Value: cdc.MustMarshal(&delegatorSlash)},
 			{Key: []byte{0x99}, Value: []byte{0x99}}, // This test should panic
 		},
 	}
@@ -53,6 +59,9 @@ func TestDecodeStore(t *testing.T) {
 		{"ValidatorSigningInfo", fmt.Sprintf("%v\n%v", info, info), false},
 		{"ValidatorMissedBlockBitArray", fmt.Sprintf("missedA: %v\nmissedB: %v", missed.Value, missed.Value), false},
 		{"AddrPubkeyRelation", fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", delPk1, delPk1), false},
+		{"Infraction", fmt.Sprintf("%v\n%v", infraction, infraction), false},
+		{"UptimeBucket", fmt.Sprintf("%v\n%v", bucket, bucket), false},
+		{"DelegatorSlash", fmt.Sprintf("%v\n%v", delegatorSlash, delegatorSlash), false},
 		{"other", "", true},
 	}
 	for i, tt := range tests {
@@ -21,6 +21,10 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+	UptimeBucketSize        = "uptime_bucket_size"
+	UptimeBucketsRetained   = "uptime_buckets_retained"
+	DoubleSignJailDuration  = "double_sign_jail_duration"
+	DowntimeTombstone       = "downtime_tombstone"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +52,37 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenUptimeBucketSize randomized UptimeBucketSize, the uptime history is
+// disabled in a quarter of the simulations
+func GenUptimeBucketSize(r *rand.Rand) int64 {
+	if r.Intn(4) == 0 {
+		return 0
+	}
+
+	return int64(simulation.RandIntBetween(r, 1, 50))
+}
+
+// GenUptimeBucketsRetained randomized UptimeBucketsRetained
+func GenUptimeBucketsRetained(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 20))
+}
+
+// GenDoubleSignJailDuration randomized DoubleSignJailDuration, double signing
+// tombstones the validator in half of the simulations
+func GenDoubleSignJailDuration(r *rand.Rand) time.Duration {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24*7)) * time.Second
+}
+
+// GenDowntimeTombstone randomized DowntimeTombstone, downtime tombstones the
+// validator in a tenth of the simulations
+func GenDowntimeTombstone(r *rand.Rand) bool {
+	return r.Intn(10) == 0
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +115,43 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var uptimeBucketSize int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UptimeBucketSize, &uptimeBucketSize, simState.Rand,
+		func(r *rand.Rand) { uptimeBucketSize = GenUptimeBucketSize(r) },
+	)
+
+	var uptimeBucketsRetained int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, UptimeBucketsRetained, &uptimeBucketsRetained, simState.Rand,
+		func(r *rand.Rand) { uptimeBucketsRetained = GenUptimeBucketsRetained(r) },
+	)
+
+	var doubleSignJailDuration time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DoubleSignJailDuration, &doubleSignJailDuration, simState.Rand,
+		func(r *rand.Rand) { doubleSignJailDuration = GenDoubleSignJailDuration(r) },
+	)
+
+	var downtimeTombstone bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeTombstone, &downtimeTombstone, simState.Rand,
+		func(r *rand.Rand) { downtimeTombstone = GenDowntimeTombstone(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
 	)
-
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	params.UptimeBucketSize = uptimeBucketSize
+	params.UptimeBucketsRetained = uptimeBucketsRetained
+	params.DoubleSignJailDuration = doubleSignJailDuration
+	params.DowntimeTombstone = downtimeTombstone
+
+	slashingGenesis := types.NewGenesisState(
+		params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{},
+		[]types.ValidatorInfractions{}, []types.ValidatorUptimeHistory{},
+	)
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
@@ -54,6 +54,10 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec3, slashingGenesis.Params.SlashFractionDowntime)
 	require.Equal(t, int64(720), slashingGenesis.Params.SignedBlocksWindow)
 	require.Equal(t, time.Duration(34800000000000), slashingGenesis.Params.DowntimeJailDuration)
+	require.Equal(t, int64(46), slashingGenesis.Params.UptimeBucketSize)
+	require.Equal(t, int64(18), slashingGenesis.Params.UptimeBucketsRetained)
+	require.Equal(t, time.Duration(0), slashingGenesis.Params.DoubleSignJailDuration)
+	require.False(t, slashingGenesis.Params.DowntimeTombstone)
 	require.Len(t, slashingGenesis.MissedBlocks, 0)
 	require.Len(t, slashingGenesis.SigningInfos, 0)
 }
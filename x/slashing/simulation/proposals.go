@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+const OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams with random slashing params.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := types.NewParams(
+			GenSignedBlocksWindow(r), GenMinSignedPerWindow(r), GenDowntimeJailDuration(r),
+			GenSlashFractionDoubleSign(r), GenSlashFractionDowntime(r),
+		)
+		params.UptimeBucketSize = GenUptimeBucketSize(r)
+		params.UptimeBucketsRetained = GenUptimeBucketsRetained(r)
+		params.DoubleSignJailDuration = GenDoubleSignJailDuration(r)
+		params.DowntimeTombstone = GenDowntimeTombstone(r)
+
+		return &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+	}
+}
@@ -13,4 +13,6 @@ var (
 	ErrMissingSelfDelegation        = sdkerrors.Register(ModuleName, 6, "validator has no self-delegation; cannot be unjailed")
 	ErrSelfDelegationTooLowToUnjail = sdkerrors.Register(ModuleName, 7, "validator's self delegation less than minimum; cannot be unjailed")
 	ErrNoSigningInfoFound           = sdkerrors.Register(ModuleName, 8, "no validator signing info found")
+	ErrValidatorNotTombstoned       = sdkerrors.Register(ModuleName, 9, "validator not tombstoned; cannot be untombstoned")
+	ErrRefundUnavailable            = sdkerrors.Register(ModuleName, 10, "refunds from the community pool are not available")
 )
@@ -6,7 +6,6 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -24,15 +23,6 @@ type BankKeeper interface {
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
-// ParamSubspace defines the expected Subspace interfacace
-type ParamSubspace interface {
-	HasKeyTable() bool
-	WithKeyTable(table paramtypes.KeyTable) paramtypes.Subspace
-	Get(ctx sdk.Context, key []byte, ptr interface{})
-	GetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
-	SetParamSet(ctx sdk.Context, ps paramtypes.ParamSet)
-}
-
 // StakingKeeper expected staking keeper
 type StakingKeeper interface {
 	// iterate through validators by operator address, execute func for each validator
@@ -44,15 +34,28 @@ type StakingKeeper interface {
 
 	// slash the validator and delegators of the validator, specifying offence height, offence power, and slash fraction
 	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) math.Int
+	// slash the validator like Slash and return the tokens slashed from each of its delegators
+	SlashWithDelegators(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec) (math.Int, []stakingtypes.DelegatorSlash)
 	Jail(sdk.Context, sdk.ConsAddress)   // jail a validator
 	Unjail(sdk.Context, sdk.ConsAddress) // unjail a validator
 
 	// Delegation allows for getting a particular delegation for a given validator
 	// and delegator outside the scope of the staking module.
 	Delegation(sdk.Context, sdk.AccAddress, sdk.ValAddress) stakingtypes.DelegationI
+	// GetValidatorDelegations returns all the delegations to a validator
+	GetValidatorDelegations(sdk.Context, sdk.ValAddress) []stakingtypes.Delegation
 
 	// MaxValidators returns the maximum amount of bonded validators
 	MaxValidators(sdk.Context) uint32
+
+	// BondDenom returns the denomination of the staking token
+	BondDenom(sdk.Context) string
+}
+
+// DistributionKeeper defines the expected distribution keeper used to refund
+// slashed stake from the community pool
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
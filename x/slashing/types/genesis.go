@@ -10,11 +10,14 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	infractions []ValidatorInfractions, uptimeHistory []ValidatorUptimeHistory,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:        params,
+		SigningInfos:  signingInfos,
+		MissedBlocks:  missedBlocks,
+		Infractions:   infractions,
+		UptimeHistory: uptimeHistory,
 	}
 }
 
@@ -29,9 +32,11 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:        DefaultParams(),
+		SigningInfos:  []SigningInfo{},
+		MissedBlocks:  []ValidatorMissedBlocks{},
+		Infractions:   []ValidatorInfractions{},
+		UptimeHistory: []ValidatorUptimeHistory{},
 	}
 }
 
@@ -62,5 +67,79 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateUptimeBucketSize(data.Params.UptimeBucketSize); err != nil {
+		return err
+	}
+
+	if err := validateUptimeBucketsRetained(data.Params.UptimeBucketsRetained); err != nil {
+		return err
+	}
+
+	if err := validateInfractions(data.Infractions); err != nil {
+		return err
+	}
+
+	return validateUptimeHistory(data.UptimeHistory)
+}
+
+func validateInfractions(validatorInfractions []ValidatorInfractions) error {
+	for _, vi := range validatorInfractions {
+		if _, err := sdk.ConsAddressFromBech32(vi.Address); err != nil {
+			return fmt.Errorf("invalid infraction address %s: %w", vi.Address, err)
+		}
+
+		seen := make(map[string]bool, len(vi.Infractions))
+		for _, infraction := range vi.Infractions {
+			if err := infraction.Validate(); err != nil {
+				return fmt.Errorf("invalid infraction of %s: %w", vi.Address, err)
+			}
+
+			key := fmt.Sprintf("%d/%d", infraction.Height, infraction.Type)
+			if seen[key] {
+				return fmt.Errorf("duplicate %s infraction of %s at height %d", infraction.Type, vi.Address, infraction.Height)
+			}
+			seen[key] = true
+		}
+
+		seenSlashes := make(map[string]bool, len(vi.DelegatorSlashes))
+		for _, delegatorSlash := range vi.DelegatorSlashes {
+			if err := delegatorSlash.Validate(); err != nil {
+				return fmt.Errorf("invalid delegator slash of %s: %w", vi.Address, err)
+			}
+
+			if !seen[fmt.Sprintf("%d/%d", delegatorSlash.Height, InfractionDoubleSign)] {
+				return fmt.Errorf("delegator slash of %s at height %d has no double sign infraction", vi.Address, delegatorSlash.Height)
+			}
+
+			key := fmt.Sprintf("%d/%s", delegatorSlash.Height, delegatorSlash.DelegatorAddress)
+			if seenSlashes[key] {
+				return fmt.Errorf("duplicate delegator slash of %s at height %d for %s", vi.Address, delegatorSlash.Height, delegatorSlash.DelegatorAddress)
+			}
+			seenSlashes[key] = true
+		}
+	}
+
+	return nil
+}
+
+func validateUptimeHistory(uptimeHistory []ValidatorUptimeHistory) error {
+	for _, history := range uptimeHistory {
+		if _, err := sdk.ConsAddressFromBech32(history.Address); err != nil {
+			return fmt.Errorf("invalid uptime history address %s: %w", history.Address, err)
+		}
+
+		seen := make(map[int64]bool, len(history.Buckets))
+		for _, bucket := range history.Buckets {
+			if bucket.StartHeight < 0 {
+				return fmt.Errorf("uptime bucket start height of %s cannot be negative, is %d", history.Address, bucket.StartHeight)
+			}
+
+			if seen[bucket.StartHeight] {
+				return fmt.Errorf("duplicate uptime bucket of %s at height %d", history.Address, bucket.StartHeight)
+			}
+			seen[bucket.StartHeight] = true
+		}
+	}
+
 	return nil
 }
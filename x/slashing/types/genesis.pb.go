@@ -34,6 +34,16 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// infractions represents a map between validator addresses and their
+	// infraction history.
+	//
+	// Since: cosmos-sdk 0.47
+	Infractions []ValidatorInfractions `protobuf:"bytes,4,rep,name=infractions,proto3" json:"infractions"`
+	// uptime_history represents a map between validator addresses and the
+	// buckets of their uptime history.
+	//
+	// Since: cosmos-sdk 0.47
+	UptimeHistory []ValidatorUptimeHistory `protobuf:"bytes,5,rep,name=uptime_history,json=uptimeHistory,proto3" json:"uptime_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +100,20 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetInfractions() []ValidatorInfractions {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUptimeHistory() []ValidatorUptimeHistory {
+	if m != nil {
+		return m.UptimeHistory
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -256,11 +280,139 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorInfractions contains the infraction history of the corresponding
+// address.
+//
+// Since: cosmos-sdk 0.47
+type ValidatorInfractions struct {
+	// address is the validator address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// infractions is the infraction history of the validator.
+	Infractions []Infraction `protobuf:"bytes,2,rep,name=infractions,proto3" json:"infractions"`
+	// delegator_slashes are the tokens slashed from each delegator for the
+	// double sign infractions of the validator.
+	DelegatorSlashes []DelegatorSlash `protobuf:"bytes,3,rep,name=delegator_slashes,json=delegatorSlashes,proto3" json:"delegator_slashes"`
+}
+
+func (m *ValidatorInfractions) Reset()         { *m = ValidatorInfractions{} }
+func (m *ValidatorInfractions) String() string { return proto.CompactTextString(m) }
+func (*ValidatorInfractions) ProtoMessage()    {}
+func (*ValidatorInfractions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{4}
+}
+func (m *ValidatorInfractions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorInfractions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorInfractions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorInfractions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorInfractions.Merge(m, src)
+}
+func (m *ValidatorInfractions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorInfractions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorInfractions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorInfractions proto.InternalMessageInfo
+
+func (m *ValidatorInfractions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorInfractions) GetInfractions() []Infraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *ValidatorInfractions) GetDelegatorSlashes() []DelegatorSlash {
+	if m != nil {
+		return m.DelegatorSlashes
+	}
+	return nil
+}
+
+// ValidatorUptimeHistory contains the uptime history of the corresponding
+// address.
+//
+// Since: cosmos-sdk 0.47
+type ValidatorUptimeHistory struct {
+	// address is the validator address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// buckets are the buckets of the uptime history of the validator.
+	Buckets []UptimeBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ValidatorUptimeHistory) Reset()         { *m = ValidatorUptimeHistory{} }
+func (m *ValidatorUptimeHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUptimeHistory) ProtoMessage()    {}
+func (*ValidatorUptimeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{5}
+}
+func (m *ValidatorUptimeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUptimeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUptimeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUptimeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUptimeHistory.Merge(m, src)
+}
+func (m *ValidatorUptimeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUptimeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUptimeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUptimeHistory proto.InternalMessageInfo
+
+func (m *ValidatorUptimeHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorUptimeHistory) GetBuckets() []UptimeBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorInfractions)(nil), "cosmos.slashing.v1beta1.ValidatorInfractions")
+	proto.RegisterType((*ValidatorUptimeHistory)(nil), "cosmos.slashing.v1beta1.ValidatorUptimeHistory")
 }
 
 func init() {
@@ -268,34 +420,43 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0xa6, 0x4d, 0xbf, 0x6f, 0xd3, 0x20, 0x58, 0x85, 0x60, 0x7a, 0x70, 0x23, 0x43,
+	0xa1, 0x97, 0xd8, 0x6a, 0x38, 0x22, 0x0e, 0x44, 0xa0, 0x52, 0x21, 0x54, 0x94, 0xa8, 0x48, 0x54,
+	0x48, 0x91, 0x63, 0x6f, 0x9c, 0x55, 0xe2, 0xdd, 0xc8, 0xb3, 0x89, 0xda, 0xb7, 0x40, 0xe2, 0xca,
+	0x23, 0x20, 0x4e, 0x3c, 0x44, 0x8f, 0x15, 0x27, 0x4e, 0x08, 0x25, 0x8f, 0xc0, 0x0b, 0xa0, 0xec,
+	0xae, 0x89, 0x0b, 0xb6, 0x82, 0x72, 0x4a, 0x76, 0xf7, 0xff, 0xff, 0xcd, 0xcc, 0xce, 0x78, 0xd1,
+	0xbe, 0xcf, 0x21, 0xe2, 0xe0, 0xc2, 0xc8, 0x83, 0x01, 0x65, 0xa1, 0x3b, 0x3d, 0xec, 0x11, 0xe1,
+	0x1d, 0xba, 0x21, 0x61, 0x04, 0x28, 0x38, 0xe3, 0x98, 0x0b, 0x8e, 0xef, 0x28, 0x99, 0x93, 0xc8,
+	0x1c, 0x2d, 0xdb, 0xad, 0x86, 0x3c, 0xe4, 0x52, 0xe3, 0x2e, 0xfe, 0x29, 0xf9, 0xee, 0x83, 0x3c,
+	0xea, 0x6f, 0xbf, 0xd2, 0xdd, 0x55, 0xba, 0xae, 0x02, 0xe8, 0x18, 0x72, 0x61, 0x7f, 0x2e, 0xa2,
+	0x9d, 0x23, 0x95, 0x43, 0x47, 0x78, 0x82, 0xe0, 0x27, 0xa8, 0x34, 0xf6, 0x62, 0x2f, 0x02, 0xd3,
+	0xa8, 0x1b, 0x07, 0xe5, 0xe6, 0x9e, 0x93, 0x93, 0x93, 0xf3, 0x5a, 0xca, 0x5a, 0x9b, 0x97, 0xdf,
+	0xf7, 0x0a, 0x6d, 0x6d, 0xc2, 0x27, 0xa8, 0x02, 0x34, 0x64, 0x94, 0x85, 0x5d, 0xca, 0xfa, 0x1c,
+	0xcc, 0x8d, 0x7a, 0xf1, 0xa0, 0xdc, 0xbc, 0x9f, 0x4b, 0xe9, 0x28, 0xf5, 0x31, 0xeb, 0x73, 0x8d,
+	0xda, 0x81, 0xe5, 0x16, 0xe0, 0xb7, 0xa8, 0x12, 0x51, 0x00, 0x12, 0x74, 0x7b, 0x23, 0xee, 0x0f,
+	0xc1, 0x2c, 0x4a, 0xa0, 0x93, 0x0b, 0x7c, 0xe3, 0x8d, 0x68, 0xe0, 0x09, 0x1e, 0xbf, 0x92, 0xb6,
+	0x96, 0x74, 0x25, 0xe8, 0x28, 0xb5, 0x87, 0x4f, 0x51, 0x99, 0xb2, 0x7e, 0xec, 0xf9, 0x82, 0x72,
+	0x06, 0xe6, 0xa6, 0x04, 0x37, 0x56, 0x83, 0x8f, 0x97, 0x26, 0xcd, 0x4d, 0x73, 0xf0, 0x3b, 0x74,
+	0x63, 0x32, 0x16, 0x34, 0x22, 0xdd, 0x01, 0x05, 0xc1, 0xe3, 0x0b, 0x73, 0x4b, 0x92, 0xdd, 0xd5,
+	0xe4, 0x53, 0xe9, 0x7b, 0xa1, 0x6c, 0x9a, 0x5d, 0x99, 0xa4, 0x37, 0xed, 0x4f, 0x06, 0x2a, 0xa7,
+	0xee, 0x0c, 0x37, 0xd1, 0xb6, 0x17, 0x04, 0x31, 0x01, 0xd5, 0xb0, 0xff, 0x5b, 0xe6, 0xd7, 0x2f,
+	0x8d, 0xaa, 0x8e, 0xf4, 0x54, 0x9d, 0x74, 0x44, 0x4c, 0x59, 0xd8, 0x4e, 0x84, 0x98, 0xa2, 0xda,
+	0x34, 0x09, 0xd9, 0x4d, 0xb7, 0xcb, 0xdc, 0xa8, 0x1b, 0xff, 0x76, 0x07, 0x7f, 0xb7, 0xad, 0x3a,
+	0xcd, 0x38, 0xb3, 0x3f, 0x1a, 0xe8, 0x76, 0x66, 0x47, 0xd6, 0x4a, 0xfc, 0xe4, 0xcf, 0x61, 0x58,
+	0x35, 0x5d, 0xa9, 0x88, 0x59, 0x23, 0x60, 0x3f, 0x46, 0xe5, 0x94, 0x04, 0x57, 0xd1, 0x16, 0x65,
+	0x01, 0x39, 0x97, 0x19, 0x15, 0xdb, 0x6a, 0x81, 0x6b, 0xa8, 0xa4, 0x4c, 0xf2, 0x7a, 0xfe, 0x6b,
+	0xeb, 0x95, 0xfd, 0xd3, 0x40, 0xd5, 0xac, 0xa1, 0x58, 0xab, 0xb4, 0x97, 0xd7, 0x87, 0x51, 0x15,
+	0x76, 0x2f, 0xb7, 0xb0, 0x65, 0xb8, 0xac, 0x11, 0x3c, 0x43, 0xb7, 0x02, 0x32, 0x22, 0xa1, 0x6a,
+	0xf0, 0xc2, 0x4b, 0x92, 0x0f, 0xe7, 0x61, 0x2e, 0xf2, 0x59, 0xe2, 0xe8, 0x2c, 0x4e, 0x34, 0xf6,
+	0x66, 0x70, 0x6d, 0x97, 0x80, 0xfd, 0xc1, 0x40, 0xb5, 0xec, 0x81, 0x5d, 0xab, 0xee, 0xe7, 0x68,
+	0xbb, 0x37, 0xf1, 0x87, 0x44, 0x24, 0x35, 0xef, 0xe7, 0x26, 0xa8, 0x82, 0xb5, 0xa4, 0x5a, 0xa7,
+	0x97, 0x78, 0x5b, 0x47, 0x97, 0x33, 0xcb, 0xb8, 0x9a, 0x59, 0xc6, 0x8f, 0x99, 0x65, 0xbc, 0x9f,
+	0x5b, 0x85, 0xab, 0xb9, 0x55, 0xf8, 0x36, 0xb7, 0x0a, 0x67, 0x8d, 0x90, 0x8a, 0xc1, 0xa4, 0xe7,
+	0xf8, 0x3c, 0xd2, 0x4f, 0x9f, 0xfe, 0x69, 0x40, 0x30, 0x74, 0xcf, 0x97, 0x8f, 0xa7, 0xb8, 0x18,
+	0x13, 0xe8, 0x95, 0xe4, 0xbb, 0xf8, 0xe8, 0xd7, 0x00, 0x41, 0xe9, 0x1d, 0x85, 0xb2, 0x05, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +479,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UptimeHistory) > 0 {
+		for iNdEx := len(m.UptimeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -481,6 +670,108 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorInfractions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorInfractions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorInfractions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorSlashes) > 0 {
+		for iNdEx := len(m.DelegatorSlashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorSlashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUptimeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUptimeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUptimeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -512,6 +803,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UptimeHistory) > 0 {
+		for _, e := range m.UptimeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -564,6 +867,50 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorInfractions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegatorSlashes) > 0 {
+		for _, e := range m.DelegatorSlashes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorUptimeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -700,25 +1047,93 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, ValidatorInfractions{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeHistory = append(m.UptimeHistory, ValidatorUptimeHistory{})
+			if err := m.UptimeHistory[len(m.UptimeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *SigningInfo) Unmarshal(dAtA []byte) error {
@@ -1041,6 +1456,272 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorInfractions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorInfractions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorInfractions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, Infraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashes = append(m.DelegatorSlashes, DelegatorSlash{})
+			if err := m.DelegatorSlashes[len(m.DelegatorSlashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUptimeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUptimeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUptimeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, UptimeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TombstoneJailEndTime is the time until which a tombstoned validator is
+// jailed, the maximum time supported by Amino like DoubleSignJailEndTime in
+// x/evidence.
+var TombstoneJailEndTime = time.Unix(253402300799, 0)
+
+// NewInfraction creates a new Infraction instance for a validator punished at
+// the given height and time
+func NewInfraction(
+	infractionType InfractionType, height int64, time time.Time, power int64,
+	slashFraction sdk.Dec, slashedTokens math.Int, jailedUntil time.Time,
+) Infraction {
+	return Infraction{
+		Type:            infractionType,
+		Height:          height,
+		Time:            time,
+		Power:           power,
+		SlashFraction:   slashFraction,
+		SlashedTokens:   slashedTokens,
+		JailedUntil:     jailedUntil,
+		RefundedTokens:  math.ZeroInt(),
+		DelegatorShares: sdk.ZeroDec(),
+	}
+}
+
+// NewDelegatorSlash creates a new DelegatorSlash instance for the tokens
+// slashed from a delegator for the infraction at the given height
+func NewDelegatorSlash(delAddr sdk.AccAddress, amount math.Int, height int64) DelegatorSlash {
+	return DelegatorSlash{
+		DelegatorAddress: delAddr.String(),
+		Amount:           amount,
+		Height:           height,
+	}
+}
+
+// IsAppealed returns true if the tombstoning of the validator for the
+// infraction was reversed by governance.
+func (i Infraction) IsAppealed() bool {
+	return i.AppealReason != ""
+}
+
+// Validate performs a stateless validation of the infraction.
+func (i Infraction) Validate() error {
+	if i.Type != InfractionDowntime && i.Type != InfractionDoubleSign {
+		return fmt.Errorf("invalid infraction type %s", i.Type)
+	}
+
+	if i.Height < 0 {
+		return fmt.Errorf("infraction height cannot be negative, is %d", i.Height)
+	}
+
+	if i.SlashFraction.IsNil() || i.SlashFraction.IsNegative() || i.SlashFraction.GT(sdk.OneDec()) {
+		return fmt.Errorf("infraction slash fraction should be between zero and one, is %s", i.SlashFraction)
+	}
+
+	if i.SlashedTokens.IsNil() || i.SlashedTokens.IsNegative() {
+		return fmt.Errorf("infraction slashed tokens cannot be negative, is %s", i.SlashedTokens)
+	}
+
+	if i.RefundedTokens.IsNil() || i.RefundedTokens.IsNegative() {
+		return fmt.Errorf("infraction refunded tokens cannot be negative, is %s", i.RefundedTokens)
+	}
+
+	if i.DelegatorShares.IsNil() || i.DelegatorShares.IsNegative() {
+		return fmt.Errorf("infraction delegator shares cannot be negative, is %s", i.DelegatorShares)
+	}
+
+	if i.IsAppealed() && !i.Tombstoned {
+		return fmt.Errorf("infraction at height %d was appealed but did not tombstone the validator", i.Height)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the delegator slash.
+func (s DelegatorSlash) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.DelegatorAddress); err != nil {
+		return fmt.Errorf("invalid delegator slash address %s: %w", s.DelegatorAddress, err)
+	}
+
+	if s.Amount.IsNil() || s.Amount.IsNegative() {
+		return fmt.Errorf("delegator slash amount cannot be negative, is %s", s.Amount)
+	}
+
+	if s.Height < 0 {
+		return fmt.Errorf("delegator slash height cannot be negative, is %d", s.Height)
+	}
+
+	return nil
+}
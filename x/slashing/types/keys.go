@@ -30,10 +30,22 @@ const (
 // - 0x02<consAddrLen (1 Byte)><consAddress_Bytes><period_Bytes>: bool
 //
 // - 0x03<accAddrLen (1 Byte)><accAddr_Bytes>: cryptotypes.PubKey
+//
+// - 0x04: Params
+//
+// - 0x05<consAddrLen (1 Byte)><consAddress_Bytes><height_Bytes><type_Byte>: Infraction
+//
+// - 0x06<consAddrLen (1 Byte)><consAddress_Bytes><startHeight_Bytes>: UptimeBucket
+//
+// - 0x07<consAddrLen (1 Byte)><consAddress_Bytes><height_Bytes><accAddrLen (1 Byte)><accAddr_Bytes>: DelegatorSlash
 var (
 	ValidatorSigningInfoKeyPrefix         = []byte{0x01} // Prefix for signing info
 	ValidatorMissedBlockBitArrayKeyPrefix = []byte{0x02} // Prefix for missed block bit array
 	AddrPubkeyRelationKeyPrefix           = []byte{0x03} // Prefix for address-pubkey relation
+	ParamsKey                             = []byte{0x04} // Prefix for params key
+	InfractionKeyPrefix                   = []byte{0x05} // Prefix for infraction history
+	UptimeBucketKeyPrefix                 = []byte{0x06} // Prefix for uptime history
+	DelegatorSlashKeyPrefix               = []byte{0x07} // Prefix for the delegator slashes of infractions
 )
 
 // ValidatorSigningInfoKey - stored by *Consensus* address (not operator address)
@@ -67,3 +79,80 @@ func ValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 func AddrPubkeyRelationKey(addr []byte) []byte {
 	return append(AddrPubkeyRelationKeyPrefix, address.MustLengthPrefix(addr)...)
 }
+
+// ValidatorInfractionsPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorInfractionsPrefixKey(v sdk.ConsAddress) []byte {
+	return append(InfractionKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// InfractionKey - stored by *Consensus* address (not operator address), then
+// by the height the validator was punished at and the type of the infraction
+func InfractionKey(v sdk.ConsAddress, height int64, infractionType InfractionType) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+
+	return append(append(ValidatorInfractionsPrefixKey(v), b...), byte(infractionType))
+}
+
+// InfractionAddress - extract the address from an infraction key
+func InfractionAddress(key []byte) sdk.ConsAddress {
+	// Remove prefix, then read the address length.
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyLength(key, 2+addrLen+8+1)
+
+	return sdk.ConsAddress(key[2 : 2+addrLen])
+}
+
+// ValidatorDelegatorSlashesPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorDelegatorSlashesPrefixKey(v sdk.ConsAddress) []byte {
+	return append(DelegatorSlashKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// InfractionDelegatorSlashesPrefixKey - stored by *Consensus* address (not
+// operator address), then by the height of the infraction
+func InfractionDelegatorSlashesPrefixKey(v sdk.ConsAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+
+	return append(ValidatorDelegatorSlashesPrefixKey(v), b...)
+}
+
+// DelegatorSlashKey - stored by *Consensus* address (not operator address),
+// then by the height of the infraction and the delegator address
+func DelegatorSlashKey(v sdk.ConsAddress, height int64, delAddr sdk.AccAddress) []byte {
+	return append(InfractionDelegatorSlashesPrefixKey(v, height), address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// DelegatorSlashAddress - extract the validator address from a delegator slash key
+func DelegatorSlashAddress(key []byte) sdk.ConsAddress {
+	// Remove prefix, then read the address length.
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 2+addrLen+8+1)
+
+	return sdk.ConsAddress(key[2 : 2+addrLen])
+}
+
+// ValidatorUptimeBucketsPrefixKey - stored by *Consensus* address (not operator address)
+func ValidatorUptimeBucketsPrefixKey(v sdk.ConsAddress) []byte {
+	return append(UptimeBucketKeyPrefix, address.MustLengthPrefix(v.Bytes())...)
+}
+
+// UptimeBucketKey - stored by *Consensus* address (not operator address), then
+// by the start height of the bucket. Heights are big endian encoded so that the
+// buckets of a validator are ordered by height.
+func UptimeBucketKey(v sdk.ConsAddress, startHeight int64) []byte {
+	return append(ValidatorUptimeBucketsPrefixKey(v), sdk.Uint64ToBigEndian(uint64(startHeight))...)
+}
+
+// SplitUptimeBucketKey - split the address and the start height from an uptime
+// bucket key
+func SplitUptimeBucketKey(key []byte) (sdk.ConsAddress, int64) {
+	// Remove prefix, then read the address length.
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyLength(key, 2+addrLen+8)
+
+	return sdk.ConsAddress(key[2 : 2+addrLen]), int64(sdk.BigEndianToUint64(key[2+addrLen:]))
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -11,7 +13,11 @@ const (
 )
 
 // verify interface at compile time
-var _ sdk.Msg = &MsgUnjail{}
+var (
+	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUntombstoneValidator{}
+)
 
 // NewMsgUnjail creates a new MsgUnjail instance
 //nolint:interfacer
@@ -41,3 +47,74 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgUntombstoneValidator creates a new MsgUntombstoneValidator instance
+//nolint:interfacer
+func NewMsgUntombstoneValidator(authority string, validatorAddr sdk.ValAddress, reason string, refund bool) *MsgUntombstoneValidator {
+	return &MsgUntombstoneValidator{
+		Authority:        authority,
+		ValidatorAddress: validatorAddr.String(),
+		Reason:           reason,
+		Refund:           refund,
+	}
+}
+
+// Route implements the LegacyMsg interface.
+func (msg MsgUntombstoneValidator) Route() string { return sdk.MsgTypeURL(&msg) }
+
+// Type implements the LegacyMsg interface.
+func (msg MsgUntombstoneValidator) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgUntombstoneValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for a MsgUntombstoneValidator message.
+func (msg *MsgUntombstoneValidator) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (msg *MsgUntombstoneValidator) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("validator input address: %s", err)
+	}
+
+	if strings.TrimSpace(msg.Reason) == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("reason cannot be blank")
+	}
+
+	return nil
+}
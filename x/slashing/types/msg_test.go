@@ -18,3 +18,20 @@ func TestMsgUnjailGetSignBytes(t *testing.T) {
 		string(bytes),
 	)
 }
+
+func TestMsgUntombstoneValidator(t *testing.T) {
+	authority := sdk.AccAddress("authority").String()
+	valAddr := sdk.ValAddress("abcd")
+
+	msg := NewMsgUntombstoneValidator(authority, valAddr, "reason", true)
+	require.NoError(t, msg.ValidateBasic())
+	require.Equal(
+		t,
+		`{"type":"cosmos-sdk/MsgUntombstoneValidator","value":{"authority":"cosmos1v96hg6r0wf5hg7ghlnjrp","reason":"reason","refund":true,"validator_address":"cosmosvaloper1v93xxeqhg9nn6"}}`,
+		string(msg.GetSignBytes()),
+	)
+
+	require.Error(t, NewMsgUntombstoneValidator("", valAddr, "reason", false).ValidateBasic())
+	require.Error(t, NewMsgUntombstoneValidator(authority, sdk.ValAddress{}, "reason", false).ValidateBasic())
+	require.Error(t, NewMsgUntombstoneValidator(authority, valAddr, " ", false).ValidateBasic())
+}
@@ -12,6 +12,14 @@ import (
 const (
 	DefaultSignedBlocksWindow   = int64(100)
 	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+
+	// The uptime history is not recorded by default
+	DefaultUptimeBucketSize      = int64(0)
+	DefaultUptimeBucketsRetained = int64(0)
+
+	// Double signing tombstones a validator by default, downtime does not
+	DefaultDoubleSignJailDuration = time.Duration(0)
+	DefaultDowntimeTombstone      = false
 )
 
 var (
@@ -61,10 +69,56 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(
+	params := NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
 	)
+	params.UptimeBucketSize = DefaultUptimeBucketSize
+	params.UptimeBucketsRetained = DefaultUptimeBucketsRetained
+	params.DoubleSignJailDuration = DefaultDoubleSignJailDuration
+	params.DowntimeTombstone = DefaultDowntimeTombstone
+
+	return params
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return err
+	}
+	if err := validateMinSignedPerWindow(p.MinSignedPerWindow); err != nil {
+		return err
+	}
+	if err := validateDowntimeJailDuration(p.DowntimeJailDuration); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDoubleSign(p.SlashFractionDoubleSign); err != nil {
+		return err
+	}
+	if err := validateSlashFractionDowntime(p.SlashFractionDowntime); err != nil {
+		return err
+	}
+	if err := validateUptimeBucketSize(p.UptimeBucketSize); err != nil {
+		return err
+	}
+	if err := validateUptimeBucketsRetained(p.UptimeBucketsRetained); err != nil {
+		return err
+	}
+	if p.UptimeBucketSize > 0 && p.UptimeBucketsRetained == 0 {
+		return fmt.Errorf("uptime buckets retained must be positive when the uptime history is enabled")
+	}
+	if err := validateDoubleSignJailDuration(p.DoubleSignJailDuration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MinSignedPerWindowInt returns the minimum number of blocks a validator must
+// sign per window.
+func (p Params) MinSignedPerWindowInt() int64 {
+	// NOTE: RoundInt64 will never panic as minSignedPerWindow is
+	//       less than 1.
+	return p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
 }
 
 func validateSignedBlocksWindow(i interface{}) error {
@@ -140,3 +194,42 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateUptimeBucketSize(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("uptime bucket size cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateUptimeBucketsRetained(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("uptime buckets retained cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateDoubleSignJailDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("double sign jail duration cannot be negative: %s", v)
+	}
+
+	return nil
+}
@@ -308,6 +308,383 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryInfractionsRequest is the request type for the Query/Infractions RPC
+// method
+//
+// Since: cosmos-sdk 0.47
+type QueryInfractionsRequest struct {
+	// cons_address is the address to query the infraction history of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionsRequest) Reset()         { *m = QueryInfractionsRequest{} }
+func (m *QueryInfractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionsRequest) ProtoMessage()    {}
+func (*QueryInfractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryInfractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionsRequest.Merge(m, src)
+}
+func (m *QueryInfractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionsRequest proto.InternalMessageInfo
+
+func (m *QueryInfractionsRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryInfractionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInfractionsResponse is the response type for the Query/Infractions RPC
+// method
+//
+// Since: cosmos-sdk 0.47
+type QueryInfractionsResponse struct {
+	// infractions is the infraction history of the validator, oldest first
+	Infractions []Infraction        `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionsResponse) Reset()         { *m = QueryInfractionsResponse{} }
+func (m *QueryInfractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionsResponse) ProtoMessage()    {}
+func (*QueryInfractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryInfractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionsResponse.Merge(m, src)
+}
+func (m *QueryInfractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionsResponse proto.InternalMessageInfo
+
+func (m *QueryInfractionsResponse) GetInfractions() []Infraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *QueryInfractionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorUptimeRequest is the request type for the Query/ValidatorUptime
+// RPC method
+//
+// Since: cosmos-sdk 0.47
+type QueryValidatorUptimeRequest struct {
+	// cons_address is the address to query the uptime history of
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	// from_height defines the lowest start height of the returned buckets.
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height defines the highest start height of the returned buckets, no
+	// upper bound is applied when it is zero.
+	ToHeight int64 `protobuf:"varint,3,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorUptimeRequest) Reset()         { *m = QueryValidatorUptimeRequest{} }
+func (m *QueryValidatorUptimeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUptimeRequest) ProtoMessage()    {}
+func (*QueryValidatorUptimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{8}
+}
+func (m *QueryValidatorUptimeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUptimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUptimeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorUptimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUptimeRequest.Merge(m, src)
+}
+func (m *QueryValidatorUptimeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUptimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUptimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorUptimeRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorUptimeRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryValidatorUptimeRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorUptimeRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryValidatorUptimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryValidatorUptimeResponse is the response type for the
+// Query/ValidatorUptime RPC method
+//
+// Since: cosmos-sdk 0.47
+type QueryValidatorUptimeResponse struct {
+	// buckets contains the buckets of the uptime history ordered by height
+	Buckets []UptimeBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+	// uptime summarizes all the buckets within the range, regardless of the
+	// pagination
+	Uptime ValidatorUptime `protobuf:"bytes,2,opt,name=uptime,proto3" json:"uptime"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryValidatorUptimeResponse) Reset()         { *m = QueryValidatorUptimeResponse{} }
+func (m *QueryValidatorUptimeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorUptimeResponse) ProtoMessage()    {}
+func (*QueryValidatorUptimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{9}
+}
+func (m *QueryValidatorUptimeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorUptimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorUptimeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorUptimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorUptimeResponse.Merge(m, src)
+}
+func (m *QueryValidatorUptimeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorUptimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorUptimeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorUptimeResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorUptimeResponse) GetBuckets() []UptimeBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *QueryValidatorUptimeResponse) GetUptime() ValidatorUptime {
+	if m != nil {
+		return m.Uptime
+	}
+	return ValidatorUptime{}
+}
+
+func (m *QueryValidatorUptimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryWorstUptimeValidatorsRequest is the request type for the
+// Query/WorstUptimeValidators RPC method
+//
+// Since: cosmos-sdk 0.47
+type QueryWorstUptimeValidatorsRequest struct {
+	// from_height defines the lowest start height of the buckets accounted for.
+	FromHeight int64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// to_height defines the highest start height of the buckets accounted for,
+	// no upper bound is applied when it is zero.
+	ToHeight int64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// limit is the maximum number of validators returned, it defaults to 10 and
+	// cannot exceed 100.
+	Limit uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) Reset()         { *m = QueryWorstUptimeValidatorsRequest{} }
+func (m *QueryWorstUptimeValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWorstUptimeValidatorsRequest) ProtoMessage()    {}
+func (*QueryWorstUptimeValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{10}
+}
+func (m *QueryWorstUptimeValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorstUptimeValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorstUptimeValidatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWorstUptimeValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorstUptimeValidatorsRequest.Merge(m, src)
+}
+func (m *QueryWorstUptimeValidatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorstUptimeValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorstUptimeValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWorstUptimeValidatorsRequest proto.InternalMessageInfo
+
+func (m *QueryWorstUptimeValidatorsRequest) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) GetToHeight() int64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryWorstUptimeValidatorsResponse is the response type for the
+// Query/WorstUptimeValidators RPC method
+//
+// Since: cosmos-sdk 0.47
+type QueryWorstUptimeValidatorsResponse struct {
+	// validators contains the validators ordered by ascending uptime
+	Validators []ValidatorUptime `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+}
+
+func (m *QueryWorstUptimeValidatorsResponse) Reset()         { *m = QueryWorstUptimeValidatorsResponse{} }
+func (m *QueryWorstUptimeValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWorstUptimeValidatorsResponse) ProtoMessage()    {}
+func (*QueryWorstUptimeValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{11}
+}
+func (m *QueryWorstUptimeValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWorstUptimeValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWorstUptimeValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWorstUptimeValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWorstUptimeValidatorsResponse.Merge(m, src)
+}
+func (m *QueryWorstUptimeValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWorstUptimeValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWorstUptimeValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWorstUptimeValidatorsResponse proto.InternalMessageInfo
+
+func (m *QueryWorstUptimeValidatorsResponse) GetValidators() []ValidatorUptime {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -315,6 +692,12 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryInfractionsRequest)(nil), "cosmos.slashing.v1beta1.QueryInfractionsRequest")
+	proto.RegisterType((*QueryInfractionsResponse)(nil), "cosmos.slashing.v1beta1.QueryInfractionsResponse")
+	proto.RegisterType((*QueryValidatorUptimeRequest)(nil), "cosmos.slashing.v1beta1.QueryValidatorUptimeRequest")
+	proto.RegisterType((*QueryValidatorUptimeResponse)(nil), "cosmos.slashing.v1beta1.QueryValidatorUptimeResponse")
+	proto.RegisterType((*QueryWorstUptimeValidatorsRequest)(nil), "cosmos.slashing.v1beta1.QueryWorstUptimeValidatorsRequest")
+	proto.RegisterType((*QueryWorstUptimeValidatorsResponse)(nil), "cosmos.slashing.v1beta1.QueryWorstUptimeValidatorsResponse")
 }
 
 func init() {
@@ -322,42 +705,62 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x4f, 0x2b, 0x55,
+	0x14, 0xc7, 0x7b, 0x29, 0x54, 0x38, 0xc5, 0x1f, 0xb9, 0xd6, 0x50, 0x0a, 0x69, 0x65, 0x08, 0x3f,
+	0xa2, 0x76, 0x86, 0x82, 0x68, 0x22, 0x61, 0x61, 0x13, 0x41, 0x62, 0x62, 0xb4, 0x28, 0x26, 0x26,
+	0xa6, 0x99, 0xb6, 0xd3, 0xe9, 0x84, 0x76, 0x6e, 0x99, 0x7b, 0x5b, 0x24, 0xc6, 0x8d, 0x6b, 0x17,
+	0x26, 0xee, 0x75, 0xe5, 0xd2, 0xa8, 0x89, 0x79, 0xdb, 0xb7, 0x65, 0x49, 0xde, 0xdb, 0xbc, 0xd5,
+	0x0b, 0x81, 0xf7, 0x37, 0xbc, 0xf5, 0x4b, 0xef, 0x3d, 0x6d, 0xa7, 0x3f, 0xa6, 0xd0, 0x86, 0x15,
+	0x9d, 0x73, 0xef, 0xf7, 0x9c, 0xcf, 0x39, 0xdf, 0x99, 0x7b, 0x81, 0xd5, 0x22, 0xe3, 0x35, 0xc6,
+	0x0d, 0x5e, 0x35, 0x79, 0xc5, 0x71, 0x6d, 0xa3, 0x99, 0x29, 0x58, 0xc2, 0xcc, 0x18, 0x67, 0x0d,
+	0xcb, 0xbb, 0xd0, 0xeb, 0x1e, 0x13, 0x8c, 0x2e, 0xa8, 0x4d, 0x7a, 0x7b, 0x93, 0x8e, 0x9b, 0x12,
+	0xef, 0xa1, 0xba, 0x60, 0x72, 0x4b, 0x29, 0x3a, 0xfa, 0xba, 0x69, 0x3b, 0xae, 0x29, 0x1c, 0xe6,
+	0xaa, 0x24, 0x89, 0x98, 0xcd, 0x6c, 0x26, 0x7f, 0x1a, 0xad, 0x5f, 0x18, 0x5d, 0xb6, 0x19, 0xb3,
+	0xab, 0x96, 0x61, 0xd6, 0x1d, 0xc3, 0x74, 0x5d, 0x26, 0xa4, 0x84, 0xe3, 0xea, 0x7a, 0x10, 0x5d,
+	0x87, 0x44, 0xed, 0x5b, 0x54, 0xfb, 0xf2, 0x2a, 0x3d, 0xd2, 0xca, 0x07, 0x2d, 0x06, 0xf4, 0xeb,
+	0x16, 0xd8, 0x57, 0xa6, 0x67, 0xd6, 0x78, 0xce, 0x3a, 0x6b, 0x58, 0x5c, 0x68, 0xdf, 0xc0, 0xdb,
+	0x3d, 0x51, 0x5e, 0x67, 0x2e, 0xb7, 0xe8, 0x3e, 0x44, 0xea, 0x32, 0x12, 0x27, 0xef, 0x92, 0xcd,
+	0xe8, 0x76, 0x4a, 0x0f, 0xe8, 0x5c, 0x57, 0xc2, 0xec, 0xf4, 0xe5, 0xf3, 0x54, 0x28, 0x87, 0x22,
+	0xed, 0x04, 0x16, 0x64, 0xd6, 0x63, 0xc7, 0x76, 0x1d, 0xd7, 0x3e, 0x72, 0xcb, 0x0c, 0x0b, 0xd2,
+	0x3d, 0x98, 0x2f, 0x32, 0x97, 0xe7, 0xcd, 0x52, 0xc9, 0xb3, 0xb8, 0xca, 0x3f, 0x97, 0x8d, 0x3f,
+	0xf9, 0x3f, 0x1d, 0xc3, 0x12, 0x9f, 0xaa, 0x95, 0x63, 0xe1, 0x39, 0xae, 0x9d, 0x8b, 0xb6, 0x76,
+	0x63, 0x48, 0xbb, 0x80, 0xf8, 0x60, 0x5e, 0x44, 0xfe, 0x01, 0xde, 0x6a, 0x9a, 0xd5, 0x3c, 0x57,
+	0x4b, 0x79, 0xc7, 0x2d, 0x33, 0x84, 0x4f, 0x07, 0xc2, 0x9f, 0x98, 0x55, 0xa7, 0x64, 0x0a, 0xe6,
+	0xf9, 0x12, 0x62, 0x2b, 0x6f, 0x34, 0xcd, 0xaa, 0x2f, 0xaa, 0x15, 0x06, 0x4b, 0xb7, 0x87, 0x48,
+	0x0f, 0x00, 0xba, 0x2e, 0x63, 0xd1, 0xf5, 0x76, 0xd1, 0xd6, 0x2b, 0xa1, 0xab, 0x97, 0xa8, 0x3b,
+	0x33, 0xdb, 0x42, 0x6d, 0xce, 0xa7, 0xd4, 0xfe, 0x26, 0xb0, 0x38, 0xa4, 0x08, 0x36, 0x78, 0x08,
+	0xd3, 0xd8, 0x54, 0x78, 0xd2, 0xa6, 0x64, 0x02, 0x7a, 0xd8, 0x83, 0x3b, 0x25, 0x71, 0x37, 0xee,
+	0xc4, 0x55, 0x14, 0x3d, 0xbc, 0x7f, 0x10, 0xf4, 0xf9, 0xc8, 0x2d, 0x7b, 0x66, 0xb1, 0x15, 0xe3,
+	0x0f, 0xe1, 0x33, 0x3d, 0x18, 0x42, 0x38, 0xc9, 0x40, 0xff, 0x25, 0x10, 0x1f, 0x04, 0xc4, 0x79,
+	0x7e, 0x01, 0x51, 0xa7, 0x1b, 0xc6, 0xb1, 0xae, 0x06, 0x8e, 0xb5, 0x9b, 0x02, 0x87, 0xe9, 0x57,
+	0x3f, 0xdc, 0x4c, 0xaf, 0x09, 0x2c, 0x49, 0xe4, 0x8e, 0x8d, 0xdf, 0xd6, 0x85, 0x53, 0xb3, 0x1e,
+	0x64, 0xae, 0x29, 0x88, 0x96, 0x3d, 0x56, 0xcb, 0x57, 0x2c, 0xc7, 0xae, 0x08, 0x89, 0x19, 0xce,
+	0x41, 0x2b, 0xf4, 0xb9, 0x8c, 0xd0, 0x25, 0x98, 0x13, 0xac, 0xbd, 0x1c, 0x96, 0xcb, 0xb3, 0x82,
+	0xe1, 0x62, 0xaf, 0x2b, 0xd3, 0x13, 0xbb, 0xf2, 0x92, 0xc0, 0xf2, 0xf0, 0x16, 0xd1, 0x99, 0xcf,
+	0xe0, 0xb5, 0x42, 0xa3, 0x78, 0x6a, 0x89, 0xb6, 0x2b, 0x6b, 0x81, 0xae, 0x28, 0x65, 0x56, 0xee,
+	0x46, 0x5f, 0xda, 0x5a, 0x7a, 0x00, 0x91, 0x86, 0x5c, 0x46, 0x3f, 0x36, 0xef, 0xfe, 0x64, 0x30,
+	0x1d, 0x9e, 0x66, 0x4a, 0xdd, 0xe7, 0x6d, 0x78, 0x72, 0x6f, 0xcf, 0x61, 0x45, 0xf6, 0xfd, 0x1d,
+	0xf3, 0xb8, 0x50, 0xa5, 0x3a, 0x95, 0x3b, 0x1f, 0x4e, 0x9f, 0x47, 0x64, 0xb4, 0x47, 0x53, 0x7d,
+	0x1e, 0xc5, 0x60, 0xa6, 0xea, 0xd4, 0x1c, 0x65, 0xde, 0xeb, 0x39, 0xf5, 0xa0, 0x09, 0xd0, 0x46,
+	0x15, 0xc6, 0xb1, 0x7f, 0x09, 0xd0, 0xec, 0x44, 0x71, 0xf2, 0xe3, 0xce, 0xcc, 0x97, 0x61, 0xfb,
+	0xcf, 0x59, 0x98, 0x91, 0x65, 0xe9, 0xaf, 0x04, 0x22, 0xea, 0xa2, 0xa0, 0xef, 0x07, 0x26, 0x1c,
+	0xbc, 0x9d, 0x12, 0x1f, 0xdc, 0x6f, 0xb3, 0xe2, 0xd7, 0x36, 0x7e, 0x79, 0xfa, 0xe2, 0xf7, 0xa9,
+	0x15, 0x9a, 0x32, 0x82, 0x6e, 0x4b, 0x75, 0x3d, 0xd1, 0xff, 0x08, 0x44, 0x7d, 0x87, 0x23, 0xdd,
+	0x1a, 0x5d, 0x66, 0xf0, 0x16, 0x4b, 0x64, 0xc6, 0x50, 0x20, 0xdd, 0xbe, 0xa4, 0xfb, 0x98, 0xee,
+	0x06, 0xd2, 0xf9, 0xaf, 0x2e, 0x6e, 0xfc, 0xe4, 0xff, 0xcc, 0x7f, 0xa6, 0x7f, 0x11, 0x98, 0xf7,
+	0xa5, 0xe5, 0xf4, 0xfe, 0x08, 0x9d, 0x71, 0x6e, 0x8f, 0x23, 0x41, 0x6c, 0x5d, 0x62, 0x6f, 0xd2,
+	0xf5, 0xfb, 0x61, 0xd3, 0x7f, 0x08, 0x44, 0x7d, 0xa7, 0xed, 0x5d, 0xb3, 0x1d, 0xbc, 0x39, 0x12,
+	0x99, 0x31, 0x14, 0x08, 0xb9, 0x27, 0x21, 0x77, 0xe9, 0x4e, 0x20, 0xa4, 0xef, 0xac, 0xee, 0x9f,
+	0xec, 0x23, 0x02, 0x6f, 0xf6, 0xbd, 0xcc, 0xf4, 0xc3, 0xd1, 0x0c, 0xc3, 0xcf, 0xe6, 0xc4, 0xee,
+	0x98, 0x2a, 0xa4, 0xff, 0x48, 0xd2, 0x6f, 0x51, 0x3d, 0x90, 0x5e, 0x1d, 0x44, 0xfd, 0xe0, 0x8f,
+	0x09, 0xbc, 0x33, 0xf4, 0x8b, 0xa6, 0x9f, 0x8c, 0x06, 0x19, 0x75, 0xfe, 0x24, 0xf6, 0x26, 0xd2,
+	0x62, 0x2b, 0x69, 0xd9, 0xca, 0x06, 0x5d, 0x0b, 0x6c, 0xe5, 0xbc, 0xa5, 0xcf, 0xab, 0x86, 0xb2,
+	0x87, 0x97, 0x37, 0x49, 0x72, 0x75, 0x93, 0x24, 0xd7, 0x37, 0x49, 0xf2, 0xdb, 0x6d, 0x32, 0x74,
+	0x75, 0x9b, 0x0c, 0x3d, 0xbb, 0x4d, 0x86, 0xbe, 0x4f, 0xdb, 0x8e, 0xa8, 0x34, 0x0a, 0x7a, 0x91,
+	0xd5, 0xda, 0xa9, 0xd4, 0x9f, 0x34, 0x2f, 0x9d, 0x1a, 0x3f, 0x76, 0xf3, 0x8a, 0x8b, 0xba, 0xc5,
+	0x0b, 0x11, 0xf9, 0x3f, 0xee, 0xce, 0xab, 0x01, 0x00, 0x3f, 0xf7, 0x9a, 0x69, 0xc6, 0x0b, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +781,20 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// Infractions queries the infraction history of given cons address
+	//
+	// Since: cosmos-sdk 0.47
+	Infractions(ctx context.Context, in *QueryInfractionsRequest, opts ...grpc.CallOption) (*QueryInfractionsResponse, error)
+	// ValidatorUptime queries the uptime history of given cons address within a
+	// range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error)
+	// WorstUptimeValidators queries the validators with the lowest uptime within
+	// a range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	WorstUptimeValidators(ctx context.Context, in *QueryWorstUptimeValidatorsRequest, opts ...grpc.CallOption) (*QueryWorstUptimeValidatorsResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +832,33 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) Infractions(ctx context.Context, in *QueryInfractionsRequest, opts ...grpc.CallOption) (*QueryInfractionsResponse, error) {
+	out := new(QueryInfractionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/Infractions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUptime(ctx context.Context, in *QueryValidatorUptimeRequest, opts ...grpc.CallOption) (*QueryValidatorUptimeResponse, error) {
+	out := new(QueryValidatorUptimeResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/ValidatorUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WorstUptimeValidators(ctx context.Context, in *QueryWorstUptimeValidatorsRequest, opts ...grpc.CallOption) (*QueryWorstUptimeValidatorsResponse, error) {
+	out := new(QueryWorstUptimeValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/WorstUptimeValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -423,6 +867,20 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// Infractions queries the infraction history of given cons address
+	//
+	// Since: cosmos-sdk 0.47
+	Infractions(context.Context, *QueryInfractionsRequest) (*QueryInfractionsResponse, error)
+	// ValidatorUptime queries the uptime history of given cons address within a
+	// range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorUptime(context.Context, *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error)
+	// WorstUptimeValidators queries the validators with the lowest uptime within
+	// a range of block heights
+	//
+	// Since: cosmos-sdk 0.47
+	WorstUptimeValidators(context.Context, *QueryWorstUptimeValidatorsRequest) (*QueryWorstUptimeValidatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +896,15 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) Infractions(ctx context.Context, req *QueryInfractionsRequest) (*QueryInfractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Infractions not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorUptime(ctx context.Context, req *QueryValidatorUptimeRequest) (*QueryValidatorUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUptime not implemented")
+}
+func (*UnimplementedQueryServer) WorstUptimeValidators(ctx context.Context, req *QueryWorstUptimeValidatorsRequest) (*QueryWorstUptimeValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WorstUptimeValidators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,41 +964,107 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.slashing.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "SigningInfo",
-			Handler:    _Query_SigningInfo_Handler,
-		},
-		{
-			MethodName: "SigningInfos",
-			Handler:    _Query_SigningInfos_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/slashing/v1beta1/query.proto",
-}
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Infractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+	if interceptor == nil {
+		return srv.(QueryServer).Infractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/Infractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Infractions(ctx, req.(*QueryInfractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/ValidatorUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUptime(ctx, req.(*QueryValidatorUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WorstUptimeValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWorstUptimeValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WorstUptimeValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/WorstUptimeValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WorstUptimeValidators(ctx, req.(*QueryWorstUptimeValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.slashing.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SigningInfo",
+			Handler:    _Query_SigningInfo_Handler,
+		},
+		{
+			MethodName: "SigningInfos",
+			Handler:    _Query_SigningInfos_Handler,
+		},
+		{
+			MethodName: "Infractions",
+			Handler:    _Query_Infractions_Handler,
+		},
+		{
+			MethodName: "ValidatorUptime",
+			Handler:    _Query_ValidatorUptime_Handler,
+		},
+		{
+			MethodName: "WorstUptimeValidators",
+			Handler:    _Query_WorstUptimeValidators_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/slashing/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
 func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
@@ -721,107 +1254,881 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInfractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryInfractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySigningInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInfractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInfractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ValSigningInfo.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySigningInfosRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInfractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySigningInfosResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidatorUptimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorUptimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorUptimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Info) > 0 {
-		for _, e := range m.Info {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryValidatorUptimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryValidatorUptimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+
+func (m *QueryValidatorUptimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Uptime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWorstUptimeValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWorstUptimeValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWorstUptimeValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ValSigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Info) > 0 {
+		for _, e := range m.Info {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUptimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorUptimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWorstUptimeValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryWorstUptimeValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
 			if iNdEx >= l {
@@ -837,12 +2144,82 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Info = append(m.Info, ValidatorSigningInfo{})
+			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -864,7 +2241,125 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInfractionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInfractionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,25 +2372,59 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, Infraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -922,7 +2451,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -947,7 +2479,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorUptimeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -970,10 +2502,10 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorUptimeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorUptimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1008,6 +2540,80 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1029,7 +2635,7 @@ func (m *QuerySigningInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorUptimeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1052,15 +2658,15 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorUptimeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorUptimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValSigningInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1087,7 +2693,77 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ValSigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, UptimeBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1112,7 +2788,7 @@ func (m *QuerySigningInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorstUptimeValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1135,17 +2811,17 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorstUptimeValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorstUptimeValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
 			}
-			var msglen int
+			m.FromHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1155,28 +2831,49 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FromHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1198,7 +2895,7 @@ func (m *QuerySigningInfosRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorstUptimeValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1221,49 +2918,15 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorstUptimeValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorstUptimeValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Info = append(m.Info, ValidatorSigningInfo{})
-			if err := m.Info[len(m.Info)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1290,10 +2953,8 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, ValidatorUptime{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
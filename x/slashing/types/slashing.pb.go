@@ -30,6 +30,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InfractionType defines the type of an infraction committed by a validator.
+//
+// Since: cosmos-sdk 0.47
+type InfractionType int32
+
+const (
+	// INFRACTION_TYPE_UNSPECIFIED defines an empty infraction type.
+	InfractionEmpty InfractionType = 0
+	// INFRACTION_TYPE_DOWNTIME defines a validator missing too many blocks.
+	InfractionDowntime InfractionType = 1
+	// INFRACTION_TYPE_DOUBLE_SIGN defines a validator signing two blocks at the
+	// same height.
+	InfractionDoubleSign InfractionType = 2
+)
+
+var InfractionType_name = map[int32]string{
+	0: "INFRACTION_TYPE_UNSPECIFIED",
+	1: "INFRACTION_TYPE_DOWNTIME",
+	2: "INFRACTION_TYPE_DOUBLE_SIGN",
+}
+
+var InfractionType_value = map[string]int32{
+	"INFRACTION_TYPE_UNSPECIFIED": 0,
+	"INFRACTION_TYPE_DOWNTIME":    1,
+	"INFRACTION_TYPE_DOUBLE_SIGN": 2,
+}
+
+func (x InfractionType) String() string {
+	return proto.EnumName(InfractionType_name, int32(x))
+}
+
+func (InfractionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{0}
+}
+
 // ValidatorSigningInfo defines a validator's signing info for monitoring their
 // liveness activity.
 type ValidatorSigningInfo struct {
@@ -131,6 +166,28 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// uptime_bucket_size is the number of blocks covered by each bucket of the
+	// uptime history of the validators. The uptime history is not recorded when
+	// it is zero.
+	//
+	// Since: cosmos-sdk 0.47
+	UptimeBucketSize int64 `protobuf:"varint,6,opt,name=uptime_bucket_size,json=uptimeBucketSize,proto3" json:"uptime_bucket_size,omitempty"`
+	// uptime_buckets_retained is the number of most recent buckets of the uptime
+	// history kept for each validator. It must be positive when the uptime
+	// history is enabled.
+	//
+	// Since: cosmos-sdk 0.47
+	UptimeBucketsRetained int64 `protobuf:"varint,7,opt,name=uptime_buckets_retained,json=uptimeBucketsRetained,proto3" json:"uptime_buckets_retained,omitempty"`
+	// double_sign_jail_duration is the duration a validator is jailed for double
+	// signing. The validator is tombstoned and jailed forever when it is zero.
+	//
+	// Since: cosmos-sdk 0.47
+	DoubleSignJailDuration time.Duration `protobuf:"bytes,8,opt,name=double_sign_jail_duration,json=doubleSignJailDuration,proto3,stdduration" json:"double_sign_jail_duration"`
+	// downtime_tombstone defines whether a validator is tombstoned and jailed
+	// forever for downtime, instead of being jailed for downtime_jail_duration.
+	//
+	// Since: cosmos-sdk 0.47
+	DowntimeTombstone bool `protobuf:"varint,9,opt,name=downtime_tombstone,json=downtimeTombstone,proto3" json:"downtime_tombstone,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -180,9 +237,370 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetUptimeBucketSize() int64 {
+	if m != nil {
+		return m.UptimeBucketSize
+	}
+	return 0
+}
+
+func (m *Params) GetUptimeBucketsRetained() int64 {
+	if m != nil {
+		return m.UptimeBucketsRetained
+	}
+	return 0
+}
+
+func (m *Params) GetDoubleSignJailDuration() time.Duration {
+	if m != nil {
+		return m.DoubleSignJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeTombstone() bool {
+	if m != nil {
+		return m.DowntimeTombstone
+	}
+	return false
+}
+
+// Infraction records an infraction of a validator and the punishment applied
+// for it.
+//
+// Since: cosmos-sdk 0.47
+type Infraction struct {
+	// type is the type of the infraction.
+	Type InfractionType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.slashing.v1beta1.InfractionType" json:"type,omitempty"`
+	// height is the block height at which the validator was punished.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the validator was punished.
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// power is the consensus power of the validator at the infraction.
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
+	// slash_fraction is the fraction of the stake of the validator slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// slashed_tokens is the amount of tokens burned by the slash.
+	SlashedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=slashed_tokens,json=slashedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slashed_tokens"`
+	// jailed_until is the time until which the validator was jailed.
+	JailedUntil time.Time `protobuf:"bytes,7,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// tombstoned defines whether the validator was tombstoned for the infraction.
+	Tombstoned bool `protobuf:"varint,8,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// appeal_reason is the reason given by governance when it reversed the
+	// tombstoning of the validator, it is empty while the tombstoning stands.
+	AppealReason string `protobuf:"bytes,9,opt,name=appeal_reason,json=appealReason,proto3" json:"appeal_reason,omitempty"`
+	// refunded_tokens is the amount of tokens refunded to the delegators from the
+	// community pool when the tombstoning was reversed.
+	RefundedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=refunded_tokens,json=refundedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"refunded_tokens"`
+	// delegator_shares is the total of the delegator shares of the validator when
+	// it was slashed, the slashed_tokens are attributed to its delegators pro rata
+	// to their shares out of it.
+	DelegatorShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"delegator_shares"`
+}
+
+func (m *Infraction) Reset()         { *m = Infraction{} }
+func (m *Infraction) String() string { return proto.CompactTextString(m) }
+func (*Infraction) ProtoMessage()    {}
+func (*Infraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *Infraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Infraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Infraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Infraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Infraction.Merge(m, src)
+}
+func (m *Infraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Infraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Infraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Infraction proto.InternalMessageInfo
+
+func (m *Infraction) GetType() InfractionType {
+	if m != nil {
+		return m.Type
+	}
+	return InfractionEmpty
+}
+
+func (m *Infraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Infraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Infraction) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *Infraction) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *Infraction) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *Infraction) GetAppealReason() string {
+	if m != nil {
+		return m.AppealReason
+	}
+	return ""
+}
+
+// DelegatorSlash is the amount of tokens slashed from a delegator, including
+// its unbonding delegations and redelegations, for a double sign infraction of
+// its validator. It is stored apart from the infraction, under its own key.
+//
+// The share of the delegator in the tokens slashed from the bonded stake of the
+// validator is only added to the amount once settled, when the delegation is
+// first modified after the infraction or when the tombstoning is reversed.
+//
+// Since: cosmos-sdk 0.47
+type DelegatorSlash struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// amount is the amount of tokens slashed from the delegator.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// height is the block height of the infraction the tokens were slashed for.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// settled defines whether the share of the delegator in the tokens slashed
+	// from the bonded stake of the validator was added to the amount.
+	Settled bool `protobuf:"varint,4,opt,name=settled,proto3" json:"settled,omitempty"`
+}
+
+func (m *DelegatorSlash) Reset()         { *m = DelegatorSlash{} }
+func (m *DelegatorSlash) String() string { return proto.CompactTextString(m) }
+func (*DelegatorSlash) ProtoMessage()    {}
+func (*DelegatorSlash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{3}
+}
+func (m *DelegatorSlash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorSlash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorSlash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorSlash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorSlash.Merge(m, src)
+}
+func (m *DelegatorSlash) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorSlash) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorSlash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorSlash proto.InternalMessageInfo
+
+func (m *DelegatorSlash) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorSlash) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DelegatorSlash) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
+// UptimeBucket counts the blocks signed and missed by a validator within a
+// range of uptime_bucket_size blocks.
+//
+// Since: cosmos-sdk 0.47
+type UptimeBucket struct {
+	// start_height is the first block height of the bucket.
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// signed_blocks is the number of blocks of the bucket signed by the
+	// validator.
+	SignedBlocks uint64 `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks of the bucket missed by the
+	// validator.
+	MissedBlocks uint64 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+}
+
+func (m *UptimeBucket) Reset()         { *m = UptimeBucket{} }
+func (m *UptimeBucket) String() string { return proto.CompactTextString(m) }
+func (*UptimeBucket) ProtoMessage()    {}
+func (*UptimeBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{4}
+}
+func (m *UptimeBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UptimeBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UptimeBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UptimeBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UptimeBucket.Merge(m, src)
+}
+func (m *UptimeBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *UptimeBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_UptimeBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UptimeBucket proto.InternalMessageInfo
+
+func (m *UptimeBucket) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *UptimeBucket) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *UptimeBucket) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
+// ValidatorUptime summarizes the uptime of a validator over a range of block
+// heights.
+//
+// Since: cosmos-sdk 0.47
+type ValidatorUptime struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// signed_blocks is the number of blocks signed by the validator.
+	SignedBlocks uint64 `protobuf:"varint,2,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of blocks missed by the validator.
+	MissedBlocks uint64 `protobuf:"varint,3,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+	// uptime is the fraction of the blocks signed by the validator.
+	Uptime github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"uptime"`
+}
+
+func (m *ValidatorUptime) Reset()         { *m = ValidatorUptime{} }
+func (m *ValidatorUptime) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUptime) ProtoMessage()    {}
+func (*ValidatorUptime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{5}
+}
+func (m *ValidatorUptime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUptime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUptime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUptime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUptime.Merge(m, src)
+}
+func (m *ValidatorUptime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUptime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUptime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUptime proto.InternalMessageInfo
+
+func (m *ValidatorUptime) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorUptime) GetSignedBlocks() uint64 {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return 0
+}
+
+func (m *ValidatorUptime) GetMissedBlocks() uint64 {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.slashing.v1beta1.InfractionType", InfractionType_name, InfractionType_value)
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*Infraction)(nil), "cosmos.slashing.v1beta1.Infraction")
+	proto.RegisterType((*DelegatorSlash)(nil), "cosmos.slashing.v1beta1.DelegatorSlash")
+	proto.RegisterType((*UptimeBucket)(nil), "cosmos.slashing.v1beta1.UptimeBucket")
+	proto.RegisterType((*ValidatorUptime)(nil), "cosmos.slashing.v1beta1.ValidatorUptime")
 }
 
 func init() {
@@ -190,43 +608,76 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 1094 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1a, 0x47,
+	0x14, 0x67, 0x0d, 0xc6, 0x78, 0x8c, 0x3f, 0x32, 0xc1, 0xf6, 0x9a, 0x4a, 0x40, 0x89, 0x94, 0x5a,
+	0x55, 0x0d, 0x8d, 0x1b, 0x55, 0xfd, 0xba, 0x04, 0x83, 0x53, 0xfa, 0x61, 0x5b, 0x0b, 0x6e, 0x94,
+	0x1e, 0xba, 0x1a, 0xd8, 0x61, 0x3d, 0xf5, 0x32, 0x83, 0x76, 0x86, 0x3a, 0x4e, 0xff, 0x81, 0xca,
+	0xa7, 0x1c, 0x73, 0xb1, 0x14, 0xa9, 0x97, 0xfe, 0x01, 0x91, 0x7a, 0xea, 0xa5, 0xa7, 0x1c, 0xa3,
+	0x9c, 0xda, 0x1e, 0x92, 0xca, 0x56, 0xa5, 0xfe, 0x19, 0xd5, 0xce, 0xcc, 0x2e, 0x60, 0xd4, 0x28,
+	0x58, 0x3e, 0xc1, 0xbc, 0xcf, 0x79, 0xbf, 0xf7, 0x9b, 0xf7, 0x16, 0xdc, 0x6c, 0x33, 0xde, 0x65,
+	0xbc, 0xcc, 0x3d, 0xc4, 0x0f, 0x08, 0x75, 0xcb, 0x3f, 0xdc, 0x6a, 0x61, 0x81, 0x6e, 0x45, 0x82,
+	0x52, 0xcf, 0x67, 0x82, 0xc1, 0x55, 0x65, 0x57, 0x8a, 0xc4, 0xda, 0x2e, 0x9b, 0x71, 0x99, 0xcb,
+	0xa4, 0x4d, 0x39, 0xf8, 0xa7, 0xcc, 0xb3, 0x39, 0x97, 0x31, 0xd7, 0xc3, 0x65, 0x79, 0x6a, 0xf5,
+	0x3b, 0x65, 0xa7, 0xef, 0x23, 0x41, 0x18, 0xd5, 0xfa, 0xfc, 0x45, 0xbd, 0x20, 0x5d, 0xcc, 0x05,
+	0xea, 0xf6, 0xb4, 0xc1, 0x9a, 0xca, 0x67, 0xab, 0xc8, 0x3a, 0xb9, 0x3c, 0x14, 0x7f, 0x9d, 0x02,
+	0x99, 0x6f, 0x90, 0x47, 0x1c, 0x24, 0x98, 0xdf, 0x20, 0x2e, 0x25, 0xd4, 0xad, 0xd3, 0x0e, 0x83,
+	0x9b, 0x60, 0x06, 0x39, 0x8e, 0x8f, 0x39, 0x37, 0x8d, 0x82, 0xb1, 0x3e, 0x5b, 0x31, 0x5f, 0x3c,
+	0xdd, 0xc8, 0x68, 0xdf, 0x3b, 0x4a, 0xd3, 0x10, 0x3e, 0xa1, 0xae, 0x15, 0x1a, 0xc2, 0xb7, 0x41,
+	0x9a, 0x0b, 0xe4, 0x0b, 0xfb, 0x00, 0x13, 0xf7, 0x40, 0x98, 0x53, 0x05, 0x63, 0x3d, 0x6e, 0xcd,
+	0x49, 0xd9, 0xe7, 0x52, 0x14, 0x98, 0x10, 0xea, 0xe0, 0x07, 0x36, 0xeb, 0x74, 0x38, 0x16, 0x66,
+	0x5c, 0x99, 0x48, 0xd9, 0xae, 0x14, 0xc1, 0xbb, 0x20, 0xfd, 0x3d, 0x22, 0x1e, 0x76, 0xec, 0x3e,
+	0x15, 0xc4, 0x33, 0x13, 0x05, 0x63, 0x7d, 0x6e, 0x33, 0x5b, 0x52, 0x55, 0x96, 0xc2, 0x2a, 0x4b,
+	0xcd, 0xb0, 0xca, 0x4a, 0xea, 0xd9, 0xcb, 0x7c, 0xec, 0xd1, 0xab, 0xbc, 0x61, 0xcd, 0x29, 0xcf,
+	0xfd, 0xc0, 0x11, 0xe6, 0x00, 0x10, 0xac, 0xdb, 0xe2, 0x82, 0x51, 0xec, 0x98, 0xd3, 0x05, 0x63,
+	0x3d, 0x65, 0x0d, 0x49, 0xe0, 0x26, 0x58, 0xee, 0x12, 0xce, 0xb1, 0x63, 0xb7, 0x3c, 0xd6, 0x3e,
+	0xe4, 0x76, 0x9b, 0xf5, 0xa9, 0xc0, 0xbe, 0x99, 0x94, 0x97, 0xba, 0xae, 0x94, 0x15, 0xa9, 0xdb,
+	0x52, 0xaa, 0x4f, 0x52, 0x8f, 0x9f, 0xe4, 0x63, 0xff, 0x3e, 0xc9, 0x1b, 0xc5, 0xdf, 0xa7, 0x41,
+	0x72, 0x0f, 0xf9, 0xa8, 0xcb, 0xe1, 0xfb, 0x20, 0xc3, 0x89, 0x4b, 0x07, 0x81, 0x8e, 0x08, 0x75,
+	0xd8, 0x91, 0x04, 0x2e, 0x6e, 0x41, 0xa5, 0x53, 0x71, 0xee, 0x49, 0x0d, 0x44, 0x41, 0x6a, 0x6a,
+	0x6b, 0xaf, 0x1e, 0xf6, 0x43, 0x97, 0x00, 0xb2, 0x74, 0xa5, 0x14, 0x14, 0xf4, 0xd7, 0xcb, 0xfc,
+	0x4d, 0x97, 0x88, 0x83, 0x7e, 0xab, 0xd4, 0x66, 0x5d, 0xdd, 0x36, 0xfd, 0xb3, 0xc1, 0x9d, 0xc3,
+	0xb2, 0x38, 0xee, 0x61, 0x5e, 0xaa, 0xe2, 0xb6, 0x05, 0xbb, 0x84, 0x36, 0x64, 0xac, 0x3d, 0xec,
+	0xeb, 0x14, 0xf7, 0xc1, 0x8a, 0xc3, 0x8e, 0x68, 0xc0, 0x05, 0x3b, 0x40, 0xc5, 0x0e, 0x59, 0x23,
+	0x31, 0x9f, 0xdb, 0x5c, 0x1b, 0x03, 0xb4, 0xaa, 0x0d, 0x14, 0x9e, 0x8f, 0x03, 0x3c, 0x33, 0x61,
+	0x88, 0x2f, 0x10, 0xf1, 0x42, 0x3d, 0x3c, 0x04, 0x59, 0x49, 0x5d, 0xbb, 0xe3, 0xa3, 0x76, 0x20,
+	0xb1, 0x1d, 0xd6, 0x6f, 0x79, 0x58, 0xd6, 0x63, 0x26, 0x2e, 0x55, 0xc2, 0xaa, 0x8c, 0xb8, 0xad,
+	0x03, 0x56, 0x65, 0xbc, 0xa0, 0x24, 0xd8, 0x01, 0xab, 0x63, 0xc9, 0xd4, 0x9d, 0xcc, 0xe9, 0x4b,
+	0x65, 0x5a, 0xbe, 0x90, 0x49, 0x05, 0x83, 0xef, 0x01, 0xd8, 0xef, 0x49, 0xb4, 0x5a, 0xfd, 0xf6,
+	0x21, 0x16, 0x36, 0x27, 0x0f, 0xb1, 0xa6, 0xc2, 0x92, 0xd2, 0x54, 0xa4, 0xa2, 0x41, 0x1e, 0x62,
+	0xf8, 0x21, 0x58, 0x1d, 0xb1, 0xe6, 0xb6, 0x8f, 0x05, 0x22, 0x01, 0xd1, 0x66, 0xa4, 0xcb, 0xf2,
+	0xb0, 0x0b, 0xb7, 0xb4, 0x12, 0x7e, 0x07, 0xd6, 0x86, 0xb0, 0xba, 0xd0, 0x98, 0xd4, 0x9b, 0x37,
+	0x66, 0xc5, 0x89, 0x10, 0x1a, 0x69, 0xcd, 0x06, 0x80, 0x51, 0xd7, 0x23, 0xaa, 0x9b, 0xb3, 0x92,
+	0xfb, 0xd7, 0x42, 0x4d, 0x33, 0x54, 0x14, 0xff, 0x9c, 0x06, 0xa0, 0x4e, 0x43, 0x64, 0xe1, 0xa7,
+	0x20, 0x11, 0xe0, 0x24, 0x89, 0xbb, 0xb0, 0xf9, 0x4e, 0xe9, 0x7f, 0xe6, 0x54, 0x69, 0xe0, 0xd2,
+	0x3c, 0xee, 0x61, 0x4b, 0x3a, 0xc1, 0x15, 0x90, 0x1c, 0x79, 0xf7, 0xfa, 0x04, 0x3f, 0x02, 0x09,
+	0xd9, 0xad, 0xf8, 0x04, 0xef, 0x58, 0x7a, 0xc0, 0x0c, 0x98, 0xee, 0xb1, 0x23, 0xec, 0x4b, 0x4a,
+	0xc5, 0x2d, 0x75, 0x80, 0x6d, 0xb0, 0x30, 0x4a, 0x08, 0xc9, 0x83, 0xd9, 0xca, 0x67, 0x93, 0xf1,
+	0xe0, 0xc5, 0xd3, 0x0d, 0xa0, 0xeb, 0x0b, 0x58, 0x31, 0x3f, 0xc2, 0x8a, 0x28, 0x09, 0x76, 0x6c,
+	0xc1, 0x0e, 0x31, 0xe5, 0x66, 0x72, 0xe2, 0x24, 0x75, 0x2a, 0x86, 0x92, 0xd4, 0xa9, 0xd0, 0x49,
+	0xb0, 0xd3, 0x94, 0x21, 0xc7, 0x26, 0xdd, 0xcc, 0xd5, 0x4c, 0xba, 0xd4, 0xd8, 0xa4, 0xbb, 0x01,
+	0xe6, 0x51, 0xaf, 0x87, 0x91, 0x67, 0xfb, 0x18, 0x71, 0x46, 0x25, 0x21, 0x66, 0xad, 0xb4, 0x12,
+	0x5a, 0x52, 0x06, 0x31, 0x58, 0xf4, 0x71, 0xa7, 0x4f, 0x9d, 0x41, 0xcd, 0xe0, 0x0a, 0x6a, 0x5e,
+	0x08, 0x83, 0xea, 0xa2, 0x5d, 0xb0, 0xe4, 0x60, 0x0f, 0xbb, 0xc1, 0xc2, 0xb1, 0xf9, 0x01, 0xf2,
+	0x31, 0x37, 0xe7, 0xae, 0xa0, 0x81, 0x8b, 0x51, 0xd4, 0x86, 0x0c, 0x5a, 0x7c, 0x65, 0x80, 0x85,
+	0x6a, 0x24, 0x0b, 0x80, 0x87, 0x35, 0x70, 0x6d, 0x90, 0xfb, 0x4d, 0xd7, 0xdb, 0xe0, 0xba, 0x5a,
+	0x0e, 0x9b, 0x20, 0x89, 0xba, 0xc1, 0x42, 0x30, 0xa7, 0x26, 0xbe, 0xf8, 0x38, 0x40, 0x3a, 0xd6,
+	0xd0, 0xfb, 0x89, 0x8f, 0xbc, 0x1f, 0x13, 0xcc, 0x70, 0x2c, 0x84, 0x87, 0x1d, 0xf9, 0x0e, 0x52,
+	0x56, 0x78, 0x2c, 0xfe, 0x08, 0xd2, 0xfb, 0x43, 0x53, 0x66, 0x6c, 0xff, 0x1a, 0xe3, 0xfb, 0xf7,
+	0x06, 0x98, 0x1f, 0x59, 0x55, 0xb2, 0x82, 0x84, 0x95, 0x1e, 0xde, 0x51, 0x81, 0xd1, 0xc8, 0x62,
+	0x94, 0x17, 0x4a, 0x58, 0xe9, 0xe1, 0x85, 0x58, 0xfc, 0xc7, 0x00, 0x8b, 0xd1, 0x97, 0x83, 0xba,
+	0xc6, 0xa5, 0x3e, 0x1a, 0xae, 0xec, 0x46, 0x41, 0x5b, 0xd4, 0xd0, 0x35, 0x13, 0x13, 0xb7, 0x65,
+	0x9c, 0x4f, 0x3a, 0xd6, 0xbb, 0xbf, 0x19, 0x60, 0x61, 0x74, 0xde, 0xc1, 0xdb, 0xe0, 0xad, 0xfa,
+	0xce, 0xb6, 0x75, 0x67, 0xab, 0x59, 0xdf, 0xdd, 0xb1, 0x9b, 0xf7, 0xf7, 0x6a, 0xf6, 0xfe, 0x4e,
+	0x63, 0xaf, 0xb6, 0x55, 0xdf, 0xae, 0xd7, 0xaa, 0x4b, 0xb1, 0xec, 0xf5, 0x93, 0xd3, 0xc2, 0xe2,
+	0xc0, 0xa9, 0xd6, 0xed, 0x89, 0x63, 0x78, 0x1b, 0x98, 0x17, 0xbd, 0xaa, 0xbb, 0xf7, 0x76, 0x9a,
+	0xf5, 0xaf, 0x6b, 0x4b, 0x46, 0x76, 0xe5, 0xe4, 0xb4, 0x00, 0x07, 0x2e, 0xd1, 0x5a, 0xfa, 0x78,
+	0x3c, 0x57, 0x75, 0x77, 0xbf, 0xf2, 0x55, 0xcd, 0x6e, 0xd4, 0xef, 0xee, 0x2c, 0x4d, 0x65, 0xcd,
+	0x93, 0xd3, 0x42, 0x66, 0xd8, 0x31, 0xdc, 0x0b, 0xd9, 0xc4, 0x4f, 0x3f, 0xe7, 0x62, 0x95, 0x2f,
+	0x7f, 0x39, 0xcb, 0x19, 0xcf, 0xce, 0x72, 0xc6, 0xf3, 0xb3, 0x9c, 0xf1, 0xf7, 0x59, 0xce, 0x78,
+	0x74, 0x9e, 0x8b, 0x3d, 0x3f, 0xcf, 0xc5, 0xfe, 0x38, 0xcf, 0xc5, 0xbe, 0xdd, 0x78, 0x2d, 0x36,
+	0x0f, 0x06, 0x9f, 0xb2, 0x12, 0xa6, 0x56, 0x52, 0xce, 0xa4, 0x0f, 0xfe, 0x1b, 0x00, 0xdf, 0x40,
+	0x52, 0x7e, 0xea, 0x0a, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -302,52 +753,214 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if this.UptimeBucketSize != that1.UptimeBucketSize {
+		return false
+	}
+	if this.UptimeBucketsRetained != that1.UptimeBucketsRetained {
+		return false
+	}
+	if this.DoubleSignJailDuration != that1.DoubleSignJailDuration {
+		return false
+	}
+	if this.DowntimeTombstone != that1.DowntimeTombstone {
+		return false
+	}
 	return true
 }
-func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *Infraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.Tombstoned {
-		i--
-		if m.Tombstoned {
-			dAtA[i] = 1
+	that1, ok := that.(*Infraction)
+	if !ok {
+		that2, ok := that.(Infraction)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i--
-		dAtA[i] = 0x28
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
-	if err1 != nil {
-		return 0, err1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	i -= n1
-	i = encodeVarintSlashing(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x22
-	if m.IndexOffset != 0 {
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if !this.SlashedTokens.Equal(that1.SlashedTokens) {
+		return false
+	}
+	if !this.JailedUntil.Equal(that1.JailedUntil) {
+		return false
+	}
+	if this.Tombstoned != that1.Tombstoned {
+		return false
+	}
+	if this.AppealReason != that1.AppealReason {
+		return false
+	}
+	if !this.RefundedTokens.Equal(that1.RefundedTokens) {
+		return false
+	}
+	if !this.DelegatorShares.Equal(that1.DelegatorShares) {
+		return false
+	}
+	return true
+}
+func (this *DelegatorSlash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorSlash)
+	if !ok {
+		that2, ok := that.(DelegatorSlash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Settled != that1.Settled {
+		return false
+	}
+	return true
+}
+func (this *UptimeBucket) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UptimeBucket)
+	if !ok {
+		that2, ok := that.(UptimeBucket)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	return true
+}
+func (this *ValidatorUptime) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorUptime)
+	if !ok {
+		that2, ok := that.(ValidatorUptime)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	if !this.Uptime.Equal(that1.Uptime) {
+		return false
+	}
+	return true
+}
+func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSlashing(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.IndexOffset != 0 {
 		i = encodeVarintSlashing(dAtA, i, uint64(m.IndexOffset))
 		i--
 		dAtA[i] = 0x18
@@ -387,6 +1000,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DowntimeTombstone {
+		i--
+		if m.DowntimeTombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DoubleSignJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DoubleSignJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	if m.UptimeBucketsRetained != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UptimeBucketsRetained))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UptimeBucketSize != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.UptimeBucketSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +1048,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -433,71 +1074,1278 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSlashing(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Infraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ValidatorSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Infraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Infraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovSlashing(uint64(l))
+	{
+		size := m.DelegatorShares.Size()
+		i -= size
+		if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovSlashing(uint64(m.StartHeight))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.RefundedTokens.Size()
+		i -= size
+		if _, err := m.RefundedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
 	}
-	if m.IndexOffset != 0 {
-		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	i--
+	dAtA[i] = 0x52
+	if len(m.AppealReason) > 0 {
+		i -= len(m.AppealReason)
+		copy(dAtA[i:], m.AppealReason)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.AppealReason)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovSlashing(uint64(l))
 	if m.Tombstoned {
-		n += 2
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SlashedTokens.Size()
+		i -= size
+		if _, err := m.SlashedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Power != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorSlash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorSlash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorSlash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UptimeBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UptimeBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UptimeBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUptime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUptime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUptime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSlashing(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashing(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ValidatorSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovSlashing(uint64(m.IndexOffset))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocksCounter))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDoubleSign.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.UptimeBucketSize != 0 {
+		n += 1 + sovSlashing(uint64(m.UptimeBucketSize))
+	}
+	if m.UptimeBucketsRetained != 0 {
+		n += 1 + sovSlashing(uint64(m.UptimeBucketsRetained))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DoubleSignJailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.DowntimeTombstone {
+		n += 2
+	}
+	return n
+}
+
+func (m *Infraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovSlashing(uint64(m.Type))
+	}
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovSlashing(uint64(m.Power))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashedTokens.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	l = len(m.AppealReason)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.RefundedTokens.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DelegatorShares.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DelegatorSlash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	if m.Settled {
+		n += 2
+	}
+	return n
+}
+
+func (m *UptimeBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovSlashing(uint64(m.StartHeight))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	return n
+}
+
+func (m *ValidatorUptime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSlashing(uint64(l))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovSlashing(uint64(m.MissedBlocks))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func sovSlashing(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashing(x uint64) (n int) {
+	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeBucketSize", wireType)
+			}
+			m.UptimeBucketSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UptimeBucketSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeBucketsRetained", wireType)
+			}
+			m.UptimeBucketsRetained = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UptimeBucketsRetained |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSignJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DoubleSignJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeTombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DowntimeTombstone = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Infraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Infraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Infraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= InfractionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppealReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppealReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SignedBlocksWindow != 0 {
-		n += 1 + sovSlashing(uint64(m.SignedBlocksWindow))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.MinSignedPerWindow.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration)
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDoubleSign.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	l = m.SlashFractionDowntime.Size()
-	n += 1 + l + sovSlashing(uint64(l))
-	return n
-}
-
-func sovSlashing(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozSlashing(x uint64) (n int) {
-	return sovSlashing(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *DelegatorSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -520,15 +2368,15 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegatorSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegatorSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -556,13 +2404,13 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.StartHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -572,16 +2420,31 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.IndexOffset = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -591,16 +2454,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IndexOffset |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -610,30 +2473,67 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSlashing
+			m.Settled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UptimeBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UptimeBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UptimeBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var v int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -643,17 +2543,16 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstoned = bool(v != 0)
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.SignedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -663,7 +2562,26 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.SignedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -689,7 +2607,7 @@ func (m *ValidatorSigningInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ValidatorUptime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,36 +2630,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorUptime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorUptime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
-			}
-			m.SignedBlocksWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSlashing
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedBlocksWindow |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -751,30 +2650,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
 			}
-			var msglen int
+			m.SignedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -784,30 +2682,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SignedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDoubleSign", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
 			}
-			var byteLen int
+			m.MissedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -817,30 +2701,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MissedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSlashing
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFractionDoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSlashing
@@ -850,22 +2720,23 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSlashing
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSlashing
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
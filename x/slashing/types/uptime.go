@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewValidatorUptime creates a new ValidatorUptime instance, computing the
+// uptime from the signed and missed blocks
+//
+//nolint:interfacer
+func NewValidatorUptime(consAddr sdk.ConsAddress, signedBlocks, missedBlocks uint64) ValidatorUptime {
+	uptime := sdk.ZeroDec()
+	if total := signedBlocks + missedBlocks; total > 0 {
+		uptime = sdk.NewDecFromInt(sdk.NewIntFromUint64(signedBlocks)).QuoInt(sdk.NewIntFromUint64(total))
+	}
+
+	return ValidatorUptime{
+		Address:      consAddr.String(),
+		SignedBlocks: signedBlocks,
+		MissedBlocks: missedBlocks,
+		Uptime:       uptime,
+	}
+}
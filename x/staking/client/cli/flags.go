@@ -32,6 +32,10 @@ const (
 	FlagNodeID        = "node-id"
 	FlagIP            = "ip"
 	FlagP2PPort       = "p2p-port"
+
+	FlagStatus     = "status"
+	FlagFromHeight = "from-height"
+	FlagToHeight   = "to-height"
 )
 
 // common flagsets to add to various functions
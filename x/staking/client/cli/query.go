@@ -33,12 +33,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryRedelegations(),
 		GetCmdQueryValidator(),
 		GetCmdQueryValidators(),
+		GetCmdQueryValidatorsByCommission(),
 		GetCmdQueryValidatorDelegations(),
 		GetCmdQueryValidatorUnbondingDelegations(),
 		GetCmdQueryValidatorRedelegations(),
 		GetCmdQueryHistoricalInfo(),
+		GetCmdQueryValidatorPowerHistory(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordByDenom(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryTotalLiquidStaked(),
 	)
 
 	return stakingQueryCmd
@@ -132,6 +138,59 @@ $ %s query staking validators
 	return cmd
 }
 
+// GetCmdQueryValidatorsByCommission implements the query validators ordered
+// by commission rate command.
+func GetCmdQueryValidatorsByCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validators-by-commission",
+		Short: "Query for all validators ordered by commission rate",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details about all validators on a network ordered by their commission
+rate, lowest first. Use --reverse to order the validators by their commission rate,
+highest first.
+
+Example:
+$ %s query staking validators-by-commission --status BOND_STATUS_BONDED
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			valStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			result, err := queryClient.ValidatorsByCommission(cmd.Context(), &types.QueryValidatorsByCommissionRequest{
+				Status:     valStatus,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(result)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only return the validators with the given status")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validators-by-commission")
+
+	return cmd
+}
+
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
 func GetCmdQueryValidatorUnbondingDelegations() *cobra.Command {
 	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
@@ -673,6 +732,76 @@ $ %s query staking historical-info 5
 	return cmd
 }
 
+// GetCmdQueryValidatorPowerHistory implements the validator power history
+// query command.
+func GetCmdQueryValidatorPowerHistory() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "validator-power-history [validator-addr]",
+		Short: "Query the consensus power changes of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consensus power changes of a validator within a range of block heights.
+A record is only stored when the power of the validator changes, the power at any height
+is the one of the latest record at or before that height.
+
+Example:
+$ %s query staking validator-power-history %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from-height 100 --to-height 200
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			fromHeight, err := cmd.Flags().GetInt64(FlagFromHeight)
+			if err != nil {
+				return err
+			}
+
+			toHeight, err := cmd.Flags().GetInt64(FlagToHeight)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryValidatorPowerHistoryRequest{
+				ValidatorAddr: addr.String(),
+				FromHeight:    fromHeight,
+				ToHeight:      toHeight,
+				Pagination:    pageReq,
+			}
+			res, err := queryClient.ValidatorPowerHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64(FlagFromHeight, 0, "Lowest block height of the returned records")
+	cmd.Flags().Int64(FlagToHeight, 0, "Highest block height of the returned records, no upper bound when zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator power history")
+
+	return cmd
+}
+
 // GetCmdQueryPool implements the pool query command.
 func GetCmdQueryPool() *cobra.Command {
 	cmd := &cobra.Command{
@@ -744,3 +873,178 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the query command for a
+// tokenize share record by its ID.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the query command for a
+// tokenize share record by the denom of its share tokens.
+func GetCmdQueryTokenizeShareRecordByDenom() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query individual tokenize share record information by share denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query individual tokenize share record information by share denom.
+
+Example:
+$ %s query staking tokenize-share-record-by-denom %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the query command for the
+// tokenize share records of an owner.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query tokenize share records by address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query tokenize share records owned by an address.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{
+				Owner:      owner.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the query command for the total
+// liquid staked tokens.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query total liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total amount of tokens delegated through tokenize share records.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
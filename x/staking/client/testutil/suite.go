@@ -322,6 +322,68 @@ func (s *IntegrationTestSuite) TestGetCmdQueryValidators() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorsByCommission() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name              string
+		args              []string
+		expectErr         bool
+		minValidatorCount int
+	}{
+		{
+			"invalid status",
+			[]string{
+				fmt.Sprintf("--%s=invalid", cli.FlagStatus),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			0,
+		},
+		{
+			"one validator case",
+			[]string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				fmt.Sprintf("--%s=1", flags.FlagLimit),
+			},
+			false,
+			1,
+		},
+		{
+			"bonded validators",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagStatus, types.Bonded.String()),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			len(s.network.Validators),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorsByCommission()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var result types.QueryValidatorsByCommissionResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &result))
+			s.Require().Equal(tc.minValidatorCount, len(result.Validators))
+			for i := 1; i < len(result.Validators); i++ {
+				s.Require().True(result.Validators[i-1].Commission.Rate.LTE(result.Validators[i].Commission.Rate))
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDelegation() {
 	val := s.network.Validators[0]
 	val2 := s.network.Validators[1]
@@ -883,6 +945,64 @@ func (s *IntegrationTestSuite) TestGetCmdQueryHistoricalInfo() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryValidatorPowerHistory() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name  string
+		args  []string
+		error bool
+	}{
+		{
+			"invalid address",
+			[]string{
+				"invalid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"invalid height range",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=10", cli.FlagFromHeight),
+				fmt.Sprintf("--%s=5", cli.FlagToHeight),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid request",
+			[]string{
+				val.ValAddress.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryValidatorPowerHistory()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.error {
+				s.Require().Error(err)
+			} else {
+				var res types.QueryValidatorPowerHistoryResponse
+
+				s.Require().NoError(err)
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+				// the validator is bonded since genesis
+				s.Require().NotEmpty(res.Records)
+				s.Require().Positive(res.Records[0].Power)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 	val := s.network.Validators[0]
 	testCases := []struct {
@@ -894,16 +1014,19 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+global_liquid_staking_cap: "1.000000000000000000"
 historical_entries: 10000
 max_entries: 7
 max_validators: 100
 min_commission_rate: "0.000000000000000000"
-unbonding_time: 1814400s`,
+power_history_blocks: "100000"
+unbonding_time: 1814400s
+validator_bond_factor: "-1.000000000000000000"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","validator_bond_factor":"-1.000000000000000000","global_liquid_staking_cap":"1.000000000000000000","power_history_blocks":"100000"}`,
 		},
 	}
 	for _, tc := range testCases {
@@ -1242,6 +1365,83 @@ func (s *IntegrationTestSuite) TestNewRedelegateCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestNewTokenizeSharesCmd() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		{
+			"Without rewards owner",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)).String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"invalid rewards owner",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)).String(),
+				"invalid",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, 0, nil,
+		},
+		{
+			"valid transaction of tokenize share",
+			[]string{
+				val.ValAddress.String(),
+				sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(100)).String(),
+				val.Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%d", flags.FlagGas, 400000),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, 0, &sdk.TxResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.NewTokenizeSharesCmd()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+
+	// the tokenized delegation is accounted as liquid staked
+	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryTotalLiquidStaked(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
+	s.Require().NoError(err)
+	s.Require().Equal(`{"tokens":"100"}`, strings.TrimSpace(out.String()))
+}
+
 func (s *IntegrationTestSuite) TestNewUnbondCmd() {
 	val := s.network.Validators[0]
 
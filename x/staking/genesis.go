@@ -43,6 +43,18 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if !data.TotalLiquidStakedTokens.IsNil() && data.TotalLiquidStakedTokens.IsNegative() {
+		return fmt.Errorf("total liquid staked tokens cannot be negative: %s", data.TotalLiquidStakedTokens)
+	}
+
+	if err := validateGenesisStatePowerHistory(data.PowerHistory); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
@@ -83,3 +95,47 @@ func validateGenesisStateValidators(validators []types.Validator) error {
 
 	return nil
 }
+
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastID)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStatePowerHistory(powerHistory []types.ValidatorPowerHistory) error {
+	for _, history := range powerHistory {
+		if _, err := sdk.ValAddressFromBech32(history.Address); err != nil {
+			return fmt.Errorf("invalid power history address %s: %w", history.Address, err)
+		}
+
+		seen := make(map[int64]bool, len(history.Records))
+		for _, record := range history.Records {
+			if record.Height < 0 {
+				return fmt.Errorf("power record height of %s cannot be negative, is %d", history.Address, record.Height)
+			}
+
+			if seen[record.Height] {
+				return fmt.Errorf("duplicate power record of %s at height %d", history.Address, record.Height)
+			}
+			seen[record.Height] = true
+		}
+	}
+
+	return nil
+}
@@ -39,6 +39,45 @@ func TestValidateGenesis(t *testing.T) {
 			data.Validators[0].Jailed = true
 			data.Validators[0].Status = types.Bonded
 		}, true},
+		// validate genesis tokenize share records
+		{"tokenize share record", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, false},
+		{"duplicate tokenize share record", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record, record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"tokenize share record id greater than last id", func(data *types.GenesisState) {
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{types.NewTokenizeShareRecord(2, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"invalid tokenize share record module account", func(data *types.GenesisState) {
+			record := types.NewTokenizeShareRecord(1, sdk.AccAddress(pk.Address()), sdk.ValAddress(pk.Address()))
+			record.ModuleAccount = "invalid"
+			data.TokenizeShareRecords = []types.TokenizeShareRecord{record}
+			data.LastTokenizeShareRecordId = 1
+		}, true},
+		{"negative total liquid staked tokens", func(data *types.GenesisState) {
+			data.TotalLiquidStakedTokens = sdk.NewInt(-1)
+		}, true},
+		// validate genesis power history
+		{"power history", func(data *types.GenesisState) {
+			data.PowerHistory = []types.ValidatorPowerHistory{
+				{Address: sdk.ValAddress(pk.Address()).String(), Records: []types.ValidatorPowerRecord{{Height: 1, Power: 10}, {Height: 2, Power: 0}}},
+			}
+		}, false},
+		{"invalid power history address", func(data *types.GenesisState) {
+			data.PowerHistory = []types.ValidatorPowerHistory{
+				{Address: sdk.AccAddress(pk.Address()).String(), Records: []types.ValidatorPowerRecord{{Height: 1, Power: 10}}},
+			}
+		}, true},
+		{"duplicate power record", func(data *types.GenesisState) {
+			data.PowerHistory = []types.ValidatorPowerHistory{
+				{Address: sdk.ValAddress(pk.Address()).String(), Records: []types.ValidatorPowerRecord{{Height: 1, Power: 10}, {Height: 1, Power: 0}}},
+			}
+		}, true},
 	}
 
 	for _, tt := range tests {
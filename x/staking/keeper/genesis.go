@@ -25,7 +25,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	// genesis.json are in block 0.
 	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
 
-	k.SetParams(ctx, data.Params)
+	// track the highest unbonding ID so that new unbonding operations do not
+	// reuse the IDs of the imported ones
+	var lastUnbondingID uint64
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
@@ -34,6 +40,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		// Manually set indices for the first time
 		k.SetValidatorByConsAddr(ctx, validator)
 		k.SetValidatorByPowerIndex(ctx, validator)
+		k.SetValidatorByCommissionIndex(ctx, validator)
 
 		// Call the creation hook if not exported
 		if !data.Exported {
@@ -47,6 +54,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 			k.InsertUnbondingValidatorQueue(ctx, validator)
 		}
 
+		for _, id := range validator.UnbondingIds {
+			k.SetValidatorByUnbondingID(ctx, validator, id)
+			if id > lastUnbondingID {
+				lastUnbondingID = id
+			}
+		}
+
 		switch validator.GetStatus() {
 		case types.Bonded:
 			bondedTokens = bondedTokens.Add(validator.GetTokens())
@@ -85,6 +99,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		for _, entry := range ubd.Entries {
 			k.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
 			notBondedTokens = notBondedTokens.Add(entry.Balance)
+
+			if entry.UnbondingId != 0 {
+				k.SetUnbondingDelegationByUnbondingID(ctx, ubd, entry.UnbondingId)
+				if entry.UnbondingId > lastUnbondingID {
+					lastUnbondingID = entry.UnbondingId
+				}
+			}
 		}
 	}
 
@@ -93,6 +114,37 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 
 		for _, entry := range red.Entries {
 			k.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+
+			if entry.UnbondingId != 0 {
+				k.SetRedelegationByUnbondingID(ctx, red, entry.UnbondingId)
+				if entry.UnbondingId > lastUnbondingID {
+					lastUnbondingID = entry.UnbondingId
+				}
+			}
+		}
+	}
+
+	k.setUnbondingID(ctx, lastUnbondingID)
+
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+
+	for _, history := range data.PowerHistory {
+		operator, err := sdk.ValAddressFromBech32(history.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, record := range history.Records {
+			k.setValidatorPowerRecord(ctx, operator, record)
 		}
 	}
 
@@ -188,14 +240,34 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	var powerHistory []types.ValidatorPowerHistory
+
+	k.IterateValidatorPowerHistory(ctx, func(operator sdk.ValAddress, record types.ValidatorPowerRecord) (stop bool) {
+		address := operator.String()
+		if n := len(powerHistory); n > 0 && powerHistory[n-1].Address == address {
+			powerHistory[n-1].Records = append(powerHistory[n-1].Records, record)
+		} else {
+			powerHistory = append(powerHistory, types.ValidatorPowerHistory{
+				Address: address,
+				Records: []types.ValidatorPowerRecord{record},
+			})
+		}
+
+		return false
+	})
+
 	return &types.GenesisState{
-		Params:               k.GetParams(ctx),
-		LastTotalPower:       k.GetLastTotalPower(ctx),
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           k.GetAllValidators(ctx),
-		Delegations:          k.GetAllDelegations(ctx),
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
+		Params:                    k.GetParams(ctx),
+		LastTotalPower:            k.GetLastTotalPower(ctx),
+		LastValidatorPowers:       lastValidatorPowers,
+		Validators:                k.GetAllValidators(ctx),
+		Delegations:               k.GetAllDelegations(ctx),
+		UnbondingDelegations:      unbondingDelegations,
+		Redelegations:             redelegations,
+		Exported:                  true,
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		TotalLiquidStakedTokens:   k.GetTotalLiquidStakedTokens(ctx),
+		PowerHistory:              powerHistory,
 	}
 }
@@ -112,6 +112,57 @@ func TestInitGenesis(t *testing.T) {
 	require.Equal(t, abcivals, vals)
 }
 
+func TestInitGenesisUnbondingIDs(t *testing.T) {
+	app, ctx, _ := bootstrapGenesisTest(t, 1)
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	require.Len(t, delegations, 1)
+	delAddr := delegations[0].GetDelegatorAddr()
+	valAddr := delegations[0].GetValidatorAddr()
+
+	_, err := app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, delegations[0].Shares.QuoInt64(2))
+	require.NoError(t, err)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddr, valAddr)
+	require.True(t, found)
+	id := ubd.Entries[0].UnbondingId
+	require.NotZero(t, id)
+
+	// drop the index and reimport the exported state
+	exported := app.StakingKeeper.ExportGenesis(ctx)
+	app.StakingKeeper.DeleteUnbondingIndex(ctx, id)
+	app.StakingKeeper.InitGenesis(ctx, exported)
+
+	resUbd, found := app.StakingKeeper.GetUnbondingDelegationByUnbondingID(ctx, id)
+	require.True(t, found)
+	require.Equal(t, ubd, resUbd)
+	require.Equal(t, id+1, app.StakingKeeper.IncrementUnbondingID(ctx))
+}
+
+func TestInitGenesisPowerHistory(t *testing.T) {
+	app, ctx, _ := bootstrapGenesisTest(t, 1)
+
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+	app.StakingKeeper.SetValidatorPowerRecord(ctx.WithBlockHeight(5), valAddr, 10)
+	app.StakingKeeper.SetValidatorPowerRecord(ctx.WithBlockHeight(8), valAddr, 0)
+	// the genesis validator has a record from height 0 on
+	records := app.StakingKeeper.GetValidatorPowerHistory(ctx, valAddr, 0, 0)
+	require.Len(t, records, 3)
+
+	exported := app.StakingKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.ValidatorPowerHistory{{Address: valAddr.String(), Records: records}}, exported.PowerHistory)
+
+	// drop the records and reimport the exported state
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, record := range records {
+		store.Delete(types.GetValidatorPowerHistoryKey(valAddr, record.Height))
+	}
+	require.Empty(t, app.StakingKeeper.GetValidatorPowerHistory(ctx, valAddr, 0, 0))
+
+	app.StakingKeeper.InitGenesis(ctx, exported)
+	require.Equal(t, records, app.StakingKeeper.GetValidatorPowerHistory(ctx, valAddr, 0, 0))
+}
+
 func TestInitGenesis_PoolsBalanceMismatch(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.NewContext(false, tmproto.Header{})
@@ -469,6 +469,166 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// TokenizeShareRecordById queries a tokenize share record by its id
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecord(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries a tokenize share record by the denom of its share tokens
+func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Error(codes.InvalidArgument, "owner address cannot be empty")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	var records []types.TokenizeShareRecord
+
+	store := ctx.KVStore(k.storeKey)
+	ownerStore := prefix.NewStore(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	pageRes, err := query.Paginate(ownerStore, req.Pagination, func(key []byte, value []byte) error {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTokenizeShareRecordsOwnedResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// TotalLiquidStaked queries the total amount of liquid staked tokens
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
+
+// ValidatorsByCommission queries the validators that match the given status
+// ordered by their commission rate, paginating over the validators by
+// commission index.
+func (k Querier) ValidatorsByCommission(c context.Context, req *types.QueryValidatorsByCommissionRequest) (*types.QueryValidatorsByCommissionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// validate the provided status, return all the validators if the status is empty
+	if req.Status != "" && !(req.Status == types.Bonded.String() || req.Status == types.Unbonded.String() || req.Status == types.Unbonding.String()) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator status %s", req.Status)
+	}
+
+	var validators types.Validators
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	commissionStore := prefix.NewStore(store, types.ValidatorsByCommissionIndexKey)
+
+	pageRes, err := query.FilteredPaginate(commissionStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		val, found := k.GetValidator(ctx, value)
+		if !found {
+			return false, status.Errorf(codes.Internal, "validator %s not found", sdk.ValAddress(value))
+		}
+
+		if req.Status != "" && !strings.EqualFold(val.GetStatus().String(), req.Status) {
+			return false, nil
+		}
+
+		if accumulate {
+			validators = append(validators, val)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorsByCommissionResponse{Validators: validators, Pagination: pageRes}, nil
+}
+
+// ValidatorPowerHistory queries the consensus power changes of a validator
+// within a range of block heights
+func (k Querier) ValidatorPowerHistory(c context.Context, req *types.QueryValidatorPowerHistoryRequest) (*types.QueryValidatorPowerHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	if req.FromHeight < 0 || req.ToHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	if req.ToHeight > 0 && req.FromHeight > req.ToHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "from height %d is greater than to height %d", req.FromHeight, req.ToHeight)
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []types.ValidatorPowerRecord
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	historyStore := prefix.NewStore(store, types.GetValidatorPowerHistoryPrefix(valAddr))
+	rangeStore := query.NewHeightRangeStore(historyStore, req.FromHeight, req.ToHeight)
+
+	pageRes, err := query.Paginate(rangeStore, req.Pagination, func(key []byte, value []byte) error {
+		records = append(records, k.unmarshalValidatorPowerRecord(key, value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorPowerHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
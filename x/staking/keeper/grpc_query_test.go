@@ -11,6 +11,8 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -786,6 +788,255 @@ func (suite *KeeperTestSuite) TestGRPCQueryValidatorUnbondingDelegations() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryTokenizeShareRecords() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+	owner, valAddr := addrs[0], sdk.ValAddress(addrs[1])
+
+	records := []types.TokenizeShareRecord{
+		types.NewTokenizeShareRecord(1, owner, valAddr),
+		types.NewTokenizeShareRecord(2, owner, valAddr),
+		types.NewTokenizeShareRecord(3, addrs[1], valAddr),
+	}
+	for _, record := range records {
+		suite.Require().NoError(app.StakingKeeper.AddTokenizeShareRecord(ctx, record))
+	}
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.NewInt(100))
+
+	// query by id
+	_, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 4})
+	suite.Require().Error(err)
+
+	resByID, err := queryClient.TokenizeShareRecordById(gocontext.Background(), &types.QueryTokenizeShareRecordByIdRequest{Id: 2})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[1], resByID.Record)
+
+	// query by denom
+	_, err = queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{})
+	suite.Require().Error(err)
+
+	resByDenom, err := queryClient.TokenizeShareRecordByDenom(gocontext.Background(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: records[2].GetShareTokenDenom()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[2], resByDenom.Record)
+
+	// query by owner
+	_, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: "invalid"})
+	suite.Require().Error(err)
+
+	resOwned, err := queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{
+		Owner:      owner.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[:1], resOwned.Records)
+	suite.Require().Equal(uint64(2), resOwned.Pagination.Total)
+
+	resOwned, err = queryClient.TokenizeShareRecordsOwned(gocontext.Background(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: owner.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[:2], resOwned.Records)
+
+	// look up by module account address
+	record, err := app.StakingKeeper.GetTokenizeShareRecordByAddress(ctx, records[1].GetModuleAddress())
+	suite.Require().NoError(err)
+	suite.Require().Equal(records[1], record)
+
+	suite.Require().NoError(app.StakingKeeper.DeleteTokenizeShareRecord(ctx, records[1].Id))
+	_, err = app.StakingKeeper.GetTokenizeShareRecordByAddress(ctx, records[1].GetModuleAddress())
+	suite.Require().Error(err)
+
+	// query total liquid staked
+	resTotal, err := queryClient.TotalLiquidStaked(gocontext.Background(), &types.QueryTotalLiquidStakedRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), resTotal.Tokens)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorsByCommission() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	// give every validator a distinct commission rate, in reverse store order
+	vals := app.StakingKeeper.GetAllValidators(ctx)
+	suite.Require().Len(vals, 3)
+	for i := range vals {
+		rate := sdk.NewDecWithPrec(int64(len(vals)-i), 2)
+		app.StakingKeeper.DeleteValidatorByCommissionIndex(ctx, vals[i])
+		vals[i].Commission = types.NewCommission(rate, sdk.OneDec(), sdk.OneDec())
+		app.StakingKeeper.SetValidator(ctx, vals[i])
+		app.StakingKeeper.SetValidatorByCommissionIndex(ctx, vals[i])
+	}
+
+	_, err := queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{Status: "invalid"})
+	suite.Require().Error(err)
+
+	res, err := queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(operators(vals[2], vals[1], vals[0]), operators(res.Validators...))
+
+	// key based pagination
+	res, err = queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(operators(vals[2], vals[1]), operators(res.Validators...))
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(operators(vals[0]), operators(res.Validators...))
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	res, err = queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(operators(vals[1]), operators(res.Validators...))
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+
+	res, err = queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(operators(vals[0], vals[1]), operators(res.Validators...))
+
+	res, err = queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{
+		Pagination: &query.PageRequest{Offset: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Validators)
+
+	// filter by status
+	var bonded []types.Validator
+	for i := len(vals) - 1; i >= 0; i-- {
+		if vals[i].IsBonded() {
+			bonded = append(bonded, vals[i])
+		}
+	}
+	res, err = queryClient.ValidatorsByCommission(gocontext.Background(), &types.QueryValidatorsByCommissionRequest{Status: types.Bonded.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(operators(bonded...), operators(res.Validators...))
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryValidatorPowerHistory() {
+	app, ctx, queryClient, vals := suite.app, suite.ctx, suite.queryClient, suite.vals
+	valAddr := vals[0].GetOperator()
+
+	for height, power := range map[int64]int64{10: 20, 20: 30, 30: 0} {
+		app.StakingKeeper.SetValidatorPowerRecord(ctx.WithBlockHeight(height), valAddr, power)
+	}
+
+	testCases := []struct {
+		msg     string
+		req     *types.QueryValidatorPowerHistoryRequest
+		expPass bool
+		expRes  []types.ValidatorPowerRecord
+	}{
+		{
+			"empty request",
+			&types.QueryValidatorPowerHistoryRequest{},
+			false,
+			nil,
+		},
+		{
+			"invalid validator address",
+			&types.QueryValidatorPowerHistoryRequest{ValidatorAddr: "invalid"},
+			false,
+			nil,
+		},
+		{
+			"invalid height range",
+			&types.QueryValidatorPowerHistoryRequest{ValidatorAddr: valAddr.String(), FromHeight: 20, ToHeight: 10},
+			false,
+			nil,
+		},
+		{
+			"negative height",
+			&types.QueryValidatorPowerHistoryRequest{ValidatorAddr: valAddr.String(), FromHeight: -1},
+			false,
+			nil,
+		},
+		{
+			"from height",
+			&types.QueryValidatorPowerHistoryRequest{ValidatorAddr: valAddr.String(), FromHeight: 10},
+			true,
+			[]types.ValidatorPowerRecord{{Height: 10, Power: 20}, {Height: 20, Power: 30}, {Height: 30, Power: 0}},
+		},
+		{
+			"height range",
+			&types.QueryValidatorPowerHistoryRequest{ValidatorAddr: valAddr.String(), FromHeight: 15, ToHeight: 30},
+			true,
+			[]types.ValidatorPowerRecord{{Height: 20, Power: 30}, {Height: 30, Power: 0}},
+		},
+		{
+			"paginated",
+			&types.QueryValidatorPowerHistoryRequest{
+				ValidatorAddr: valAddr.String(),
+				FromHeight:    10,
+				Pagination:    &query.PageRequest{Limit: 1, Offset: 1},
+			},
+			true,
+			[]types.ValidatorPowerRecord{{Height: 20, Power: 30}},
+		},
+		{
+			"paginated in reverse within the height range",
+			&types.QueryValidatorPowerHistoryRequest{
+				ValidatorAddr: valAddr.String(),
+				FromHeight:    15,
+				ToHeight:      25,
+				Pagination:    &query.PageRequest{Reverse: true},
+			},
+			true,
+			[]types.ValidatorPowerRecord{{Height: 20, Power: 30}},
+		},
+		{
+			"no records",
+			&types.QueryValidatorPowerHistoryRequest{ValidatorAddr: vals[1].GetOperator().String(), FromHeight: 10},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			res, err := queryClient.ValidatorPowerHistory(gocontext.Background(), tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expRes, res.Records)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+
+	// the pagination only covers the records of the height range
+	req := &types.QueryValidatorPowerHistoryRequest{
+		ValidatorAddr: valAddr.String(),
+		FromHeight:    15,
+		ToHeight:      30,
+		Pagination:    &query.PageRequest{Limit: 1, CountTotal: true},
+	}
+	res, err := queryClient.ValidatorPowerHistory(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ValidatorPowerRecord{{Height: 20, Power: 30}}, res.Records)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	res, err = queryClient.ValidatorPowerHistory(gocontext.Background(), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.ValidatorPowerRecord{{Height: 30, Power: 0}}, res.Records)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+// operators returns the operator addresses of the given validators.
+func operators(vals ...types.Validator) []string {
+	addrs := make([]string, len(vals))
+	for i, val := range vals {
+		addrs[i] = val.OperatorAddress
+	}
+
+	return addrs
+}
+
 func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers []int64) ([]sdk.AccAddress, []sdk.ValAddress, []types.Validator) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 5, app.StakingKeeper.TokensFromConsensusPower(ctx, 300))
 	valAddrs := simtestutil.ConvertAddrsToValAddrs(addrs)
@@ -796,7 +1047,7 @@ func createValidators(t *testing.T, ctx sdk.Context, app *simapp.SimApp, powers
 		app.GetKey(types.StoreKey),
 		app.AccountKeeper,
 		app.BankKeeper,
-		app.GetSubspace(types.ModuleName),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	val1 := teststaking.NewValidator(t, valAddrs[0], pks[0])
@@ -87,3 +87,11 @@ func (k Keeper) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress,
 	}
 	return nil
 }
+
+// AfterUnbondingInitiated - call hook if registered
+func (k Keeper) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	if k.hooks != nil {
+		return k.hooks.AfterUnbondingInitiated(ctx, id)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated through
+// tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens delegated through
+// tokenize share records.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// CheckExceedsGlobalLiquidStakingCap returns true if liquid staking tokens more
+// would exceed the global liquid staking cap, relative to the total bonded tokens.
+func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens math.Int) bool {
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	if liquidStakingCap.GTE(sdk.OneDec()) {
+		return false
+	}
+
+	updatedLiquidStaked := sdk.NewDecFromInt(k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	totalStaked := sdk.NewDecFromInt(k.TotalBondedTokens(ctx))
+
+	return updatedLiquidStaked.GT(totalStaked.Mul(liquidStakingCap))
+}
+
+// CheckExceedsValidatorBondCap returns true if tokenizing shares more of a
+// validator would exceed the multiple of its validator bond shares set by the
+// validator bond factor.
+func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) bool {
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if validatorBondFactor.IsNegative() {
+		return false
+	}
+
+	maxLiquidShares := validator.ValidatorBondShares.Mul(validatorBondFactor)
+	return validator.LiquidShares.Add(shares).GT(maxLiquidShares)
+}
+
+// SafelyIncreaseTotalLiquidStakedTokens increases the total liquid staked
+// tokens, failing if the global liquid staking cap would be exceeded.
+func (k Keeper) SafelyIncreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) error {
+	if k.CheckExceedsGlobalLiquidStakingCap(ctx, tokens) {
+		return types.ErrLiquidStakingCapExceeded
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+	return nil
+}
+
+// DecreaseTotalLiquidStakedTokens decreases the total liquid staked tokens.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) error {
+	totalLiquidStaked := k.GetTotalLiquidStakedTokens(ctx)
+	if tokens.GT(totalLiquidStaked) {
+		return sdkerrors.Wrapf(types.ErrNotEnoughBalance, "total liquid staked tokens %s, cannot decrease by %s", totalLiquidStaked, tokens)
+	}
+
+	k.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked.Sub(tokens))
+	return nil
+}
+
+// SafelyIncreaseValidatorLiquidShares increases the liquid shares of a
+// validator, failing if its validator bond cap would be exceeded.
+func (k Keeper) SafelyIncreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) (types.Validator, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, types.ErrNoValidatorFound
+	}
+
+	if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+		return validator, types.ErrInsufficientValidatorBond
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Add(shares)
+	k.SetValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// DecreaseValidatorLiquidShares decreases the liquid shares of a validator.
+func (k Keeper) DecreaseValidatorLiquidShares(ctx sdk.Context, valAddr sdk.ValAddress, shares sdk.Dec) (types.Validator, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return validator, types.ErrNoValidatorFound
+	}
+
+	if shares.GT(validator.LiquidShares) {
+		return validator, sdkerrors.Wrapf(types.ErrNotEnoughDelegationShares, "validator liquid shares %s, cannot decrease by %s", validator.LiquidShares, shares)
+	}
+
+	validator.LiquidShares = validator.LiquidShares.Sub(shares)
+	k.SetValidator(ctx, validator)
+
+	return validator, nil
+}
+
+// checkValidatorBondDecrease returns an error if removing shares from the
+// validator bond of a validator would leave it with more liquid shares than
+// its validator bond allows.
+func (k Keeper) checkValidatorBondDecrease(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	validatorBondFactor := k.ValidatorBondFactor(ctx)
+	if validatorBondFactor.IsNegative() {
+		return nil
+	}
+
+	maxLiquidShares := validator.ValidatorBondShares.Sub(shares).Mul(validatorBondFactor)
+	if !validator.LiquidShares.GT(maxLiquidShares) {
+		return nil
+	}
+
+	// a factor of zero allows no liquid shares whatever the validator bond
+	if validatorBondFactor.IsZero() {
+		return sdkerrors.Wrapf(
+			types.ErrInsufficientValidatorBond,
+			"validator has %s liquid shares, which a validator bond factor of zero doesn't allow",
+			validator.LiquidShares,
+		)
+	}
+
+	return sdkerrors.Wrapf(
+		types.ErrInsufficientValidatorBond,
+		"validator has %s liquid shares, which requires at least %s validator bond shares",
+		validator.LiquidShares, validator.LiquidShares.Quo(validatorBondFactor),
+	)
+}
@@ -2,19 +2,26 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
 	v043 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	v048 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v048"
+	v049 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v049"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
-	keeper *Keeper
+	keeper         *Keeper
+	legacySubspace exported.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper *Keeper) Migrator {
+func NewMigrator(keeper *Keeper, legacySubspace exported.Subspace) Migrator {
 	return Migrator{
-		keeper: keeper,
+		keeper:         keeper,
+		legacySubspace: legacySubspace,
 	}
 }
 
@@ -25,5 +32,24 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 // Migrate2to3 migrates x/staking state from consensus version 2 to 3.
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
-	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore)
+	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.legacySubspace.(paramtypes.Subspace))
+}
+
+// Migrate3to4 migrates x/staking state from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5, raising
+// the commission rates below the minimum commission rate param. The param must
+// be set before the migration runs, e.g. in the upgrade handler.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v048.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6, setting
+// the liquid staking and power history params to their defaults and the
+// liquid staking shares of the validators to zero.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v049.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
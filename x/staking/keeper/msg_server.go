@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -103,6 +104,7 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetNewValidatorByPowerIndex(ctx, validator)
+	k.SetValidatorByCommissionIndex(ctx, validator)
 
 	// call the after-creation hook
 	if err := k.AfterValidatorCreated(ctx, validator.GetOperator()); err != nil {
@@ -165,7 +167,9 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 			return nil, err
 		}
 
+		k.DeleteValidatorByCommissionIndex(ctx, validator)
 		validator.Commission = commission
+		k.SetValidatorByCommissionIndex(ctx, validator)
 	}
 
 	if msg.MinSelfDelegation != nil {
@@ -287,6 +291,10 @@ func (k msgServer) BeginRedelegate(goCtx context.Context, msg *types.MsgBeginRed
 		return nil, err
 	}
 
+	if err := k.checkValidatorBondUnbond(ctx, delegatorAddress, valSrcAddr, shares); err != nil {
+		return nil, err
+	}
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, delegatorAddress, valSrcAddr, valDstAddr, shares,
 	)
@@ -351,6 +359,10 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 		)
 	}
 
+	if err := k.checkValidatorBondUnbond(ctx, delegatorAddress, addr, shares); err != nil {
+		return nil, err
+	}
+
 	completionTime, err := k.Keeper.Undelegate(ctx, delegatorAddress, addr, shares)
 	if err != nil {
 		return nil, err
@@ -466,6 +478,7 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 	amount := unbondEntry.Balance.Sub(msg.Amount.Amount)
 	if amount.IsZero() {
 		ubd.RemoveEntry(unbondEntryIndex)
+		k.DeleteUnbondingIndex(ctx, unbondEntry.UnbondingId)
 	} else {
 		// update the unbondingDelegationEntryBalance and InitialBalance for ubd entry
 		unbondEntry.Balance = amount
@@ -492,3 +505,337 @@ func (k msgServer) CancelUnbondingDelegation(goCtx context.Context, msg *types.M
 
 	return &types.MsgCancelUnbondingDelegationResponse{}, nil
 }
+
+// UpdateParams implements the Msg/UpdateParams Msg service.
+func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// TokenizeShares defines a method for tokenizing the shares of a delegation
+// into share tokens
+func (k msgServer) TokenizeShares(goCtx context.Context, msg *types.MsgTokenizeShares) (*types.MsgTokenizeSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOwner, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if msg.Amount.Denom != bondDenom {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Amount.Denom, bondDenom,
+		)
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondNotTokenizable
+	}
+
+	// the delegation received by a redelegation must remain slashable for the
+	// infractions of the source validator
+	if k.HasReceivingRedelegation(ctx, delegatorAddress, valAddr) {
+		return nil, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delegatorAddress, valAddr, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// the operator can't tokenize its self-delegation below the minimum
+	if delegatorAddress.Equals(sdk.AccAddress(valAddr)) &&
+		validator.TokensFromShares(delegation.Shares.Sub(shares)).TruncateInt().LT(validator.MinSelfDelegation) {
+		return nil, types.ErrSelfDelegationBelowMinimum
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+
+	record := types.NewTokenizeShareRecord(recordID, shareOwner, valAddr)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return nil, err
+	}
+
+	// undelegate the shares, the returned amount can be slightly lower than the
+	// requested amount because of the decimal to int conversion
+	returnAmount, err := k.Unbond(ctx, delegatorAddress, valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// move the tokens to the module account of the record, which then
+	// delegates them
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, returnCoins); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, delegatorAddress, record.GetModuleAddress(), returnCoins); err != nil {
+		return nil, err
+	}
+
+	// the validator must be read again as it was updated by the unbonding
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	newShares, err := k.Keeper.Delegate(ctx, record.GetModuleAddress(), returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// mint the share tokens of the delegation to the delegator
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if shareToken.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize (truncates to zero share tokens)")
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	if err := k.SafelyIncreaseTotalLiquidStakedTokens(ctx, returnAmount); err != nil {
+		return nil, err
+	}
+	if _, err := k.SafelyIncreaseValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTokenizeShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyShareOwner, msg.TokenizedShareOwner),
+			sdk.NewAttribute(types.AttributeKeyShareRecordID, strconv.FormatUint(record.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTokenizedShares, shareToken.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgTokenizeSharesResponse{
+		Amount: shareToken,
+	}, nil
+}
+
+// RedeemTokensForShares defines a method for redeeming share tokens for a
+// delegation
+func (k msgServer) RedeemTokensForShares(goCtx context.Context, msg *types.MsgRedeemTokensForShares) (*types.MsgRedeemTokensForSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	shareToken := msg.Amount
+	balance := k.bankKeeper.GetBalance(ctx, delegatorAddress, shareToken.Denom)
+	if balance.Amount.LT(shareToken.Amount) {
+		return nil, sdkerrors.Wrapf(types.ErrNotEnoughBalance, "%s is smaller than %s", balance, shareToken)
+	}
+
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	if !found {
+		return nil, types.ErrNoDelegatorForAddress
+	}
+
+	// redeeming all the share tokens of the record also redeems the fraction
+	// of share left in its delegation when the share tokens were minted
+	shares := sdk.NewDecFromInt(shareToken.Amount)
+	if shareToken.IsEqual(k.bankKeeper.GetSupply(ctx, shareToken.Denom)) {
+		shares = delegation.Shares
+	}
+
+	tokens := validator.TokensFromShares(shares).TruncateInt()
+	if tokens.IsZero() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "too few share tokens to redeem (truncates to zero tokens)")
+	}
+
+	if err := k.DecreaseTotalLiquidStakedTokens(ctx, tokens); err != nil {
+		return nil, err
+	}
+	if _, err := k.DecreaseValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return nil, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, record.GetModuleAddress(), valAddr, shares)
+	if err != nil {
+		return nil, err
+	}
+
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	// the record is removed once all of its share tokens were redeemed
+	if _, found := k.GetDelegation(ctx, record.GetModuleAddress(), valAddr); !found {
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	// burn the share tokens
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return nil, err
+	}
+
+	// delegate the tokens of the shares from the delegator
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, sdk.NewCoins(returnCoin)); err != nil {
+		return nil, err
+	}
+
+	// the validator must be read again as it was updated by the unbonding
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	if _, err := k.Keeper.Delegate(ctx, delegatorAddress, returnAmount, types.Unbonded, validator, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemShares,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, record.Validator),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, returnCoin.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgRedeemTokensForSharesResponse{
+		Amount: returnCoin,
+	}, nil
+}
+
+// ValidatorBond defines a method for designating a delegation as a validator bond
+func (k msgServer) ValidatorBond(goCtx context.Context, msg *types.MsgValidatorBond) (*types.MsgValidatorBondResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delegatorAddress, valAddr)
+	if !found {
+		return nil, types.ErrNoDelegation
+	}
+
+	if delegation.ValidatorBond {
+		return nil, types.ErrValidatorBondAlreadySet
+	}
+
+	delegation.ValidatorBond = true
+	k.SetDelegation(ctx, delegation)
+
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Add(delegation.Shares)
+	k.SetValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeValidatorBond,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgValidatorBondResponse{}, nil
+}
+
+// checkValidatorBondUnbond returns an error if the delegation is a validator
+// bond and unbonding shares from it would leave the validator with more
+// liquid shares than its validator bond allows.
+func (k msgServer) checkValidatorBondUnbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	return k.checkValidatorBondDecrease(ctx, validator, shares)
+}
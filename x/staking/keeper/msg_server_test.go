@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -45,6 +46,7 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		delegatorAddr, validatorAddr, 10,
 		ctx.BlockTime().Add(time.Minute*10),
 		unbondingAmount.Amount,
+		0,
 	)
 
 	// set and retrieve a record
@@ -144,3 +146,364 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+
+	authority := app.StakingKeeper.GetAuthority()
+	params := types.DefaultParams()
+	testCases := []struct {
+		name      string
+		input     func() *types.MsgUpdateParams
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "valid",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params,
+				}
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid authority",
+			input: func() *types.MsgUpdateParams {
+				return &types.MsgUpdateParams{
+					Authority: "authority",
+					Params:    params,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "zero max validators",
+			input: func() *types.MsgUpdateParams {
+				params1 := params
+				params1.MaxValidators = 0
+
+				return &types.MsgUpdateParams{
+					Authority: authority,
+					Params:    params1,
+				}
+			},
+			expErr:    true,
+			expErrMsg: "max validators",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.input()
+			_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Params, app.StakingKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	delegatorAddr, ownerAddr := delAddrs[0], delAddrs[1]
+
+	// create a validator with a one to one exchange rate
+	valAddr := sdk.ValAddress(delAddrs[2])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[0], sdk.NewInt(100), true)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	// tokenize the shares of a part of the delegation
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 400), ownerAddr))
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, ownerAddr.String(), record.Owner)
+	require.Equal(t, valAddr.String(), record.Validator)
+	require.Equal(t, sdk.NewInt64Coin(record.GetShareTokenDenom(), 400), res.Amount)
+	require.Equal(t, res.Amount, app.BankKeeper.GetBalance(ctx, delegatorAddr, res.Amount.Denom))
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr))
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(600), delegation.Shares)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(400), delegation.Shares)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(400), validator.LiquidShares)
+	require.Equal(t, sdk.NewInt(400), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	// share tokens of an unknown record cannot be redeemed
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(bondDenom, 100)))
+	require.Error(t, err)
+
+	// more share tokens than the balance cannot be redeemed
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(ownerAddr, sdk.NewInt64Coin(record.GetShareTokenDenom(), 100)))
+	require.ErrorIs(t, err, types.ErrNotEnoughBalance)
+
+	// redeem a part of the share tokens
+	redeemRes, err := msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(record.GetShareTokenDenom(), 150)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 150), redeemRes.Amount)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(750), delegation.Shares)
+	require.Equal(t, sdk.NewInt(250), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.NoError(t, err)
+
+	// redeeming the rest of the share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, sdk.NewInt64Coin(record.GetShareTokenDenom(), 250)))
+	require.NoError(t, err)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegatorAddr, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1000), delegation.Shares)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+
+	_, err = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.ErrorIs(t, err, types.ErrTokenizeShareRecordNotExists)
+	require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.LiquidShares.IsZero())
+}
+
+func TestRedeemTokensForSharesLiquidShares(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	delegatorAddr := delAddrs[0]
+
+	valAddr := sdk.ValAddress(delAddrs[1])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[0], sdk.NewInt(100), true)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	// lower the exchange rate so that the tokenized shares are not integers
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	validator.Tokens = sdk.NewInt(700)
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), delegatorAddr))
+	require.NoError(t, err)
+
+	record, err := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.NoError(t, err)
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.False(t, delegation.Shares.IsInteger())
+
+	// the liquid shares are the shares of the record delegation
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, delegation.Shares, validator.LiquidShares)
+
+	// redeeming more tokens than the total liquid staked tokens fails
+	totalLiquidStaked := app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, sdk.ZeroInt())
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, res.Amount))
+	require.ErrorIs(t, err, types.ErrNotEnoughBalance)
+	app.StakingKeeper.SetTotalLiquidStakedTokens(ctx, totalLiquidStaked)
+
+	// redeeming all the share tokens removes all the liquid shares
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegatorAddr, res.Amount))
+	require.NoError(t, err)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.LiquidShares.IsZero())
+}
+
+func TestTokenizeSharesMinSelfDelegation(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddr := sdk.ValAddress(delAddrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	msg := tstaking.CreateValidatorMsg(valAddr, PKs[0], sdk.NewInt(100))
+	msg.MinSelfDelegation = sdk.NewInt(60)
+	_, err := tstaking.CreateValidatorWithMsg(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the operator cannot tokenize its self-delegation below the minimum
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddrs[0], valAddr, sdk.NewInt64Coin(bondDenom, 50), delAddrs[0]))
+	require.ErrorIs(t, err, types.ErrSelfDelegationBelowMinimum)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddrs[0], valAddr, sdk.NewInt64Coin(bondDenom, 40), delAddrs[0]))
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.False(t, validator.Jailed)
+}
+
+func TestValidatorBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	delegatorAddr, bondAddr := delAddrs[0], delAddrs[1]
+
+	// create a validator with a one to one exchange rate
+	valAddr := sdk.ValAddress(delAddrs[2])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[0], sdk.NewInt(100), true)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+
+	// the validator has no validator bond
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), delegatorAddr))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	// only an existing delegation can be a validator bond
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(bondAddr, valAddr))
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 20)))
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(bondAddr, valAddr))
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(bondAddr, valAddr))
+	require.ErrorIs(t, err, types.ErrValidatorBondAlreadySet)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(20), validator.ValidatorBondShares)
+
+	// the validator bond cannot be tokenized
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 10), bondAddr))
+	require.ErrorIs(t, err, types.ErrValidatorBondNotTokenizable)
+
+	// the validator bond allows tokenizing up to 200 shares
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), delegatorAddr))
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 150), delegatorAddr))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	// the validator bond cannot go below the tokenized shares
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 15)))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 10)))
+	require.NoError(t, err)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), validator.ValidatorBondShares)
+	require.Equal(t, sdk.NewDec(100), validator.LiquidShares)
+}
+
+func TestUndelegateValidatorBondZeroFactor(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	delegatorAddr, bondAddr := delAddrs[0], delAddrs[1]
+
+	valAddr := sdk.ValAddress(delAddrs[2])
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidator(valAddr, PKs[0], sdk.NewInt(100), true)
+
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 1000)))
+	require.NoError(t, err)
+	_, err = msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 20)))
+	require.NoError(t, err)
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(bondAddr, valAddr))
+	require.NoError(t, err)
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewInt64Coin(bondDenom, 100), delegatorAddr))
+	require.NoError(t, err)
+
+	// a factor of zero allows no liquid shares, so the validator bond can't
+	// be decreased while the tokenized shares exist
+	params.ValidatorBondFactor = sdk.ZeroDec()
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(bondAddr, valAddr, sdk.NewInt64Coin(bondDenom, 10)))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBond)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(20), validator.ValidatorBondShares)
+}
+
+func TestTokenizeSharesGlobalLiquidStakingCap(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))
+	validators := app.StakingKeeper.GetValidators(ctx, 10)
+	require.Equal(t, len(validators), 1)
+
+	valAddr := validators[0].GetOperator()
+	delegatorAddr := delAddrs[0]
+
+	// the bonded tokens are 1 power of the genesis validator and 9 power of
+	// the delegation, so at most 2.5 power can be liquid staked
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegatorAddr, valAddr, sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 9))))
+	require.NoError(t, err)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 2)), delegatorAddr))
+	require.NoError(t, err)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegatorAddr, valAddr, sdk.NewCoin(bondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 1)), delegatorAddr))
+	require.ErrorIs(t, err, types.ErrLiquidStakingCapExceeded)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 2), app.StakingKeeper.GetTotalLiquidStakedTokens(ctx))
+}
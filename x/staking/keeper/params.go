@@ -10,35 +10,30 @@ import (
 )
 
 // UnbondingTime
-func (k Keeper) UnbondingTime(ctx sdk.Context) (res time.Duration) {
-	k.paramstore.Get(ctx, types.KeyUnbondingTime, &res)
-	return
+func (k Keeper) UnbondingTime(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).UnbondingTime
 }
 
 // MaxValidators - Maximum number of validators
-func (k Keeper) MaxValidators(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxValidators, &res)
-	return
+func (k Keeper) MaxValidators(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxValidators
 }
 
 // MaxEntries - Maximum number of simultaneous unbonding
 // delegations or redelegations (per pair/trio)
-func (k Keeper) MaxEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyMaxEntries, &res)
-	return
+func (k Keeper) MaxEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).MaxEntries
 }
 
 // HistoricalEntries = number of historical info entries
 // to persist in store
-func (k Keeper) HistoricalEntries(ctx sdk.Context) (res uint32) {
-	k.paramstore.Get(ctx, types.KeyHistoricalEntries, &res)
-	return
+func (k Keeper) HistoricalEntries(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).HistoricalEntries
 }
 
 // BondDenom - Bondable coin denomination
-func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
-	k.paramstore.Get(ctx, types.KeyBondDenom, &res)
-	return
+func (k Keeper) BondDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BondDenom
 }
 
 // PowerReduction - is the amount of staking tokens required for 1 unit of consensus-engine power.
@@ -50,24 +45,51 @@ func (k Keeper) PowerReduction(ctx sdk.Context) math.Int {
 }
 
 // MinCommissionRate - Minimum validator commission rate
-func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
-	k.paramstore.Get(ctx, types.KeyMinCommissionRate, &res)
-	return
+func (k Keeper) MinCommissionRate(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).MinCommissionRate
 }
 
-// Get all parameters as types.Params
-func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(
-		k.UnbondingTime(ctx),
-		k.MaxValidators(ctx),
-		k.MaxEntries(ctx),
-		k.HistoricalEntries(ctx),
-		k.BondDenom(ctx),
-		k.MinCommissionRate(ctx),
-	)
+// ValidatorBondFactor - Multiple of its validator bond shares that a validator
+// can have tokenized
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValidatorBondFactor
 }
 
-// set the params
-func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramstore.SetParamSet(ctx, &params)
+// GlobalLiquidStakingCap - Maximum ratio of the bonded tokens that can be
+// liquid staked
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).GlobalLiquidStakingCap
+}
+
+// PowerHistoryBlocks - Number of blocks the validator power records are kept for
+func (k Keeper) PowerHistoryBlocks(ctx sdk.Context) uint64 {
+	return k.GetParams(ctx).PowerHistoryBlocks
+}
+
+// SetParams sets the x/staking module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetParams gets the x/staking module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
 }
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"strconv"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetValidatorPowerRecord records the consensus power of a validator from the
+// current block height on, and prunes its records older than the
+// PowerHistoryBlocks param but the latest of them, which holds the power at the
+// start of that window. Nothing is recorded when the param is zero. An event is
+// emitted in any case so that the history can be indexed off-chain.
+func (k Keeper) SetValidatorPowerRecord(ctx sdk.Context, operator sdk.ValAddress, power int64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorPowerChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+		),
+	)
+
+	historyBlocks := k.PowerHistoryBlocks(ctx)
+	if historyBlocks == 0 {
+		return
+	}
+
+	k.setValidatorPowerRecord(ctx, operator, types.ValidatorPowerRecord{Height: ctx.BlockHeight(), Power: power})
+
+	if uint64(ctx.BlockHeight()) > historyBlocks {
+		k.pruneValidatorPowerHistory(ctx, operator, ctx.BlockHeight()-int64(historyBlocks))
+	}
+}
+
+// setValidatorPowerRecord stores a power record of a validator, keyed by its
+// height.
+func (k Keeper) setValidatorPowerRecord(ctx sdk.Context, operator sdk.ValAddress, record types.ValidatorPowerRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: record.Power})
+	store.Set(types.GetValidatorPowerHistoryKey(operator, record.Height), bz)
+}
+
+// pruneValidatorPowerHistory deletes the power records of a validator up to the
+// given block height, inclusive, except for the latest of them: it holds the
+// power of the validator at that height, until the next record.
+func (k Keeper) pruneValidatorPowerHistory(ctx sdk.Context, operator sdk.ValAddress, toHeight int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.Iterator(types.GetValidatorPowerHistoryPrefix(operator), types.GetValidatorPowerHistoryKey(operator, toHeight+1))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(keys) == 0 {
+		return
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
+
+// deleteValidatorPowerHistory deletes all the power records of a validator.
+func (k Keeper) deleteValidatorPowerHistory(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorPowerHistoryPrefix(operator))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetValidatorPowerHistory returns the power records of a validator between
+// the given block heights, both inclusive, ordered by height. No upper bound
+// is applied when toHeight is zero.
+func (k Keeper) GetValidatorPowerHistory(ctx sdk.Context, operator sdk.ValAddress, fromHeight, toHeight int64) (records []types.ValidatorPowerRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.GetValidatorPowerHistoryKey(operator, fromHeight)
+	end := sdk.PrefixEndBytes(types.GetValidatorPowerHistoryPrefix(operator))
+	if toHeight > 0 {
+		end = types.GetValidatorPowerHistoryKey(operator, toHeight+1)
+	}
+
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		records = append(records, k.unmarshalValidatorPowerRecord(iterator.Key(), iterator.Value()))
+	}
+
+	return records
+}
+
+// IterateValidatorPowerHistory iterates over the power records of all the
+// validators, ordered by validator and height.
+func (k Keeper) IterateValidatorPowerHistory(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, record types.ValidatorPowerRecord) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorPowerHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		operator, _ := types.SplitValidatorPowerHistoryKey(iterator.Key())
+		if handler(operator, k.unmarshalValidatorPowerRecord(iterator.Key(), iterator.Value())) {
+			break
+		}
+	}
+}
+
+// unmarshalValidatorPowerRecord decodes a power record from the height in its
+// key and the power in its value.
+func (k Keeper) unmarshalValidatorPowerRecord(key, value []byte) types.ValidatorPowerRecord {
+	var power gogotypes.Int64Value
+	k.cdc.MustUnmarshal(value, &power)
+
+	return types.ValidatorPowerRecord{
+		Height: int64(sdk.BigEndianToUint64(key[len(key)-8:])),
+		Power:  power.Value,
+	}
+}
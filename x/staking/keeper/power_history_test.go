@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestValidatorPowerHistory(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	addrVals := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	tstaking := teststaking.NewHelper(t, ctx.WithBlockHeight(1), app.StakingKeeper)
+
+	// the validator joins the bonded set
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	var powerEvents sdk.Events
+	for _, event := range tstaking.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidatorPowerChange {
+			powerEvents = append(powerEvents, event)
+		}
+	}
+	require.Equal(t, sdk.Events{sdk.NewEvent(
+		types.EventTypeValidatorPowerChange,
+		sdk.NewAttribute(types.AttributeKeyValidator, addrVals[0].String()),
+		sdk.NewAttribute(types.AttributeKeyPower, "10"),
+	)}, powerEvents)
+
+	// its power increases
+	tstaking.Ctx = tstaking.Ctx.WithBlockHeight(2)
+	tstaking.DelegateWithPower(addrDels[1], addrVals[0], 5)
+	tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	// nothing is recorded when the power does not change
+	tstaking.Ctx = tstaking.Ctx.WithBlockHeight(3)
+	tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	// the validator leaves the bonded set
+	tstaking.Ctx = tstaking.Ctx.WithBlockHeight(4)
+	app.StakingKeeper.Jail(tstaking.Ctx, sdk.ConsAddress(PKs[0].Address()))
+	tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	ctx = tstaking.Ctx
+	require.Equal(t, []types.ValidatorPowerRecord{
+		{Height: 1, Power: 10},
+		{Height: 2, Power: 15},
+		{Height: 4, Power: 0},
+	}, app.StakingKeeper.GetValidatorPowerHistory(ctx, addrVals[0], 0, 0))
+
+	require.Equal(t, []types.ValidatorPowerRecord{
+		{Height: 2, Power: 15},
+	}, app.StakingKeeper.GetValidatorPowerHistory(ctx, addrVals[0], 2, 3))
+
+	require.Empty(t, app.StakingKeeper.GetValidatorPowerHistory(ctx, addrVals[0], 3, 3))
+	require.Empty(t, app.StakingKeeper.GetValidatorPowerHistory(ctx, addrVals[1], 0, 0))
+}
+
+func TestValidatorPowerHistoryPruning(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+	valAddr := sdk.ValAddress(PKs[0].Address())
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.PowerHistoryBlocks = 10
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	// the records older than 10 blocks are pruned when a new one is recorded,
+	// but the latest of them, which holds the power 10 blocks ago
+	for _, record := range []types.ValidatorPowerRecord{{Height: 1, Power: 10}, {Height: 5, Power: 20}, {Height: 12, Power: 30}} {
+		app.StakingKeeper.SetValidatorPowerRecord(ctx.WithBlockHeight(record.Height), valAddr, record.Power)
+	}
+	require.Equal(t, []types.ValidatorPowerRecord{
+		{Height: 1, Power: 10},
+		{Height: 5, Power: 20},
+		{Height: 12, Power: 30},
+	}, app.StakingKeeper.GetValidatorPowerHistory(ctx, valAddr, 0, 0))
+
+	app.StakingKeeper.SetValidatorPowerRecord(ctx.WithBlockHeight(16), valAddr, 35)
+	require.Equal(t, []types.ValidatorPowerRecord{
+		{Height: 5, Power: 20},
+		{Height: 12, Power: 30},
+		{Height: 16, Power: 35},
+	}, app.StakingKeeper.GetValidatorPowerHistory(ctx, valAddr, 0, 0))
+
+	// nothing is recorded when the power history is disabled, the event is
+	// still emitted
+	params.PowerHistoryBlocks = 0
+	require.NoError(t, app.StakingKeeper.SetParams(ctx, params))
+
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	app.StakingKeeper.SetValidatorPowerRecord(ctx, valAddr, 40)
+	require.Len(t, app.StakingKeeper.GetValidatorPowerHistory(ctx, valAddr, 0, 0), 3)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeValidatorPowerChange, ctx.EventManager().Events()[0].Type)
+}
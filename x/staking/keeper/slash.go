@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"cosmossdk.io/math"
 
@@ -24,6 +26,40 @@ import (
 //    Infraction was committed at the current height or at a past height,
 //    not at a height in the future
 func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) math.Int {
+	return k.slash(ctx, consAddr, infractionHeight, power, slashFactor, nil)
+}
+
+// SlashWithDelegators slashes a validator like Slash and also returns the
+// tokens slashed from the unbonding delegations and redelegations of each
+// delegator of the validator, ordered by delegator address.
+//
+// NOTE: the tokens slashed from the bonded stake of the validator, which is
+// returned, are not attributed to its delegators as that would require
+// iterating over all the delegations. They can be attributed pro rata to the
+// delegator shares of the validator before the slash.
+func (k Keeper) SlashWithDelegators(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec) (math.Int, []types.DelegatorSlash) {
+	slashed := make(map[string]math.Int)
+	burned := k.slash(ctx, consAddr, infractionHeight, power, slashFactor, slashed)
+
+	delegatorSlashes := make([]types.DelegatorSlash, 0, len(slashed))
+	for delAddr, amount := range slashed {
+		if amount.IsPositive() {
+			delegatorSlashes = append(delegatorSlashes, types.DelegatorSlash{
+				DelegatorAddress: sdk.AccAddress(delAddr),
+				Amount:           amount,
+			})
+		}
+	}
+	sort.Slice(delegatorSlashes, func(i, j int) bool {
+		return bytes.Compare(delegatorSlashes[i].DelegatorAddress, delegatorSlashes[j].DelegatorAddress) < 0
+	})
+
+	return burned, delegatorSlashes
+}
+
+// slash slashes a validator, adding the tokens slashed from each delegator to
+// slashed, keyed by delegator address bytes, when it is not nil.
+func (k Keeper) slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, slashFactor sdk.Dec, slashed map[string]math.Int) math.Int {
 	logger := k.Logger(ctx)
 
 	if slashFactor.IsNegative() {
@@ -84,7 +120,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through unbonding delegations from slashed validator
 		unbondingDelegations := k.GetUnbondingDelegationsFromValidator(ctx, operatorAddress)
 		for _, unbondingDelegation := range unbondingDelegations {
-			amountSlashed := k.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			amountSlashed, burned := k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+			addDelegatorSlash(slashed, unbondingDelegation.DelegatorAddress, burned)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -95,7 +132,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		// Iterate through redelegations from slashed source validator
 		redelegations := k.GetRedelegationsFromSrcValidator(ctx, operatorAddress)
 		for _, redelegation := range redelegations {
-			amountSlashed := k.SlashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			amountSlashed, burned := k.slashRedelegation(ctx, validator, redelegation, infractionHeight, slashFactor)
+			addDelegatorSlash(slashed, redelegation.DelegatorAddress, burned)
 			if amountSlashed.IsZero() {
 				continue
 			}
@@ -119,6 +157,16 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// The tokens of the tokenized shares of the validator are slashed along with
+	// the validator, remove them from the total liquid staked tokens.
+	if validator.LiquidShares.IsPositive() && validator.DelegatorShares.IsPositive() {
+		liquidTokensToBurn := sdk.NewDecFromInt(tokensToBurn).Mul(validator.LiquidShares).Quo(validator.DelegatorShares).TruncateInt()
+		liquidTokensToBurn = sdk.MinInt(liquidTokensToBurn, k.GetTotalLiquidStakedTokens(ctx))
+		if err := k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokensToBurn); err != nil {
+			panic(err)
+		}
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -169,9 +217,18 @@ func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
 	infractionHeight int64, slashFactor sdk.Dec,
 ) (totalSlashAmount math.Int) {
+	totalSlashAmount, _ = k.slashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor)
+	return totalSlashAmount
+}
+
+// slashUnbondingDelegation slashes an unbonding delegation like
+// SlashUnbondingDelegation and also returns the amount actually burned.
+func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation types.UnbondingDelegation,
+	infractionHeight int64, slashFactor sdk.Dec,
+) (totalSlashAmount, burnedAmount math.Int) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = sdk.ZeroInt()
-	burnedAmount := sdk.ZeroInt()
+	burnedAmount = sdk.ZeroInt()
 
 	// perform slashing on all entries within the unbonding delegation
 	for i, entry := range unbondingDelegation.Entries {
@@ -180,7 +237,8 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 			continue
 		}
 
-		if entry.IsMature(now) {
+		// an entry on hold stays slashable until it is released, even if mature
+		if entry.IsMature(now) && !entry.OnHold() {
 			// Unbonding delegation no longer eligible for slashing, skip it
 			continue
 		}
@@ -211,7 +269,7 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, burnedAmount
 }
 
 // slash a redelegation and update the pool
@@ -223,6 +281,15 @@ func (k Keeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
 	infractionHeight int64, slashFactor sdk.Dec,
 ) (totalSlashAmount math.Int) {
+	totalSlashAmount, _ = k.slashRedelegation(ctx, srcValidator, redelegation, infractionHeight, slashFactor)
+	return totalSlashAmount
+}
+
+// slashRedelegation slashes a redelegation like SlashRedelegation and also
+// returns the amount actually burned.
+func (k Keeper) slashRedelegation(ctx sdk.Context, srcValidator types.Validator, redelegation types.Redelegation,
+	infractionHeight int64, slashFactor sdk.Dec,
+) (totalSlashAmount, burnedAmount math.Int) {
 	now := ctx.BlockHeader().Time
 	totalSlashAmount = sdk.ZeroInt()
 	bondedBurnedAmount, notBondedBurnedAmount := sdk.ZeroInt(), sdk.ZeroInt()
@@ -234,7 +301,8 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			continue
 		}
 
-		if entry.IsMature(now) {
+		// an entry on hold stays slashable until it is released, even if mature
+		if entry.IsMature(now) && !entry.OnHold() {
 			// Redelegation no longer eligible for slashing, skip it
 			continue
 		}
@@ -297,5 +365,19 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 		panic(err)
 	}
 
-	return totalSlashAmount
+	return totalSlashAmount, bondedBurnedAmount.Add(notBondedBurnedAmount)
+}
+
+// addDelegatorSlash adds amount to the tokens slashed from a delegator, given
+// by its bech32 address, when slashed is not nil.
+func addDelegatorSlash(slashed map[string]math.Int, delegatorAddress string, amount math.Int) {
+	if slashed == nil || !amount.IsPositive() {
+		return
+	}
+
+	delAddr := string(sdk.MustAccAddressFromBech32(delegatorAddress))
+	if total, ok := slashed[delAddr]; ok {
+		amount = total.Add(amount)
+	}
+	slashed[delAddr] = amount
 }
@@ -81,7 +81,7 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 	// set an unbonding delegation with expiration timestamp (beyond which the
 	// unbonding delegation shouldn't be slashed)
 	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
-		time.Unix(5, 0), sdk.NewInt(10))
+		time.Unix(5, 0), sdk.NewInt(10), 0)
 
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 
@@ -132,7 +132,7 @@ func TestSlashRedelegation(t *testing.T) {
 	// set a redelegation with an expiration timestamp beyond which the
 	// redelegation shouldn't be slashed
 	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
-		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10))
+		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10), 0)
 
 	app.StakingKeeper.SetRedelegation(ctx, rd)
 
@@ -183,6 +183,82 @@ func TestSlashRedelegation(t *testing.T) {
 	require.Equal(t, balances.Sub(burnedCoins...), app.BankKeeper.GetAllBalances(ctx, bondedPool.GetAddress()))
 }
 
+// tests that mature entries on hold are still slashed
+func TestSlashMatureEntriesOnHold(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	fraction := sdk.NewDecWithPrec(5, 1)
+
+	// add bonded tokens to pool for the redelegation
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	startCoins := sdk.NewCoins(sdk.NewInt64Coin(app.StakingKeeper.BondDenom(ctx), 15))
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, bondedPool.GetName(), startCoins))
+	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
+
+	// both entries are mature but held by an external module
+	ctx = ctx.WithBlockHeader(tmproto.Header{Time: time.Unix(10, 0)})
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 0,
+		time.Unix(5, 0), sdk.NewInt(10), 1)
+	ubd.Entries[0].UnbondingOnHoldRefCount = 1
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 0,
+		time.Unix(5, 0), sdk.NewInt(10), sdk.NewDec(10), 2)
+	rd.Entries[0].UnbondingOnHoldRefCount = 1
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[1], sdk.NewDec(10)))
+
+	slashAmount := app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
+	require.Equal(t, sdk.NewInt(5), slashAmount)
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(5), ubd.Entries[0].Balance)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, addrVals[0])
+	require.True(t, found)
+	slashAmount = app.StakingKeeper.SlashRedelegation(ctx, validator, rd, 0, fraction)
+	require.Equal(t, sdk.NewInt(5), slashAmount)
+	del, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[1])
+	require.True(t, found)
+	require.Equal(t, int64(5), del.Shares.RoundInt64())
+
+	// once released, the mature entries are no longer slashed
+	ubd.Entries[0].UnbondingOnHoldRefCount = 0
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+	slashAmount = app.StakingKeeper.SlashUnbondingDelegation(ctx, ubd, 0, fraction)
+	require.True(t, slashAmount.IsZero())
+}
+
+// tests the tokens slashed from each delegator by SlashWithDelegators
+func TestSlashWithDelegators(t *testing.T) {
+	app, ctx, addrDels, addrVals := bootstrapSlashTest(t, 10)
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 1)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	// the bonded stake of the validator is delegated by a single delegator
+	valTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[1], addrVals[0], sdk.NewDecFromInt(valTokens)))
+
+	// set a redelegation and the associated delegation
+	rdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11, time.Unix(0, 0), rdTokens, sdk.NewDecFromInt(rdTokens), 0)
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[1], sdk.NewDecFromInt(rdTokens)))
+
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, bondedPool.GetName(), sdk.NewCoins(sdk.NewCoin(bondDenom, rdTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, bondedPool)
+
+	// 5 tokens are slashed, 3 from the redelegation and 2 from the validator,
+	// only the former are attributed to a delegator
+	ctx = ctx.WithBlockHeight(12)
+	burned, delegatorSlashes := app.StakingKeeper.SlashWithDelegators(ctx, consAddr, 10, 10, fraction)
+	require.Equal(t, app.StakingKeeper.TokensFromConsensusPower(ctx, 2), burned)
+	require.Equal(t, []types.DelegatorSlash{
+		{DelegatorAddress: addrDels[0], Amount: app.StakingKeeper.TokensFromConsensusPower(ctx, 3)},
+	}, delegatorSlashes)
+}
+
 // tests Slash at a future height (must panic)
 func TestSlashAtFutureHeight(t *testing.T) {
 	app, ctx, _, _ := bootstrapSlashTest(t, 10)
@@ -265,7 +341,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 	// set an unbonding delegation with expiration timestamp beyond which the
 	// unbonding delegation shouldn't be slashed
 	ubdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
-	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0), ubdTokens)
+	ubd := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11, time.Unix(0, 0), ubdTokens, 0)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
 
 	// slash validator for the first time
@@ -390,7 +466,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 
 	// set a redelegation
 	rdTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
-	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11, time.Unix(0, 0), rdTokens, sdk.NewDecFromInt(rdTokens))
+	rd := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11, time.Unix(0, 0), rdTokens, sdk.NewDecFromInt(rdTokens), 0)
 	app.StakingKeeper.SetRedelegation(ctx, rd)
 
 	// set the associated delegation
@@ -542,7 +618,7 @@ func TestSlashBoth(t *testing.T) {
 	// set a redelegation with expiration timestamp beyond which the
 	// redelegation shouldn't be slashed
 	rdATokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 6)
-	rdA := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11, time.Unix(0, 0), rdATokens, sdk.NewDecFromInt(rdATokens))
+	rdA := types.NewRedelegation(addrDels[0], addrVals[0], addrVals[1], 11, time.Unix(0, 0), rdATokens, sdk.NewDecFromInt(rdATokens), 0)
 	app.StakingKeeper.SetRedelegation(ctx, rdA)
 
 	// set the associated delegation
@@ -553,7 +629,7 @@ func TestSlashBoth(t *testing.T) {
 	// unbonding delegation shouldn't be slashed)
 	ubdATokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
 	ubdA := types.NewUnbondingDelegation(addrDels[0], addrVals[0], 11,
-		time.Unix(0, 0), ubdATokens)
+		time.Unix(0, 0), ubdATokens, 0)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, ubdA)
 
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, rdATokens.MulRaw(2)))
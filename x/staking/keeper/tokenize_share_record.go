@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the ID of the last created tokenize
+// share record.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the ID of the last created tokenize share
+// record.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns the tokenize share record with the given ID.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (tokenizeShareRecord types.TokenizeShareRecord, err error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return tokenizeShareRecord, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "tokenize share record %d", id)
+	}
+
+	k.cdc.MustUnmarshal(bz, &tokenizeShareRecord)
+	return tokenizeShareRecord, nil
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the
+// share tokens with the given denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "share denom %s", denom)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordByAddress returns the tokenize share record whose
+// module account has the given address.
+func (k Keeper) GetTokenizeShareRecordByAddress(ctx sdk.Context, addr sdk.AccAddress) (types.TokenizeShareRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.GetTokenizeShareRecordIDByAddressKey(addr))
+	if bz == nil {
+		return types.TokenizeShareRecord{}, sdkerrors.Wrapf(types.ErrTokenizeShareRecordNotExists, "module account %s", addr)
+	}
+
+	return k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTokenizeShareRecordIDsByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := k.GetTokenizeShareRecord(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			panic(err)
+		}
+
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+	}
+
+	return tokenizeShareRecords
+}
+
+// IterateTokenizeShareRecords iterates through all the tokenize share records.
+func (k Keeper) IterateTokenizeShareRecords(ctx sdk.Context, cb func(record types.TokenizeShareRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.TokenizeShareRecordPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (tokenizeShareRecords []types.TokenizeShareRecord) {
+	k.IterateTokenizeShareRecords(ctx, func(record types.TokenizeShareRecord) bool {
+		tokenizeShareRecords = append(tokenizeShareRecords, record)
+		return false
+	})
+
+	return tokenizeShareRecords
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record along with its
+// owner, denom and module account address indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, tokenizeShareRecord types.TokenizeShareRecord) error {
+	if k.hasTokenizeShareRecord(ctx, tokenizeShareRecord.Id) {
+		return fmt.Errorf("tokenize share record %d already exists", tokenizeShareRecord.Id)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(tokenizeShareRecord.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	idBz := sdk.Uint64ToBigEndian(tokenizeShareRecord.Id)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(tokenizeShareRecord.Id), k.cdc.MustMarshal(&tokenizeShareRecord))
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, tokenizeShareRecord.Id), idBz)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(tokenizeShareRecord.GetShareTokenDenom()), idBz)
+	store.Set(types.GetTokenizeShareRecordIDByAddressKey(tokenizeShareRecord.GetModuleAddress()), idBz)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord removes a tokenize share record along with its
+// owner, denom and module account address indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, err := k.GetTokenizeShareRecord(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+	store.Delete(types.GetTokenizeShareRecordIDByAddressKey(record.GetModuleAddress()))
+
+	return nil
+}
+
+func (k Keeper) hasTokenizeShareRecord(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetTokenizeShareRecordByIndexKey(id))
+}
@@ -0,0 +1,302 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// unbondingHooks puts every unbonding operation on hold as soon as it is
+// initiated, the way a consumer chain provider would.
+type unbondingHooks struct {
+	types.MultiStakingHooks
+
+	k   *keeper.Keeper
+	ids []uint64
+}
+
+func (h *unbondingHooks) AfterUnbondingInitiated(ctx sdk.Context, id uint64) error {
+	h.ids = append(h.ids, id)
+	return h.k.PutUnbondingOnHold(ctx, id)
+}
+
+func setupUnbondingHooks(t *testing.T) (*simapp.SimApp, *unbondingHooks, *teststaking.Helper, []sdk.AccAddress, []sdk.ValAddress) {
+	_, app, ctx := createTestInput(t)
+
+	hooks := &unbondingHooks{k: app.StakingKeeper}
+	app.StakingKeeper.SetHooks(hooks)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	addrVals := simtestutil.ConvertAddrsToValAddrs(addrDels)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	return app, hooks, tstaking, addrDels, addrVals
+}
+
+func TestIncrementUnbondingID(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	for i := uint64(1); i <= 5; i++ {
+		require.Equal(t, i, app.StakingKeeper.IncrementUnbondingID(ctx))
+	}
+}
+
+func TestUnbondingDelegationOnHold(t *testing.T) {
+	app, hooks, tstaking, addrDels, addrVals := setupUnbondingHooks(t)
+	ctx, k := tstaking.Ctx, hooks.k
+
+	tstaking.CreateValidator(addrVals[0], PKs[0], sdk.NewInt(100), true)
+	tstaking.Delegate(addrDels[1], addrVals[0], sdk.NewInt(50))
+
+	completionTime, err := k.Undelegate(ctx, addrDels[1], addrVals[0], sdk.NewDec(20))
+	require.NoError(t, err)
+	_, err = k.Undelegate(ctx, addrDels[1], addrVals[0], sdk.NewDec(30))
+	require.NoError(t, err)
+	require.Len(t, hooks.ids, 2)
+
+	unbondingType, found := k.GetUnbondingType(ctx, hooks.ids[0])
+	require.True(t, found)
+	require.Equal(t, types.UnbondingType_UnbondingDelegation, unbondingType)
+
+	ubd, found := k.GetUnbondingDelegationByUnbondingID(ctx, hooks.ids[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 2)
+	for i, entry := range ubd.Entries {
+		require.Equal(t, hooks.ids[i], entry.UnbondingId)
+		require.True(t, entry.OnHold())
+	}
+
+	// allow the second entry to complete before it matures
+	require.NoError(t, k.UnbondingCanComplete(ctx, hooks.ids[1]))
+
+	// mature entries on hold are not completed
+	ctx = ctx.WithBlockTime(completionTime)
+	coins, err := k.CompleteUnbonding(ctx, addrDels[1], addrVals[0])
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)), coins)
+	balance := app.BankKeeper.GetBalance(ctx, addrDels[1], sdk.DefaultBondDenom)
+
+	ubd, found = k.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Equal(t, hooks.ids[0], ubd.Entries[0].UnbondingId)
+
+	_, found = k.GetUnbondingType(ctx, hooks.ids[1])
+	require.False(t, found)
+
+	// the mature entry completes as soon as it is no longer on hold
+	require.NoError(t, k.UnbondingCanComplete(ctx, hooks.ids[0]))
+
+	_, found = k.GetUnbondingDelegation(ctx, addrDels[1], addrVals[0])
+	require.False(t, found)
+	_, found = k.GetUnbondingType(ctx, hooks.ids[0])
+	require.False(t, found)
+	require.Equal(t,
+		balance.Amount.AddRaw(20),
+		app.BankKeeper.GetBalance(ctx, addrDels[1], sdk.DefaultBondDenom).Amount,
+	)
+
+	require.ErrorIs(t, k.UnbondingCanComplete(ctx, hooks.ids[0]), types.ErrUnbondingNotFound)
+	require.ErrorIs(t, k.PutUnbondingOnHold(ctx, hooks.ids[0]), types.ErrUnbondingNotFound)
+}
+
+func TestRedelegationOnHold(t *testing.T) {
+	_, hooks, tstaking, _, addrVals := setupUnbondingHooks(t)
+	k := hooks.k
+	delAddr := sdk.AccAddress(addrVals[0])
+
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(addrVals[1], PKs[1], 10, true)
+	ctx := tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	completionTime, err := k.BeginRedelegation(ctx, delAddr, addrVals[0], addrVals[1], sdk.NewDec(5))
+	require.NoError(t, err)
+	require.Len(t, hooks.ids, 1)
+	id := hooks.ids[0]
+
+	unbondingType, found := k.GetUnbondingType(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.UnbondingType_Redelegation, unbondingType)
+
+	red, found := k.GetRedelegationByUnbondingID(ctx, id)
+	require.True(t, found)
+	require.Len(t, red.Entries, 1)
+	require.True(t, red.Entries[0].OnHold())
+
+	// a mature redelegation on hold is not completed
+	ctx = ctx.WithBlockTime(completionTime)
+	_, err = k.CompleteRedelegation(ctx, delAddr, addrVals[0], addrVals[1])
+	require.NoError(t, err)
+	_, found = k.GetRedelegation(ctx, delAddr, addrVals[0], addrVals[1])
+	require.True(t, found)
+
+	require.NoError(t, k.UnbondingCanComplete(ctx, id))
+	_, found = k.GetRedelegation(ctx, delAddr, addrVals[0], addrVals[1])
+	require.False(t, found)
+	_, found = k.GetUnbondingType(ctx, id)
+	require.False(t, found)
+}
+
+func TestValidatorUnbondingOnHold(t *testing.T) {
+	_, hooks, tstaking, _, addrVals := setupUnbondingHooks(t)
+	k := hooks.k
+
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	ctx := tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+	tstaking.CheckValidator(addrVals[0], types.Bonded, false)
+
+	// jail the validator so that it begins unbonding
+	k.Jail(ctx, sdk.ConsAddress(PKs[0].Address()))
+	ctx = tstaking.TurnBlock(ctx.BlockTime())
+	validator := tstaking.CheckValidator(addrVals[0], types.Unbonding, true)
+	require.Len(t, hooks.ids, 1)
+	id := hooks.ids[0]
+	require.Equal(t, []uint64{id}, validator.UnbondingIds)
+	require.Equal(t, int64(1), validator.UnbondingOnHoldRefCount)
+
+	unbondingType, found := k.GetUnbondingType(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.UnbondingType_ValidatorUnbonding, unbondingType)
+
+	// the validator stays unbonding after the unbonding period while on hold
+	ctx = tstaking.TurnBlock(validator.UnbondingTime.Add(time.Second))
+	tstaking.CheckValidator(addrVals[0], types.Unbonding, true)
+
+	// the mature validator completes unbonding as soon as it is no longer on hold
+	require.NoError(t, k.UnbondingCanComplete(ctx, id))
+	validator = tstaking.CheckValidator(addrVals[0], types.Unbonded, true)
+	require.Empty(t, validator.UnbondingIds)
+	require.Empty(t, k.GetUnbondingValidators(ctx, validator.UnbondingTime, validator.UnbondingHeight))
+	_, found = k.GetUnbondingType(ctx, id)
+	require.False(t, found)
+
+	require.ErrorIs(t, k.UnbondingCanComplete(ctx, id), types.ErrUnbondingNotFound)
+}
+
+func TestValidatorUnbondingOnHoldSharedQueueKey(t *testing.T) {
+	_, hooks, tstaking, _, addrVals := setupUnbondingHooks(t)
+	k := hooks.k
+
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(addrVals[1], PKs[1], 10, true)
+	ctx := tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	// both validators begin unbonding in the same block, under the same queue key
+	k.Jail(ctx, sdk.ConsAddress(PKs[0].Address()))
+	k.Jail(ctx, sdk.ConsAddress(PKs[1].Address()))
+	ctx = tstaking.TurnBlock(ctx.BlockTime())
+	validator := tstaking.CheckValidator(addrVals[0], types.Unbonding, true)
+	require.Len(t, hooks.ids, 2)
+	require.Len(t, k.GetUnbondingValidators(ctx, validator.UnbondingTime, validator.UnbondingHeight), 2)
+
+	// release the hold of one of them before the end of the unbonding period
+	otherID := tstaking.CheckValidator(addrVals[1], types.Unbonding, true).UnbondingIds[0]
+	require.NoError(t, k.UnbondingCanComplete(ctx, otherID))
+
+	// only the validator which is not on hold completes, the other one is
+	// kept under the same queue key
+	ctx = tstaking.TurnBlock(validator.UnbondingTime)
+	tstaking.CheckValidator(addrVals[0], types.Unbonding, true)
+	tstaking.CheckValidator(addrVals[1], types.Unbonded, true)
+	require.Equal(t,
+		[]string{addrVals[0].String()},
+		k.GetUnbondingValidators(ctx, validator.UnbondingTime, validator.UnbondingHeight),
+	)
+
+	// releasing the hold completes the unbonding and empties the queue key
+	require.NoError(t, k.UnbondingCanComplete(ctx, validator.UnbondingIds[0]))
+	tstaking.CheckValidator(addrVals[0], types.Unbonded, true)
+	require.Empty(t, k.GetUnbondingValidators(ctx, validator.UnbondingTime, validator.UnbondingHeight))
+
+	// later blocks don't process the validators again
+	ctx = tstaking.TurnBlock(ctx.BlockTime().Add(time.Second))
+	tstaking.CheckValidator(addrVals[0], types.Unbonded, true)
+	tstaking.CheckValidator(addrVals[1], types.Unbonded, true)
+}
+
+func TestValidatorRebondedWhileOnHold(t *testing.T) {
+	_, hooks, tstaking, _, addrVals := setupUnbondingHooks(t)
+	k := hooks.k
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	ctx := tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	k.Jail(ctx, consAddr)
+	ctx = tstaking.TurnBlock(ctx.BlockTime())
+	validator := tstaking.CheckValidator(addrVals[0], types.Unbonding, true)
+	require.Len(t, hooks.ids, 1)
+	id := hooks.ids[0]
+
+	// bonding the validator again cancels its unbonding and the hold on it
+	k.Unjail(ctx, consAddr)
+	ctx = tstaking.TurnBlock(ctx.BlockTime())
+	validator = tstaking.CheckValidator(addrVals[0], types.Bonded, false)
+	require.Empty(t, validator.UnbondingIds)
+	require.Zero(t, validator.UnbondingOnHoldRefCount)
+	_, found := k.GetUnbondingType(ctx, id)
+	require.False(t, found)
+	require.ErrorIs(t, k.UnbondingCanComplete(ctx, id), types.ErrUnbondingNotFound)
+
+	// the next unbonding of the validator is tracked under a new id only
+	k.Jail(ctx, consAddr)
+	ctx = tstaking.TurnBlock(ctx.BlockTime())
+	validator = tstaking.CheckValidator(addrVals[0], types.Unbonding, true)
+	require.Len(t, hooks.ids, 2)
+	require.Equal(t, []uint64{hooks.ids[1]}, validator.UnbondingIds)
+	require.Equal(t, int64(1), validator.UnbondingOnHoldRefCount)
+
+	ctx = tstaking.TurnBlock(validator.UnbondingTime)
+	require.NoError(t, k.UnbondingCanComplete(ctx, hooks.ids[1]))
+	tstaking.CheckValidator(addrVals[0], types.Unbonded, true)
+}
+
+func TestUnbondingOnHoldEndBlocker(t *testing.T) {
+	app, hooks, tstaking, addrDels, addrVals := setupUnbondingHooks(t)
+	k := hooks.k
+
+	tstaking.CreateValidatorWithValPower(addrVals[0], PKs[0], 10, true)
+	tstaking.CreateValidatorWithValPower(addrVals[1], PKs[1], 10, true)
+	tstaking.Delegate(addrDels[2], addrVals[0], sdk.NewInt(100))
+	ctx := tstaking.TurnBlock(tstaking.Ctx.BlockTime())
+
+	completionTime, err := k.Undelegate(ctx, addrDels[2], addrVals[0], sdk.NewDec(40))
+	require.NoError(t, err)
+	_, err = k.BeginRedelegation(ctx, addrDels[2], addrVals[0], addrVals[1], sdk.NewDec(60))
+	require.NoError(t, err)
+	require.Len(t, hooks.ids, 2)
+	ubdID, redID := hooks.ids[0], hooks.ids[1]
+
+	// the end block dequeues the mature entries but leaves them in place while
+	// they are on hold
+	ctx = tstaking.TurnBlock(completionTime)
+	_, found := k.GetUnbondingDelegation(ctx, addrDels[2], addrVals[0])
+	require.True(t, found)
+	_, found = k.GetRedelegation(ctx, addrDels[2], addrVals[0], addrVals[1])
+	require.True(t, found)
+	require.Empty(t, k.DequeueAllMatureUBDQueue(ctx, ctx.BlockTime()))
+	require.Empty(t, k.DequeueAllMatureRedelegationQueue(ctx, ctx.BlockTime()))
+
+	// releasing the holds completes the entries
+	balance := app.BankKeeper.GetBalance(ctx, addrDels[2], sdk.DefaultBondDenom)
+	require.NoError(t, k.UnbondingCanComplete(ctx, ubdID))
+	require.NoError(t, k.UnbondingCanComplete(ctx, redID))
+
+	_, found = k.GetUnbondingDelegation(ctx, addrDels[2], addrVals[0])
+	require.False(t, found)
+	_, found = k.GetRedelegation(ctx, addrDels[2], addrVals[0], addrVals[1])
+	require.False(t, found)
+	require.Equal(t, balance.AddAmount(sdk.NewInt(40)), app.BankKeeper.GetBalance(ctx, addrDels[2], sdk.DefaultBondDenom))
+	for _, id := range []uint64{ubdID, redID} {
+		_, found = k.GetUnbondingType(ctx, id)
+		require.False(t, found)
+	}
+}
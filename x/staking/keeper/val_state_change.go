@@ -96,6 +96,7 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // ApplyAndReturnValidatorSetUpdates applies and return accumulated updates to the bonded validator set. Also,
 // * Updates the active valset as keyed by LastValidatorPowerKey.
 // * Updates the total power as keyed by LastTotalPowerKey.
+// * Records the power changes of the validators as keyed by ValidatorPowerHistoryKey.
 // * Updates validator status' according to updated powers.
 // * Updates the fee pool bonded vs not-bonded tokens.
 // * Updates relevant indices.
@@ -174,6 +175,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
+			k.SetValidatorPowerRecord(ctx, valAddr, newPower)
 		}
 
 		delete(last, valAddrStr)
@@ -195,6 +197,7 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
+		k.SetValidatorPowerRecord(ctx, validator.GetOperator(), 0)
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
@@ -236,6 +239,14 @@ func (k Keeper) unbondingToBonded(ctx sdk.Context, validator types.Validator) (t
 		panic(fmt.Sprintf("bad state transition unbondingToBonded, validator: %v\n", validator))
 	}
 
+	// the unbonding is cancelled, holds put on it by external modules no
+	// longer apply
+	for _, id := range validator.UnbondingIds {
+		k.DeleteUnbondingIndex(ctx, id)
+	}
+	validator.UnbondingIds = []uint64{}
+	validator.UnbondingOnHoldRefCount = 0
+
 	return k.bondValidator(ctx, validator)
 }
 
@@ -334,6 +345,16 @@ func (k Keeper) beginUnbondingValidator(ctx sdk.Context, validator types.Validat
 	}
 	k.AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator())
 
+	// track the unbonding so that external modules can put it on hold
+	id := k.IncrementUnbondingID(ctx)
+	validator.UnbondingIds = append(validator.UnbondingIds, id)
+	k.SetValidator(ctx, validator)
+	k.SetValidatorByUnbondingID(ctx, validator, id)
+
+	if err := k.AfterUnbondingInitiated(ctx, id); err != nil {
+		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	}
+
 	return validator, nil
 }
 
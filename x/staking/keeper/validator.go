@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -95,6 +96,18 @@ func (k Keeper) SetNewValidatorByPowerIndex(ctx sdk.Context, validator types.Val
 	store.Set(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)), validator.GetOperator())
 }
 
+// validator index
+func (k Keeper) SetValidatorByCommissionIndex(ctx sdk.Context, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorsByCommissionIndexKey(validator), validator.GetOperator())
+}
+
+// validator index
+func (k Keeper) DeleteValidatorByCommissionIndex(ctx sdk.Context, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorsByCommissionIndexKey(validator))
+}
+
 // Update the tokens of an existing validator, update the validators power index key
 func (k Keeper) AddValidatorTokensAndShares(ctx sdk.Context, validator types.Validator,
 	tokensToAdd math.Int,
@@ -143,8 +156,8 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, err
 	}
 
-	if newRate.LT(k.MinCommissionRate(ctx)) {
-		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+	if minRate := k.MinCommissionRate(ctx); newRate.LT(minRate) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", minRate)
 	}
 
 	commission.Rate = newRate
@@ -181,6 +194,8 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorsByCommissionIndexKey(validator))
+	k.deleteValidatorPowerHistory(ctx, address)
 
 	// call hooks
 	k.AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator())
@@ -442,6 +457,10 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 			addrs := types.ValAddresses{}
 			k.cdc.MustUnmarshal(unbondingValIterator.Value(), &addrs)
 
+			// validators whose unbonding is on hold stay in the queue until
+			// external modules allow the unbonding to complete
+			var onHoldAddrs []string
+
 			for _, valAddr := range addrs.Addresses {
 				addr, err := sdk.ValAddressFromBech32(valAddr)
 				if err != nil {
@@ -456,13 +475,33 @@ func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
 					panic("unexpected validator in unbonding queue; status was not unbonding")
 				}
 
-				val = k.UnbondingToUnbonded(ctx, val)
-				if val.GetDelegatorShares().IsZero() {
-					k.RemoveValidator(ctx, val.GetOperator())
+				if val.UnbondingOnHoldRefCount > 0 {
+					onHoldAddrs = append(onHoldAddrs, valAddr)
+					continue
 				}
+
+				k.unbondMatureValidator(ctx, val)
 			}
 
-			store.Delete(key)
+			if len(onHoldAddrs) == 0 {
+				store.Delete(key)
+			} else {
+				store.Set(key, k.cdc.MustMarshal(&types.ValAddresses{Addresses: onHoldAddrs}))
+			}
 		}
 	}
 }
+
+// unbondMatureValidator completes the unbonding of a mature validator which is
+// not on hold. The validator is removed if it has no delegator shares left.
+func (k Keeper) unbondMatureValidator(ctx sdk.Context, val types.Validator) {
+	for _, id := range val.UnbondingIds {
+		k.DeleteUnbondingIndex(ctx, id)
+	}
+	val.UnbondingIds = []uint64{}
+
+	val = k.UnbondingToUnbonded(ctx, val)
+	if val.GetDelegatorShares().IsZero() {
+		k.RemoveValidator(ctx, val.GetOperator())
+	}
+}
@@ -341,11 +341,13 @@ func TestValidatorBasics(t *testing.T) {
 		"attempting to remove a validator which still contains tokens",
 		func() { app.StakingKeeper.RemoveValidator(ctx, validators[1].GetOperator()) })
 
+	require.NotEmpty(t, app.StakingKeeper.GetValidatorPowerHistory(ctx, addrVals[1], 0, 0))
 	validators[1].Tokens = sdk.ZeroInt()                                // ...remove all tokens
 	app.StakingKeeper.SetValidator(ctx, validators[1])                  // ...set the validator
 	app.StakingKeeper.RemoveValidator(ctx, validators[1].GetOperator()) // Now it can be removed.
 	_, found = app.StakingKeeper.GetValidator(ctx, addrVals[1])
 	require.False(t, found)
+	require.Empty(t, app.StakingKeeper.GetValidatorPowerHistory(ctx, addrVals[1], 0, 0))
 }
 
 // test how the validators are sorted, tests GetBondedValidatorsByPower
@@ -1088,6 +1090,10 @@ func TestUpdateValidatorCommission(t *testing.T) {
 		{val2, sdk.NewDecWithPrec(2, 1), false},
 	}
 
+	// commission rates below the minimum are rejected with a dedicated error
+	_, err := app.StakingKeeper.UpdateValidatorCommission(ctx, val2, sdk.NewDecWithPrec(1, 2))
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
 	for i, tc := range testCases {
 		commission, err := app.StakingKeeper.UpdateValidatorCommission(ctx, tc.validator, tc.newRate)
 
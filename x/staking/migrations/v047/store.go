@@ -0,0 +1,48 @@
+package v047
+
+import (
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v0.46 to v0.47. The
+// migration includes:
+// - Migrate the x/staking parameters from the x/params module into the x/staking
+// module store.
+//
+// The liquid staking and power history parameters aren't part of the legacy
+// parameters, they are set by the consensus version 6 migration.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	var currParams types.Params
+	legacySubspace.GetParamSet(ctx, &currParams)
+
+	if err := validateLegacyParams(&currParams); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&currParams)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// validateLegacyParams validates the parameters of the legacy parameter set.
+func validateLegacyParams(params *types.Params) error {
+	for _, pair := range params.ParamSetPairs() {
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
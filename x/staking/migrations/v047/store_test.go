@@ -0,0 +1,58 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/exported"
+	v047 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type mockSubspace struct {
+	ps types.Params
+}
+
+func newMockSubspace(ps types.Params) mockSubspace {
+	return mockSubspace{ps: ps}
+}
+
+func (ms mockSubspace) GetParamSet(ctx sdk.Context, ps exported.ParamSet) {
+	*ps.(*types.Params) = ms.ps
+}
+
+func TestMigrate(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// the liquid staking and power history parameters are not part of the
+	// legacy parameters, they are left unset until the consensus version 6
+	// migration
+	legacyParams := types.DefaultParams()
+	legacyParams.ValidatorBondFactor = sdk.Dec{}
+	legacyParams.GlobalLiquidStakingCap = sdk.Dec{}
+	legacyParams.PowerHistoryBlocks = 0
+
+	legacySubspace := newMockSubspace(legacyParams)
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+
+	var res types.Params
+	bz := store.Get(types.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+
+	expected := legacyParams
+	expected.ValidatorBondFactor = sdk.ZeroDec()
+	expected.GlobalLiquidStakingCap = sdk.ZeroDec()
+	require.Equal(t, expected, res)
+
+	// the legacy parameters are validated
+	legacyParams.BondDenom = ""
+	require.Error(t, v047.MigrateStore(ctx, storeKey, newMockSubspace(legacyParams), cdc))
+}
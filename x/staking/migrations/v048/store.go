@@ -0,0 +1,75 @@
+package v048
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 4 to
+// 5. The migration includes:
+//
+// - Raise the commission rate (and max rate if needed) of the validators below
+// the MinCommissionRate param to it.
+// - Index the validators by commission rate.
+//
+// The migration does not change the MinCommissionRate param itself: chains
+// raising the minimum commission rate must set the param in their upgrade
+// handler before running the module migrations. Only that param is validated,
+// the liquid staking and power history params are set by the consensus version
+// 6 migration.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	if err := validateMinCommissionRate(&params); err != nil {
+		return err
+	}
+
+	migrateValidatorsMinCommission(ctx, store, cdc, params.MinCommissionRate)
+
+	return nil
+}
+
+// validateMinCommissionRate validates the MinCommissionRate param with the
+// validation function of its legacy parameter set pair.
+func validateMinCommissionRate(params *types.Params) error {
+	for _, pair := range params.ParamSetPairs() {
+		if bytes.Equal(pair.Key, types.KeyMinCommissionRate) {
+			return pair.ValidatorFn(params.MinCommissionRate)
+		}
+	}
+
+	return nil
+}
+
+// migrateValidatorsMinCommission raises the commission rate of all the
+// validators below minCommissionRate to it, raising their max rate as well
+// when needed, and indexes all the validators by commission rate.
+func migrateValidatorsMinCommission(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, minCommissionRate sdk.Dec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validator.Commission.Rate = minCommissionRate
+			if validator.Commission.MaxRate.LT(minCommissionRate) {
+				validator.Commission.MaxRate = minCommissionRate
+			}
+			validator.Commission.UpdateTime = ctx.BlockTime()
+
+			store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+		}
+
+		store.Set(types.GetValidatorsByCommissionIndexKey(validator), validator.GetOperator())
+	}
+}
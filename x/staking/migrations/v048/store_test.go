@@ -0,0 +1,95 @@
+package v048_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v048 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v048"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateMinCommission(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	store := ctx.KVStore(storeKey)
+
+	// the liquid staking and power history params are only set by the
+	// consensus version 6 migration
+	minRate := sdk.NewDecWithPrec(5, 2)
+	params := types.DefaultParams()
+	params.MinCommissionRate = minRate
+	params.ValidatorBondFactor = sdk.Dec{}
+	params.GlobalLiquidStakingCap = sdk.Dec{}
+	params.PowerHistoryBlocks = 0
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	commissions := []types.Commission{
+		types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.ZeroDec()),
+		types.NewCommission(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+		types.NewCommission(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+	}
+
+	validators := make([]types.Validator, len(commissions))
+	for i, commission := range commissions {
+		valAddr := sdk.ValAddress(fmt.Sprintf("val%d________________", i))
+		validators[i] = types.Validator{
+			OperatorAddress: valAddr.String(),
+			Status:          types.Bonded,
+			Tokens:          sdk.NewInt(100),
+			DelegatorShares: sdk.NewDec(100),
+			Commission:      commission,
+		}
+		store.Set(types.GetValidatorKey(valAddr), cdc.MustMarshal(&validators[i]))
+	}
+
+	require.NoError(t, v048.MigrateStore(ctx, storeKey, cdc))
+
+	// the params are left untouched
+	require.Equal(t, cdc.MustMarshal(&params), store.Get(types.ParamsKey))
+
+	expected := []types.Commission{
+		{
+			CommissionRates: types.NewCommissionRates(minRate, minRate, sdk.ZeroDec()),
+			UpdateTime:      ctx.BlockTime(),
+		},
+		{
+			CommissionRates: types.NewCommissionRates(minRate, sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+			UpdateTime:      ctx.BlockTime(),
+		},
+		commissions[2],
+	}
+	for i, validator := range validators {
+		var resValidator types.Validator
+		require.NoError(t, cdc.Unmarshal(store.Get(types.GetValidatorKey(validator.GetOperator())), &resValidator))
+		require.Equal(t, expected[i].Rate, resValidator.Commission.Rate, "validator %d", i)
+		require.Equal(t, expected[i].MaxRate, resValidator.Commission.MaxRate, "validator %d", i)
+		require.Equal(t, expected[i].MaxChangeRate, resValidator.Commission.MaxChangeRate, "validator %d", i)
+		require.True(t, expected[i].UpdateTime.Equal(resValidator.Commission.UpdateTime), "validator %d", i)
+
+		// the validators are indexed by their migrated commission rate
+		require.Equal(t, []byte(validator.GetOperator()), store.Get(types.GetValidatorsByCommissionIndexKey(resValidator)), "validator %d", i)
+	}
+}
+
+func TestMigrateInvalidMinCommission(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	params.MinCommissionRate = sdk.NewDecWithPrec(101, 2)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	require.Error(t, v048.MigrateStore(ctx, storeKey, cdc))
+}
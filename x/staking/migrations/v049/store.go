@@ -0,0 +1,56 @@
+package v049
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 5 to
+// 6. The migration includes:
+//
+// - Set the liquid staking and power history parameters to their default
+// values.
+// - Set the validator bond shares and liquid shares of the validators to zero.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if err := cdc.Unmarshal(store.Get(types.ParamsKey), &params); err != nil {
+		return err
+	}
+
+	params.ValidatorBondFactor = types.DefaultValidatorBondFactor
+	params.GlobalLiquidStakingCap = types.DefaultGlobalLiquidStakingCap
+	params.PowerHistoryBlocks = types.DefaultPowerHistoryBlocks
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	migrateValidatorsLiquidShares(store, cdc)
+
+	return nil
+}
+
+// migrateValidatorsLiquidShares sets the validator bond shares and liquid
+// shares of all the validators to zero.
+func migrateValidatorsLiquidShares(store sdk.KVStore, cdc codec.BinaryCodec) {
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		validator.ValidatorBondShares = sdk.ZeroDec()
+		validator.LiquidShares = sdk.ZeroDec()
+
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+}
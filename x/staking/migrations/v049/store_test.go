@@ -0,0 +1,97 @@
+package v049_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	v048 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v048"
+	v049 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v049"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrate(t *testing.T) {
+	cdc := simapp.MakeTestEncodingConfig().Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// the params don't have the liquid staking and power history params
+	// before the migration
+	params := types.DefaultParams()
+	params.ValidatorBondFactor = sdk.Dec{}
+	params.GlobalLiquidStakingCap = sdk.Dec{}
+	params.PowerHistoryBlocks = 0
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	// validators don't have validator bond and liquid shares before the migration
+	valAddr := sdk.ValAddress("val1________________")
+	validator := types.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          types.Bonded,
+		Tokens:          sdk.NewInt(100),
+		DelegatorShares: sdk.NewDec(100),
+	}
+	store.Set(types.GetValidatorKey(valAddr), cdc.MustMarshal(&validator))
+
+	require.NoError(t, v049.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, types.DefaultParams(), res)
+
+	var resValidator types.Validator
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetValidatorKey(valAddr)), &resValidator))
+	require.True(t, resValidator.ValidatorBondShares.IsZero())
+	require.True(t, resValidator.LiquidShares.IsZero())
+	require.Equal(t, validator.Tokens, resValidator.Tokens)
+}
+
+// TestMigrateFromLegacySubspace runs the migrations from consensus version 3,
+// with the params in the x/params subspace, to 6 in sequence.
+func TestMigrateFromLegacySubspace(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tStoreKey)
+	store := ctx.KVStore(storeKey)
+
+	legacyParams := types.DefaultParams()
+	legacyParams.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	legacySubspace := paramtypes.NewSubspace(cdc, encCfg.Amino, storeKey, tStoreKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+	legacySubspace.SetParamSet(ctx, &legacyParams)
+
+	valAddr := sdk.ValAddress("val1________________")
+	validator := types.Validator{
+		OperatorAddress: valAddr.String(),
+		Status:          types.Bonded,
+		Tokens:          sdk.NewInt(100),
+		DelegatorShares: sdk.NewDec(100),
+		Commission:      types.NewCommission(sdk.ZeroDec(), sdk.NewDecWithPrec(20, 2), sdk.NewDecWithPrec(1, 2)),
+	}
+	store.Set(types.GetValidatorKey(valAddr), cdc.MustMarshal(&validator))
+
+	require.NoError(t, v047.MigrateStore(ctx, storeKey, legacySubspace, cdc))
+	require.NoError(t, v048.MigrateStore(ctx, storeKey, cdc))
+	require.NoError(t, v049.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(types.ParamsKey), &res))
+	require.Equal(t, legacyParams, res)
+	require.NoError(t, res.Validate())
+
+	var resValidator types.Validator
+	require.NoError(t, cdc.Unmarshal(store.Get(types.GetValidatorKey(valAddr)), &resValidator))
+	require.Equal(t, legacyParams.MinCommissionRate, resValidator.Commission.Rate)
+	require.True(t, resValidator.ValidatorBondShares.IsZero())
+	require.True(t, resValidator.LiquidShares.IsZero())
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 
+	gogotypes "github.com/gogo/protobuf/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -57,6 +59,44 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &redB)
 
 			return fmt.Sprintf("%v\n%v", redA, redB)
+		case bytes.Equal(kvA.Key[:1], types.UnbondingIDKey),
+			bytes.Equal(kvA.Key[:1], types.UnbondingTypeKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.UnbondingIndexKey):
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], types.ValidatorPowerHistoryKey):
+			var powerA, powerB gogotypes.Int64Value
+
+			cdc.MustUnmarshal(kvA.Value, &powerA)
+			cdc.MustUnmarshal(kvB.Value, &powerB)
+
+			return fmt.Sprintf("%d\n%d", powerA.Value, powerB.Value)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordPrefix):
+			var recordA, recordB types.TokenizeShareRecord
+
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+		case bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByOwnerPrefix),
+			bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByDenomPrefix),
+			bytes.Equal(kvA.Key[:1], types.TokenizeShareRecordIDByAddressPrefix),
+			bytes.Equal(kvA.Key[:1], types.LastTokenizeShareRecordIDKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], types.TotalLiquidStakedTokensKey):
+			var tokensA, tokensB sdk.IntProto
+
+			cdc.MustUnmarshal(kvA.Value, &tokensA)
+			cdc.MustUnmarshal(kvB.Value, &tokensB)
+
+			return fmt.Sprintf("%v\n%v", tokensA, tokensB)
 		default:
 			panic(fmt.Sprintf("invalid staking key prefix %X", kvA.Key[:1]))
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -39,8 +40,10 @@ func TestDecodeStore(t *testing.T) {
 	val, err := types.NewValidator(valAddr1, delPk1, types.NewDescription("test", "test", "test", "test", "test"))
 	require.NoError(t, err)
 	del := types.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
-	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
-	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	ubd := types.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt(), 0)
+	red := types.NewRedelegation(delAddr1, valAddr1, valAddr1, 12, bondTime, sdk.OneInt(), sdk.OneDec(), 0)
+	params := types.DefaultParams()
+	record := types.NewTokenizeShareRecord(1, delAddr1, valAddr1)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -50,6 +53,14 @@ func TestDecodeStore(t *testing.T) {
 			{Key: types.GetDelegationKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&del)},
 			{Key: types.GetUBDKey(delAddr1, valAddr1), Value: cdc.MustMarshal(&ubd)},
 			{Key: types.GetREDKey(delAddr1, valAddr1, valAddr1), Value: cdc.MustMarshal(&red)},
+			{Key: types.UnbondingIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.GetUnbondingIndexKey(2), Value: types.GetUBDKey(delAddr1, valAddr1)},
+			{Key: types.GetUnbondingTypeKey(2), Value: sdk.Uint64ToBigEndian(uint64(types.UnbondingType_UnbondingDelegation))},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
+			{Key: types.GetValidatorPowerHistoryKey(valAddr1, 10), Value: cdc.MustMarshal(&gogotypes.Int64Value{Value: 5})},
+			{Key: types.GetTokenizeShareRecordByIndexKey(1), Value: cdc.MustMarshal(&record)},
+			{Key: types.LastTokenizeShareRecordIDKey, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.TotalLiquidStakedTokensKey, Value: cdc.MustMarshal(&sdk.IntProto{Int: sdk.OneInt()})},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -64,6 +75,14 @@ func TestDecodeStore(t *testing.T) {
 		{"Delegation", fmt.Sprintf("%v\n%v", del, del)},
 		{"UnbondingDelegation", fmt.Sprintf("%v\n%v", ubd, ubd)},
 		{"Redelegation", fmt.Sprintf("%v\n%v", red, red)},
+		{"UnbondingID", "2\n2"},
+		{"UnbondingIndex", fmt.Sprintf("%X\n%X", types.GetUBDKey(delAddr1, valAddr1), types.GetUBDKey(delAddr1, valAddr1))},
+		{"UnbondingType", "1\n1"},
+		{"Params", fmt.Sprintf("%v\n%v", params, params)},
+		{"ValidatorPowerHistory", "5\n5"},
+		{"TokenizeShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"LastTokenizeShareRecordID", "1\n1"},
+		{"TotalLiquidStakedTokens", fmt.Sprintf("%v\n%v", sdk.OneInt(), sdk.OneInt())},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -19,6 +19,9 @@ const (
 	unbondingTime     = "unbonding_time"
 	maxValidators     = "max_validators"
 	historicalEntries = "historical_entries"
+
+	validatorBondFactor    = "validator_bond_factor"
+	globalLiquidStakingCap = "global_liquid_staking_cap"
 )
 
 // genUnbondingTime returns randomized UnbondingTime
@@ -36,6 +39,21 @@ func getHistEntries(r *rand.Rand) uint32 {
 	return uint32(r.Intn(int(types.DefaultHistoricalEntries + 1)))
 }
 
+// genValidatorBondFactor returns a randomized ValidatorBondFactor, which is
+// disabled half of the time.
+func genValidatorBondFactor(r *rand.Rand) sdk.Dec {
+	if r.Intn(2) == 0 {
+		return sdk.NewDec(-1)
+	}
+
+	return sdk.NewDec(int64(simulation.RandIntBetween(r, 1, 500)))
+}
+
+// genGlobalLiquidStakingCap returns a randomized GlobalLiquidStakingCap between 1-100%.
+func genGlobalLiquidStakingCap(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 101)), 2)
+}
+
 // RandomizedGenState generates a random GenesisState for staking
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
@@ -44,6 +62,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		maxVals           uint32
 		histEntries       uint32
 		minCommissionRate sdk.Dec
+		valBondFactor     sdk.Dec
+		liquidStakingCap  sdk.Dec
 	)
 
 	simState.AppParams.GetOrGenerate(
@@ -61,10 +81,20 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { histEntries = getHistEntries(r) },
 	)
 
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, validatorBondFactor, &valBondFactor, simState.Rand,
+		func(r *rand.Rand) { valBondFactor = genValidatorBondFactor(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, globalLiquidStakingCap, &liquidStakingCap, simState.Rand,
+		func(r *rand.Rand) { liquidStakingCap = genGlobalLiquidStakingCap(r) },
+	)
+
 	// NOTE: the slashing module need to be defined after the staking module on the
 	// NewSimulationManager constructor for this to work
 	simState.UnbondTime = unbondTime
-	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate)
+	params := types.NewParams(simState.UnbondTime, maxVals, 7, histEntries, sdk.DefaultBondDenom, minCommissionRate, valBondFactor, liquidStakingCap, types.DefaultPowerHistoryBlocks)
 
 	// validators & delegations
 	var (
@@ -23,6 +23,9 @@ const (
 	OpWeightMsgUndelegate                = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate           = "op_weight_msg_begin_redelegate"
 	OpWeightMsgCancelUnbondingDelegation = "op_weight_msg_cancel_unbonding_delegation"
+	OpWeightMsgTokenizeShares            = "op_weight_msg_tokenize_shares"
+	OpWeightMsgRedeemTokensForShares     = "op_weight_msg_redeem_tokens_for_shares"
+	OpWeightMsgValidatorBond             = "op_weight_msg_validator_bond"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -37,6 +40,9 @@ func WeightedOperations(
 		weightMsgUndelegate                int
 		weightMsgBeginRedelegate           int
 		weightMsgCancelUnbondingDelegation int
+		weightMsgTokenizeShares            int
+		weightMsgRedeemTokensForShares     int
+		weightMsgValidatorBond             int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgCreateValidator, &weightMsgCreateValidator, nil,
@@ -75,6 +81,24 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgTokenizeShares, &weightMsgTokenizeShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgTokenizeShares = simappparams.DefaultWeightMsgTokenizeShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRedeemTokensForShares, &weightMsgRedeemTokensForShares, nil,
+		func(_ *rand.Rand) {
+			weightMsgRedeemTokensForShares = simappparams.DefaultWeightMsgRedeemTokensForShares
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgValidatorBond, &weightMsgValidatorBond, nil,
+		func(_ *rand.Rand) {
+			weightMsgValidatorBond = simappparams.DefaultWeightMsgValidatorBond
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgCreateValidator,
@@ -100,6 +124,18 @@ func WeightedOperations(
 			weightMsgCancelUnbondingDelegation,
 			SimulateMsgCancelUnbondingDelegate(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgTokenizeShares,
+			SimulateMsgTokenizeShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRedeemTokensForShares,
+			SimulateMsgRedeemTokensForShares(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgValidatorBond,
+			SimulateMsgValidatorBond(ak, bk, k),
+		),
 	}
 }
 
@@ -325,6 +361,10 @@ func SimulateMsgUndelegate(ak types.AccountKeeper, bk types.BankKeeper, k *keepe
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		if isTokenizeShareRecordAccount(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "delegation is owned by a tokenize share record"), nil, nil
+		}
+
 		if k.HasMaxUnbondingDelegationEntries(ctx, delAddr, valAddr) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUndelegate, "keeper does have a max unbonding delegation entries"), nil, nil
 		}
@@ -472,6 +512,10 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k *
 		delegation := delegations[r.Intn(len(delegations))]
 		delAddr := delegation.GetDelegatorAddr()
 
+		if isTokenizeShareRecordAccount(ctx, k, delAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "delegation is owned by a tokenize share record"), nil, nil
+		}
+
 		if k.HasReceivingRedelegation(ctx, delAddr, srcAddr) {
 			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBeginRedelegate, "receveing redelegation is not allowed"), nil, nil // skip
 		}
@@ -552,3 +596,250 @@ func SimulateMsgBeginRedelegate(ak types.AccountKeeper, bk types.BankKeeper, k *
 		return simulation.GenAndDeliverTxWithRandFees(txCtx)
 	}
 }
+
+// SimulateMsgTokenizeShares generates a MsgTokenizeShares with random values
+func SimulateMsgTokenizeShares(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator is not ok"), nil, nil
+		}
+
+		if validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator has invalid exchange rate"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if delegation.ValidatorBond {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator bond cannot be tokenized"), nil, nil
+		}
+
+		if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "receiving redelegation is not allowed"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "total bond is negative"), nil, nil
+		}
+
+		tokenizeAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "invalid tokenize amount"), nil, err
+		}
+
+		// check if the shares truncate to zero
+		shares, err := validator.SharesFromTokens(tokenizeAmt)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "invalid shares"), nil, err
+		}
+
+		if validator.TokensFromShares(shares).TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "shares truncate to zero"), nil, nil
+		}
+
+		if k.CheckExceedsGlobalLiquidStakingCap(ctx, tokenizeAmt) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "global liquid staking cap exceeded"), nil, nil
+		}
+
+		if k.CheckExceedsValidatorBondCap(ctx, validator, shares) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "validator bond cap exceeded"), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+
+		// the delegation can be owned by the module account of a tokenize share record
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgTokenizeShares, "account private key is nil"), nil, nil
+		}
+
+		// get random share owner
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgTokenizeShares(
+			delAddr, valAddr, sdk.NewCoin(k.BondDenom(ctx), tokenizeAmt), owner.Address,
+		)
+
+		spendable := bk.SpendableCoins(ctx, delAddr)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgRedeemTokensForShares generates a MsgRedeemTokensForShares with random values
+func SimulateMsgRedeemTokensForShares(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		records := k.GetAllTokenizeShareRecords(ctx)
+		if len(records) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "no tokenize share records"), nil, nil
+		}
+
+		// get random tokenize share record
+		record := records[r.Intn(len(records))]
+		denom := record.GetShareTokenDenom()
+
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "invalid validator address"), nil, err
+		}
+
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || validator.InvalidExRate() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "validator is not ok"), nil, nil
+		}
+
+		// get random account holding share tokens of the record
+		var (
+			simAccount simtypes.Account
+			balance    sdk.Coin
+		)
+
+		for _, i := range r.Perm(len(accs)) {
+			balance = bk.GetBalance(ctx, accs[i].Address, denom)
+			if balance.IsPositive() {
+				simAccount = accs[i]
+				break
+			}
+		}
+
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "no account holds share tokens"), nil, nil
+		}
+
+		redeemAmt, err := simtypes.RandPositiveInt(r, balance.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "invalid redeem amount"), nil, err
+		}
+
+		// check if the tokens truncate to zero
+		if validator.TokensFromShares(sdk.NewDecFromInt(redeemAmt)).TruncateInt().IsZero() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRedeemTokensForShares, "tokens truncate to zero"), nil, nil
+		}
+
+		msg := types.NewMsgRedeemTokensForShares(simAccount.Address, sdk.NewCoin(denom, redeemAmt))
+
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgValidatorBond generates a MsgValidatorBond with random values
+func SimulateMsgValidatorBond(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		// get random validator
+		validator, ok := keeper.RandomValidator(r, k, ctx)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgValidatorBond, "validator is not ok"), nil, nil
+		}
+
+		valAddr := validator.GetOperator()
+		delegations := k.GetValidatorDelegations(ctx, valAddr)
+		if delegations == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgValidatorBond, "keeper does have any delegation entries"), nil, nil
+		}
+
+		// get random delegator from validator
+		delegation := delegations[r.Intn(len(delegations))]
+		delAddr := delegation.GetDelegatorAddr()
+
+		if delegation.ValidatorBond {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgValidatorBond, "delegation is already a validator bond"), nil, nil
+		}
+
+		// need to retrieve the simulation account associated with delegation to retrieve PrivKey
+		var simAccount simtypes.Account
+
+		for _, simAcc := range accs {
+			if simAcc.Address.Equals(delAddr) {
+				simAccount = simAcc
+				break
+			}
+		}
+
+		// the delegation can be owned by the module account of a tokenize share record
+		if simAccount.PrivKey == nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgValidatorBond, "account private key is nil"), nil, nil
+		}
+
+		msg := types.NewMsgValidatorBond(delAddr, valAddr)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// isTokenizeShareRecordAccount returns true if the address is the module account
+// of a tokenize share record. Such accounts hold delegations but have no key.
+func isTokenizeShareRecordAccount(ctx sdk.Context, k *keeper.Keeper, addr sdk.AccAddress) bool {
+	_, err := k.GetTokenizeShareRecordByAddress(ctx, addr)
+	return err == nil
+}
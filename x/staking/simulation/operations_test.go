@@ -53,6 +53,9 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgUndelegate, types.ModuleName, types.TypeMsgUndelegate},
 		{simappparams.DefaultWeightMsgBeginRedelegate, types.ModuleName, types.TypeMsgBeginRedelegate},
 		{simappparams.DefaultWeightMsgCancelUnbondingDelegation, types.ModuleName, types.TypeMsgCancelUnbondingDelegation},
+		{simappparams.DefaultWeightMsgTokenizeShares, types.ModuleName, types.TypeMsgTokenizeShares},
+		{simappparams.DefaultWeightMsgRedeemTokensForShares, types.ModuleName, types.TypeMsgRedeemTokensForShares},
+		{simappparams.DefaultWeightMsgValidatorBond, types.ModuleName, types.TypeMsgValidatorBond},
 	}
 
 	for i, w := range weightesOps {
@@ -124,7 +127,7 @@ func TestSimulateMsgCancelUnbondingDelegation(t *testing.T) {
 	setupValidatorRewards(app, ctx, validator0.GetOperator())
 
 	// unbonding delegation
-	udb := types.NewUnbondingDelegation(delegator.Address, validator0.GetOperator(), app.LastBlockHeight(), blockTime.Add(2*time.Minute), delTokens)
+	udb := types.NewUnbondingDelegation(delegator.Address, validator0.GetOperator(), app.LastBlockHeight(), blockTime.Add(2*time.Minute), delTokens, 0)
 	app.StakingKeeper.SetUnbondingDelegation(ctx, udb)
 	setupValidatorRewards(app, ctx, validator0.GetOperator())
 
@@ -257,6 +260,48 @@ func TestSimulateMsgUndelegate(t *testing.T) {
 	require.Len(t, futureOperations, 0)
 }
 
+// TestSimulateMsgValidatorBond tests the normal scenario of a valid message of type TypeMsgValidatorBond.
+// Abonormal scenarios, where the message is created by an errors, are not tested here.
+func TestSimulateMsgValidatorBond(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	app, ctx, accounts := createTestApp(t, false, r, 3)
+
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// remove genesis validator account
+	accounts = accounts[1:]
+
+	// setup accounts[0] as validator
+	validator0 := getTestingValidator0(t, app, ctx, accounts)
+
+	// setup delegation
+	delTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 2)
+	validator0, issuedShares := validator0.AddTokensFromDel(delTokens)
+	app.StakingKeeper.SetValidator(ctx, validator0)
+	delegator := accounts[1]
+	delegation := types.NewDelegation(delegator.Address, validator0.GetOperator(), issuedShares)
+	app.StakingKeeper.SetDelegation(ctx, delegation)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgValidatorBond(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+	operationMsg, futureOperations, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg types.MsgValidatorBond
+	types.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, delegator.Address.String(), msg.DelegatorAddress)
+	require.Equal(t, types.TypeMsgValidatorBond, msg.Type())
+	require.Equal(t, validator0.OperatorAddress, msg.ValidatorAddress)
+	require.Len(t, futureOperations, 0)
+}
+
 // TestSimulateMsgBeginRedelegate tests the normal scenario of a valid message of type TypeMsgBeginRedelegate.
 // Abonormal scenarios, where the message is created by an errors, are not tested here.
 func TestSimulateMsgBeginRedelegate(t *testing.T) {
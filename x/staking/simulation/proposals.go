@@ -0,0 +1,41 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// OpWeightMsgUpdateParams app params key for the MsgUpdateParams proposal
+const OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+
+// ProposalMsgs defines the module weighted proposals' messages
+func ProposalMsgs(k *keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			simtestutil.DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams randomizing the validator
+// set and liquid staking params, the bond denom is kept.
+func SimulateMsgUpdateParams(k *keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) sdk.Msg {
+		params := k.GetParams(ctx)
+		params.UnbondingTime = genUnbondingTime(r)
+		params.MaxValidators = genMaxValidators(r)
+		params.HistoricalEntries = getHistEntries(r)
+		params.ValidatorBondFactor = genValidatorBondFactor(r)
+		params.GlobalLiquidStakingCap = genGlobalLiquidStakingCap(r)
+
+		return &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params}
+	}
+}
@@ -50,4 +50,13 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+	ErrTokenizeShareRecordNotExists    = sdkerrors.Register(ModuleName, 41, "tokenize share record not exists")
+	ErrValidatorBondNotTokenizable     = sdkerrors.Register(ModuleName, 42, "validator bond delegation cannot be tokenized")
+	ErrValidatorBondAlreadySet         = sdkerrors.Register(ModuleName, 43, "delegation is already a validator bond")
+	ErrInsufficientValidatorBond       = sdkerrors.Register(ModuleName, 44, "insufficient validator bond shares")
+	ErrLiquidStakingCapExceeded        = sdkerrors.Register(ModuleName, 45, "delegation or tokenization exceeds the global cap")
+	ErrNotEnoughBalance                = sdkerrors.Register(ModuleName, 46, "not enough balance")
+	ErrRedelegationInProgress          = sdkerrors.Register(ModuleName, 47, "delegator is not allowed to tokenize shares from validator with a redelegation in progress")
+	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 48, "unbonding operation not found")
+	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 49, "cannot un-hold unbonding operation that is not on hold")
 )
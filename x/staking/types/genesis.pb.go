@@ -44,6 +44,24 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share
+	// record.
+	//
+	// Since: cosmos-sdk 0.47
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// total_liquid_staked_tokens is the amount of tokens delegated through
+	// tokenize share records.
+	//
+	// Since: cosmos-sdk 0.47
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// power_history defines the power records of each validator at genesis.
+	//
+	// Since: cosmos-sdk 0.47
+	PowerHistory []ValidatorPowerHistory `protobuf:"bytes,12,rep,name=power_history,json=powerHistory,proto3" json:"power_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +146,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPowerHistory() []ValidatorPowerHistory {
+	if m != nil {
+		return m.PowerHistory
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -169,9 +208,68 @@ func (m *LastValidatorPower) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LastValidatorPower proto.InternalMessageInfo
 
+// ValidatorPowerHistory contains the power records of the corresponding
+// validator.
+//
+// Since: cosmos-sdk 0.47
+type ValidatorPowerHistory struct {
+	// address is the operator address of the validator.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// records are the power records of the validator ordered by height.
+	Records []ValidatorPowerRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+}
+
+func (m *ValidatorPowerHistory) Reset()         { *m = ValidatorPowerHistory{} }
+func (m *ValidatorPowerHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPowerHistory) ProtoMessage()    {}
+func (*ValidatorPowerHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9b3dec8894f2831b, []int{2}
+}
+func (m *ValidatorPowerHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPowerHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPowerHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPowerHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPowerHistory.Merge(m, src)
+}
+func (m *ValidatorPowerHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPowerHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPowerHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPowerHistory proto.InternalMessageInfo
+
+func (m *ValidatorPowerHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorPowerHistory) GetRecords() []ValidatorPowerRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.staking.v1beta1.GenesisState")
 	proto.RegisterType((*LastValidatorPower)(nil), "cosmos.staking.v1beta1.LastValidatorPower")
+	proto.RegisterType((*ValidatorPowerHistory)(nil), "cosmos.staking.v1beta1.ValidatorPowerHistory")
 }
 
 func init() {
@@ -179,38 +277,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 631 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0xc7, 0x9b, 0xfd, 0x6b, 0xe7, 0x76, 0x3f, 0xfd, 0x64, 0xba, 0x91, 0x55, 0x22, 0x2d, 0xd5,
+	0x84, 0x2a, 0xd8, 0x52, 0x6d, 0xdc, 0x10, 0x07, 0xa8, 0x10, 0x63, 0x68, 0x87, 0x29, 0x1d, 0x08,
+	0x71, 0x89, 0xdc, 0xd9, 0xa4, 0x56, 0xb3, 0x38, 0xd8, 0xee, 0xd8, 0x78, 0x05, 0x1c, 0xb9, 0x72,
+	0xdb, 0x8b, 0xe0, 0x45, 0xec, 0x38, 0x71, 0x42, 0x1c, 0x26, 0xb4, 0x5d, 0xe0, 0x5d, 0xa0, 0xd8,
+	0x4e, 0xe9, 0x48, 0x33, 0xd0, 0x4e, 0xad, 0xe3, 0xef, 0xf7, 0xf3, 0x3c, 0x71, 0xbe, 0x8f, 0xc1,
+	0xca, 0x1e, 0x13, 0xfb, 0x4c, 0xb4, 0x85, 0x44, 0x03, 0x1a, 0x05, 0xed, 0x83, 0xf5, 0x1e, 0x91,
+	0x68, 0xbd, 0x1d, 0x90, 0x88, 0x08, 0x2a, 0xdc, 0x98, 0x33, 0xc9, 0xe0, 0x92, 0x56, 0xb9, 0x46,
+	0xe5, 0x1a, 0x55, 0xad, 0x1a, 0xb0, 0x80, 0x29, 0x49, 0x3b, 0xf9, 0xa7, 0xd5, 0xb5, 0x3c, 0x66,
+	0xea, 0xd6, 0xaa, 0x65, 0xad, 0xf2, 0xb5, 0xdd, 0x14, 0x50, 0x8b, 0xe6, 0xcf, 0x22, 0xa8, 0x6c,
+	0xea, 0x06, 0xba, 0x12, 0x49, 0x02, 0x1f, 0x82, 0xb9, 0x18, 0x71, 0xb4, 0x2f, 0x6c, 0xab, 0x61,
+	0xb5, 0xca, 0x1b, 0x8e, 0x3b, 0xb9, 0x21, 0x77, 0x47, 0xa9, 0x3a, 0x33, 0x27, 0x67, 0xf5, 0x82,
+	0x67, 0x3c, 0xf0, 0x15, 0xf8, 0x3f, 0x44, 0x42, 0xfa, 0x92, 0x49, 0x14, 0xfa, 0x31, 0x7b, 0x47,
+	0xb8, 0x3d, 0xd5, 0xb0, 0x5a, 0x95, 0x8e, 0x9b, 0xe8, 0xbe, 0x9d, 0xd5, 0xef, 0x04, 0x54, 0xf6,
+	0x87, 0x3d, 0x77, 0x8f, 0xed, 0x9b, 0x4e, 0xcc, 0xcf, 0x9a, 0xc0, 0x83, 0xb6, 0x3c, 0x8a, 0x89,
+	0x70, 0xb7, 0x22, 0xe9, 0xfd, 0x97, 0x70, 0x76, 0x13, 0xcc, 0x4e, 0x42, 0x81, 0x18, 0x2c, 0x2a,
+	0xf2, 0x01, 0x0a, 0x29, 0x46, 0x92, 0x71, 0x4d, 0x17, 0xf6, 0x74, 0x63, 0xba, 0x55, 0xde, 0xb8,
+	0x9b, 0xd7, 0xe6, 0x36, 0x12, 0xf2, 0x65, 0xea, 0x51, 0x28, 0xd3, 0xf2, 0x8d, 0x30, 0xb3, 0x23,
+	0xe0, 0x26, 0x00, 0xa3, 0x02, 0xc2, 0x9e, 0x51, 0xe8, 0xdb, 0x79, 0xe8, 0x91, 0xd9, 0x10, 0xc7,
+	0xac, 0xf0, 0x39, 0x28, 0x63, 0x12, 0x92, 0x00, 0x49, 0xca, 0x22, 0x61, 0xcf, 0x2a, 0x52, 0x33,
+	0x8f, 0xf4, 0x64, 0x24, 0x35, 0xa8, 0x71, 0x33, 0x7c, 0x03, 0x16, 0x87, 0x51, 0x8f, 0x45, 0x98,
+	0x46, 0x81, 0x3f, 0x4e, 0x9d, 0x53, 0xd4, 0x7b, 0x79, 0xd4, 0x17, 0xa9, 0x29, 0x83, 0xaf, 0x0e,
+	0xb3, 0x5b, 0x02, 0xee, 0x80, 0x05, 0x4e, 0xc6, 0xf9, 0x45, 0xc5, 0x5f, 0xc9, 0xe3, 0x7b, 0x04,
+	0xff, 0x09, 0xbe, 0x0c, 0x80, 0x35, 0x50, 0x22, 0x87, 0x31, 0xe3, 0x92, 0x60, 0xbb, 0xd4, 0xb0,
+	0x5a, 0x25, 0x6f, 0xb4, 0x86, 0x01, 0x58, 0x92, 0x6c, 0x40, 0x22, 0xfa, 0x9e, 0xf8, 0xa2, 0x8f,
+	0x38, 0xf1, 0x39, 0xd9, 0x63, 0x1c, 0x0b, 0x7b, 0xfe, 0xea, 0xd7, 0xda, 0x35, 0xae, 0x6e, 0x62,
+	0xf2, 0x94, 0x27, 0x7d, 0x2d, 0x99, 0xdd, 0x12, 0xf0, 0x11, 0xb8, 0x65, 0x32, 0x39, 0xa1, 0x9a,
+	0x4f, 0xb1, 0x0d, 0x1a, 0x56, 0x6b, 0xc6, 0x5b, 0xd6, 0x81, 0xcb, 0x00, 0xb6, 0x30, 0x1c, 0x80,
+	0x9a, 0x0e, 0x74, 0x48, 0xdf, 0x0e, 0x29, 0xf6, 0x93, 0x8e, 0x08, 0xd6, 0x40, 0x61, 0x97, 0xaf,
+	0x95, 0xef, 0x9b, 0x8a, 0xb8, 0xad, 0x80, 0x5d, 0xc5, 0x53, 0xb5, 0x93, 0x11, 0x5a, 0x50, 0xc9,
+	0xf6, 0xfb, 0x54, 0x48, 0xc6, 0x8f, 0xec, 0x8a, 0x3a, 0x8e, 0xb5, 0xbf, 0xa6, 0x50, 0x45, 0xf8,
+	0x99, 0x36, 0x99, 0x03, 0xa9, 0xc4, 0x63, 0xcf, 0x9a, 0x7d, 0x00, 0xb3, 0xd3, 0x00, 0x37, 0x40,
+	0x11, 0x61, 0xcc, 0x89, 0xd0, 0x13, 0x3f, 0xdf, 0xb1, 0xbf, 0x7c, 0x5e, 0xab, 0x9a, 0x62, 0x8f,
+	0xf5, 0x4e, 0x57, 0x72, 0x1a, 0x05, 0x5e, 0x2a, 0x84, 0x55, 0x30, 0xfb, 0x7b, 0xb6, 0xa7, 0x3d,
+	0xbd, 0x78, 0x50, 0xfa, 0x70, 0x5c, 0x2f, 0xfc, 0x38, 0xae, 0x17, 0x9a, 0x9f, 0x2c, 0xb0, 0x38,
+	0xb1, 0xaf, 0x6b, 0x55, 0xdb, 0x06, 0xc5, 0x34, 0x1a, 0x53, 0xea, 0x2c, 0x56, 0xff, 0xed, 0x2c,
+	0x2e, 0x65, 0x23, 0x45, 0x74, 0x9e, 0x9e, 0x9c, 0x3b, 0xd6, 0xe9, 0xb9, 0x63, 0x7d, 0x3f, 0x77,
+	0xac, 0x8f, 0x17, 0x4e, 0xe1, 0xf4, 0xc2, 0x29, 0x7c, 0xbd, 0x70, 0x0a, 0xaf, 0x57, 0xaf, 0xfc,
+	0x74, 0x87, 0xa3, 0x4b, 0x56, 0x7d, 0xc4, 0xde, 0x9c, 0xba, 0x40, 0xef, 0xff, 0x1a, 0x00, 0x8f,
+	0x1e, 0x5f, 0x95, 0xd7, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +340,49 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PowerHistory) > 0 {
+		for iNdEx := len(m.PowerHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -371,6 +521,50 @@ func (m *LastValidatorPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPowerHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPowerHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPowerHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -425,6 +619,23 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PowerHistory) > 0 {
+		for _, e := range m.PowerHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -444,6 +655,25 @@ func (m *LastValidatorPower) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorPowerHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -735,6 +965,126 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerHistory = append(m.PowerHistory, ValidatorPowerHistory{})
+			if err := m.PowerHistory[len(m.PowerHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -857,6 +1207,122 @@ func (m *LastValidatorPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorPowerHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPowerHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPowerHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, ValidatorPowerRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
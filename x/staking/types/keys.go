@@ -34,9 +34,10 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
-	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
-	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
-	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorsKey                  = []byte{0x21} // prefix for each key to a validator
+	ValidatorsByConsAddrKey        = []byte{0x22} // prefix for each key to a validator index, by pubkey
+	ValidatorsByPowerIndexKey      = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorsByCommissionIndexKey = []byte{0x24} // prefix for each key to a validator index, sorted by commission rate
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -45,11 +46,26 @@ var (
 	RedelegationByValSrcIndexKey     = []byte{0x35} // prefix for each key for an redelegation, by source validator operator
 	RedelegationByValDstIndexKey     = []byte{0x36} // prefix for each key for an redelegation, by destination validator operator
 
+	UnbondingIDKey    = []byte{0x37} // key for the counter for the incrementing id for UnbondingOperations
+	UnbondingIndexKey = []byte{0x38} // prefix for an index for looking up unbonding operations by their IDs
+	UnbondingTypeKey  = []byte{0x39} // prefix for an index containing the type of unbonding operations
+
 	UnbondingQueueKey    = []byte{0x41} // prefix for the timestamps in unbonding queue
 	RedelegationQueueKey = []byte{0x42} // prefix for the timestamps in redelegations queue
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	ValidatorPowerHistoryKey = []byte{0x52} // prefix for the consensus power changes of each validator
+
+	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	TokenizeShareRecordPrefix            = []byte{0x61} // key for tokenize share record with given ID
+	TokenizeShareRecordIDByOwnerPrefix   = []byte{0x62} // key for tokenize share record ID from owner
+	TokenizeShareRecordIDByDenomPrefix   = []byte{0x63} // key for tokenize share record ID from denom
+	LastTokenizeShareRecordIDKey         = []byte{0x64} // key for last tokenize share record ID
+	TotalLiquidStakedTokensKey           = []byte{0x65} // key for total liquid staked tokens
+	TokenizeShareRecordIDByAddressPrefix = []byte{0x66} // key for tokenize share record ID from module account address
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -118,6 +134,29 @@ func GetLastValidatorPowerKey(operator sdk.ValAddress) []byte {
 	return append(LastValidatorPowerKey, address.MustLengthPrefix(operator)...)
 }
 
+// GetValidatorsByCommissionIndexKey creates the validator by commission index.
+// Validators are ordered by commission rate, lowest first, and then by operator
+// address.
+// VALUE: validator operator address ([]byte)
+func GetValidatorsByCommissionIndexKey(validator Validator) []byte {
+	addr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	// commission rates are between zero and one, so their integer
+	// representation (with 18 decimals) fits in an uint64
+	rate := validator.Commission.Rate
+	if rate.IsNil() {
+		rate = sdk.ZeroDec()
+	}
+	rateBz := sdk.Uint64ToBigEndian(rate.BigInt().Uint64())
+
+	// key is of format prefix || ratebytes || addrLen (1byte) || addrBytes
+	key := append(append([]byte{}, ValidatorsByCommissionIndexKey...), rateBz...)
+	return append(key, address.MustLengthPrefix(addr)...)
+}
+
 // ParseValidatorPowerRankKey parses the validators operator address from power rank key
 func ParseValidatorPowerRankKey(key []byte) (operAddr []byte) {
 	powerBytesLen := 8
@@ -349,3 +388,67 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetValidatorPowerHistoryPrefix returns the key prefix of the power records
+// of a validator.
+func GetValidatorPowerHistoryPrefix(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorPowerHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorPowerHistoryKey returns the key of the power record of a
+// validator at a given height. Heights are big endian encoded so that the
+// records of a validator are ordered by height.
+// VALUE: gogotypes.Int64Value
+func GetValidatorPowerHistoryKey(operatorAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorPowerHistoryPrefix(operatorAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// SplitValidatorPowerHistoryKey splits a power record key into the operator
+// address of the validator and the height of the record.
+func SplitValidatorPowerHistoryKey(key []byte) (sdk.ValAddress, int64) {
+	// Remove prefix, then read the address length.
+	kv.AssertKeyAtLeastLength(key, 2)
+	addrLen := int(key[1])
+	kv.AssertKeyLength(key, 2+addrLen+8)
+
+	return sdk.ValAddress(key[2 : 2+addrLen]), int64(sdk.BigEndianToUint64(key[2+addrLen:]))
+}
+
+// GetUnbondingIndexKey returns a key for the index for looking up UnbondingDelegations by the UnbondingDelegationEntries they contain
+func GetUnbondingIndexKey(id uint64) []byte {
+	return append(UnbondingIndexKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetUnbondingTypeKey returns a key for an index containing the type of unbonding operations
+func GetUnbondingTypeKey(id uint64) []byte {
+	return append(UnbondingTypeKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDsByOwnerPrefix returns the key prefix of the
+// tokenize share record IDs of an owner.
+func GetTokenizeShareRecordIDsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the key of a tokenize share
+// record ID in the owner index.
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIDsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the key of a tokenize share
+// record ID in the denom index.
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetTokenizeShareRecordIDByAddressKey returns the key of a tokenize share
+// record ID in the module account address index.
+func GetTokenizeShareRecordIDByAddressKey(addr sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByAddressPrefix, address.MustLengthPrefix(addr)...)
+}
@@ -31,10 +31,23 @@ const (
 	// value by not adding the staking module to the application module manager's
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
+
+	// DefaultPowerHistoryBlocks keeps the validator power records for 100000
+	// blocks, about a week with 6 second blocks.
+	DefaultPowerHistoryBlocks uint64 = 100000
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = sdk.ZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultValidatorBondFactor is set to -1, which disables the validator bond
+	// requirement
+	DefaultValidatorBondFactor = sdk.NewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%, which doesn't cap liquid staking
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+)
 
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
@@ -53,18 +66,28 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string,
+	minCommissionRate, validatorBondFactor, globalLiquidStakingCap sdk.Dec, powerHistoryBlocks uint64,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:          unbondingTime,
+		MaxValidators:          maxValidators,
+		MaxEntries:             maxEntries,
+		HistoricalEntries:      historicalEntries,
+		BondDenom:              bondDenom,
+		MinCommissionRate:      minCommissionRate,
+		ValidatorBondFactor:    validatorBondFactor,
+		GlobalLiquidStakingCap: globalLiquidStakingCap,
+		PowerHistoryBlocks:     powerHistoryBlocks,
 	}
 }
 
 // Implements params.ParamSet
+//
+// NOTE: the liquid staking and power history parameters were added after the parameters moved
+// from the x/params module to the x/staking store, they aren't part of the
+// legacy parameter set.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyUnbondingTime, &p.UnbondingTime, validateUnbondingTime),
@@ -85,6 +108,9 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultPowerHistoryBlocks,
 	)
 }
 
@@ -136,6 +162,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateGlobalLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -232,3 +266,38 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("validator bond factor cannot be nil")
+	}
+	if v.IsNegative() && !v.Equal(sdk.NewDec(-1)) {
+		return fmt.Errorf("validator bond factor must be positive or -1: %s", v)
+	}
+
+	return nil
+}
+
+func validateGlobalLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("global liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("global liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("global liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
@@ -35,4 +35,25 @@ func Test_validateParams(t *testing.T) {
 
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate liquid staking params
+	params = types.DefaultParams()
+	params.ValidatorBondFactor = sdk.NewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFactor = sdk.NewDec(-2)
+	require.Error(t, params.Validate())
+
+	params.ValidatorBondFactor = sdk.Dec{}
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(-1, 2)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
 }
@@ -76,6 +76,42 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{0}
 }
 
+// UnbondingType is the type of an unbonding operation.
+type UnbondingType int32
+
+const (
+	// UNSPECIFIED defines an invalid unbonding type.
+	UnbondingType_Undefined UnbondingType = 0
+	// UNBONDING_DELEGATION defines the unbonding of a delegation.
+	UnbondingType_UnbondingDelegation UnbondingType = 1
+	// REDELEGATION defines a redelegation.
+	UnbondingType_Redelegation UnbondingType = 2
+	// VALIDATOR_UNBONDING defines the unbonding of a validator.
+	UnbondingType_ValidatorUnbonding UnbondingType = 3
+)
+
+var UnbondingType_name = map[int32]string{
+	0: "UNBONDING_TYPE_UNSPECIFIED",
+	1: "UNBONDING_TYPE_UNBONDING_DELEGATION",
+	2: "UNBONDING_TYPE_REDELEGATION",
+	3: "UNBONDING_TYPE_VALIDATOR_UNBONDING",
+}
+
+var UnbondingType_value = map[string]int32{
+	"UNBONDING_TYPE_UNSPECIFIED":          0,
+	"UNBONDING_TYPE_UNBONDING_DELEGATION": 1,
+	"UNBONDING_TYPE_REDELEGATION":         2,
+	"UNBONDING_TYPE_VALIDATOR_UNBONDING":  3,
+}
+
+func (x UnbondingType) String() string {
+	return proto.EnumName(UnbondingType_name, int32(x))
+}
+
+func (UnbondingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+
 // HistoricalInfo contains header and validator information for a given block.
 // It is stored as part of staking module's state, which persists the `n` most
 // recent HistoricalInfo
@@ -132,6 +168,67 @@ func (m *HistoricalInfo) GetValset() []Validator {
 	return nil
 }
 
+// ValidatorPowerRecord records the consensus power of a validator from a given
+// block height on. A record is only stored when the power of the validator
+// changes, the power at any height is thus the one of the latest record at or
+// before that height.
+//
+// Since: cosmos-sdk 0.47
+type ValidatorPowerRecord struct {
+	// height is the block height at which the power was applied.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// power is the consensus power of the validator, zero once it is no longer
+	// part of the bonded validator set.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *ValidatorPowerRecord) Reset()         { *m = ValidatorPowerRecord{} }
+func (m *ValidatorPowerRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPowerRecord) ProtoMessage()    {}
+func (*ValidatorPowerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+func (m *ValidatorPowerRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPowerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPowerRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPowerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPowerRecord.Merge(m, src)
+}
+func (m *ValidatorPowerRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPowerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPowerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPowerRecord proto.InternalMessageInfo
+
+func (m *ValidatorPowerRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ValidatorPowerRecord) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
 // CommissionRates defines the initial commission rates to be used for creating
 // a validator.
 type CommissionRates struct {
@@ -146,7 +243,7 @@ type CommissionRates struct {
 func (m *CommissionRates) Reset()      { *m = CommissionRates{} }
 func (*CommissionRates) ProtoMessage() {}
 func (*CommissionRates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
 }
 func (m *CommissionRates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,7 +283,7 @@ type Commission struct {
 func (m *Commission) Reset()      { *m = Commission{} }
 func (*Commission) ProtoMessage() {}
 func (*Commission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{2}
+	return fileDescriptor_64c30c6cf92913c9, []int{3}
 }
 func (m *Commission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,7 +336,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{3}
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,12 +433,28 @@ type Validator struct {
 	//
 	// Since: cosmos-sdk 0.46
 	MinSelfDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=min_self_delegation,json=minSelfDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_self_delegation"`
+	// validator_bond_shares is the number of shares self bonded from the validator.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorBondShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=validator_bond_shares,json=validatorBondShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_shares"`
+	// liquid_shares is the number of shares of the validator that were tokenized.
+	//
+	// Since: cosmos-sdk 0.47
+	LiquidShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=liquid_shares,json=liquidShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquid_shares"`
+	// unbonding_on_hold_ref_count is the number of times the validator unbonding has been put on hold.
+	//
+	// Since: cosmos-sdk 0.47
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,14,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
+	// unbonding_ids are the list of unbonding ids associated with the validator unbonding.
+	//
+	// Since: cosmos-sdk 0.47
+	UnbondingIds []uint64 `protobuf:"varint,15,rep,packed,name=unbonding_ids,json=unbondingIds,proto3" json:"unbonding_ids,omitempty"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -378,7 +491,7 @@ type ValAddresses struct {
 func (m *ValAddresses) Reset()      { *m = ValAddresses{} }
 func (*ValAddresses) ProtoMessage() {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -425,7 +538,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -463,7 +576,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +625,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +663,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,12 +709,17 @@ type Delegation struct {
 	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 	// shares define the delegation shares received.
 	Shares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares"`
+	// validator_bond is true if the delegation is a validator bond, which allows
+	// the validator to have its shares tokenized.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorBond bool `protobuf:"varint,4,opt,name=validator_bond,json=validatorBond,proto3" json:"validator_bond,omitempty"`
 }
 
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -644,7 +762,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,12 +801,20 @@ type UnbondingDelegationEntry struct {
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance"`
 	// balance defines the tokens to receive at completion.
 	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// Incrementing id that uniquely identifies this entry
+	//
+	// Since: cosmos-sdk 0.47
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// Strictly positive if this entry's unbonding has been stopped by external modules
+	//
+	// Since: cosmos-sdk 0.47
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 }
 
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,6 +857,20 @@ func (m *UnbondingDelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *UnbondingDelegationEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *UnbondingDelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+	if m != nil {
+		return m.UnbondingOnHoldRefCount
+	}
+	return 0
+}
+
 // RedelegationEntry defines a redelegation object with relevant metadata.
 type RedelegationEntry struct {
 	// creation_height  defines the height which the redelegation took place.
@@ -741,12 +881,20 @@ type RedelegationEntry struct {
 	InitialBalance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_balance,json=initialBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_balance"`
 	// shares_dst is the amount of destination-validator shares created by redelegation.
 	SharesDst github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=shares_dst,json=sharesDst,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"shares_dst"`
+	// Incrementing id that uniquely identifies this entry
+	//
+	// Since: cosmos-sdk 0.47
+	UnbondingId uint64 `protobuf:"varint,5,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// Strictly positive if this entry's unbonding has been stopped by external modules
+	//
+	// Since: cosmos-sdk 0.47
+	UnbondingOnHoldRefCount int64 `protobuf:"varint,6,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 }
 
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,6 +937,20 @@ func (m *RedelegationEntry) GetCompletionTime() time.Time {
 	return time.Time{}
 }
 
+func (m *RedelegationEntry) GetUnbondingId() uint64 {
+	if m != nil {
+		return m.UnbondingId
+	}
+	return 0
+}
+
+func (m *RedelegationEntry) GetUnbondingOnHoldRefCount() int64 {
+	if m != nil {
+		return m.UnbondingOnHoldRefCount
+	}
+	return 0
+}
+
 // Redelegation contains the list of a particular delegator's redelegating bonds
 // from a particular source validator to a particular destination validator.
 type Redelegation struct {
@@ -805,7 +967,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,12 +1010,29 @@ type Params struct {
 	BondDenom string `protobuf:"bytes,5,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// validator_bond_factor is the multiple of its validator bond shares that a
+	// validator can have tokenized. A negative value disables the requirement.
+	//
+	// Since: cosmos-sdk 0.47
+	ValidatorBondFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=validator_bond_factor,json=validatorBondFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_bond_factor" yaml:"validator_bond_factor"`
+	// global_liquid_staking_cap is the maximum ratio of the total bonded tokens
+	// that can be liquid staked.
+	//
+	// Since: cosmos-sdk 0.47
+	GlobalLiquidStakingCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_liquid_staking_cap" yaml:"global_liquid_staking_cap"`
+	// power_history_blocks is the number of blocks the consensus power records of
+	// the validators are kept for, the older records of a validator are pruned
+	// when a new one is recorded. Zero disables the records, the power changes
+	// are still emitted as events to be indexed off-chain.
+	//
+	// Since: cosmos-sdk 0.47
+	PowerHistoryBlocks uint64 `protobuf:"varint,9,opt,name=power_history_blocks,json=powerHistoryBlocks,proto3" json:"power_history_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,6 +1096,13 @@ func (m *Params) GetBondDenom() string {
 	return ""
 }
 
+func (m *Params) GetPowerHistoryBlocks() uint64 {
+	if m != nil {
+		return m.PowerHistoryBlocks
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -927,7 +1113,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1168,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1216,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1084,7 +1270,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,9 +1299,88 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// TokenizeShareRecord represents a tokenized delegation. The delegation is held
+// by the module account of the record and the share tokens of the record are
+// transferable bank coins.
+//
+// Since: cosmos-sdk 0.47
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the owner of the record.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the name of the module account holding the delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the operator address of the validator of the delegation.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.UnbondingType", UnbondingType_name, UnbondingType_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
+	proto.RegisterType((*ValidatorPowerRecord)(nil), "cosmos.staking.v1beta1.ValidatorPowerRecord")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
 	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
@@ -1135,6 +1400,7 @@ func init() {
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "cosmos.staking.v1beta1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "cosmos.staking.v1beta1.RedelegationResponse")
 	proto.RegisterType((*Pool)(nil), "cosmos.staking.v1beta1.Pool")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1142,112 +1408,140 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1669 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x0c, 0x45, 0x3d, 0x4a, 0xa2, 0x34, 0x56, 0x52, 0x9a, 0x68, 0x49, 0x96, 0x4d,
-	0x13, 0xa7, 0x88, 0xa9, 0x5a, 0x05, 0x02, 0x54, 0x28, 0x50, 0x98, 0x22, 0x53, 0xab, 0x4e, 0x5c,
-	0x86, 0x94, 0x55, 0xf4, 0x07, 0x5d, 0x0c, 0x77, 0x47, 0xd4, 0x54, 0xbb, 0xb3, 0xc4, 0xce, 0xd0,
-	0x15, 0x81, 0x16, 0x28, 0xd0, 0x4b, 0xea, 0x53, 0x8e, 0xb9, 0x18, 0x30, 0x90, 0x1e, 0x73, 0x0c,
-	0x7a, 0xe9, 0xa1, 0xd7, 0x34, 0x27, 0x23, 0xa7, 0xa6, 0x2d, 0xd4, 0xc2, 0xbe, 0x14, 0x3d, 0x15,
-	0xb9, 0xb7, 0x28, 0xe6, 0x67, 0x7f, 0x4c, 0x8a, 0x8a, 0x14, 0xa8, 0x40, 0x00, 0x5f, 0xec, 0x9d,
-	0x99, 0xf7, 0xbe, 0x79, 0xef, 0x7b, 0x3f, 0x7a, 0x43, 0x78, 0xd1, 0x09, 0xb8, 0x1f, 0xf0, 0x4d,
-	0x2e, 0xf0, 0x11, 0x65, 0xc3, 0xcd, 0x7b, 0x37, 0x06, 0x44, 0xe0, 0x1b, 0xd1, 0xba, 0x39, 0x0a,
-	0x03, 0x11, 0xa0, 0x17, 0xb4, 0x54, 0x33, 0xda, 0x35, 0x52, 0x95, 0x8d, 0x61, 0x30, 0x0c, 0x94,
-	0xc8, 0xa6, 0xfc, 0xd2, 0xd2, 0x95, 0xab, 0xc3, 0x20, 0x18, 0x7a, 0x64, 0x53, 0xad, 0x06, 0xe3,
-	0x83, 0x4d, 0xcc, 0x26, 0xe6, 0xa8, 0x3a, 0x7d, 0xe4, 0x8e, 0x43, 0x2c, 0x68, 0xc0, 0xcc, 0x79,
-	0x6d, 0xfa, 0x5c, 0x50, 0x9f, 0x70, 0x81, 0xfd, 0x51, 0x84, 0xad, 0x2d, 0xb1, 0xf5, 0xa5, 0xc6,
-	0x2c, 0x83, 0x6d, 0x5c, 0x19, 0x60, 0x4e, 0x62, 0x3f, 0x9c, 0x80, 0x46, 0xd8, 0x5f, 0x16, 0x84,
-	0xb9, 0x24, 0xf4, 0x29, 0x13, 0x9b, 0x62, 0x32, 0x22, 0x5c, 0xff, 0xab, 0x4f, 0x1b, 0xbf, 0xb5,
-	0x60, 0xf5, 0x16, 0xe5, 0x22, 0x08, 0xa9, 0x83, 0xbd, 0x5d, 0x76, 0x10, 0xa0, 0xd7, 0x20, 0x7f,
-	0x48, 0xb0, 0x4b, 0xc2, 0xb2, 0x55, 0xb7, 0xae, 0x15, 0xb7, 0xca, 0xcd, 0x04, 0xa1, 0xa9, 0x75,
-	0x6f, 0xa9, 0xf3, 0x56, 0xee, 0xc3, 0x93, 0x5a, 0xa6, 0x67, 0xa4, 0xd1, 0x77, 0x21, 0x7f, 0x0f,
-	0x7b, 0x9c, 0x88, 0x72, 0xb6, 0xbe, 0x70, 0xad, 0xb8, 0xf5, 0xd5, 0xe6, 0xe9, 0xf4, 0x35, 0xf7,
-	0xb1, 0x47, 0x5d, 0x2c, 0x82, 0x18, 0x40, 0xab, 0x35, 0xde, 0xcf, 0x42, 0x69, 0x27, 0xf0, 0x7d,
-	0xca, 0x39, 0x0d, 0x58, 0x0f, 0x0b, 0xc2, 0x51, 0x17, 0x72, 0x21, 0x16, 0x44, 0x99, 0xb2, 0xd4,
-	0xfa, 0x8e, 0x94, 0xff, 0xcb, 0x49, 0xed, 0xa5, 0x21, 0x15, 0x87, 0xe3, 0x41, 0xd3, 0x09, 0x7c,
-	0x43, 0x86, 0xf9, 0xef, 0x3a, 0x77, 0x8f, 0x8c, 0x7f, 0x6d, 0xe2, 0x7c, 0xfc, 0xc1, 0x75, 0x30,
-	0x36, 0xb4, 0x89, 0xd3, 0x53, 0x48, 0xe8, 0x87, 0x50, 0xf0, 0xf1, 0xb1, 0xad, 0x50, 0xb3, 0x97,
-	0x80, 0xba, 0xe8, 0xe3, 0x63, 0x69, 0x2b, 0x72, 0xa1, 0x24, 0x81, 0x9d, 0x43, 0xcc, 0x86, 0x44,
-	0xe3, 0x2f, 0x5c, 0x02, 0xfe, 0x8a, 0x8f, 0x8f, 0x77, 0x14, 0xa6, 0xbc, 0x65, 0xbb, 0xf0, 0xee,
-	0xc3, 0x5a, 0xe6, 0x9f, 0x0f, 0x6b, 0x56, 0xe3, 0x0f, 0x16, 0x40, 0x42, 0x17, 0xfa, 0x29, 0xac,
-	0x39, 0xf1, 0x4a, 0x5d, 0xcf, 0x4d, 0x00, 0x5f, 0x9e, 0x17, 0x88, 0x29, 0xb2, 0x5b, 0x05, 0x69,
-	0xe8, 0xa3, 0x93, 0x9a, 0xd5, 0x2b, 0x39, 0x53, 0x71, 0xe8, 0x40, 0x71, 0x3c, 0x72, 0xb1, 0x20,
-	0xb6, 0x4c, 0x4d, 0x45, 0x5c, 0x71, 0xab, 0xd2, 0xd4, 0x79, 0xdb, 0x8c, 0xf2, 0xb6, 0xb9, 0x17,
-	0xe5, 0xad, 0xc6, 0x7a, 0xe7, 0xef, 0x35, 0xab, 0x07, 0x5a, 0x51, 0x1e, 0xa5, 0xac, 0x7f, 0xdf,
-	0x82, 0x62, 0x9b, 0x70, 0x27, 0xa4, 0x23, 0x59, 0x08, 0xa8, 0x0c, 0x8b, 0x7e, 0xc0, 0xe8, 0x91,
-	0x49, 0xbb, 0xa5, 0x5e, 0xb4, 0x44, 0x15, 0x28, 0x50, 0x97, 0x30, 0x41, 0xc5, 0x44, 0x07, 0xac,
-	0x17, 0xaf, 0xa5, 0xd6, 0x2f, 0xc8, 0x80, 0xd3, 0x88, 0xeb, 0x5e, 0xb4, 0x44, 0xaf, 0xc0, 0x1a,
-	0x27, 0xce, 0x38, 0xa4, 0x62, 0x62, 0x3b, 0x01, 0x13, 0xd8, 0x11, 0xe5, 0x9c, 0x12, 0x29, 0x45,
-	0xfb, 0x3b, 0x7a, 0x5b, 0x82, 0xb8, 0x44, 0x60, 0xea, 0xf1, 0xf2, 0x73, 0x1a, 0xc4, 0x2c, 0x53,
-	0xe6, 0xfe, 0x29, 0x0f, 0x4b, 0x71, 0xde, 0xa2, 0x1d, 0x58, 0x0b, 0x46, 0x24, 0x94, 0xdf, 0x36,
-	0x76, 0xdd, 0x90, 0x70, 0x6e, 0x32, 0xb4, 0xfc, 0xf1, 0x07, 0xd7, 0x37, 0x0c, 0xdd, 0x37, 0xf5,
-	0x49, 0x5f, 0x84, 0x94, 0x0d, 0x7b, 0xa5, 0x48, 0xc3, 0x6c, 0xa3, 0x1f, 0xc9, 0x80, 0x31, 0x4e,
-	0x18, 0x1f, 0x73, 0x7b, 0x34, 0x1e, 0x1c, 0x91, 0x89, 0xe1, 0x75, 0x63, 0x86, 0xd7, 0x9b, 0x6c,
-	0xd2, 0x2a, 0x7f, 0x94, 0x40, 0x3b, 0xe1, 0x64, 0x24, 0x82, 0x66, 0x77, 0x3c, 0xb8, 0x4d, 0x26,
-	0x32, 0x5a, 0x06, 0xa7, 0xab, 0x60, 0xd0, 0x0b, 0x90, 0xff, 0x39, 0xa6, 0x1e, 0x71, 0x15, 0x2b,
-	0x85, 0x9e, 0x59, 0xa1, 0x6d, 0xc8, 0x73, 0x81, 0xc5, 0x98, 0x2b, 0x2a, 0x56, 0xb7, 0x1a, 0xf3,
-	0x32, 0xa3, 0x15, 0x30, 0xb7, 0xaf, 0x24, 0x7b, 0x46, 0x03, 0xed, 0x41, 0x5e, 0x04, 0x47, 0x84,
-	0x19, 0x92, 0x2e, 0x94, 0xd5, 0xbb, 0x4c, 0xa4, 0xb2, 0x7a, 0x97, 0x89, 0x9e, 0xc1, 0x42, 0x43,
-	0x58, 0x73, 0x89, 0x47, 0x86, 0x8a, 0x4a, 0x7e, 0x88, 0x43, 0xc2, 0xcb, 0xf9, 0x4b, 0xa8, 0x9a,
-	0x52, 0x8c, 0xda, 0x57, 0xa0, 0xe8, 0x36, 0x14, 0xdd, 0x24, 0xdd, 0xca, 0x8b, 0x8a, 0xe8, 0xaf,
-	0xcd, 0xf3, 0x3f, 0x95, 0x99, 0xa6, 0x49, 0xa5, 0xb5, 0x65, 0x72, 0x8d, 0xd9, 0x20, 0x60, 0x2e,
-	0x65, 0x43, 0xfb, 0x90, 0xd0, 0xe1, 0xa1, 0x28, 0x17, 0xea, 0xd6, 0xb5, 0x85, 0x5e, 0x29, 0xde,
-	0xbf, 0xa5, 0xb6, 0xd1, 0x6d, 0x58, 0x4d, 0x44, 0x55, 0xed, 0x2c, 0x5d, 0xa0, 0x76, 0x56, 0x62,
-	0x5d, 0x79, 0x8a, 0x6e, 0x01, 0x24, 0x85, 0x59, 0x06, 0x05, 0xd4, 0xf8, 0xec, 0xea, 0x36, 0x2e,
-	0xa4, 0x74, 0x91, 0x07, 0x57, 0x7c, 0xca, 0x6c, 0x4e, 0xbc, 0x03, 0xdb, 0x50, 0x25, 0x21, 0x8b,
-	0x97, 0x10, 0xda, 0x75, 0x9f, 0xb2, 0x3e, 0xf1, 0x0e, 0xda, 0x31, 0xec, 0xf6, 0xf2, 0xdb, 0x0f,
-	0x6b, 0x19, 0x53, 0x4b, 0x99, 0x46, 0x17, 0x96, 0xf7, 0xb1, 0x67, 0xca, 0x80, 0x70, 0xf4, 0x1a,
-	0x2c, 0xe1, 0x68, 0x51, 0xb6, 0xea, 0x0b, 0x67, 0x96, 0x51, 0x22, 0xaa, 0xab, 0xf3, 0xd7, 0x7f,
-	0xab, 0x5b, 0x8d, 0xdf, 0x59, 0x90, 0x6f, 0xef, 0x77, 0x31, 0x0d, 0x51, 0x07, 0xd6, 0x93, 0x84,
-	0x3a, 0x6f, 0x6d, 0x26, 0x39, 0x18, 0x15, 0x67, 0x07, 0xd6, 0xef, 0x45, 0xe5, 0x1e, 0xc3, 0x64,
-	0x3f, 0x0b, 0x26, 0x56, 0x31, 0xfb, 0x53, 0x8e, 0x77, 0x60, 0x51, 0x5b, 0xc9, 0xd1, 0x36, 0x3c,
-	0x37, 0x92, 0x1f, 0xca, 0xdf, 0xe2, 0x56, 0x75, 0x6e, 0x22, 0x2a, 0x79, 0x13, 0x40, 0xad, 0xd2,
-	0xf8, 0x8f, 0x05, 0xd0, 0xde, 0xdf, 0xdf, 0x0b, 0xe9, 0xc8, 0x23, 0xe2, 0xb2, 0x3c, 0x7e, 0x03,
-	0x9e, 0x4f, 0x3c, 0xe6, 0xa1, 0x73, 0x6e, 0xaf, 0xaf, 0xc4, 0x6a, 0xfd, 0xd0, 0x39, 0x15, 0xcd,
-	0xe5, 0x22, 0x46, 0x5b, 0x38, 0x37, 0x5a, 0x9b, 0x8b, 0xd3, 0x69, 0xec, 0x43, 0x31, 0x71, 0x9f,
-	0xa3, 0x36, 0x14, 0x84, 0xf9, 0x36, 0x6c, 0x36, 0xe6, 0xb3, 0x19, 0xa9, 0x19, 0x46, 0x63, 0xcd,
-	0xc6, 0x7f, 0x25, 0xa9, 0x71, 0xc6, 0x7e, 0xb1, 0xd2, 0x48, 0xf6, 0x5e, 0xd3, 0x1b, 0x2f, 0x63,
-	0xa2, 0x30, 0x58, 0x53, 0xac, 0xfe, 0x26, 0x0b, 0x57, 0xee, 0x46, 0xdd, 0xe6, 0x0b, 0xcb, 0x44,
-	0x17, 0x16, 0x09, 0x13, 0x21, 0x55, 0x54, 0xc8, 0x58, 0x7f, 0x73, 0x5e, 0xac, 0x4f, 0xf1, 0xa5,
-	0xc3, 0x44, 0x38, 0x31, 0x91, 0x8f, 0x60, 0xa6, 0x58, 0xf8, 0x6b, 0x16, 0xca, 0xf3, 0x34, 0xd1,
-	0xcb, 0x50, 0x72, 0x42, 0xa2, 0x36, 0xa2, 0xae, 0x6f, 0xa9, 0xae, 0xbf, 0x1a, 0x6d, 0x9b, 0xa6,
-	0xff, 0x26, 0xc8, 0x01, 0x4a, 0x26, 0x96, 0x14, 0xbd, 0xf0, 0xc4, 0xb4, 0x9a, 0x28, 0xab, 0xb6,
-	0x4f, 0xa0, 0x44, 0x19, 0x15, 0x14, 0x7b, 0xf6, 0x00, 0x7b, 0x98, 0x39, 0x9f, 0x67, 0xb2, 0x9c,
-	0x6d, 0xd4, 0xab, 0x06, 0xb4, 0xa5, 0x31, 0xd1, 0x3e, 0x2c, 0x46, 0xf0, 0xb9, 0x4b, 0x80, 0x8f,
-	0xc0, 0x52, 0x53, 0xd4, 0x27, 0x59, 0x58, 0xef, 0x11, 0xf7, 0xd9, 0xa2, 0xf5, 0x27, 0x00, 0xba,
-	0xe0, 0x64, 0x1f, 0xfc, 0x1c, 0xcc, 0xce, 0x16, 0xf0, 0x92, 0xc6, 0x6b, 0x73, 0x91, 0xe2, 0xf6,
-	0xa3, 0x2c, 0x2c, 0xa7, 0xb9, 0x7d, 0x06, 0xfe, 0x2e, 0xa0, 0xdd, 0xa4, 0x1b, 0xe4, 0x54, 0x37,
-	0x78, 0x65, 0x5e, 0x37, 0x98, 0xc9, 0xba, 0xb3, 0xdb, 0xc0, 0xa7, 0x59, 0xc8, 0x77, 0x71, 0x88,
-	0x7d, 0x8e, 0xbe, 0x3f, 0x33, 0xc0, 0xe9, 0x57, 0xd5, 0xd5, 0x99, 0x9c, 0x6b, 0x9b, 0x47, 0xbd,
-	0x4e, 0xb9, 0x77, 0x4f, 0x99, 0xdf, 0xbe, 0x0e, 0xab, 0xf2, 0x89, 0x18, 0xbb, 0xa2, 0x49, 0x5c,
-	0x51, 0x6f, 0xbc, 0xf8, 0x75, 0xc1, 0x51, 0x0d, 0x8a, 0x52, 0x2c, 0x69, 0x74, 0x52, 0x06, 0x7c,
-	0x7c, 0xdc, 0xd1, 0x3b, 0xe8, 0x3a, 0xa0, 0xc3, 0xf8, 0xd1, 0x6e, 0x27, 0x14, 0x48, 0xb9, 0xf5,
-	0xe4, 0x24, 0x12, 0xff, 0x0a, 0x80, 0xb4, 0xc2, 0x76, 0x09, 0x0b, 0x7c, 0xf3, 0xc6, 0x59, 0x92,
-	0x3b, 0x6d, 0xb9, 0x81, 0x7e, 0xa9, 0x67, 0xc1, 0xa9, 0xd7, 0xa3, 0x19, 0xc3, 0xdf, 0xb8, 0x58,
-	0xa6, 0x7e, 0x7a, 0x52, 0xab, 0x4c, 0xb0, 0xef, 0x6d, 0x37, 0x4e, 0x81, 0x6c, 0xa8, 0xd9, 0xf0,
-	0xe9, 0x57, 0x67, 0x2a, 0x83, 0xdf, 0xb3, 0x00, 0x25, 0x2d, 0xb7, 0x47, 0xf8, 0x48, 0x3e, 0x6b,
-	0xe4, 0xd0, 0x9b, 0x9a, 0x50, 0xad, 0xb3, 0x87, 0xde, 0x44, 0x3f, 0x1a, 0x7a, 0x53, 0x15, 0xf1,
-	0xed, 0xa4, 0xc1, 0x65, 0x4d, 0x0c, 0x0d, 0xcc, 0x00, 0x73, 0x92, 0x1a, 0x9c, 0x69, 0xa4, 0x3d,
-	0xd3, 0xc3, 0x32, 0x8d, 0x4f, 0x2c, 0xb8, 0x3a, 0x93, 0x4d, 0xb1, 0xb1, 0x3f, 0x03, 0x14, 0xa6,
-	0x0e, 0x55, 0x6c, 0x26, 0xc6, 0xe8, 0x0b, 0x27, 0xe7, 0x7a, 0x38, 0xd3, 0x2b, 0xff, 0x5f, 0x3d,
-	0x3a, 0xa7, 0x22, 0xf0, 0x47, 0x0b, 0x36, 0xd2, 0xc6, 0xc4, 0x6e, 0xdd, 0x81, 0xe5, 0xb4, 0x2d,
-	0xc6, 0xa1, 0x17, 0xcf, 0xe3, 0x90, 0xf1, 0xe5, 0x29, 0x7d, 0xf4, 0x56, 0x52, 0xb8, 0xfa, 0xc7,
-	0xa2, 0x1b, 0xe7, 0xe6, 0x26, 0xb2, 0x69, 0xba, 0x80, 0x73, 0xd1, 0x14, 0x93, 0xeb, 0x06, 0x81,
-	0x87, 0x7e, 0x05, 0xeb, 0x2c, 0x10, 0xb6, 0xcc, 0x72, 0xe2, 0xda, 0xe6, 0xe5, 0xaa, 0xbb, 0xdf,
-	0x5b, 0x17, 0xa3, 0xec, 0x5f, 0x27, 0xb5, 0x59, 0xa8, 0x29, 0x1e, 0x4b, 0x2c, 0x10, 0x2d, 0x75,
-	0xbe, 0xa7, 0xdf, 0xb5, 0x21, 0xac, 0x3c, 0x7d, 0xb5, 0xee, 0x96, 0x6f, 0x5e, 0xf8, 0xea, 0x95,
-	0xb3, 0xae, 0x5d, 0x1e, 0xa4, 0xee, 0xdc, 0x2e, 0xc8, 0x18, 0xfe, 0xfb, 0x61, 0xcd, 0xfa, 0xc6,
-	0xef, 0x2d, 0x80, 0xe4, 0x09, 0x8f, 0x5e, 0x85, 0x2f, 0xb5, 0x7e, 0x70, 0xa7, 0x6d, 0xf7, 0xf7,
-	0x6e, 0xee, 0xdd, 0xed, 0xdb, 0x77, 0xef, 0xf4, 0xbb, 0x9d, 0x9d, 0xdd, 0xd7, 0x77, 0x3b, 0xed,
-	0xb5, 0x4c, 0xa5, 0x74, 0xff, 0x41, 0xbd, 0x78, 0x97, 0xf1, 0x11, 0x71, 0xe8, 0x01, 0x25, 0x2e,
-	0x7a, 0x09, 0x36, 0x9e, 0x96, 0x96, 0xab, 0x4e, 0x7b, 0xcd, 0xaa, 0x2c, 0xdf, 0x7f, 0x50, 0x2f,
-	0xe8, 0xe9, 0x88, 0xb8, 0xe8, 0x1a, 0x3c, 0x3f, 0x2b, 0xb7, 0x7b, 0xe7, 0x7b, 0x6b, 0xd9, 0xca,
-	0xca, 0xfd, 0x07, 0xf5, 0xa5, 0x78, 0x8c, 0x42, 0x0d, 0x40, 0x69, 0x49, 0x83, 0xb7, 0x50, 0x81,
-	0xfb, 0x0f, 0xea, 0x79, 0x4d, 0x5b, 0x25, 0xf7, 0xf6, 0x7b, 0xd5, 0x4c, 0xeb, 0xf5, 0x0f, 0x1f,
-	0x57, 0xad, 0x47, 0x8f, 0xab, 0xd6, 0x3f, 0x1e, 0x57, 0xad, 0x77, 0x9e, 0x54, 0x33, 0x8f, 0x9e,
-	0x54, 0x33, 0x7f, 0x7e, 0x52, 0xcd, 0xfc, 0xf8, 0xd5, 0x33, 0x19, 0x3b, 0x8e, 0x7f, 0xc9, 0x55,
-	0xdc, 0x0d, 0xf2, 0xaa, 0x29, 0x7f, 0xeb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x0e, 0x8f,
-	0x94, 0xe8, 0x15, 0x00, 0x00,
+	// 2113 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
+	0xf9, 0xd7, 0x52, 0xb4, 0x24, 0x7e, 0x14, 0x45, 0x69, 0xac, 0xd8, 0x34, 0x9d, 0xbf, 0x48, 0xd3,
+	0x76, 0xe2, 0x04, 0x31, 0x15, 0xfb, 0x0f, 0x04, 0xa8, 0x1a, 0x20, 0x10, 0x45, 0x3a, 0x56, 0xad,
+	0xc8, 0xcc, 0x4a, 0x56, 0x91, 0xb6, 0xe8, 0x62, 0xb9, 0x3b, 0xa2, 0xa6, 0x5a, 0xee, 0xb0, 0x3b,
+	0x43, 0xdb, 0x2c, 0x5a, 0xa0, 0x8f, 0x4b, 0x2a, 0xa0, 0x40, 0x8e, 0xb9, 0x08, 0x30, 0x90, 0xf6,
+	0x96, 0x4b, 0x81, 0xa0, 0x40, 0x1f, 0x40, 0xaf, 0x41, 0x4e, 0x46, 0x4e, 0x6d, 0x51, 0xb8, 0x85,
+	0x7d, 0x29, 0x7a, 0x2a, 0x7a, 0x2f, 0x50, 0xcc, 0x63, 0x1f, 0x24, 0x25, 0x59, 0x2a, 0xd4, 0x22,
+	0x40, 0x2e, 0x36, 0xe7, 0x7b, 0xfc, 0xf6, 0x9b, 0xef, 0x35, 0xf3, 0x8d, 0xe0, 0x8a, 0x43, 0x59,
+	0x87, 0xb2, 0x45, 0xc6, 0xed, 0x5d, 0xe2, 0xb7, 0x17, 0xef, 0xdf, 0x68, 0x61, 0x6e, 0xdf, 0x08,
+	0xd7, 0xd5, 0x6e, 0x40, 0x39, 0x45, 0xe7, 0x94, 0x54, 0x35, 0xa4, 0x6a, 0xa9, 0xe2, 0x7c, 0x9b,
+	0xb6, 0xa9, 0x14, 0x59, 0x14, 0xbf, 0x94, 0x74, 0xf1, 0x42, 0x9b, 0xd2, 0xb6, 0x87, 0x17, 0xe5,
+	0xaa, 0xd5, 0xdb, 0x5e, 0xb4, 0xfd, 0xbe, 0x66, 0x2d, 0x0c, 0xb3, 0xdc, 0x5e, 0x60, 0x73, 0x42,
+	0x7d, 0xcd, 0x2f, 0x0d, 0xf3, 0x39, 0xe9, 0x60, 0xc6, 0xed, 0x4e, 0x37, 0xc4, 0x56, 0x96, 0x58,
+	0xea, 0xa3, 0xda, 0x2c, 0x8d, 0xad, 0xb7, 0xd2, 0xb2, 0x19, 0x8e, 0xf6, 0xe1, 0x50, 0x12, 0x62,
+	0xbf, 0xc8, 0xb1, 0xef, 0xe2, 0xa0, 0x43, 0x7c, 0xbe, 0xc8, 0xfb, 0x5d, 0xcc, 0xd4, 0xbf, 0x8a,
+	0x5b, 0xf9, 0xa9, 0x01, 0x33, 0xb7, 0x09, 0xe3, 0x34, 0x20, 0x8e, 0xed, 0xad, 0xfa, 0xdb, 0x14,
+	0xbd, 0x01, 0x13, 0x3b, 0xd8, 0x76, 0x71, 0x50, 0x30, 0xca, 0xc6, 0xb5, 0xec, 0xcd, 0x42, 0x35,
+	0x46, 0xa8, 0x2a, 0xdd, 0xdb, 0x92, 0x5f, 0x4b, 0x7f, 0xfa, 0xa4, 0x34, 0x66, 0x6a, 0x69, 0xf4,
+	0x16, 0x4c, 0xdc, 0xb7, 0x3d, 0x86, 0x79, 0x21, 0x55, 0x1e, 0xbf, 0x96, 0xbd, 0x79, 0xa9, 0x7a,
+	0xb0, 0xfb, 0xaa, 0x5b, 0xb6, 0x47, 0x5c, 0x9b, 0xd3, 0x08, 0x40, 0xa9, 0x55, 0xea, 0x30, 0x1f,
+	0xb1, 0x9a, 0xf4, 0x01, 0x0e, 0x4c, 0xec, 0xd0, 0xc0, 0x45, 0xe7, 0x84, 0x41, 0xa4, 0xbd, 0xc3,
+	0xa5, 0x41, 0xe3, 0xa6, 0x5e, 0xa1, 0x79, 0x38, 0xd3, 0x15, 0x62, 0x85, 0x94, 0x24, 0xab, 0x45,
+	0xe5, 0xe3, 0x14, 0xe4, 0x57, 0x68, 0xa7, 0x43, 0x18, 0x23, 0xd4, 0x37, 0x6d, 0x8e, 0x19, 0x6a,
+	0x42, 0x3a, 0xb0, 0x39, 0x96, 0xfa, 0x99, 0xda, 0x9b, 0xe2, 0xab, 0x7f, 0x7a, 0x52, 0x7a, 0xa9,
+	0x4d, 0xf8, 0x4e, 0xaf, 0x55, 0x75, 0x68, 0x47, 0xbb, 0x54, 0xff, 0x77, 0x9d, 0xb9, 0xbb, 0xda,
+	0x4b, 0x75, 0xec, 0x7c, 0xfe, 0xc9, 0x75, 0xd0, 0x3b, 0xa9, 0x63, 0xc7, 0x94, 0x48, 0xe8, 0xeb,
+	0x30, 0xd5, 0xb1, 0x1f, 0x5a, 0x12, 0x35, 0x75, 0x0a, 0xa8, 0x93, 0x1d, 0xfb, 0xa1, 0xb0, 0x15,
+	0xb9, 0x90, 0x17, 0xc0, 0xce, 0x8e, 0xed, 0xb7, 0xb1, 0xc2, 0x1f, 0x3f, 0x05, 0xfc, 0x5c, 0xc7,
+	0x7e, 0xb8, 0x22, 0x31, 0xc5, 0x57, 0x96, 0xa6, 0x3e, 0x7c, 0x54, 0x1a, 0xfb, 0xdb, 0xa3, 0x92,
+	0x51, 0xf9, 0xad, 0x01, 0x10, 0xbb, 0x0b, 0x7d, 0x0b, 0x66, 0x9d, 0x68, 0x25, 0x3f, 0xcf, 0x74,
+	0x1a, 0xbc, 0x7c, 0x58, 0x38, 0x87, 0x9c, 0x5d, 0x9b, 0x12, 0x86, 0x3e, 0x7e, 0x52, 0x32, 0xcc,
+	0xbc, 0x33, 0x14, 0x87, 0x06, 0x64, 0x7b, 0x5d, 0xd7, 0xe6, 0xd8, 0x12, 0x09, 0x2e, 0x1d, 0x97,
+	0xbd, 0x59, 0xac, 0xaa, 0xec, 0xaf, 0x86, 0xd9, 0x5f, 0xdd, 0x0c, 0xb3, 0x5f, 0x61, 0x7d, 0xf0,
+	0x97, 0x92, 0x61, 0x82, 0x52, 0x14, 0xac, 0x84, 0xf5, 0x1f, 0x1b, 0x90, 0xad, 0x63, 0xe6, 0x04,
+	0xa4, 0x2b, 0xca, 0x09, 0x15, 0x60, 0xb2, 0x43, 0x7d, 0xb2, 0xab, 0x93, 0x37, 0x63, 0x86, 0x4b,
+	0x54, 0x84, 0x29, 0xe2, 0x62, 0x9f, 0x13, 0xde, 0x57, 0x01, 0x33, 0xa3, 0xb5, 0xd0, 0x7a, 0x80,
+	0x5b, 0x8c, 0x84, 0xbe, 0x36, 0xc3, 0x25, 0x7a, 0x05, 0x66, 0x19, 0x76, 0x7a, 0x01, 0xe1, 0x7d,
+	0xcb, 0xa1, 0x3e, 0xb7, 0x1d, 0x5e, 0x48, 0x4b, 0x91, 0x7c, 0x48, 0x5f, 0x51, 0x64, 0x01, 0xe2,
+	0x62, 0x6e, 0x13, 0x8f, 0x15, 0xce, 0x28, 0x10, 0xbd, 0x4c, 0x98, 0xfb, 0xa3, 0x0c, 0x64, 0xa2,
+	0x14, 0x47, 0x2b, 0x30, 0x4b, 0xbb, 0x38, 0x10, 0xbf, 0x2d, 0xdb, 0x75, 0x03, 0xcc, 0x98, 0xce,
+	0xd0, 0xc2, 0xe7, 0x9f, 0x5c, 0x9f, 0xd7, 0xee, 0x5e, 0x56, 0x9c, 0x0d, 0x1e, 0x10, 0xbf, 0x6d,
+	0xe6, 0x43, 0x0d, 0x4d, 0x46, 0xef, 0x89, 0x80, 0xf9, 0x0c, 0xfb, 0xac, 0xc7, 0xac, 0x6e, 0xaf,
+	0xb5, 0x8b, 0xfb, 0xda, 0xaf, 0xf3, 0x23, 0x7e, 0x5d, 0xf6, 0xfb, 0xb5, 0xc2, 0x67, 0x31, 0xb4,
+	0x13, 0xf4, 0xbb, 0x9c, 0x56, 0x9b, 0xbd, 0xd6, 0x1d, 0xdc, 0x37, 0xf3, 0x11, 0x4e, 0x53, 0xc2,
+	0x88, 0xba, 0xfb, 0x8e, 0x4d, 0x3c, 0xec, 0x4a, 0xaf, 0x4c, 0x99, 0x7a, 0x85, 0x96, 0x60, 0x82,
+	0x71, 0x9b, 0xf7, 0x98, 0x74, 0xc5, 0xcc, 0xcd, 0xca, 0x61, 0x99, 0x51, 0xa3, 0xbe, 0xbb, 0x21,
+	0x25, 0x4d, 0xad, 0x81, 0x36, 0x61, 0x82, 0xd3, 0x5d, 0xec, 0x6b, 0x27, 0x9d, 0x28, 0xab, 0x57,
+	0x7d, 0x9e, 0xc8, 0xea, 0x55, 0x9f, 0x9b, 0x1a, 0x0b, 0xb5, 0x61, 0xd6, 0xc5, 0x1e, 0x6e, 0x4b,
+	0x57, 0xb2, 0x1d, 0x3b, 0xc0, 0xac, 0x30, 0x71, 0x0a, 0x55, 0x93, 0x8f, 0x50, 0x37, 0x24, 0x28,
+	0xba, 0x03, 0x59, 0x37, 0x4e, 0xb7, 0xc2, 0xa4, 0x74, 0xf4, 0xe5, 0xc3, 0xf6, 0x9f, 0xc8, 0x4c,
+	0xdd, 0xea, 0x92, 0xda, 0x22, 0xb9, 0x7a, 0x7e, 0x8b, 0xfa, 0x2e, 0xf1, 0xdb, 0x96, 0xee, 0x70,
+	0x53, 0xb2, 0x95, 0xe5, 0x23, 0xfa, 0x6d, 0x49, 0x46, 0x77, 0x60, 0x26, 0x16, 0x95, 0xb5, 0x93,
+	0x39, 0x41, 0xed, 0xe4, 0x22, 0x5d, 0xc1, 0x45, 0xb7, 0x01, 0xe2, 0xc2, 0x2c, 0x80, 0x04, 0xaa,
+	0x3c, 0xbf, 0xba, 0xf5, 0x16, 0x12, 0xba, 0xc8, 0x83, 0xb3, 0x1d, 0xe2, 0x5b, 0x0c, 0x7b, 0xdb,
+	0x96, 0x76, 0x95, 0x80, 0xcc, 0x9e, 0x42, 0x68, 0xe7, 0x3a, 0xc4, 0xdf, 0xc0, 0xde, 0x76, 0x3d,
+	0x82, 0x45, 0x5d, 0x78, 0xe1, 0x7e, 0x58, 0x3c, 0x96, 0xd8, 0x50, 0x18, 0xea, 0xe9, 0x53, 0x08,
+	0xf5, 0xd9, 0x08, 0x5a, 0x66, 0xad, 0x0a, 0xb7, 0x0d, 0x39, 0x8f, 0x7c, 0xb7, 0x47, 0xa2, 0x2f,
+	0xe5, 0x4e, 0xe1, 0x4b, 0xd3, 0x0a, 0x52, 0x7f, 0xe2, 0x4d, 0xb8, 0x18, 0x47, 0x96, 0xfa, 0xd6,
+	0x0e, 0xf5, 0x5c, 0x2b, 0xc0, 0xdb, 0x96, 0x43, 0x7b, 0x3e, 0x2f, 0xcc, 0xc8, 0x7c, 0x38, 0x1f,
+	0x89, 0xdc, 0xf5, 0x6f, 0x53, 0xcf, 0x35, 0xf1, 0xf6, 0x8a, 0x60, 0xa3, 0xcb, 0x10, 0xc7, 0xd6,
+	0x22, 0x2e, 0x2b, 0xe4, 0xcb, 0xe3, 0xd7, 0xd2, 0xe6, 0x74, 0x44, 0x5c, 0x75, 0xd9, 0xd2, 0xf4,
+	0xfb, 0x8f, 0x4a, 0x63, 0xba, 0x07, 0x8d, 0x55, 0x9a, 0x30, 0xbd, 0x65, 0x7b, 0xba, 0x7d, 0x60,
+	0x86, 0xde, 0x80, 0x8c, 0x1d, 0x2e, 0x0a, 0x46, 0x79, 0xfc, 0xc8, 0xf6, 0x13, 0x8b, 0xaa, 0xae,
+	0xf6, 0xc3, 0x3f, 0x97, 0x8d, 0xca, 0xcf, 0x0d, 0x98, 0xa8, 0x6f, 0x35, 0x6d, 0x12, 0xa0, 0x06,
+	0xcc, 0xc5, 0x85, 0x78, 0xdc, 0x9e, 0x16, 0xd7, 0xae, 0xa6, 0x0b, 0x98, 0x38, 0xd2, 0x21, 0x4c,
+	0xea, 0x79, 0x30, 0x91, 0x8a, 0xa6, 0x0f, 0x6d, 0xbc, 0x01, 0x93, 0xca, 0x4a, 0x86, 0x96, 0xe0,
+	0x4c, 0x57, 0xfc, 0x90, 0xfb, 0xcd, 0xde, 0x5c, 0x38, 0xb4, 0x80, 0xa5, 0xbc, 0x4e, 0x7c, 0xa5,
+	0x52, 0xf9, 0x97, 0x01, 0x50, 0xdf, 0xda, 0xda, 0x0c, 0x48, 0xd7, 0xc3, 0xfc, 0xb4, 0x76, 0xbc,
+	0x96, 0xcc, 0x6d, 0x16, 0x38, 0xc7, 0xde, 0x75, 0x9c, 0xb7, 0x1b, 0x81, 0x73, 0x20, 0x9a, 0xcb,
+	0x78, 0x84, 0x36, 0x7e, 0x6c, 0xb4, 0x3a, 0xe3, 0x07, 0xbb, 0x71, 0x03, 0xb2, 0xf1, 0xf6, 0x19,
+	0xaa, 0xc3, 0x14, 0xd7, 0xbf, 0xb5, 0x37, 0x2b, 0x87, 0x7b, 0x33, 0x54, 0xd3, 0x1e, 0x8d, 0x34,
+	0x2b, 0xbf, 0x48, 0x01, 0x24, 0x2a, 0xfd, 0x0b, 0x95, 0x46, 0xe2, 0xcc, 0xd2, 0xe5, 0x7f, 0x1a,
+	0x37, 0x31, 0x8d, 0x85, 0xae, 0xc2, 0xcc, 0x60, 0x37, 0x93, 0xa7, 0xe9, 0x94, 0x99, 0x1b, 0x68,
+	0x44, 0x43, 0xce, 0xff, 0x49, 0x0a, 0xce, 0xde, 0x0b, 0x6b, 0xfb, 0x0b, 0xeb, 0xb0, 0x26, 0x4c,
+	0x62, 0x9f, 0x07, 0x44, 0x7a, 0x4c, 0xa4, 0xc4, 0xeb, 0x87, 0xa5, 0xc4, 0x01, 0x7b, 0x69, 0xf8,
+	0x3c, 0xe8, 0xeb, 0x04, 0x09, 0x61, 0x86, 0xbc, 0xf0, 0xbb, 0x71, 0x28, 0x1c, 0xa6, 0x89, 0x5e,
+	0x86, 0xbc, 0x13, 0x60, 0x49, 0xb0, 0x06, 0xc6, 0x86, 0x99, 0x90, 0xac, 0xcf, 0xd4, 0x77, 0x40,
+	0xdc, 0x4f, 0x45, 0xfe, 0x09, 0xd1, 0x13, 0x5f, 0x48, 0x67, 0x62, 0x65, 0xc1, 0x46, 0x18, 0xf2,
+	0xc4, 0x27, 0x9c, 0xd8, 0x9e, 0xd5, 0xb2, 0x3d, 0xdb, 0x77, 0xfe, 0x93, 0x8b, 0xfb, 0xe8, 0x39,
+	0x38, 0xa3, 0x41, 0x6b, 0x0a, 0x13, 0x6d, 0xc1, 0x64, 0x08, 0x9f, 0x3e, 0x05, 0xf8, 0x10, 0x0c,
+	0x5d, 0x82, 0xe9, 0xe4, 0x49, 0x22, 0xaf, 0x67, 0x69, 0x33, 0x9b, 0x38, 0x48, 0x9e, 0x77, 0x54,
+	0x4d, 0x1c, 0x79, 0x54, 0x25, 0x6e, 0xc1, 0xbf, 0x19, 0x87, 0x39, 0x13, 0xbb, 0x5f, 0xae, 0xb8,
+	0x7d, 0x13, 0x40, 0x15, 0xbe, 0xe8, 0xc7, 0x85, 0xf4, 0x29, 0x34, 0x92, 0x8c, 0xc2, 0xab, 0x33,
+	0xfe, 0xbf, 0x0c, 0xde, 0x67, 0x29, 0x98, 0x4e, 0x06, 0xef, 0x4b, 0x70, 0x00, 0xa2, 0xd5, 0xb8,
+	0x9f, 0xa5, 0x65, 0x3f, 0x7b, 0xe5, 0xb0, 0x7e, 0x36, 0x92, 0xd6, 0x47, 0x37, 0xb2, 0x5f, 0x9f,
+	0x81, 0x89, 0xa6, 0x1d, 0xd8, 0x1d, 0x86, 0xbe, 0x36, 0x72, 0xc3, 0x57, 0x63, 0xf7, 0x85, 0x91,
+	0xa4, 0xae, 0xeb, 0xb7, 0x23, 0x95, 0xd3, 0x1f, 0x1e, 0x70, 0xc1, 0xbf, 0x0a, 0x33, 0xe2, 0x0d,
+	0x21, 0xda, 0x8a, 0x72, 0x62, 0x4e, 0x3e, 0x02, 0x44, 0xe3, 0x27, 0x43, 0x25, 0xc8, 0x0a, 0xb1,
+	0xb8, 0x55, 0x0b, 0x19, 0xe8, 0xd8, 0x0f, 0x1b, 0x8a, 0x82, 0xae, 0x03, 0xda, 0x89, 0xde, 0x86,
+	0xac, 0xd8, 0x05, 0x42, 0x6e, 0x2e, 0xe6, 0x84, 0xe2, 0xff, 0x07, 0x20, 0x6f, 0xe5, 0x2e, 0xf6,
+	0x69, 0x47, 0x0f, 0xc1, 0x19, 0x41, 0xa9, 0x0b, 0x02, 0xfa, 0xbe, 0x1a, 0x16, 0x86, 0x9e, 0x17,
+	0xf4, 0x9c, 0xb6, 0x76, 0xb2, 0x52, 0xf8, 0xe7, 0x93, 0x52, 0xb1, 0x6f, 0x77, 0xbc, 0xa5, 0xca,
+	0x01, 0x90, 0x15, 0x39, 0x3c, 0x0c, 0x3e, 0x4b, 0xa0, 0x1f, 0x1b, 0x23, 0xd3, 0xc3, 0xb6, 0xed,
+	0x70, 0x1a, 0xc8, 0x21, 0x2e, 0x53, 0x5b, 0x3f, 0xb1, 0x01, 0x2f, 0x2a, 0x03, 0x0e, 0x04, 0xad,
+	0x0c, 0xcd, 0x13, 0xb7, 0x24, 0x15, 0xfd, 0xcc, 0x80, 0x0b, 0x6d, 0x8f, 0xb6, 0x6c, 0xcf, 0x0a,
+	0xe7, 0x0a, 0x95, 0x40, 0x96, 0x63, 0x77, 0xe5, 0xec, 0x97, 0xa9, 0x99, 0x27, 0x36, 0xa4, 0xac,
+	0x0c, 0x39, 0x14, 0xb8, 0x62, 0x9e, 0x53, 0xbc, 0x35, 0x35, 0x78, 0x28, 0xce, 0x8a, 0xdd, 0x45,
+	0xaf, 0xc3, 0xbc, 0x7c, 0x34, 0xb3, 0x54, 0x30, 0xfb, 0x56, 0xcb, 0xa3, 0xce, 0x2e, 0x93, 0xc3,
+	0x65, 0xda, 0x44, 0x92, 0xa7, 0x5e, 0x07, 0xfb, 0x35, 0xc9, 0x49, 0x34, 0x82, 0x8f, 0x0c, 0x40,
+	0xf1, 0xd9, 0x6b, 0x62, 0xd6, 0xa5, 0x3e, 0x93, 0xc3, 0x65, 0x62, 0x12, 0x34, 0x8e, 0x1e, 0x2e,
+	0x63, 0xfd, 0x70, 0xb8, 0x8c, 0x75, 0xd1, 0x57, 0xe2, 0x93, 0x2e, 0xa5, 0x4b, 0x41, 0xc3, 0x88,
+	0xa7, 0xce, 0xc4, 0x80, 0x4a, 0x42, 0xed, 0x50, 0x3e, 0xb2, 0x72, 0xac, 0xf2, 0x47, 0x03, 0x2e,
+	0x8c, 0x14, 0x65, 0x64, 0xec, 0xb7, 0x01, 0x05, 0x09, 0xa6, 0x4c, 0xf1, 0xbe, 0x36, 0xfa, 0xc4,
+	0x35, 0x3e, 0x17, 0x0c, 0x33, 0xfe, 0x5b, 0x87, 0xf5, 0x52, 0x5a, 0x46, 0xe0, 0xf7, 0x06, 0xcc,
+	0x27, 0x8d, 0x89, 0xb6, 0xb5, 0x0e, 0xd3, 0x49, 0x5b, 0xf4, 0x86, 0xae, 0x1c, 0x67, 0x43, 0x7a,
+	0x2f, 0x03, 0xfa, 0xe8, 0xdd, 0xb8, 0xff, 0xa9, 0xa7, 0xdd, 0x1b, 0xc7, 0xf6, 0x4d, 0x68, 0xd3,
+	0x70, 0x1f, 0x4c, 0x87, 0xd7, 0xd9, 0x74, 0x93, 0x52, 0x0f, 0xfd, 0x00, 0xe6, 0x7c, 0xca, 0x65,
+	0x05, 0x61, 0xd7, 0xd2, 0x2f, 0x44, 0xea, 0x10, 0x79, 0xf7, 0x64, 0x2e, 0xfb, 0xfb, 0x93, 0xd2,
+	0x28, 0xd4, 0x90, 0x1f, 0xf3, 0x3e, 0xe5, 0x35, 0xc9, 0xdf, 0x94, 0x6c, 0x14, 0x40, 0x6e, 0xf0,
+	0xd3, 0xea, 0xd0, 0x79, 0xe7, 0xc4, 0x9f, 0xce, 0x1d, 0xf5, 0xd9, 0xe9, 0x56, 0xe2, 0x9b, 0x4b,
+	0x53, 0x22, 0x86, 0xff, 0x90, 0xf7, 0x21, 0x03, 0xce, 0x4a, 0x22, 0xf9, 0x1e, 0x96, 0xaf, 0x02,
+	0xfa, 0xdd, 0x7b, 0x06, 0x52, 0xc4, 0x95, 0x5e, 0x48, 0x9b, 0x29, 0xe2, 0xa2, 0x2a, 0x9c, 0xa1,
+	0x0f, 0x7c, 0xfd, 0xde, 0x7d, 0xd4, 0x21, 0xa6, 0xc4, 0xe4, 0x31, 0x40, 0xdd, 0x9e, 0x87, 0x2d,
+	0xdb, 0x51, 0xa7, 0xbc, 0x7a, 0xdd, 0xcc, 0x29, 0xea, 0xb2, 0x22, 0x8a, 0x07, 0x80, 0xa8, 0x57,
+	0x15, 0xd2, 0xcf, 0x81, 0x8e, 0x45, 0x55, 0x12, 0xbe, 0xfa, 0x2b, 0x03, 0x20, 0x7e, 0xe7, 0x43,
+	0xaf, 0xc1, 0xf9, 0xda, 0xdd, 0xf5, 0xba, 0xb5, 0xb1, 0xb9, 0xbc, 0x79, 0x6f, 0xc3, 0xba, 0xb7,
+	0xbe, 0xd1, 0x6c, 0xac, 0xac, 0xde, 0x5a, 0x6d, 0xd4, 0x67, 0xc7, 0x8a, 0xf9, 0xbd, 0xfd, 0x72,
+	0xf6, 0x9e, 0xcf, 0xba, 0xd8, 0x21, 0xdb, 0x04, 0xbb, 0xe8, 0x25, 0x98, 0x1f, 0x94, 0x16, 0xab,
+	0x46, 0x7d, 0xd6, 0x28, 0x4e, 0xef, 0xed, 0x97, 0xa7, 0xd4, 0x1d, 0x1f, 0xbb, 0xe8, 0x1a, 0xbc,
+	0x30, 0x2a, 0xb7, 0xba, 0xfe, 0xf6, 0x6c, 0xaa, 0x98, 0xdb, 0xdb, 0x2f, 0x67, 0xa2, 0x61, 0x00,
+	0x55, 0x00, 0x25, 0x25, 0x35, 0xde, 0x78, 0x11, 0xf6, 0xf6, 0xcb, 0x13, 0x2a, 0xe6, 0xc5, 0xf4,
+	0xfb, 0x1f, 0x2d, 0x8c, 0xbd, 0xfa, 0xcb, 0x14, 0xe4, 0x22, 0xbd, 0xcd, 0x7e, 0x17, 0xa3, 0xaf,
+	0x42, 0x31, 0x42, 0xb6, 0x36, 0xdf, 0x6b, 0x36, 0x86, 0xcc, 0xbf, 0xb8, 0xb7, 0x5f, 0x3e, 0x3f,
+	0xa0, 0x62, 0xdd, 0xf3, 0x5d, 0xbc, 0x4d, 0x7c, 0xec, 0xa2, 0x75, 0xb8, 0x3c, 0xa2, 0x1c, 0x2e,
+	0xeb, 0x8d, 0xb5, 0xc6, 0xdb, 0xcb, 0x9b, 0xab, 0x77, 0xd7, 0x67, 0x8d, 0xe2, 0xd5, 0xbd, 0xfd,
+	0xf2, 0xa5, 0x61, 0x94, 0xd1, 0x89, 0xee, 0x2d, 0xb8, 0x38, 0x84, 0x67, 0x36, 0x12, 0x38, 0xa9,
+	0xe2, 0xc2, 0xde, 0x7e, 0xb9, 0x38, 0x88, 0x33, 0x70, 0x2f, 0x5b, 0x83, 0xca, 0x10, 0xc0, 0xd6,
+	0xf2, 0xda, 0x6a, 0x7d, 0x79, 0xf3, 0xae, 0x99, 0x70, 0xe0, 0x78, 0xf1, 0xca, 0xde, 0x7e, 0xb9,
+	0x3c, 0x88, 0x13, 0xdd, 0x11, 0x22, 0xb2, 0xf2, 0x59, 0xed, 0xd6, 0xa7, 0x4f, 0x17, 0x8c, 0xc7,
+	0x4f, 0x17, 0x8c, 0xbf, 0x3e, 0x5d, 0x30, 0x3e, 0x78, 0xb6, 0x30, 0xf6, 0xf8, 0xd9, 0xc2, 0xd8,
+	0x1f, 0x9e, 0x2d, 0x8c, 0x7d, 0xe3, 0xb5, 0x23, 0x4b, 0xe4, 0x61, 0xf4, 0x87, 0x36, 0x59, 0x2c,
+	0xad, 0x09, 0x79, 0x99, 0xf9, 0xff, 0x7f, 0x0f, 0x00, 0x85, 0xca, 0x53, 0xb8, 0x87, 0x1b, 0x00,
+	0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1256,476 +1550,502 @@ func (this *Pool) Description() (desc *github_com_gogo_protobuf_protoc_gen_gogo_
 func StakingDescription() (desc *github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_gogo_protobuf_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 7499 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbc, 0x6b, 0x70, 0x24, 0xd7,
-		0x75, 0x1f, 0x8e, 0x79, 0x60, 0x30, 0x73, 0x66, 0x30, 0x68, 0x5c, 0x60, 0x77, 0x67, 0x41, 0x12,
-		0x00, 0x87, 0x8f, 0x5d, 0xbe, 0xb0, 0xe4, 0x92, 0xbb, 0xcb, 0x9d, 0xb5, 0xc4, 0xff, 0xbc, 0x16,
-		0xc4, 0x2e, 0x1e, 0xc3, 0x1e, 0x60, 0xf9, 0xf0, 0xdf, 0xe9, 0x6a, 0xf4, 0x5c, 0x0c, 0x9a, 0xe8,
-		0xe9, 0x6e, 0x77, 0xf7, 0xec, 0x2e, 0x58, 0x4e, 0x8a, 0x2e, 0xe5, 0x21, 0x6d, 0x2a, 0x8e, 0x1c,
-		0xa7, 0x62, 0x59, 0xd6, 0x2a, 0x94, 0xe5, 0x44, 0x8e, 0xa2, 0x3c, 0x6c, 0x29, 0x4a, 0x1c, 0x57,
-		0x12, 0x25, 0xa9, 0x24, 0xb2, 0x3e, 0xa4, 0x64, 0x7f, 0x88, 0xed, 0x3c, 0x18, 0x87, 0x52, 0x25,
-		0x8c, 0xa2, 0xc4, 0x8e, 0xc2, 0x54, 0x25, 0xa5, 0x52, 0x2a, 0x75, 0x5f, 0xfd, 0x98, 0x07, 0x66,
-		0xb0, 0x59, 0xca, 0xae, 0xf2, 0x27, 0x4c, 0x9f, 0x7b, 0x7e, 0xbf, 0x3e, 0xf7, 0xdc, 0x73, 0xcf,
-		0x3d, 0xf7, 0x76, 0x37, 0xe0, 0x9f, 0x5e, 0x81, 0xe5, 0xb6, 0x65, 0xb5, 0x0d, 0x7c, 0xce, 0x76,
-		0x2c, 0xcf, 0xda, 0xed, 0xee, 0x9d, 0x6b, 0x61, 0x57, 0x73, 0x74, 0xdb, 0xb3, 0x9c, 0x15, 0x2a,
-		0x43, 0x33, 0x4c, 0x63, 0x45, 0x68, 0x14, 0x37, 0x60, 0xf6, 0xaa, 0x6e, 0xe0, 0x9a, 0xaf, 0xd8,
-		0xc4, 0x1e, 0x7a, 0x11, 0x92, 0x7b, 0xba, 0x81, 0x0b, 0xb1, 0xe5, 0xc4, 0xd9, 0xec, 0xf9, 0x47,
-		0x57, 0x7a, 0x40, 0x2b, 0x51, 0x44, 0x83, 0x88, 0x65, 0x8a, 0x28, 0x7e, 0x3b, 0x09, 0x73, 0x03,
-		0x5a, 0x11, 0x82, 0xa4, 0xa9, 0x76, 0x08, 0x63, 0xec, 0x6c, 0x46, 0xa6, 0xbf, 0x51, 0x01, 0xa6,
-		0x6c, 0x55, 0x3b, 0x50, 0xdb, 0xb8, 0x10, 0xa7, 0x62, 0x71, 0x89, 0x16, 0x01, 0x5a, 0xd8, 0xc6,
-		0x66, 0x0b, 0x9b, 0xda, 0x61, 0x21, 0xb1, 0x9c, 0x38, 0x9b, 0x91, 0x43, 0x12, 0xf4, 0x14, 0xcc,
-		0xda, 0xdd, 0x5d, 0x43, 0xd7, 0x94, 0x90, 0x1a, 0x2c, 0x27, 0xce, 0x4e, 0xca, 0x12, 0x6b, 0xa8,
-		0x05, 0xca, 0x67, 0x60, 0xe6, 0x16, 0x56, 0x0f, 0xc2, 0xaa, 0x59, 0xaa, 0x9a, 0x27, 0xe2, 0x90,
-		0x62, 0x15, 0x72, 0x1d, 0xec, 0xba, 0x6a, 0x1b, 0x2b, 0xde, 0xa1, 0x8d, 0x0b, 0x49, 0xda, 0xfb,
-		0xe5, 0xbe, 0xde, 0xf7, 0xf6, 0x3c, 0xcb, 0x51, 0xdb, 0x87, 0x36, 0x46, 0x65, 0xc8, 0x60, 0xb3,
-		0xdb, 0x61, 0x0c, 0x93, 0x43, 0xfc, 0x57, 0x37, 0xbb, 0x9d, 0x5e, 0x96, 0x34, 0x81, 0x71, 0x8a,
-		0x29, 0x17, 0x3b, 0x37, 0x75, 0x0d, 0x17, 0x52, 0x94, 0xe0, 0x4c, 0x1f, 0x41, 0x93, 0xb5, 0xf7,
-		0x72, 0x08, 0x1c, 0xaa, 0x42, 0x06, 0xdf, 0xf6, 0xb0, 0xe9, 0xea, 0x96, 0x59, 0x98, 0xa2, 0x24,
-		0x8f, 0x0d, 0x18, 0x45, 0x6c, 0xb4, 0x7a, 0x29, 0x02, 0x1c, 0xba, 0x08, 0x53, 0x96, 0xed, 0xe9,
-		0x96, 0xe9, 0x16, 0xd2, 0xcb, 0xb1, 0xb3, 0xd9, 0xf3, 0x0f, 0x0e, 0x0c, 0x84, 0x2d, 0xa6, 0x23,
-		0x0b, 0x65, 0xb4, 0x06, 0x92, 0x6b, 0x75, 0x1d, 0x0d, 0x2b, 0x9a, 0xd5, 0xc2, 0x8a, 0x6e, 0xee,
-		0x59, 0x85, 0x0c, 0x25, 0x58, 0xea, 0xef, 0x08, 0x55, 0xac, 0x5a, 0x2d, 0xbc, 0x66, 0xee, 0x59,
-		0x72, 0xde, 0x8d, 0x5c, 0xa3, 0x93, 0x90, 0x72, 0x0f, 0x4d, 0x4f, 0xbd, 0x5d, 0xc8, 0xd1, 0x08,
-		0xe1, 0x57, 0xc5, 0x5f, 0x4d, 0xc1, 0xcc, 0x38, 0x21, 0x76, 0x05, 0x26, 0xf7, 0x48, 0x2f, 0x0b,
-		0xf1, 0xe3, 0xf8, 0x80, 0x61, 0xa2, 0x4e, 0x4c, 0xdd, 0xa3, 0x13, 0xcb, 0x90, 0x35, 0xb1, 0xeb,
-		0xe1, 0x16, 0x8b, 0x88, 0xc4, 0x98, 0x31, 0x05, 0x0c, 0xd4, 0x1f, 0x52, 0xc9, 0x7b, 0x0a, 0xa9,
-		0xd7, 0x60, 0xc6, 0x37, 0x49, 0x71, 0x54, 0xb3, 0x2d, 0x62, 0xf3, 0xdc, 0x28, 0x4b, 0x56, 0xea,
-		0x02, 0x27, 0x13, 0x98, 0x9c, 0xc7, 0x91, 0x6b, 0x54, 0x03, 0xb0, 0x4c, 0x6c, 0xed, 0x29, 0x2d,
-		0xac, 0x19, 0x85, 0xf4, 0x10, 0x2f, 0x6d, 0x11, 0x95, 0x3e, 0x2f, 0x59, 0x4c, 0xaa, 0x19, 0xe8,
-		0x72, 0x10, 0x6a, 0x53, 0x43, 0x22, 0x65, 0x83, 0x4d, 0xb2, 0xbe, 0x68, 0xdb, 0x81, 0xbc, 0x83,
-		0x49, 0xdc, 0xe3, 0x16, 0xef, 0x59, 0x86, 0x1a, 0xb1, 0x32, 0xb2, 0x67, 0x32, 0x87, 0xb1, 0x8e,
-		0x4d, 0x3b, 0xe1, 0x4b, 0xf4, 0x08, 0xf8, 0x02, 0x85, 0x86, 0x15, 0xd0, 0x2c, 0x94, 0x13, 0xc2,
-		0x4d, 0xb5, 0x83, 0x17, 0xde, 0x82, 0x7c, 0xd4, 0x3d, 0x68, 0x1e, 0x26, 0x5d, 0x4f, 0x75, 0x3c,
-		0x1a, 0x85, 0x93, 0x32, 0xbb, 0x40, 0x12, 0x24, 0xb0, 0xd9, 0xa2, 0x59, 0x6e, 0x52, 0x26, 0x3f,
-		0xd1, 0xff, 0x17, 0x74, 0x38, 0x41, 0x3b, 0xfc, 0x78, 0xff, 0x88, 0x46, 0x98, 0x7b, 0xfb, 0xbd,
-		0x70, 0x09, 0xa6, 0x23, 0x1d, 0x18, 0xf7, 0xd6, 0xc5, 0x9f, 0x80, 0x13, 0x03, 0xa9, 0xd1, 0x6b,
-		0x30, 0xdf, 0x35, 0x75, 0xd3, 0xc3, 0x8e, 0xed, 0x60, 0x12, 0xb1, 0xec, 0x56, 0x85, 0xff, 0x34,
-		0x35, 0x24, 0xe6, 0x76, 0xc2, 0xda, 0x8c, 0x45, 0x9e, 0xeb, 0xf6, 0x0b, 0x9f, 0xcc, 0xa4, 0xdf,
-		0x9f, 0x92, 0xde, 0x7e, 0xfb, 0xed, 0xb7, 0xe3, 0xc5, 0x7f, 0x9c, 0x82, 0xf9, 0x41, 0x73, 0x66,
-		0xe0, 0xf4, 0x3d, 0x09, 0x29, 0xb3, 0xdb, 0xd9, 0xc5, 0x0e, 0x75, 0xd2, 0xa4, 0xcc, 0xaf, 0x50,
-		0x19, 0x26, 0x0d, 0x75, 0x17, 0x1b, 0x85, 0xe4, 0x72, 0xec, 0x6c, 0xfe, 0xfc, 0x53, 0x63, 0xcd,
-		0xca, 0x95, 0x75, 0x02, 0x91, 0x19, 0x12, 0x7d, 0x14, 0x92, 0x3c, 0x45, 0x13, 0x86, 0x27, 0xc7,
-		0x63, 0x20, 0x73, 0x49, 0xa6, 0x38, 0xf4, 0x00, 0x64, 0xc8, 0x5f, 0x16, 0x1b, 0x29, 0x6a, 0x73,
-		0x9a, 0x08, 0x48, 0x5c, 0xa0, 0x05, 0x48, 0xd3, 0x69, 0xd2, 0xc2, 0x62, 0x69, 0xf3, 0xaf, 0x49,
-		0x60, 0xb5, 0xf0, 0x9e, 0xda, 0x35, 0x3c, 0xe5, 0xa6, 0x6a, 0x74, 0x31, 0x0d, 0xf8, 0x8c, 0x9c,
-		0xe3, 0xc2, 0x1b, 0x44, 0x86, 0x96, 0x20, 0xcb, 0x66, 0x95, 0x6e, 0xb6, 0xf0, 0x6d, 0x9a, 0x3d,
-		0x27, 0x65, 0x36, 0xd1, 0xd6, 0x88, 0x84, 0xdc, 0xfe, 0x4d, 0xd7, 0x32, 0x45, 0x68, 0xd2, 0x5b,
-		0x10, 0x01, 0xbd, 0xfd, 0xa5, 0xde, 0xc4, 0xfd, 0xd0, 0xe0, 0xee, 0xf5, 0xcd, 0xa5, 0x33, 0x30,
-		0x43, 0x35, 0x9e, 0xe7, 0x43, 0xaf, 0x1a, 0x85, 0xd9, 0xe5, 0xd8, 0xd9, 0xb4, 0x9c, 0x67, 0xe2,
-		0x2d, 0x2e, 0x2d, 0x7e, 0x35, 0x0e, 0x49, 0x9a, 0x58, 0x66, 0x20, 0xbb, 0xfd, 0x7a, 0xa3, 0xae,
-		0xd4, 0xb6, 0x76, 0x2a, 0xeb, 0x75, 0x29, 0x86, 0xf2, 0x00, 0x54, 0x70, 0x75, 0x7d, 0xab, 0xbc,
-		0x2d, 0xc5, 0xfd, 0xeb, 0xb5, 0xcd, 0xed, 0x8b, 0x2f, 0x48, 0x09, 0x1f, 0xb0, 0xc3, 0x04, 0xc9,
-		0xb0, 0xc2, 0xf3, 0xe7, 0xa5, 0x49, 0x24, 0x41, 0x8e, 0x11, 0xac, 0xbd, 0x56, 0xaf, 0x5d, 0x7c,
-		0x41, 0x4a, 0x45, 0x25, 0xcf, 0x9f, 0x97, 0xa6, 0xd0, 0x34, 0x64, 0xa8, 0xa4, 0xb2, 0xb5, 0xb5,
-		0x2e, 0xa5, 0x7d, 0xce, 0xe6, 0xb6, 0xbc, 0xb6, 0xb9, 0x2a, 0x65, 0x7c, 0xce, 0x55, 0x79, 0x6b,
-		0xa7, 0x21, 0x81, 0xcf, 0xb0, 0x51, 0x6f, 0x36, 0xcb, 0xab, 0x75, 0x29, 0xeb, 0x6b, 0x54, 0x5e,
-		0xdf, 0xae, 0x37, 0xa5, 0x5c, 0xc4, 0xac, 0xe7, 0xcf, 0x4b, 0xd3, 0xfe, 0x2d, 0xea, 0x9b, 0x3b,
-		0x1b, 0x52, 0x1e, 0xcd, 0xc2, 0x34, 0xbb, 0x85, 0x30, 0x62, 0xa6, 0x47, 0x74, 0xf1, 0x05, 0x49,
-		0x0a, 0x0c, 0x61, 0x2c, 0xb3, 0x11, 0xc1, 0xc5, 0x17, 0x24, 0x54, 0xac, 0xc2, 0x24, 0x0d, 0x43,
-		0x84, 0x20, 0xbf, 0x5e, 0xae, 0xd4, 0xd7, 0x95, 0xad, 0xc6, 0xf6, 0xda, 0xd6, 0x66, 0x79, 0x5d,
-		0x8a, 0x05, 0x32, 0xb9, 0xfe, 0xca, 0xce, 0x9a, 0x5c, 0xaf, 0x49, 0xf1, 0xb0, 0xac, 0x51, 0x2f,
-		0x6f, 0xd7, 0x6b, 0x52, 0xa2, 0xa8, 0xc1, 0xfc, 0xa0, 0x84, 0x3a, 0x70, 0x0a, 0x85, 0x62, 0x21,
-		0x3e, 0x24, 0x16, 0x28, 0x57, 0x6f, 0x2c, 0x14, 0xbf, 0x15, 0x87, 0xb9, 0x01, 0x8b, 0xca, 0xc0,
-		0x9b, 0xbc, 0x04, 0x93, 0x2c, 0x96, 0xd9, 0x32, 0xfb, 0xc4, 0xc0, 0xd5, 0x89, 0x46, 0x76, 0xdf,
-		0x52, 0x4b, 0x71, 0xe1, 0x52, 0x23, 0x31, 0xa4, 0xd4, 0x20, 0x14, 0x7d, 0x01, 0xfb, 0x63, 0x7d,
-		0xc9, 0x9f, 0xad, 0x8f, 0x17, 0xc7, 0x59, 0x1f, 0xa9, 0xec, 0x78, 0x8b, 0xc0, 0xe4, 0x80, 0x45,
-		0xe0, 0x0a, 0xcc, 0xf6, 0x11, 0x8d, 0x9d, 0x8c, 0x3f, 0x16, 0x83, 0xc2, 0x30, 0xe7, 0x8c, 0x48,
-		0x89, 0xf1, 0x48, 0x4a, 0xbc, 0xd2, 0xeb, 0xc1, 0x87, 0x87, 0x0f, 0x42, 0xdf, 0x58, 0x7f, 0x21,
-		0x06, 0x27, 0x07, 0x97, 0x94, 0x03, 0x6d, 0xf8, 0x28, 0xa4, 0x3a, 0xd8, 0xdb, 0xb7, 0x44, 0x59,
-		0xf5, 0xf8, 0x80, 0xc5, 0x9a, 0x34, 0xf7, 0x0e, 0x36, 0x47, 0x85, 0x57, 0xfb, 0xc4, 0xb0, 0xba,
-		0x90, 0x59, 0xd3, 0x67, 0xe9, 0x27, 0xe2, 0x70, 0x62, 0x20, 0xf9, 0x40, 0x43, 0x1f, 0x02, 0xd0,
-		0x4d, 0xbb, 0xeb, 0xb1, 0xd2, 0x89, 0x65, 0xe2, 0x0c, 0x95, 0xd0, 0xe4, 0x45, 0xb2, 0x6c, 0xd7,
-		0xf3, 0xdb, 0x13, 0xb4, 0x1d, 0x98, 0x88, 0x2a, 0xbc, 0x18, 0x18, 0x9a, 0xa4, 0x86, 0x2e, 0x0e,
-		0xe9, 0x69, 0x5f, 0x60, 0x3e, 0x0b, 0x92, 0x66, 0xe8, 0xd8, 0xf4, 0x14, 0xd7, 0x73, 0xb0, 0xda,
-		0xd1, 0xcd, 0x36, 0x5d, 0x6a, 0xd2, 0xa5, 0xc9, 0x3d, 0xd5, 0x70, 0xb1, 0x3c, 0xc3, 0x9a, 0x9b,
-		0xa2, 0x95, 0x20, 0x68, 0x00, 0x39, 0x21, 0x44, 0x2a, 0x82, 0x60, 0xcd, 0x3e, 0xa2, 0xf8, 0xd3,
-		0x19, 0xc8, 0x86, 0x0a, 0x70, 0xf4, 0x30, 0xe4, 0xde, 0x54, 0x6f, 0xaa, 0x8a, 0xd8, 0x54, 0x31,
-		0x4f, 0x64, 0x89, 0xac, 0xc1, 0x37, 0x56, 0xcf, 0xc2, 0x3c, 0x55, 0xb1, 0xba, 0x1e, 0x76, 0x14,
-		0xcd, 0x50, 0x5d, 0x97, 0x3a, 0x2d, 0x4d, 0x55, 0x11, 0x69, 0xdb, 0x22, 0x4d, 0x55, 0xd1, 0x82,
-		0x2e, 0xc0, 0x1c, 0x45, 0x74, 0xba, 0x86, 0xa7, 0xdb, 0x06, 0x56, 0xc8, 0x36, 0xcf, 0xa5, 0x4b,
-		0x8e, 0x6f, 0xd9, 0x2c, 0xd1, 0xd8, 0xe0, 0x0a, 0xc4, 0x22, 0x17, 0xd5, 0xe0, 0x21, 0x0a, 0x6b,
-		0x63, 0x13, 0x3b, 0xaa, 0x87, 0x15, 0xfc, 0xe3, 0x5d, 0xd5, 0x70, 0x15, 0xd5, 0x6c, 0x29, 0xfb,
-		0xaa, 0xbb, 0x5f, 0x98, 0x27, 0x04, 0x95, 0x78, 0x21, 0x26, 0x9f, 0x26, 0x8a, 0xab, 0x5c, 0xaf,
-		0x4e, 0xd5, 0xca, 0x66, 0xeb, 0x65, 0xd5, 0xdd, 0x47, 0x25, 0x38, 0x49, 0x59, 0x5c, 0xcf, 0xd1,
-		0xcd, 0xb6, 0xa2, 0xed, 0x63, 0xed, 0x40, 0xe9, 0x7a, 0x7b, 0x2f, 0x16, 0x1e, 0x08, 0xdf, 0x9f,
-		0x5a, 0xd8, 0xa4, 0x3a, 0x55, 0xa2, 0xb2, 0xe3, 0xed, 0xbd, 0x88, 0x9a, 0x90, 0x23, 0x83, 0xd1,
-		0xd1, 0xdf, 0xc2, 0xca, 0x9e, 0xe5, 0xd0, 0x35, 0x34, 0x3f, 0x20, 0x35, 0x85, 0x3c, 0xb8, 0xb2,
-		0xc5, 0x01, 0x1b, 0x56, 0x0b, 0x97, 0x26, 0x9b, 0x8d, 0x7a, 0xbd, 0x26, 0x67, 0x05, 0xcb, 0x55,
-		0xcb, 0x21, 0x01, 0xd5, 0xb6, 0x7c, 0x07, 0x67, 0x59, 0x40, 0xb5, 0x2d, 0xe1, 0xde, 0x0b, 0x30,
-		0xa7, 0x69, 0xac, 0xcf, 0xba, 0xa6, 0xf0, 0xcd, 0x98, 0x5b, 0x90, 0x22, 0xce, 0xd2, 0xb4, 0x55,
-		0xa6, 0xc0, 0x63, 0xdc, 0x45, 0x97, 0xe1, 0x44, 0xe0, 0xac, 0x30, 0x70, 0xb6, 0xaf, 0x97, 0xbd,
-		0xd0, 0x0b, 0x30, 0x67, 0x1f, 0xf6, 0x03, 0x51, 0xe4, 0x8e, 0xf6, 0x61, 0x2f, 0xec, 0x12, 0xcc,
-		0xdb, 0xfb, 0x76, 0x3f, 0xee, 0xc9, 0x30, 0x0e, 0xd9, 0xfb, 0x76, 0x2f, 0xf0, 0x31, 0xba, 0x33,
-		0x77, 0xb0, 0xa6, 0x7a, 0xb8, 0x55, 0x38, 0x15, 0x56, 0x0f, 0x35, 0xa0, 0x15, 0x90, 0x34, 0x4d,
-		0xc1, 0xa6, 0xba, 0x6b, 0x60, 0x45, 0x75, 0xb0, 0xa9, 0xba, 0x85, 0x25, 0xaa, 0x9c, 0xf4, 0x9c,
-		0x2e, 0x96, 0xf3, 0x9a, 0x56, 0xa7, 0x8d, 0x65, 0xda, 0x86, 0x9e, 0x84, 0x59, 0x6b, 0xf7, 0x4d,
-		0x8d, 0x45, 0xa4, 0x62, 0x3b, 0x78, 0x4f, 0xbf, 0x5d, 0x78, 0x94, 0xba, 0x77, 0x86, 0x34, 0xd0,
-		0x78, 0x6c, 0x50, 0x31, 0x7a, 0x02, 0x24, 0xcd, 0xdd, 0x57, 0x1d, 0x9b, 0xa6, 0x64, 0xd7, 0x56,
-		0x35, 0x5c, 0x78, 0x8c, 0xa9, 0x32, 0xf9, 0xa6, 0x10, 0x93, 0x19, 0xe1, 0xde, 0xd2, 0xf7, 0x3c,
-		0xc1, 0x78, 0x86, 0xcd, 0x08, 0x2a, 0xe3, 0x6c, 0x67, 0x41, 0x22, 0x9e, 0x88, 0xdc, 0xf8, 0x2c,
-		0x55, 0xcb, 0xdb, 0xfb, 0x76, 0xf8, 0xbe, 0x8f, 0xc0, 0x34, 0xd1, 0x0c, 0x6e, 0xfa, 0x04, 0x2b,
-		0xdc, 0xec, 0xfd, 0xd0, 0x1d, 0x5f, 0x80, 0x93, 0x44, 0xa9, 0x83, 0x3d, 0xb5, 0xa5, 0x7a, 0x6a,
-		0x48, 0xfb, 0x69, 0xaa, 0x4d, 0xdc, 0xbe, 0xc1, 0x1b, 0x23, 0x76, 0x3a, 0xdd, 0xdd, 0x43, 0x3f,
-		0xb0, 0x9e, 0x61, 0x76, 0x12, 0x99, 0x08, 0xad, 0x0f, 0xad, 0x38, 0x2f, 0x96, 0x20, 0x17, 0x8e,
-		0x7b, 0x94, 0x01, 0x16, 0xf9, 0x52, 0x8c, 0x14, 0x41, 0xd5, 0xad, 0x1a, 0x29, 0x5f, 0xde, 0xa8,
-		0x4b, 0x71, 0x52, 0x46, 0xad, 0xaf, 0x6d, 0xd7, 0x15, 0x79, 0x67, 0x73, 0x7b, 0x6d, 0xa3, 0x2e,
-		0x25, 0x42, 0x85, 0xfd, 0xb5, 0x64, 0xfa, 0x71, 0xe9, 0x0c, 0xa9, 0x1a, 0xf2, 0xd1, 0x9d, 0x1a,
-		0xfa, 0x11, 0x38, 0x25, 0x8e, 0x55, 0x5c, 0xec, 0x29, 0xb7, 0x74, 0x87, 0x4e, 0xc8, 0x8e, 0xca,
-		0x16, 0x47, 0x3f, 0x7e, 0xe6, 0xb9, 0x56, 0x13, 0x7b, 0xaf, 0xea, 0x0e, 0x99, 0x6e, 0x1d, 0xd5,
-		0x43, 0xeb, 0xb0, 0x64, 0x5a, 0x8a, 0xeb, 0xa9, 0x66, 0x4b, 0x75, 0x5a, 0x4a, 0x70, 0xa0, 0xa5,
-		0xa8, 0x9a, 0x86, 0x5d, 0xd7, 0x62, 0x0b, 0xa1, 0xcf, 0xf2, 0xa0, 0x69, 0x35, 0xb9, 0x72, 0xb0,
-		0x42, 0x94, 0xb9, 0x6a, 0x4f, 0xf8, 0x26, 0x86, 0x85, 0xef, 0x03, 0x90, 0xe9, 0xa8, 0xb6, 0x82,
-		0x4d, 0xcf, 0x39, 0xa4, 0xf5, 0x79, 0x5a, 0x4e, 0x77, 0x54, 0xbb, 0x4e, 0xae, 0x7f, 0x28, 0xdb,
-		0xa4, 0x6b, 0xc9, 0x74, 0x52, 0x9a, 0xbc, 0x96, 0x4c, 0x4f, 0x4a, 0xa9, 0x6b, 0xc9, 0x74, 0x4a,
-		0x9a, 0xba, 0x96, 0x4c, 0xa7, 0xa5, 0xcc, 0xb5, 0x64, 0x3a, 0x23, 0x41, 0xf1, 0xbd, 0x04, 0xe4,
-		0xc2, 0x15, 0x3c, 0xd9, 0x10, 0x69, 0x74, 0x0d, 0x8b, 0xd1, 0x2c, 0xf7, 0xc8, 0x91, 0xf5, 0xfe,
-		0x4a, 0x95, 0x2c, 0x6e, 0xa5, 0x14, 0x2b, 0x97, 0x65, 0x86, 0x24, 0x85, 0x05, 0x09, 0x3f, 0xcc,
-		0xca, 0x93, 0xb4, 0xcc, 0xaf, 0xd0, 0x2a, 0xa4, 0xde, 0x74, 0x29, 0x77, 0x8a, 0x72, 0x3f, 0x7a,
-		0x34, 0xf7, 0xb5, 0x26, 0x25, 0xcf, 0x5c, 0x6b, 0x2a, 0x9b, 0x5b, 0xf2, 0x46, 0x79, 0x5d, 0xe6,
-		0x70, 0x74, 0x1a, 0x92, 0x86, 0xfa, 0xd6, 0x61, 0x74, 0x19, 0xa4, 0xa2, 0x71, 0x87, 0xe5, 0x34,
-		0x24, 0x6f, 0x61, 0xf5, 0x20, 0xba, 0xf8, 0x50, 0xd1, 0x87, 0x38, 0x3d, 0xce, 0xc1, 0x24, 0xf5,
-		0x17, 0x02, 0xe0, 0x1e, 0x93, 0x26, 0x50, 0x1a, 0x92, 0xd5, 0x2d, 0x99, 0x4c, 0x11, 0x09, 0x72,
-		0x4c, 0xaa, 0x34, 0xd6, 0xea, 0xd5, 0xba, 0x14, 0x2f, 0x5e, 0x80, 0x14, 0x73, 0x02, 0x99, 0x3e,
-		0xbe, 0x1b, 0xa4, 0x09, 0x7e, 0xc9, 0x39, 0x62, 0xa2, 0x75, 0x67, 0xa3, 0x52, 0x97, 0xa5, 0x78,
-		0xdf, 0xe0, 0x17, 0x5d, 0xc8, 0x85, 0x2b, 0xf3, 0x1f, 0xce, 0xf6, 0xfc, 0x6b, 0x31, 0xc8, 0x86,
-		0x2a, 0x6d, 0x52, 0x22, 0xa9, 0x86, 0x61, 0xdd, 0x52, 0x54, 0x43, 0x57, 0x5d, 0x1e, 0x1a, 0x40,
-		0x45, 0x65, 0x22, 0x19, 0x77, 0xe8, 0x7e, 0x48, 0x93, 0x66, 0x52, 0x4a, 0x15, 0x3f, 0x1b, 0x03,
-		0xa9, 0xb7, 0xd4, 0xed, 0x31, 0x33, 0xf6, 0x07, 0x69, 0x66, 0xf1, 0x33, 0x31, 0xc8, 0x47, 0xeb,
-		0xdb, 0x1e, 0xf3, 0x1e, 0xfe, 0x03, 0x35, 0xef, 0x77, 0xe3, 0x30, 0x1d, 0xa9, 0x6a, 0xc7, 0xb5,
-		0xee, 0xc7, 0x61, 0x56, 0x6f, 0xe1, 0x8e, 0x6d, 0x79, 0xd8, 0xd4, 0x0e, 0x15, 0x03, 0xdf, 0xc4,
-		0x46, 0xa1, 0x48, 0x93, 0xc6, 0xb9, 0xa3, 0xeb, 0xe6, 0x95, 0xb5, 0x00, 0xb7, 0x4e, 0x60, 0xa5,
-		0xb9, 0xb5, 0x5a, 0x7d, 0xa3, 0xb1, 0xb5, 0x5d, 0xdf, 0xac, 0xbe, 0xae, 0xec, 0x6c, 0x5e, 0xdf,
-		0xdc, 0x7a, 0x75, 0x53, 0x96, 0xf4, 0x1e, 0xb5, 0x0f, 0x71, 0xda, 0x37, 0x40, 0xea, 0x35, 0x0a,
-		0x9d, 0x82, 0x41, 0x66, 0x49, 0x13, 0x68, 0x0e, 0x66, 0x36, 0xb7, 0x94, 0xe6, 0x5a, 0xad, 0xae,
-		0xd4, 0xaf, 0x5e, 0xad, 0x57, 0xb7, 0x9b, 0xec, 0x24, 0xc4, 0xd7, 0xde, 0x8e, 0x4c, 0xf0, 0xe2,
-		0xa7, 0x13, 0x30, 0x37, 0xc0, 0x12, 0x54, 0xe6, 0x7b, 0x18, 0xb6, 0xad, 0x7a, 0x66, 0x1c, 0xeb,
-		0x57, 0x48, 0x15, 0xd1, 0x50, 0x1d, 0x8f, 0x6f, 0x79, 0x9e, 0x00, 0xe2, 0x25, 0xd3, 0xd3, 0xf7,
-		0x74, 0xec, 0xf0, 0x13, 0x26, 0xb6, 0xb1, 0x99, 0x09, 0xe4, 0xec, 0x90, 0xe9, 0x69, 0x40, 0xb6,
-		0xe5, 0xea, 0x9e, 0x7e, 0x13, 0x2b, 0xba, 0x29, 0x8e, 0xa3, 0xc8, 0x46, 0x27, 0x29, 0x4b, 0xa2,
-		0x65, 0xcd, 0xf4, 0x7c, 0x6d, 0x13, 0xb7, 0xd5, 0x1e, 0x6d, 0x92, 0xcc, 0x13, 0xb2, 0x24, 0x5a,
-		0x7c, 0xed, 0x87, 0x21, 0xd7, 0xb2, 0xba, 0xa4, 0xfa, 0x63, 0x7a, 0x64, 0xed, 0x88, 0xc9, 0x59,
-		0x26, 0xf3, 0x55, 0x78, 0x5d, 0x1f, 0x9c, 0x83, 0xe5, 0xe4, 0x2c, 0x93, 0x31, 0x95, 0x33, 0x30,
-		0xa3, 0xb6, 0xdb, 0x0e, 0x21, 0x17, 0x44, 0x6c, 0xa7, 0x92, 0xf7, 0xc5, 0x54, 0x71, 0xe1, 0x1a,
-		0xa4, 0x85, 0x1f, 0xc8, 0xe2, 0x4d, 0x3c, 0xa1, 0xd8, 0x6c, 0xfb, 0x1d, 0x3f, 0x9b, 0x91, 0xd3,
-		0xa6, 0x68, 0x7c, 0x18, 0x72, 0xba, 0xab, 0x04, 0xc7, 0xfa, 0xf1, 0xe5, 0xf8, 0xd9, 0xb4, 0x9c,
-		0xd5, 0x5d, 0xff, 0x48, 0xb4, 0xf8, 0x85, 0x38, 0xe4, 0xa3, 0x8f, 0x25, 0x50, 0x0d, 0xd2, 0x86,
-		0xa5, 0xa9, 0x34, 0xb4, 0xd8, 0x33, 0xb1, 0xb3, 0x23, 0x9e, 0x64, 0xac, 0xac, 0x73, 0x7d, 0xd9,
-		0x47, 0x2e, 0xfc, 0xcb, 0x18, 0xa4, 0x85, 0x18, 0x9d, 0x84, 0xa4, 0xad, 0x7a, 0xfb, 0x94, 0x6e,
-		0xb2, 0x12, 0x97, 0x62, 0x32, 0xbd, 0x26, 0x72, 0xd7, 0x56, 0x4d, 0x1a, 0x02, 0x5c, 0x4e, 0xae,
-		0xc9, 0xb8, 0x1a, 0x58, 0x6d, 0xd1, 0x6d, 0x90, 0xd5, 0xe9, 0x60, 0xd3, 0x73, 0xc5, 0xb8, 0x72,
-		0x79, 0x95, 0x8b, 0xd1, 0x53, 0x30, 0xeb, 0x39, 0xaa, 0x6e, 0x44, 0x74, 0x93, 0x54, 0x57, 0x12,
-		0x0d, 0xbe, 0x72, 0x09, 0x4e, 0x0b, 0xde, 0x16, 0xf6, 0x54, 0x6d, 0x1f, 0xb7, 0x02, 0x50, 0x8a,
-		0x1e, 0x77, 0x9c, 0xe2, 0x0a, 0x35, 0xde, 0x2e, 0xb0, 0xc5, 0xdf, 0x88, 0xc1, 0xac, 0xd8, 0xb8,
-		0xb5, 0x7c, 0x67, 0x6d, 0x00, 0xa8, 0xa6, 0x69, 0x79, 0x61, 0x77, 0xf5, 0x87, 0x72, 0x1f, 0x6e,
-		0xa5, 0xec, 0x83, 0xe4, 0x10, 0xc1, 0x42, 0x07, 0x20, 0x68, 0x19, 0xea, 0xb6, 0x25, 0xc8, 0xf2,
-		0x67, 0x4e, 0xf4, 0xc1, 0x25, 0xdb, 0xea, 0x03, 0x13, 0x91, 0x1d, 0x1e, 0x9a, 0x87, 0xc9, 0x5d,
-		0xdc, 0xd6, 0x4d, 0x7e, 0x92, 0xcc, 0x2e, 0xc4, 0x81, 0x4c, 0xd2, 0x3f, 0x90, 0xa9, 0xfc, 0x09,
-		0x98, 0xd3, 0xac, 0x4e, 0xaf, 0xb9, 0x15, 0xa9, 0xe7, 0xb8, 0xc1, 0x7d, 0x39, 0xf6, 0xc6, 0x33,
-		0x5c, 0xa9, 0x6d, 0x19, 0xaa, 0xd9, 0x5e, 0xb1, 0x9c, 0x76, 0xf0, 0xe0, 0x95, 0x54, 0x3c, 0x6e,
-		0xe8, 0xf1, 0xab, 0xbd, 0xfb, 0xbf, 0x62, 0xb1, 0x5f, 0x88, 0x27, 0x56, 0x1b, 0x95, 0x2f, 0xc6,
-		0x17, 0x56, 0x19, 0xb0, 0x21, 0x9c, 0x21, 0xe3, 0x3d, 0x03, 0x6b, 0xa4, 0x83, 0xf0, 0x9d, 0xa7,
-		0x60, 0xbe, 0x6d, 0xb5, 0x2d, 0xca, 0x74, 0x8e, 0xfc, 0xe2, 0x4f, 0x6e, 0x33, 0xbe, 0x74, 0x61,
-		0xe4, 0x63, 0xde, 0xd2, 0x26, 0xcc, 0x71, 0x65, 0x85, 0x3e, 0x3a, 0x62, 0x1b, 0x1b, 0x74, 0xe4,
-		0xa9, 0x5a, 0xe1, 0x97, 0xbf, 0x4d, 0x97, 0x6f, 0x79, 0x96, 0x43, 0x49, 0x1b, 0xdb, 0xfb, 0x94,
-		0x64, 0x38, 0x11, 0xe1, 0x63, 0x93, 0x14, 0x3b, 0x23, 0x18, 0xff, 0x19, 0x67, 0x9c, 0x0b, 0x31,
-		0x36, 0x39, 0xb4, 0x54, 0x85, 0xe9, 0xe3, 0x70, 0xfd, 0x73, 0xce, 0x95, 0xc3, 0x61, 0x92, 0x55,
-		0x98, 0xa1, 0x24, 0x5a, 0xd7, 0xf5, 0xac, 0x0e, 0xcd, 0x80, 0x47, 0xd3, 0xfc, 0x8b, 0x6f, 0xb3,
-		0x59, 0x93, 0x27, 0xb0, 0xaa, 0x8f, 0x2a, 0x95, 0x80, 0x3e, 0x2d, 0x6b, 0x61, 0xcd, 0x18, 0xc1,
-		0xf0, 0x75, 0x6e, 0x88, 0xaf, 0x5f, 0xba, 0x01, 0xf3, 0xe4, 0x37, 0x4d, 0x50, 0x61, 0x4b, 0x46,
-		0x1f, 0xc1, 0x15, 0x7e, 0xe3, 0x63, 0x6c, 0x62, 0xce, 0xf9, 0x04, 0x21, 0x9b, 0x42, 0xa3, 0xd8,
-		0xc6, 0x9e, 0x87, 0x1d, 0x57, 0x51, 0x8d, 0x41, 0xe6, 0x85, 0xce, 0x30, 0x0a, 0x3f, 0xf7, 0xdd,
-		0xe8, 0x28, 0xae, 0x32, 0x64, 0xd9, 0x30, 0x4a, 0x3b, 0x70, 0x6a, 0x40, 0x54, 0x8c, 0xc1, 0xf9,
-		0x69, 0xce, 0x39, 0xdf, 0x17, 0x19, 0x84, 0xb6, 0x01, 0x42, 0xee, 0x8f, 0xe5, 0x18, 0x9c, 0x3f,
-		0xcf, 0x39, 0x11, 0xc7, 0x8a, 0x21, 0x25, 0x8c, 0xd7, 0x60, 0xf6, 0x26, 0x76, 0x76, 0x2d, 0x97,
-		0x9f, 0x1b, 0x8d, 0x41, 0xf7, 0x19, 0x4e, 0x37, 0xc3, 0x81, 0xf4, 0x20, 0x89, 0x70, 0x5d, 0x86,
-		0xf4, 0x9e, 0xaa, 0xe1, 0x31, 0x28, 0xee, 0x72, 0x8a, 0x29, 0xa2, 0x4f, 0xa0, 0x65, 0xc8, 0xb5,
-		0x2d, 0xbe, 0x46, 0x8d, 0x86, 0x7f, 0x96, 0xc3, 0xb3, 0x02, 0xc3, 0x29, 0x6c, 0xcb, 0xee, 0x1a,
-		0x64, 0x01, 0x1b, 0x4d, 0xf1, 0x97, 0x05, 0x85, 0xc0, 0x70, 0x8a, 0x63, 0xb8, 0xf5, 0x1d, 0x41,
-		0xe1, 0x86, 0xfc, 0xf9, 0x12, 0x64, 0x2d, 0xd3, 0x38, 0xb4, 0xcc, 0x71, 0x8c, 0xf8, 0x1c, 0x67,
-		0x00, 0x0e, 0x21, 0x04, 0x57, 0x20, 0x33, 0xee, 0x40, 0xfc, 0x95, 0xef, 0x8a, 0xe9, 0x21, 0x46,
-		0x60, 0x15, 0x66, 0x44, 0x82, 0xd2, 0x2d, 0x73, 0x0c, 0x8a, 0xbf, 0xca, 0x29, 0xf2, 0x21, 0x18,
-		0xef, 0x86, 0x87, 0x5d, 0xaf, 0x8d, 0xc7, 0x21, 0xf9, 0x82, 0xe8, 0x06, 0x87, 0x70, 0x57, 0xee,
-		0x62, 0x53, 0xdb, 0x1f, 0x8f, 0xe1, 0x97, 0x84, 0x2b, 0x05, 0x86, 0x50, 0x54, 0x61, 0xba, 0xa3,
-		0x3a, 0xee, 0xbe, 0x6a, 0x8c, 0x35, 0x1c, 0x7f, 0x8d, 0x73, 0xe4, 0x7c, 0x10, 0xf7, 0x48, 0xd7,
-		0x3c, 0x0e, 0xcd, 0x17, 0x85, 0x47, 0x42, 0x30, 0x3e, 0xf5, 0x5c, 0x8f, 0x1e, 0xb2, 0x1d, 0x87,
-		0xed, 0xaf, 0x8b, 0xa9, 0xc7, 0xb0, 0x1b, 0x61, 0xc6, 0x2b, 0x90, 0x71, 0xf5, 0xb7, 0xc6, 0xa2,
-		0xf9, 0x92, 0x18, 0x69, 0x0a, 0x20, 0xe0, 0xd7, 0xe1, 0xf4, 0xc0, 0x65, 0x62, 0x0c, 0xb2, 0xbf,
-		0xc1, 0xc9, 0x4e, 0x0e, 0x58, 0x2a, 0x78, 0x4a, 0x38, 0x2e, 0xe5, 0xdf, 0x14, 0x29, 0x01, 0xf7,
-		0x70, 0x35, 0xc8, 0xae, 0xc1, 0x55, 0xf7, 0x8e, 0xe7, 0xb5, 0xbf, 0x25, 0xbc, 0xc6, 0xb0, 0x11,
-		0xaf, 0x6d, 0xc3, 0x49, 0xce, 0x78, 0xbc, 0x71, 0xfd, 0xdb, 0x22, 0xb1, 0x32, 0xf4, 0x4e, 0x74,
-		0x74, 0x7f, 0x14, 0x16, 0x7c, 0x77, 0x8a, 0xf2, 0xd4, 0x55, 0x3a, 0xaa, 0x3d, 0x06, 0xf3, 0x2f,
-		0x73, 0x66, 0x91, 0xf1, 0xfd, 0xfa, 0xd6, 0xdd, 0x50, 0x6d, 0x42, 0xfe, 0x1a, 0x14, 0x04, 0x79,
-		0xd7, 0x74, 0xb0, 0x66, 0xb5, 0x4d, 0xfd, 0x2d, 0xdc, 0x1a, 0x83, 0xfa, 0x57, 0x7a, 0x86, 0x6a,
-		0x27, 0x04, 0x27, 0xcc, 0x6b, 0x20, 0xf9, 0xb5, 0x8a, 0xa2, 0x77, 0x6c, 0xcb, 0xf1, 0x46, 0x30,
-		0x7e, 0x59, 0x8c, 0x94, 0x8f, 0x5b, 0xa3, 0xb0, 0x52, 0x1d, 0xd8, 0x93, 0xe7, 0x71, 0x43, 0xf2,
-		0x2b, 0x9c, 0x68, 0x3a, 0x40, 0xf1, 0xc4, 0xa1, 0x59, 0x1d, 0x5b, 0x75, 0xc6, 0xc9, 0x7f, 0x7f,
-		0x47, 0x24, 0x0e, 0x0e, 0xe1, 0x89, 0x83, 0x54, 0x74, 0x64, 0xb5, 0x1f, 0x83, 0xe1, 0xab, 0x22,
-		0x71, 0x08, 0x0c, 0xa7, 0x10, 0x05, 0xc3, 0x18, 0x14, 0x7f, 0x57, 0x50, 0x08, 0x0c, 0xa1, 0x78,
-		0x25, 0x58, 0x68, 0x1d, 0xdc, 0xd6, 0x5d, 0xcf, 0x61, 0x45, 0xf1, 0xd1, 0x54, 0x7f, 0xef, 0xbb,
-		0xd1, 0x22, 0x4c, 0x0e, 0x41, 0x49, 0x26, 0xe2, 0xc7, 0xae, 0x74, 0xcf, 0x34, 0xda, 0xb0, 0x5f,
-		0x15, 0x99, 0x28, 0x04, 0x23, 0xb6, 0x85, 0x2a, 0x44, 0xe2, 0x76, 0x8d, 0xec, 0x14, 0xc6, 0xa0,
-		0xfb, 0xfb, 0x3d, 0xc6, 0x35, 0x05, 0x96, 0x70, 0x86, 0xea, 0x9f, 0xae, 0x79, 0x80, 0x0f, 0xc7,
-		0x8a, 0xce, 0x5f, 0xeb, 0xa9, 0x7f, 0x76, 0x18, 0x92, 0xe5, 0x90, 0x99, 0x9e, 0x7a, 0x0a, 0x8d,
-		0x7a, 0xcf, 0xa8, 0xf0, 0x93, 0x1f, 0xf0, 0xfe, 0x46, 0xcb, 0xa9, 0xd2, 0x3a, 0x09, 0xf2, 0x68,
-		0xd1, 0x33, 0x9a, 0xec, 0x63, 0x1f, 0xf8, 0x71, 0x1e, 0xa9, 0x79, 0x4a, 0x57, 0x61, 0x3a, 0x52,
-		0xf0, 0x8c, 0xa6, 0xfa, 0x93, 0x9c, 0x2a, 0x17, 0xae, 0x77, 0x4a, 0x17, 0x20, 0x49, 0x8a, 0x97,
-		0xd1, 0xf0, 0x3f, 0xc5, 0xe1, 0x54, 0xbd, 0xf4, 0x11, 0x48, 0x8b, 0xa2, 0x65, 0x34, 0xf4, 0x4f,
-		0x73, 0xa8, 0x0f, 0x21, 0x70, 0x51, 0xb0, 0x8c, 0x86, 0xff, 0x19, 0x01, 0x17, 0x10, 0x02, 0x1f,
-		0xdf, 0x85, 0x5f, 0xfb, 0xb3, 0x49, 0xbe, 0xe8, 0x08, 0xdf, 0x5d, 0x81, 0x29, 0x5e, 0xa9, 0x8c,
-		0x46, 0x7f, 0x82, 0xdf, 0x5c, 0x20, 0x4a, 0x97, 0x60, 0x72, 0x4c, 0x87, 0xff, 0x39, 0x0e, 0x65,
-		0xfa, 0xa5, 0x2a, 0x64, 0x43, 0xd5, 0xc9, 0x68, 0xf8, 0x4f, 0x71, 0x78, 0x18, 0x45, 0x4c, 0xe7,
-		0xd5, 0xc9, 0x68, 0x82, 0x3f, 0x2f, 0x4c, 0xe7, 0x08, 0xe2, 0x36, 0x51, 0x98, 0x8c, 0x46, 0x7f,
-		0x52, 0x78, 0x5d, 0x40, 0x4a, 0x2f, 0x41, 0xc6, 0x5f, 0x6c, 0x46, 0xe3, 0x7f, 0x9a, 0xe3, 0x03,
-		0x0c, 0xf1, 0x40, 0x68, 0xb1, 0x1b, 0x4d, 0xf1, 0x17, 0x84, 0x07, 0x42, 0x28, 0x32, 0x8d, 0x7a,
-		0x0b, 0x98, 0xd1, 0x4c, 0x3f, 0x23, 0xa6, 0x51, 0x4f, 0xfd, 0x42, 0x46, 0x93, 0xe6, 0xfc, 0xd1,
-		0x14, 0x7f, 0x51, 0x8c, 0x26, 0xd5, 0x27, 0x66, 0xf4, 0x56, 0x04, 0xa3, 0x39, 0x7e, 0x56, 0x98,
-		0xd1, 0x53, 0x10, 0x94, 0x1a, 0x80, 0xfa, 0xab, 0x81, 0xd1, 0x7c, 0x9f, 0xe2, 0x7c, 0xb3, 0x7d,
-		0xc5, 0x40, 0xe9, 0x55, 0x38, 0x39, 0xb8, 0x12, 0x18, 0xcd, 0xfa, 0x73, 0x1f, 0xf4, 0xec, 0xdd,
-		0xc2, 0x85, 0x40, 0x69, 0x3b, 0x58, 0x52, 0xc2, 0x55, 0xc0, 0x68, 0xda, 0x4f, 0x7f, 0x10, 0x4d,
-		0xdc, 0xe1, 0x22, 0xa0, 0x54, 0x06, 0x08, 0x16, 0xe0, 0xd1, 0x5c, 0x9f, 0xe1, 0x5c, 0x21, 0x10,
-		0x99, 0x1a, 0x7c, 0xfd, 0x1d, 0x8d, 0xbf, 0x2b, 0xa6, 0x06, 0x47, 0x90, 0xa9, 0x21, 0x96, 0xde,
-		0xd1, 0xe8, 0xcf, 0x8a, 0xa9, 0x21, 0x20, 0x24, 0xb2, 0x43, 0xab, 0xdb, 0x68, 0x86, 0xcf, 0x89,
-		0xc8, 0x0e, 0xa1, 0x4a, 0x9b, 0x30, 0xdb, 0xb7, 0x20, 0x8e, 0xa6, 0xfa, 0x05, 0x4e, 0x25, 0xf5,
-		0xae, 0x87, 0xe1, 0xc5, 0x8b, 0x2f, 0x86, 0xa3, 0xd9, 0x3e, 0xdf, 0xb3, 0x78, 0xf1, 0xb5, 0xb0,
-		0x74, 0x05, 0xd2, 0x66, 0xd7, 0x30, 0xc8, 0xe4, 0x41, 0x47, 0xbf, 0x1b, 0x58, 0xf8, 0xcf, 0xdf,
-		0xe7, 0xde, 0x11, 0x80, 0xd2, 0x05, 0x98, 0xc4, 0x9d, 0x5d, 0xdc, 0x1a, 0x85, 0xfc, 0xce, 0xf7,
-		0x45, 0xc2, 0x24, 0xda, 0xa5, 0x97, 0x00, 0xd8, 0xd1, 0x08, 0x7d, 0x18, 0x38, 0x02, 0xfb, 0x5f,
-		0xbe, 0xcf, 0x5f, 0xc6, 0x09, 0x20, 0x01, 0x01, 0x7b, 0xb5, 0xe7, 0x68, 0x82, 0xef, 0x46, 0x09,
-		0xe8, 0x88, 0x5c, 0x86, 0xa9, 0x37, 0x5d, 0xcb, 0xf4, 0xd4, 0xf6, 0x28, 0xf4, 0x7f, 0xe5, 0x68,
-		0xa1, 0x4f, 0x1c, 0xd6, 0xb1, 0x1c, 0xec, 0xa9, 0x6d, 0x77, 0x14, 0xf6, 0xbf, 0x71, 0xac, 0x0f,
-		0x20, 0x60, 0x4d, 0x75, 0xbd, 0x71, 0xfa, 0xfd, 0x7b, 0x02, 0x2c, 0x00, 0xc4, 0x68, 0xf2, 0xfb,
-		0x00, 0x1f, 0x8e, 0xc2, 0xfe, 0xbe, 0x30, 0x9a, 0xeb, 0x97, 0x3e, 0x02, 0x19, 0xf2, 0x93, 0xbd,
-		0x61, 0x37, 0x02, 0xfc, 0xdf, 0x39, 0x38, 0x40, 0x90, 0x3b, 0xbb, 0x5e, 0xcb, 0xd3, 0x47, 0x3b,
-		0xfb, 0x7b, 0x7c, 0xa4, 0x85, 0x7e, 0xa9, 0x0c, 0x59, 0xd7, 0x6b, 0xb5, 0xba, 0xbc, 0x3e, 0x1d,
-		0x01, 0xff, 0x1f, 0xdf, 0xf7, 0x8f, 0x2c, 0x7c, 0x0c, 0x19, 0xed, 0x5b, 0x07, 0x9e, 0x6d, 0xd1,
-		0x07, 0x1e, 0xa3, 0x18, 0x3e, 0xe0, 0x0c, 0x21, 0x48, 0xa9, 0x0a, 0x39, 0xd2, 0x17, 0x07, 0xdb,
-		0x98, 0x3e, 0x9d, 0x1a, 0x41, 0xf1, 0x3f, 0xb9, 0x03, 0x22, 0xa0, 0xca, 0x8f, 0x7d, 0xfd, 0xbd,
-		0xc5, 0xd8, 0x37, 0xdf, 0x5b, 0x8c, 0xfd, 0xee, 0x7b, 0x8b, 0xb1, 0x4f, 0x7e, 0x6b, 0x71, 0xe2,
-		0x9b, 0xdf, 0x5a, 0x9c, 0xf8, 0xed, 0x6f, 0x2d, 0x4e, 0x0c, 0x3e, 0x25, 0x86, 0x55, 0x6b, 0xd5,
-		0x62, 0xe7, 0xc3, 0x6f, 0x14, 0xdb, 0xba, 0xb7, 0xdf, 0xdd, 0x5d, 0xd1, 0xac, 0x0e, 0x3d, 0xc6,
-		0x0d, 0x4e, 0x6b, 0xfd, 0x4d, 0x0e, 0xfc, 0x20, 0x46, 0x36, 0xcc, 0xd1, 0xb3, 0x5c, 0xd5, 0x3c,
-		0x1c, 0xf6, 0xad, 0xce, 0x45, 0x48, 0x94, 0xcd, 0x43, 0x74, 0x9a, 0x65, 0x37, 0xa5, 0xeb, 0x18,
-		0xfc, 0x1d, 0xaf, 0x29, 0x72, 0xbd, 0xe3, 0x18, 0x68, 0x3e, 0x78, 0x11, 0x33, 0x76, 0x36, 0xc7,
-		0xdf, 0xae, 0xac, 0xfc, 0x54, 0xec, 0x78, 0xdd, 0x48, 0x97, 0xcd, 0x43, 0xda, 0x8b, 0x46, 0xec,
-		0x8d, 0xa7, 0x47, 0x1e, 0x72, 0x1f, 0x98, 0xd6, 0x2d, 0x93, 0x98, 0x6d, 0xef, 0x8a, 0x03, 0xee,
-		0xc5, 0xde, 0x03, 0xee, 0x57, 0xb1, 0x61, 0x5c, 0x27, 0x7a, 0xdb, 0x04, 0xb2, 0x9b, 0x62, 0xaf,
-		0x13, 0xc3, 0xcf, 0xc4, 0x61, 0xb1, 0xef, 0x2c, 0x9b, 0x47, 0xc0, 0x30, 0x27, 0x94, 0x20, 0x5d,
-		0x13, 0x81, 0x55, 0x80, 0x29, 0x17, 0x6b, 0x96, 0xd9, 0x72, 0xa9, 0x23, 0x12, 0xb2, 0xb8, 0x24,
-		0x8e, 0x30, 0x55, 0xd3, 0x72, 0xf9, 0x5b, 0x92, 0xec, 0xa2, 0xf2, 0xf3, 0xc7, 0x74, 0xc4, 0xb4,
-		0xb8, 0x93, 0xf0, 0xc6, 0x73, 0x63, 0x7a, 0x43, 0x74, 0x22, 0x72, 0xec, 0x3f, 0xae, 0x57, 0x7e,
-		0x36, 0x0e, 0x4b, 0xbd, 0x5e, 0x21, 0xd3, 0xca, 0xf5, 0xd4, 0x8e, 0x3d, 0xcc, 0x2d, 0x57, 0x20,
-		0xb3, 0x2d, 0x74, 0x8e, 0xed, 0x97, 0xbb, 0xc7, 0xf4, 0x4b, 0xde, 0xbf, 0x95, 0x70, 0xcc, 0xf9,
-		0x31, 0x1d, 0xe3, 0xf7, 0xe3, 0x9e, 0x3c, 0xf3, 0xbf, 0x53, 0x70, 0x5a, 0xb3, 0xdc, 0x8e, 0xe5,
-		0x2a, 0xec, 0xf9, 0x08, 0xbb, 0xe0, 0x3e, 0xc9, 0x85, 0x9b, 0x46, 0x3f, 0x24, 0x29, 0x5e, 0x87,
-		0xb9, 0x35, 0x92, 0x2a, 0xc8, 0x16, 0x28, 0x78, 0xbc, 0x33, 0xf0, 0x45, 0xd2, 0xe5, 0x48, 0xb5,
-		0xcf, 0x1f, 0x2f, 0x85, 0x45, 0xc5, 0x9f, 0x8c, 0x81, 0xd4, 0xd4, 0x54, 0x43, 0x75, 0xfe, 0x5f,
-		0xa9, 0xd0, 0x25, 0x00, 0xfa, 0x01, 0x52, 0xf0, 0xc5, 0x50, 0xfe, 0x7c, 0x61, 0x25, 0xdc, 0xb9,
-		0x15, 0x76, 0x27, 0xfa, 0x39, 0x42, 0x86, 0xea, 0x92, 0x9f, 0x4f, 0xbe, 0x06, 0x10, 0x34, 0xa0,
-		0x07, 0xe0, 0x54, 0xb3, 0x5a, 0x5e, 0x2f, 0xcb, 0x0a, 0x7b, 0xb3, 0x7d, 0xb3, 0xd9, 0xa8, 0x57,
-		0xd7, 0xae, 0xae, 0xd5, 0x6b, 0xd2, 0x04, 0x3a, 0x09, 0x28, 0xdc, 0xe8, 0xbf, 0x94, 0x72, 0x02,
-		0x66, 0xc3, 0x72, 0xf6, 0x7a, 0x7c, 0x9c, 0x94, 0x89, 0x7a, 0xc7, 0x36, 0x30, 0x7d, 0xee, 0xa7,
-		0xe8, 0xc2, 0x6b, 0xa3, 0x2b, 0x90, 0x5f, 0xff, 0x57, 0xec, 0x95, 0xe9, 0xb9, 0x00, 0xee, 0xfb,
-		0xbc, 0xb4, 0x0e, 0xb3, 0xaa, 0xa6, 0x61, 0x3b, 0x42, 0x39, 0x22, 0x4f, 0x13, 0x42, 0xfa, 0x24,
-		0x93, 0x23, 0x03, 0xb6, 0x4b, 0x90, 0x72, 0x69, 0xef, 0x47, 0x51, 0x7c, 0x83, 0x53, 0x70, 0xf5,
-		0x92, 0x09, 0xb3, 0xa4, 0xec, 0x53, 0x1d, 0x1c, 0x32, 0xe3, 0xe8, 0x43, 0x86, 0x7f, 0xf0, 0xe5,
-		0x67, 0xe9, 0x73, 0xcd, 0x87, 0xa3, 0xc3, 0x32, 0x20, 0x9c, 0x64, 0x89, 0x73, 0x07, 0x86, 0x62,
-		0xc8, 0x8b, 0xfb, 0x71, 0x83, 0x8f, 0xbe, 0xd9, 0x3f, 0xe4, 0x37, 0x5b, 0x1c, 0x14, 0x03, 0xa1,
-		0x3b, 0x4d, 0x73, 0x56, 0xd6, 0x50, 0xa9, 0x0f, 0x9b, 0xd3, 0x6f, 0x3c, 0x15, 0x5a, 0x9a, 0x18,
-		0x25, 0xff, 0xf3, 0x0c, 0x65, 0xbe, 0x12, 0xbe, 0x8d, 0x3f, 0xf7, 0x7e, 0x2b, 0x01, 0x8b, 0x5c,
-		0x79, 0x57, 0x75, 0xf1, 0xb9, 0x9b, 0xcf, 0xed, 0x62, 0x4f, 0x7d, 0xee, 0x9c, 0x66, 0xe9, 0x22,
-		0x57, 0xcf, 0xf1, 0xe9, 0x48, 0xda, 0x57, 0x78, 0xfb, 0xc2, 0xc0, 0xa7, 0x99, 0x0b, 0xc3, 0xa7,
-		0x71, 0x71, 0x07, 0x92, 0x55, 0x4b, 0x37, 0x49, 0xaa, 0x6a, 0x61, 0xd3, 0xea, 0xf0, 0xd9, 0xc3,
-		0x2e, 0xd0, 0x73, 0x90, 0x52, 0x3b, 0x56, 0xd7, 0xf4, 0xd8, 0xcc, 0xa9, 0x9c, 0xfe, 0xfa, 0xbb,
-		0x4b, 0x13, 0xff, 0xfa, 0xdd, 0xa5, 0xc4, 0x9a, 0xe9, 0xfd, 0xe6, 0x57, 0x9e, 0x01, 0x4e, 0xb5,
-		0x66, 0x7a, 0x32, 0x57, 0x2c, 0x25, 0xdf, 0x7f, 0x67, 0x29, 0x56, 0x7c, 0x0d, 0xa6, 0x6a, 0x58,
-		0xbb, 0x17, 0xe6, 0x1a, 0xd6, 0x42, 0xcc, 0x35, 0xac, 0xf5, 0x30, 0x5f, 0x82, 0xf4, 0x9a, 0xe9,
-		0xb1, 0xb7, 0xd0, 0x9f, 0x82, 0x84, 0x6e, 0xb2, 0x17, 0x1b, 0x8f, 0xb4, 0x8d, 0x68, 0x11, 0x60,
-		0x0d, 0x6b, 0x3e, 0xb0, 0x85, 0xb5, 0x5e, 0x60, 0xff, 0xad, 0x89, 0x56, 0xa5, 0xf6, 0xdb, 0xff,
-		0x61, 0x71, 0xe2, 0xed, 0xf7, 0x16, 0x27, 0x86, 0x0e, 0x71, 0x71, 0xe8, 0x10, 0xbb, 0xad, 0x03,
-		0x96, 0x91, 0xfd, 0x91, 0xfd, 0x62, 0x12, 0x1e, 0xa2, 0x1f, 0x27, 0x39, 0x1d, 0xdd, 0xf4, 0xce,
-		0x69, 0xce, 0xa1, 0xed, 0xd1, 0x72, 0xc5, 0xda, 0xe3, 0x03, 0x3b, 0x1b, 0x34, 0xaf, 0xb0, 0xe6,
-		0xc1, 0xc3, 0x5a, 0xdc, 0x83, 0xc9, 0x06, 0xc1, 0x11, 0x17, 0x7b, 0x96, 0xa7, 0x1a, 0x7c, 0xfd,
-		0x61, 0x17, 0x44, 0xca, 0x3e, 0x68, 0x8a, 0x33, 0xa9, 0x2e, 0xbe, 0x65, 0x32, 0xb0, 0xba, 0xc7,
-		0xde, 0x0b, 0x4f, 0xd0, 0xc2, 0x25, 0x4d, 0x04, 0xf4, 0x15, 0xf0, 0x79, 0x98, 0x54, 0xbb, 0xec,
-		0x05, 0x86, 0x04, 0xa9, 0x68, 0xe8, 0x45, 0xf1, 0x3a, 0x4c, 0xf1, 0xc7, 0xa8, 0x48, 0x82, 0xc4,
-		0x01, 0x3e, 0xa4, 0xf7, 0xc9, 0xc9, 0xe4, 0x27, 0x5a, 0x81, 0x49, 0x6a, 0x3c, 0xff, 0xe0, 0xa5,
-		0xb0, 0xd2, 0x67, 0xfd, 0x0a, 0x35, 0x52, 0x66, 0x6a, 0xc5, 0x6b, 0x90, 0xae, 0x59, 0x1d, 0xdd,
-		0xb4, 0xa2, 0x6c, 0x19, 0xc6, 0x46, 0x6d, 0xb6, 0xbb, 0x3c, 0x2a, 0x64, 0x76, 0x81, 0x4e, 0x42,
-		0x8a, 0x7d, 0x27, 0xc0, 0x5f, 0xc2, 0xe0, 0x57, 0xc5, 0x2a, 0x4c, 0x51, 0xee, 0x2d, 0x9b, 0x24,
-		0x7f, 0xff, 0x95, 0xcc, 0x0c, 0xff, 0x6a, 0x8c, 0xd3, 0xc7, 0x03, 0x63, 0x11, 0x24, 0x5b, 0xaa,
-		0xa7, 0xf2, 0x7e, 0xd3, 0xdf, 0xc5, 0x8f, 0x42, 0x9a, 0x93, 0xb8, 0xe8, 0x3c, 0x24, 0x2c, 0xdb,
-		0xe5, 0xaf, 0x51, 0x2c, 0x0c, 0xeb, 0xca, 0x96, 0x5d, 0x49, 0x92, 0x98, 0x91, 0x89, 0x72, 0x45,
-		0x1e, 0x1a, 0x16, 0x2f, 0x86, 0xc2, 0x22, 0x34, 0xe4, 0xa1, 0x9f, 0x6c, 0x48, 0xfb, 0xc2, 0xc1,
-		0x0f, 0x96, 0xcf, 0xc5, 0x61, 0x31, 0xd4, 0x7a, 0x13, 0x3b, 0xae, 0x6e, 0x99, 0x2c, 0xa2, 0x78,
-		0xb4, 0xa0, 0x90, 0x91, 0xbc, 0x7d, 0x48, 0xb8, 0x7c, 0x04, 0x12, 0x65, 0xdb, 0x46, 0x0b, 0x90,
-		0xa6, 0xd7, 0x9a, 0xc5, 0xe2, 0x25, 0x29, 0xfb, 0xd7, 0xa4, 0xcd, 0xb5, 0xf6, 0xbc, 0x5b, 0xaa,
-		0xe3, 0x7f, 0x4a, 0x27, 0xae, 0x8b, 0x97, 0x21, 0x53, 0xb5, 0x4c, 0x17, 0x9b, 0x6e, 0x97, 0x56,
-		0x36, 0xbb, 0x86, 0xa5, 0x1d, 0x70, 0x06, 0x76, 0x41, 0x1c, 0xae, 0xda, 0x36, 0x45, 0x26, 0x65,
-		0xf2, 0x93, 0xcd, 0xd9, 0x4a, 0x73, 0xa8, 0x8b, 0x2e, 0x1f, 0xdf, 0x45, 0xbc, 0x93, 0xbe, 0x8f,
-		0x7e, 0x10, 0x83, 0x07, 0xfb, 0x27, 0xd4, 0x01, 0x3e, 0x74, 0x8f, 0x3b, 0x9f, 0x5e, 0x83, 0x4c,
-		0x83, 0x7e, 0xcf, 0x7e, 0x1d, 0x1f, 0xa2, 0x05, 0x98, 0xc2, 0xad, 0xf3, 0x17, 0x2e, 0x3c, 0x77,
-		0x99, 0x45, 0xfb, 0xcb, 0x13, 0xb2, 0x10, 0xa0, 0x45, 0xc8, 0xb8, 0x58, 0xb3, 0xcf, 0x5f, 0xb8,
-		0x78, 0xf0, 0x1c, 0x0b, 0xaf, 0x97, 0x27, 0xe4, 0x40, 0x54, 0x4a, 0x93, 0x5e, 0xbf, 0xff, 0xb9,
-		0xa5, 0x58, 0x65, 0x12, 0x12, 0x6e, 0xb7, 0xf3, 0xa1, 0xc6, 0xc8, 0xa7, 0x27, 0x61, 0x39, 0x8c,
-		0xa4, 0xf5, 0xdf, 0x4d, 0xd5, 0xd0, 0x5b, 0x6a, 0xf0, 0x9f, 0x08, 0xa4, 0x90, 0x0f, 0xa8, 0xc6,
-		0x90, 0x95, 0xe2, 0x48, 0x4f, 0x16, 0x7f, 0x25, 0x06, 0xb9, 0x1b, 0x82, 0xb9, 0x89, 0x3d, 0x74,
-		0x05, 0xc0, 0xbf, 0x93, 0x98, 0x36, 0x0f, 0xac, 0xf4, 0xde, 0x6b, 0xc5, 0xc7, 0xc8, 0x21, 0x75,
-		0x74, 0x89, 0x06, 0xa2, 0x6d, 0xb9, 0xfc, 0xf3, 0xaa, 0x11, 0x50, 0x5f, 0x19, 0x3d, 0x0d, 0x88,
-		0x66, 0x38, 0xe5, 0xa6, 0xe5, 0xe9, 0x66, 0x5b, 0xb1, 0xad, 0x5b, 0xfc, 0xa3, 0xd5, 0x84, 0x2c,
-		0xd1, 0x96, 0x1b, 0xb4, 0xa1, 0x41, 0xe4, 0xc4, 0xe8, 0x8c, 0xcf, 0x42, 0x8a, 0x75, 0xb5, 0xd5,
-		0x72, 0xb0, 0xeb, 0xf2, 0x24, 0x26, 0x2e, 0xd1, 0x15, 0x98, 0xb2, 0xbb, 0xbb, 0x8a, 0xc8, 0x18,
-		0xd9, 0xf3, 0x0f, 0x0e, 0x9a, 0xff, 0x22, 0x3e, 0x78, 0x06, 0x48, 0xd9, 0xdd, 0x5d, 0x12, 0x2d,
-		0x0f, 0x43, 0x6e, 0x80, 0x31, 0xd9, 0x9b, 0x81, 0x1d, 0xf4, 0xdf, 0x28, 0xf0, 0x1e, 0x28, 0xb6,
-		0xa3, 0x5b, 0x8e, 0xee, 0x1d, 0xd2, 0x77, 0xa1, 0x12, 0xb2, 0x24, 0x1a, 0x1a, 0x5c, 0x5e, 0x3c,
-		0x80, 0x99, 0x26, 0x2d, 0xe2, 0x02, 0xcb, 0x2f, 0x04, 0xf6, 0xc5, 0x46, 0xdb, 0x37, 0xd4, 0xb2,
-		0x78, 0x9f, 0x65, 0x95, 0x57, 0x86, 0x46, 0xe7, 0xa5, 0xe3, 0x47, 0x67, 0x74, 0xb5, 0xfb, 0xbd,
-		0xd3, 0x91, 0xc9, 0xc9, 0x82, 0x33, 0x9c, 0xbe, 0xc6, 0x0d, 0xcc, 0x51, 0x7b, 0xb4, 0x85, 0xa3,
-		0x17, 0xd5, 0x85, 0x11, 0x69, 0x74, 0x61, 0xe4, 0x14, 0x2a, 0x5e, 0x86, 0xe9, 0x86, 0xea, 0x78,
-		0x4d, 0xec, 0xbd, 0x8c, 0xd5, 0x16, 0x76, 0xa2, 0xab, 0xee, 0xb4, 0x58, 0x75, 0x11, 0x24, 0xe9,
-		0xd2, 0xca, 0x56, 0x1d, 0xfa, 0xbb, 0xb8, 0x0f, 0x49, 0xfa, 0x3e, 0xa4, 0xbf, 0x22, 0x73, 0x04,
-		0x5b, 0x91, 0x49, 0x2e, 0x3d, 0xf4, 0xb0, 0x2b, 0x8e, 0x11, 0xe8, 0x05, 0x7a, 0x41, 0xac, 0xab,
-		0x89, 0xa3, 0xd7, 0x55, 0x1e, 0x88, 0x7c, 0x75, 0x35, 0x60, 0xaa, 0x42, 0x52, 0xf1, 0x5a, 0xcd,
-		0x37, 0x24, 0x16, 0x18, 0x82, 0x36, 0x60, 0xc6, 0x56, 0x1d, 0x8f, 0x7e, 0x1a, 0xb2, 0x4f, 0x7b,
-		0xc1, 0x63, 0x7d, 0xa9, 0x7f, 0xe6, 0x45, 0x3a, 0xcb, 0xef, 0x32, 0x6d, 0x87, 0x85, 0xc5, 0xff,
-		0x98, 0x84, 0x14, 0x77, 0xc6, 0x47, 0x60, 0x8a, 0xbb, 0x95, 0x47, 0xe7, 0x43, 0x2b, 0xfd, 0x0b,
-		0xd3, 0x8a, 0xbf, 0x80, 0x70, 0x3e, 0x81, 0x41, 0x8f, 0x43, 0x5a, 0xdb, 0x57, 0x75, 0x53, 0xd1,
-		0x5b, 0xbc, 0x20, 0xcc, 0xbe, 0xf7, 0xee, 0xd2, 0x54, 0x95, 0xc8, 0xd6, 0x6a, 0xf2, 0x14, 0x6d,
-		0x5c, 0x6b, 0x91, 0x4a, 0x60, 0x1f, 0xeb, 0xed, 0x7d, 0x8f, 0xcf, 0x30, 0x7e, 0x85, 0x5e, 0x84,
-		0x24, 0x09, 0x08, 0xfe, 0xe1, 0xe0, 0x42, 0x5f, 0x85, 0xef, 0x6f, 0xa1, 0x2b, 0x69, 0x72, 0xe3,
-		0x4f, 0xfe, 0xfb, 0xa5, 0x98, 0x4c, 0x11, 0xa8, 0x0a, 0xd3, 0x86, 0xea, 0x7a, 0x0a, 0x5d, 0xc1,
-		0xc8, 0xed, 0x27, 0x29, 0xc5, 0xe9, 0x7e, 0x87, 0x70, 0xc7, 0x72, 0xd3, 0xb3, 0x04, 0xc5, 0x44,
-		0x2d, 0x74, 0x16, 0x24, 0x4a, 0xa2, 0x59, 0x9d, 0x8e, 0xee, 0xb1, 0xda, 0x2a, 0x45, 0xfd, 0x9e,
-		0x27, 0xf2, 0x2a, 0x15, 0xd3, 0x0a, 0xeb, 0x01, 0xc8, 0xd0, 0x4f, 0x95, 0xa8, 0x0a, 0x7b, 0x09,
-		0x37, 0x4d, 0x04, 0xb4, 0xf1, 0x0c, 0xcc, 0x04, 0xf9, 0x91, 0xa9, 0xa4, 0x19, 0x4b, 0x20, 0xa6,
-		0x8a, 0xcf, 0xc2, 0xbc, 0x89, 0x6f, 0xd3, 0xd7, 0x82, 0x23, 0xda, 0x19, 0xaa, 0x8d, 0x48, 0xdb,
-		0x8d, 0x28, 0xe2, 0x31, 0xc8, 0x6b, 0xc2, 0xf9, 0x4c, 0x17, 0xa8, 0xee, 0xb4, 0x2f, 0xa5, 0x6a,
-		0xa7, 0x21, 0xad, 0xda, 0x36, 0x53, 0xc8, 0xf2, 0xfc, 0x68, 0xdb, 0xb4, 0xe9, 0x49, 0x98, 0xa5,
-		0x7d, 0x74, 0xb0, 0xdb, 0x35, 0x3c, 0x4e, 0x92, 0xa3, 0x3a, 0x33, 0xa4, 0x41, 0x66, 0x72, 0xaa,
-		0xfb, 0x08, 0x4c, 0xe3, 0x9b, 0x7a, 0x0b, 0x9b, 0x1a, 0x66, 0x7a, 0xd3, 0x54, 0x2f, 0x27, 0x84,
-		0x54, 0xe9, 0x09, 0xf0, 0xf3, 0x9e, 0x22, 0x72, 0x72, 0x9e, 0xf1, 0x09, 0x79, 0x99, 0x89, 0x8b,
-		0x05, 0x48, 0xd6, 0x54, 0x4f, 0x25, 0x05, 0x86, 0x77, 0x9b, 0x2d, 0x34, 0x39, 0x99, 0xfc, 0x2c,
-		0xbe, 0x1f, 0x87, 0xe4, 0x0d, 0xcb, 0xc3, 0xe8, 0xf9, 0x50, 0x01, 0x98, 0x1f, 0x14, 0xcf, 0x4d,
-		0xbd, 0x6d, 0xe2, 0xd6, 0x86, 0xdb, 0x0e, 0xfd, 0x5f, 0x81, 0x20, 0x9c, 0xe2, 0x91, 0x70, 0x9a,
-		0x87, 0x49, 0xc7, 0xea, 0x9a, 0x2d, 0xf1, 0xfe, 0x2a, 0xbd, 0x40, 0x75, 0x48, 0xfb, 0x51, 0x92,
-		0x1c, 0x15, 0x25, 0x33, 0x24, 0x4a, 0x48, 0x0c, 0x73, 0x81, 0x3c, 0xb5, 0xcb, 0x83, 0xa5, 0x02,
-		0x19, 0x3f, 0x79, 0xf1, 0x68, 0x1b, 0x2f, 0x60, 0x03, 0x18, 0x59, 0x4c, 0xfc, 0xb1, 0xf7, 0x9d,
-		0xc7, 0x22, 0x4e, 0xf2, 0x1b, 0xb8, 0xf7, 0x22, 0x61, 0xc5, 0xff, 0xc7, 0xc1, 0x14, 0xed, 0x57,
-		0x10, 0x56, 0xec, 0xff, 0x1c, 0x3c, 0x08, 0x19, 0x57, 0x6f, 0x9b, 0xaa, 0xd7, 0x75, 0x30, 0x8f,
-		0xbc, 0x40, 0x50, 0xfc, 0x5a, 0x0c, 0x52, 0x2c, 0x92, 0x43, 0x7e, 0x8b, 0x0d, 0xf6, 0x5b, 0x7c,
-		0x98, 0xdf, 0x12, 0xf7, 0xee, 0xb7, 0x32, 0x80, 0x6f, 0x8c, 0xcb, 0x3f, 0x3d, 0x1f, 0x50, 0x31,
-		0x30, 0x13, 0x9b, 0x7a, 0x9b, 0x4f, 0xd4, 0x10, 0xa8, 0xf8, 0xef, 0x62, 0xa4, 0x88, 0xe5, 0xed,
-		0xa8, 0x0c, 0xd3, 0xc2, 0x2e, 0x65, 0xcf, 0x50, 0xdb, 0x3c, 0x76, 0x1e, 0x1a, 0x6a, 0xdc, 0x55,
-		0x43, 0x6d, 0xcb, 0x59, 0x6e, 0x0f, 0xb9, 0x18, 0x3c, 0x0e, 0xf1, 0x21, 0xe3, 0x10, 0x19, 0xf8,
-		0xc4, 0xbd, 0x0d, 0x7c, 0x64, 0x88, 0x92, 0xbd, 0x43, 0xf4, 0xe5, 0x38, 0xdd, 0xcc, 0xd8, 0x96,
-		0xab, 0x1a, 0x3f, 0x8c, 0x19, 0xf1, 0x00, 0x64, 0x6c, 0xcb, 0x50, 0x58, 0x0b, 0x7b, 0xaf, 0x3b,
-		0x6d, 0x5b, 0x86, 0xdc, 0x37, 0xec, 0x93, 0xf7, 0x69, 0xba, 0xa4, 0xee, 0x83, 0xd7, 0xa6, 0x7a,
-		0xbd, 0xe6, 0x40, 0x8e, 0xb9, 0x82, 0xaf, 0x65, 0xcf, 0x12, 0x1f, 0xd0, 0xc5, 0x31, 0xd6, 0xbf,
-		0xf6, 0x32, 0xb3, 0x99, 0xa6, 0xcc, 0xf5, 0x08, 0x82, 0xa5, 0xfe, 0x41, 0xbb, 0xe0, 0x70, 0x58,
-		0xca, 0x5c, 0xaf, 0xf8, 0x97, 0x62, 0x00, 0xeb, 0xc4, 0xb3, 0xb4, 0xbf, 0x64, 0x15, 0x72, 0xa9,
-		0x09, 0x4a, 0xe4, 0xce, 0x8b, 0xc3, 0x06, 0x8d, 0xdf, 0x3f, 0xe7, 0x86, 0xed, 0xae, 0xc2, 0x74,
-		0x10, 0x8c, 0x2e, 0x16, 0xc6, 0x2c, 0x1e, 0x51, 0x55, 0x37, 0xb1, 0x27, 0xe7, 0x6e, 0x86, 0xae,
-		0x8a, 0xff, 0x24, 0x06, 0x19, 0x6a, 0xd3, 0x06, 0xf6, 0xd4, 0xc8, 0x18, 0xc6, 0xee, 0x7d, 0x0c,
-		0x1f, 0x02, 0x60, 0x34, 0xae, 0xfe, 0x16, 0xe6, 0x91, 0x95, 0xa1, 0x92, 0xa6, 0xfe, 0x16, 0x46,
-		0x17, 0x7d, 0x87, 0x27, 0x8e, 0x76, 0xb8, 0xa8, 0xba, 0xb9, 0xdb, 0x4f, 0xc1, 0x14, 0xfd, 0x57,
-		0x4d, 0xb7, 0x5d, 0x5e, 0x48, 0xa7, 0xcc, 0x6e, 0x67, 0xfb, 0xb6, 0x5b, 0x7c, 0x13, 0xa6, 0xb6,
-		0x6f, 0xb3, 0xb3, 0x91, 0x07, 0x20, 0xe3, 0x58, 0x16, 0x5f, 0x93, 0x59, 0x2d, 0x94, 0x26, 0x02,
-		0xba, 0x04, 0x89, 0xf3, 0x80, 0x78, 0x70, 0x1e, 0x10, 0x1c, 0x68, 0x24, 0xc6, 0x3a, 0xd0, 0x78,
-		0xf2, 0xb7, 0x62, 0x90, 0x0d, 0xe5, 0x07, 0xf4, 0x1c, 0x9c, 0xa8, 0xac, 0x6f, 0x55, 0xaf, 0x2b,
-		0x6b, 0x35, 0xe5, 0xea, 0x7a, 0x79, 0x35, 0xf8, 0x72, 0x69, 0xe1, 0xe4, 0x9d, 0xbb, 0xcb, 0x28,
-		0xa4, 0xbb, 0x63, 0xd2, 0x73, 0x7a, 0x74, 0x0e, 0xe6, 0xa3, 0x90, 0x72, 0xa5, 0x59, 0xdf, 0xdc,
-		0x96, 0x62, 0x0b, 0x27, 0xee, 0xdc, 0x5d, 0x9e, 0x0d, 0x21, 0xca, 0xbb, 0x2e, 0x36, 0xbd, 0x7e,
-		0x40, 0x75, 0x6b, 0x63, 0x63, 0x6d, 0x5b, 0x8a, 0xf7, 0x01, 0x78, 0xc2, 0x7e, 0x02, 0x66, 0xa3,
-		0x80, 0xcd, 0xb5, 0x75, 0x29, 0xb1, 0x80, 0xee, 0xdc, 0x5d, 0xce, 0x87, 0xb4, 0x37, 0x75, 0x63,
-		0x21, 0xfd, 0xf1, 0xcf, 0x2f, 0x4e, 0xfc, 0xd2, 0x2f, 0x2e, 0xc6, 0x48, 0xcf, 0xa6, 0x23, 0x39,
-		0x02, 0x3d, 0x0d, 0xa7, 0x9a, 0x6b, 0xab, 0x9b, 0xf5, 0x9a, 0xb2, 0xd1, 0x5c, 0x15, 0x27, 0xdd,
-		0xa2, 0x77, 0x33, 0x77, 0xee, 0x2e, 0x67, 0x79, 0x97, 0x86, 0x69, 0x37, 0xe4, 0xfa, 0x8d, 0xad,
-		0xed, 0xba, 0x14, 0x63, 0xda, 0x0d, 0x07, 0xdf, 0xb4, 0x3c, 0xf6, 0xbf, 0xdc, 0x9e, 0x85, 0xd3,
-		0x03, 0xb4, 0xfd, 0x8e, 0xcd, 0xde, 0xb9, 0xbb, 0x3c, 0xdd, 0x70, 0x30, 0x9b, 0x3f, 0x14, 0xb1,
-		0x02, 0x85, 0x7e, 0xc4, 0x56, 0x63, 0xab, 0x59, 0x5e, 0x97, 0x96, 0x17, 0xa4, 0x3b, 0x77, 0x97,
-		0x73, 0x22, 0x19, 0x12, 0xfd, 0xa0, 0x67, 0x1f, 0xe6, 0x8e, 0xe7, 0xfd, 0xa7, 0xe0, 0x51, 0x7e,
-		0x06, 0xe8, 0x7a, 0xea, 0x81, 0x6e, 0xb6, 0xfd, 0xc3, 0x5b, 0x7e, 0xcd, 0x77, 0x3e, 0x27, 0xf9,
-		0x39, 0xa3, 0x90, 0x8e, 0x38, 0xc2, 0x1d, 0xfa, 0xe4, 0x72, 0x61, 0xc4, 0x43, 0xbd, 0xd1, 0x5b,
-		0xa7, 0xe1, 0xc7, 0xc3, 0x0b, 0x23, 0x0e, 0xa1, 0x17, 0x8e, 0xdc, 0xdc, 0x15, 0x3f, 0x11, 0x83,
-		0xfc, 0xcb, 0xba, 0xeb, 0x59, 0x8e, 0xae, 0xa9, 0x06, 0xfd, 0x5e, 0xe9, 0xe2, 0xb8, 0xb9, 0xb5,
-		0x67, 0xaa, 0xbf, 0x04, 0xa9, 0x9b, 0xaa, 0xc1, 0x92, 0x5a, 0xf8, 0x59, 0x40, 0xaf, 0xfb, 0x82,
-		0xd4, 0x26, 0x08, 0x18, 0xac, 0xf8, 0xa5, 0x38, 0xcc, 0xd0, 0xc9, 0xe0, 0xb2, 0x7f, 0xc5, 0x45,
-		0xf6, 0x58, 0x0d, 0x48, 0x3a, 0xaa, 0xc7, 0x0f, 0x0d, 0x2b, 0x3f, 0xc2, 0xcf, 0x81, 0x1f, 0x1f,
-		0x7d, 0x9a, 0xbb, 0xd2, 0x7f, 0x54, 0x4c, 0x99, 0xd0, 0xab, 0x90, 0xee, 0xa8, 0xb7, 0x15, 0xca,
-		0x1a, 0xbf, 0x0f, 0xac, 0x53, 0x1d, 0xf5, 0x36, 0xb1, 0x15, 0xb5, 0x60, 0x86, 0x10, 0x6b, 0xfb,
-		0xaa, 0xd9, 0xc6, 0x8c, 0x3f, 0x71, 0x1f, 0xf8, 0xa7, 0x3b, 0xea, 0xed, 0x2a, 0xe5, 0x24, 0x77,
-		0x29, 0xa5, 0x3f, 0xf5, 0xce, 0xd2, 0x04, 0x3d, 0x66, 0xff, 0xb5, 0x18, 0x40, 0xe0, 0x2e, 0xf4,
-		0xff, 0x83, 0xa4, 0xf9, 0x57, 0xf4, 0xf6, 0x2e, 0x1f, 0xc0, 0x33, 0xc3, 0x06, 0xa2, 0xc7, 0xd9,
-		0x6c, 0x61, 0xfe, 0xe6, 0xbb, 0x4b, 0x31, 0x79, 0x46, 0xeb, 0x19, 0x87, 0x3a, 0x64, 0xbb, 0x76,
-		0x4b, 0xf5, 0xb0, 0x42, 0x37, 0x71, 0xf1, 0x63, 0x2c, 0xf2, 0xc0, 0x80, 0xa4, 0x29, 0x64, 0xfd,
-		0x97, 0x62, 0x90, 0xad, 0x85, 0x1e, 0xf2, 0x15, 0x60, 0xaa, 0x63, 0x99, 0xfa, 0x01, 0x0f, 0xbb,
-		0x8c, 0x2c, 0x2e, 0xd1, 0x02, 0xa4, 0xd9, 0x97, 0x9a, 0xde, 0xa1, 0x38, 0xf1, 0x14, 0xd7, 0x04,
-		0x75, 0x0b, 0xef, 0xba, 0xba, 0xf0, 0xb5, 0x2c, 0x2e, 0xc9, 0xd6, 0xc5, 0xc5, 0x5a, 0xd7, 0xd1,
-		0xbd, 0x43, 0x45, 0xb3, 0x4c, 0x4f, 0xd5, 0x3c, 0xfe, 0xcd, 0xdf, 0x8c, 0x90, 0x57, 0x99, 0x98,
-		0x90, 0xb4, 0xb0, 0xa7, 0xea, 0x86, 0x5b, 0x60, 0x0f, 0xc2, 0xc4, 0x65, 0xc8, 0xdc, 0x5f, 0x4f,
-		0x85, 0x8f, 0xa8, 0xaa, 0x20, 0x59, 0x36, 0x76, 0x22, 0x25, 0x25, 0x8b, 0xd0, 0xc2, 0x6f, 0x7e,
-		0xe5, 0x99, 0x79, 0xee, 0x6e, 0x5e, 0x54, 0xb2, 0x97, 0x5a, 0xe5, 0x19, 0x81, 0x10, 0xb5, 0xe6,
-		0xeb, 0x64, 0xc0, 0xc4, 0x7e, 0xcf, 0xee, 0xee, 0x06, 0xc7, 0x5a, 0xf3, 0x7d, 0x7e, 0x2d, 0x9b,
-		0x87, 0x95, 0xc2, 0x37, 0x02, 0xea, 0xe0, 0x2c, 0xe9, 0x3a, 0x3e, 0x24, 0xa3, 0xc5, 0x79, 0x1a,
-		0x94, 0x86, 0x94, 0x88, 0x6f, 0xaa, 0xba, 0x21, 0x3e, 0x40, 0x97, 0xf9, 0x15, 0x2a, 0x41, 0xca,
-		0xf5, 0x54, 0xaf, 0xeb, 0xf2, 0x7f, 0x14, 0x57, 0x1c, 0x16, 0x19, 0x15, 0xcb, 0x6c, 0x35, 0xa9,
-		0xa6, 0xcc, 0x11, 0x68, 0x1b, 0x52, 0x9e, 0x75, 0x80, 0x4d, 0xee, 0xa4, 0x63, 0x45, 0xf5, 0x80,
-		0x67, 0x51, 0x8c, 0x0b, 0xb5, 0x41, 0x6a, 0x61, 0x03, 0xb7, 0x59, 0x41, 0xb4, 0xaf, 0x92, 0x7d,
-		0x43, 0xea, 0x3e, 0xcc, 0x9a, 0x19, 0x9f, 0xb5, 0x49, 0x49, 0xd1, 0xf5, 0xe8, 0x63, 0x66, 0xf6,
-		0x5f, 0x15, 0x1f, 0x19, 0xd6, 0xff, 0x50, 0x64, 0x8a, 0xc3, 0x84, 0xf0, 0x13, 0xe9, 0x27, 0x40,
-		0xea, 0x9a, 0xbb, 0x96, 0x49, 0x3f, 0x13, 0xe5, 0xc5, 0x78, 0x9a, 0x96, 0x37, 0x33, 0xbe, 0xfc,
-		0x65, 0x56, 0x95, 0x5f, 0x87, 0x7c, 0xa0, 0x4a, 0xe7, 0x4e, 0xe6, 0x18, 0x73, 0x67, 0xda, 0xc7,
-		0x92, 0x56, 0xf4, 0x32, 0x40, 0x30, 0x31, 0xe9, 0xf1, 0x40, 0x76, 0xf8, 0x18, 0x06, 0xb3, 0x5b,
-		0x6c, 0xb3, 0x02, 0x2c, 0x32, 0x60, 0xae, 0xa3, 0x9b, 0x8a, 0x8b, 0x8d, 0x3d, 0x85, 0xbb, 0x8a,
-		0x50, 0x66, 0xef, 0xc3, 0xd0, 0xce, 0x76, 0x74, 0xb3, 0x89, 0x8d, 0xbd, 0x9a, 0x4f, 0x5b, 0xca,
-		0x7d, 0xfc, 0x9d, 0xa5, 0x09, 0x3e, 0x97, 0x26, 0x8a, 0x0d, 0x7a, 0x44, 0xcd, 0xa7, 0x01, 0x76,
-		0xd1, 0x45, 0xc8, 0xa8, 0xe2, 0x82, 0x1e, 0x1c, 0x1c, 0x35, 0x8d, 0x02, 0x55, 0x36, 0x3b, 0xdf,
-		0xfe, 0xb7, 0xcb, 0xb1, 0xe2, 0x2f, 0xc6, 0x20, 0x55, 0xbb, 0xd1, 0x50, 0x75, 0x07, 0xd5, 0x61,
-		0x36, 0x08, 0xa8, 0x71, 0xe7, 0x66, 0x10, 0x83, 0x62, 0x72, 0xd6, 0x87, 0xed, 0x1a, 0x8f, 0xa4,
-		0xe9, 0xdd, 0x4f, 0xf6, 0x74, 0xbc, 0x0e, 0x53, 0xcc, 0x4a, 0x17, 0x95, 0x60, 0xd2, 0x26, 0x3f,
-		0xf8, 0x89, 0xfc, 0xe2, 0xd0, 0x40, 0xa4, 0xfa, 0xfe, 0x09, 0x22, 0x81, 0x14, 0x7f, 0x10, 0x03,
-		0xa8, 0xdd, 0xb8, 0xb1, 0xed, 0xe8, 0xb6, 0x81, 0xbd, 0xfb, 0xd5, 0xe3, 0x75, 0x38, 0x11, 0xda,
-		0x9a, 0x38, 0xda, 0xd8, 0xbd, 0x9e, 0x0b, 0x36, 0x27, 0x8e, 0x36, 0x90, 0xad, 0xe5, 0x7a, 0x3e,
-		0x5b, 0x62, 0x6c, 0xb6, 0x9a, 0xeb, 0x0d, 0x76, 0x63, 0x13, 0xb2, 0x41, 0xf7, 0x5d, 0x54, 0x83,
-		0xb4, 0xc7, 0x7f, 0x73, 0x6f, 0x16, 0x87, 0x7b, 0x53, 0xc0, 0xb8, 0x47, 0x7d, 0x64, 0xf1, 0xff,
-		0x10, 0xa7, 0xfa, 0x11, 0xfb, 0x87, 0x2b, 0x8c, 0x48, 0xee, 0xe5, 0xb9, 0xf1, 0x7e, 0x54, 0x14,
-		0x9c, 0xab, 0xc7, 0xab, 0x1f, 0x8b, 0xc3, 0xdc, 0x8e, 0xc8, 0x36, 0x7f, 0x68, 0x3d, 0xd1, 0x80,
-		0x29, 0x6c, 0x7a, 0x8e, 0x4e, 0x5d, 0x41, 0xc6, 0xfa, 0xd9, 0x61, 0x63, 0x3d, 0xa0, 0x2f, 0xf4,
-		0xff, 0x15, 0x89, 0x73, 0x6d, 0x4e, 0xd3, 0xe3, 0x85, 0x7f, 0x13, 0x87, 0xc2, 0x30, 0x24, 0x3a,
-		0x03, 0x33, 0x9a, 0x83, 0xa9, 0x40, 0x89, 0x1c, 0xae, 0xe5, 0x85, 0x98, 0x27, 0xfd, 0x0d, 0x20,
-		0x05, 0x14, 0x09, 0x2c, 0xa2, 0x7a, 0xec, 0x8a, 0x29, 0x1f, 0x80, 0x69, 0xda, 0xc7, 0x30, 0xa3,
-		0x9b, 0xba, 0xa7, 0xab, 0x86, 0xb2, 0xab, 0x1a, 0xaa, 0xa9, 0xdd, 0x4b, 0x65, 0xd9, 0x9f, 0xa8,
-		0xf3, 0x9c, 0xb4, 0xc2, 0x38, 0xd1, 0x0d, 0x98, 0x12, 0xf4, 0xc9, 0xfb, 0x40, 0x2f, 0xc8, 0x42,
-		0x55, 0xd4, 0xef, 0xc4, 0x61, 0x56, 0xc6, 0xad, 0x3f, 0x5a, 0x6e, 0xfd, 0x51, 0x00, 0x36, 0xe1,
-		0x48, 0x1e, 0xbc, 0x07, 0xcf, 0xf6, 0x4f, 0xe0, 0x0c, 0xe3, 0xab, 0xb9, 0x5e, 0xc8, 0xb7, 0xdf,
-		0x88, 0x43, 0x2e, 0xec, 0xdb, 0x3f, 0x02, 0xeb, 0x02, 0x5a, 0x0b, 0xb2, 0x41, 0x92, 0xff, 0xa7,
-		0xd5, 0x21, 0xd9, 0xa0, 0x2f, 0xea, 0x8e, 0x4e, 0x03, 0xdf, 0x8b, 0x43, 0xaa, 0xa1, 0x3a, 0x6a,
-		0xc7, 0x45, 0xd7, 0xfa, 0x0a, 0x38, 0x71, 0xca, 0xd6, 0xf7, 0xff, 0xb4, 0xf9, 0xa6, 0x9e, 0x85,
-		0xdc, 0xa7, 0x06, 0xd4, 0x6f, 0x8f, 0x41, 0x9e, 0x6c, 0x11, 0x43, 0x0f, 0xe4, 0xe3, 0xf4, 0x31,
-		0x23, 0xd9, 0xe3, 0x05, 0x4f, 0x83, 0xd0, 0x12, 0x64, 0x89, 0x5a, 0x90, 0xe8, 0x88, 0x0e, 0x74,
-		0xd4, 0xdb, 0x75, 0x26, 0x41, 0xcf, 0x00, 0xda, 0xf7, 0x37, 0xed, 0x4a, 0xe0, 0x02, 0xa2, 0x37,
-		0x1b, 0xb4, 0x08, 0xf5, 0x87, 0x00, 0x88, 0x15, 0x0a, 0x7b, 0xc9, 0x8b, 0xed, 0x71, 0x32, 0x44,
-		0x52, 0xa3, 0x2f, 0x7a, 0xfd, 0x04, 0xab, 0x05, 0x7b, 0x76, 0x8f, 0xbc, 0x0c, 0x5f, 0x3f, 0x5e,
-		0xa4, 0x7e, 0xef, 0xdd, 0xa5, 0x85, 0x43, 0xb5, 0x63, 0x94, 0x8a, 0x03, 0x28, 0x8b, 0xb4, 0x36,
-		0x8c, 0xee, 0x3a, 0x43, 0x11, 0xfc, 0xf9, 0x18, 0xa0, 0x20, 0xe5, 0xca, 0xd8, 0xb5, 0xc9, 0xb6,
-		0x86, 0x14, 0xbd, 0xa1, 0x0a, 0x35, 0x76, 0x74, 0xd1, 0x1b, 0xe0, 0x45, 0xd1, 0x1b, 0x9a, 0x11,
-		0x97, 0x83, 0x04, 0x17, 0xe7, 0x63, 0x38, 0xe0, 0x0d, 0xbd, 0x95, 0xaa, 0xa5, 0x0b, 0x74, 0x5f,
-		0x0e, 0x9b, 0x28, 0xfe, 0x4e, 0x0c, 0x4e, 0xf7, 0x45, 0x93, 0x6f, 0xec, 0x1f, 0x03, 0xe4, 0x84,
-		0x1a, 0xf9, 0xbf, 0xcc, 0x63, 0x46, 0x1f, 0x3b, 0x38, 0x67, 0x9d, 0xbe, 0x5c, 0xf9, 0x61, 0xe5,
-		0x68, 0xf6, 0xe6, 0xde, 0x3f, 0x8a, 0xc1, 0x7c, 0xd8, 0x18, 0xbf, 0x5b, 0x9b, 0x90, 0x0b, 0xdb,
-		0xc2, 0x3b, 0xf4, 0xe8, 0x38, 0x1d, 0xe2, 0x7d, 0x89, 0xe0, 0xd1, 0x2b, 0xc1, 0xc4, 0x65, 0x87,
-		0x45, 0xcf, 0x8d, 0xed, 0x1b, 0x61, 0x53, 0xef, 0x04, 0x4e, 0x8a, 0x2a, 0x26, 0xd9, 0xb0, 0x2c,
-		0x03, 0xfd, 0x71, 0x98, 0x35, 0x2d, 0x4f, 0x21, 0x51, 0x8e, 0x5b, 0x0a, 0xdf, 0xb9, 0xb2, 0xec,
-		0xf7, 0xca, 0xf1, 0x5c, 0xf6, 0x9d, 0x77, 0x97, 0xfa, 0xa9, 0x7a, 0xfc, 0x38, 0x63, 0x5a, 0x5e,
-		0x85, 0xb6, 0x6f, 0xb3, 0x7d, 0xad, 0x03, 0xd3, 0xd1, 0x5b, 0xb3, 0x6c, 0xb9, 0x71, 0xec, 0x5b,
-		0x4f, 0x1f, 0x75, 0xdb, 0xdc, 0x6e, 0xe8, 0x9e, 0xec, 0x9d, 0xa6, 0xdf, 0x7f, 0x67, 0x29, 0xf6,
-		0xe4, 0x57, 0x63, 0x00, 0xc1, 0x16, 0x1e, 0x3d, 0x0d, 0xa7, 0x2a, 0x5b, 0x9b, 0x35, 0xa5, 0xb9,
-		0x5d, 0xde, 0xde, 0x69, 0x46, 0xdf, 0x7c, 0x16, 0x67, 0xc2, 0xae, 0x8d, 0x35, 0x7d, 0x4f, 0xc7,
-		0x2d, 0xf4, 0x38, 0xcc, 0x47, 0xb5, 0xc9, 0x55, 0xbd, 0x26, 0xc5, 0x16, 0x72, 0x77, 0xee, 0x2e,
-		0xa7, 0x59, 0x75, 0x84, 0x5b, 0xe8, 0x2c, 0x9c, 0xe8, 0xd7, 0x5b, 0xdb, 0x5c, 0x95, 0xe2, 0x0b,
-		0xd3, 0x77, 0xee, 0x2e, 0x67, 0xfc, 0x32, 0x0a, 0x15, 0x01, 0x85, 0x35, 0x39, 0x5f, 0x62, 0x01,
-		0xee, 0xdc, 0x5d, 0x4e, 0x31, 0xb7, 0x2d, 0x24, 0x3f, 0xfe, 0xf9, 0xc5, 0x89, 0xca, 0xd5, 0xa1,
-		0xa7, 0xbe, 0x4f, 0x1f, 0xe9, 0xb1, 0xdb, 0xfe, 0x49, 0x6e, 0xe4, 0xa8, 0xf7, 0xff, 0x06, 0x00,
-		0x00, 0xff, 0xff, 0xdf, 0xc6, 0xe4, 0x92, 0x04, 0x66, 0x00, 0x00,
+		// 7916 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7d, 0x6b, 0x70, 0x24, 0xd7,
+		0x75, 0xde, 0xce, 0x03, 0x83, 0x99, 0x83, 0xc1, 0xa0, 0xd1, 0x00, 0x77, 0x67, 0x67, 0x49, 0x00,
+		0x1c, 0xbe, 0x96, 0x14, 0x89, 0x25, 0x97, 0xdc, 0x5d, 0xee, 0xac, 0x24, 0x66, 0x80, 0x99, 0xdd,
+		0xc5, 0x12, 0x8f, 0x61, 0x0f, 0xb0, 0x7c, 0x38, 0x4e, 0x57, 0xa3, 0xe7, 0x62, 0xd0, 0xdc, 0x9e,
+		0xee, 0x56, 0x77, 0xcf, 0xee, 0x82, 0xe5, 0xa4, 0xa8, 0x28, 0x89, 0xad, 0x4d, 0xd9, 0x91, 0xed,
+		0x54, 0x2c, 0xcb, 0x5a, 0x45, 0xb2, 0xec, 0xc8, 0x51, 0x94, 0x87, 0x2c, 0x45, 0x89, 0xec, 0x3c,
+		0x9c, 0x54, 0x25, 0x51, 0xf4, 0x23, 0x25, 0xfb, 0x47, 0xec, 0xa4, 0x12, 0xc6, 0xa1, 0x54, 0x89,
+		0xa2, 0x28, 0xb1, 0x63, 0x33, 0x55, 0x49, 0xa9, 0x54, 0x95, 0x3a, 0xf7, 0xd1, 0x8f, 0x79, 0x60,
+		0x06, 0xcc, 0x92, 0x76, 0x95, 0x7f, 0x61, 0xee, 0xb9, 0xe7, 0x7c, 0x7d, 0xee, 0xb9, 0xe7, 0xde,
+		0x7b, 0xee, 0xb9, 0xb7, 0x1b, 0xf0, 0x87, 0x97, 0x60, 0xa9, 0x6d, 0xdb, 0x6d, 0x93, 0x9c, 0x71,
+		0x5c, 0xdb, 0xb7, 0x77, 0xbb, 0x7b, 0x67, 0x5a, 0xc4, 0xd3, 0x5d, 0xc3, 0xf1, 0x6d, 0x77, 0x99,
+		0xd2, 0xe4, 0x19, 0xc6, 0xb1, 0x2c, 0x38, 0xca, 0x1b, 0x30, 0x7b, 0xd9, 0x30, 0x49, 0x2d, 0x60,
+		0x6c, 0x12, 0x5f, 0x7e, 0x1e, 0xd2, 0x7b, 0x86, 0x49, 0x8a, 0x89, 0xa5, 0xd4, 0xe9, 0xa9, 0xb3,
+		0x0f, 0x2f, 0xf7, 0x08, 0x2d, 0xc7, 0x25, 0x1a, 0x48, 0x56, 0xa8, 0x44, 0xf9, 0x3b, 0x69, 0x98,
+		0x1b, 0x50, 0x2b, 0xcb, 0x90, 0xb6, 0xb4, 0x0e, 0x22, 0x26, 0x4e, 0xe7, 0x14, 0xfa, 0x5b, 0x2e,
+		0xc2, 0xa4, 0xa3, 0xe9, 0x37, 0xb4, 0x36, 0x29, 0x26, 0x29, 0x59, 0x14, 0xe5, 0x05, 0x80, 0x16,
+		0x71, 0x88, 0xd5, 0x22, 0x96, 0x7e, 0x50, 0x4c, 0x2d, 0xa5, 0x4e, 0xe7, 0x94, 0x08, 0x45, 0xfe,
+		0x00, 0xcc, 0x3a, 0xdd, 0x5d, 0xd3, 0xd0, 0xd5, 0x08, 0x1b, 0x2c, 0xa5, 0x4e, 0x4f, 0x28, 0x12,
+		0xab, 0xa8, 0x85, 0xcc, 0x8f, 0xc1, 0xcc, 0x2d, 0xa2, 0xdd, 0x88, 0xb2, 0x4e, 0x51, 0xd6, 0x02,
+		0x92, 0x23, 0x8c, 0xab, 0x90, 0xef, 0x10, 0xcf, 0xd3, 0xda, 0x44, 0xf5, 0x0f, 0x1c, 0x52, 0x4c,
+		0xd3, 0xd6, 0x2f, 0xf5, 0xb5, 0xbe, 0xb7, 0xe5, 0x53, 0x5c, 0x6a, 0xfb, 0xc0, 0x21, 0x72, 0x15,
+		0x72, 0xc4, 0xea, 0x76, 0x18, 0xc2, 0xc4, 0x10, 0xfb, 0xd5, 0xad, 0x6e, 0xa7, 0x17, 0x25, 0x8b,
+		0x62, 0x1c, 0x62, 0xd2, 0x23, 0xee, 0x4d, 0x43, 0x27, 0xc5, 0x0c, 0x05, 0x78, 0xac, 0x0f, 0xa0,
+		0xc9, 0xea, 0x7b, 0x31, 0x84, 0x9c, 0xbc, 0x0a, 0x39, 0x72, 0xdb, 0x27, 0x96, 0x67, 0xd8, 0x56,
+		0x71, 0x92, 0x82, 0x3c, 0x32, 0xa0, 0x17, 0x89, 0xd9, 0xea, 0x85, 0x08, 0xe5, 0xe4, 0xf3, 0x30,
+		0x69, 0x3b, 0xbe, 0x61, 0x5b, 0x5e, 0x31, 0xbb, 0x94, 0x38, 0x3d, 0x75, 0xf6, 0xfe, 0x81, 0x8e,
+		0xb0, 0xc5, 0x78, 0x14, 0xc1, 0x2c, 0xaf, 0x81, 0xe4, 0xd9, 0x5d, 0x57, 0x27, 0xaa, 0x6e, 0xb7,
+		0x88, 0x6a, 0x58, 0x7b, 0x76, 0x31, 0x47, 0x01, 0x16, 0xfb, 0x1b, 0x42, 0x19, 0x57, 0xed, 0x16,
+		0x59, 0xb3, 0xf6, 0x6c, 0xa5, 0xe0, 0xc5, 0xca, 0xf2, 0x71, 0xc8, 0x78, 0x07, 0x96, 0xaf, 0xdd,
+		0x2e, 0xe6, 0xa9, 0x87, 0xf0, 0x52, 0xf9, 0xeb, 0x19, 0x98, 0x19, 0xc7, 0xc5, 0x2e, 0xc1, 0xc4,
+		0x1e, 0xb6, 0xb2, 0x98, 0x3c, 0x8a, 0x0d, 0x98, 0x4c, 0xdc, 0x88, 0x99, 0x77, 0x69, 0xc4, 0x2a,
+		0x4c, 0x59, 0xc4, 0xf3, 0x49, 0x8b, 0x79, 0x44, 0x6a, 0x4c, 0x9f, 0x02, 0x26, 0xd4, 0xef, 0x52,
+		0xe9, 0x77, 0xe5, 0x52, 0xaf, 0xc0, 0x4c, 0xa0, 0x92, 0xea, 0x6a, 0x56, 0x5b, 0xf8, 0xe6, 0x99,
+		0x51, 0x9a, 0x2c, 0xd7, 0x85, 0x9c, 0x82, 0x62, 0x4a, 0x81, 0xc4, 0xca, 0x72, 0x0d, 0xc0, 0xb6,
+		0x88, 0xbd, 0xa7, 0xb6, 0x88, 0x6e, 0x16, 0xb3, 0x43, 0xac, 0xb4, 0x85, 0x2c, 0x7d, 0x56, 0xb2,
+		0x19, 0x55, 0x37, 0xe5, 0x8b, 0xa1, 0xab, 0x4d, 0x0e, 0xf1, 0x94, 0x0d, 0x36, 0xc8, 0xfa, 0xbc,
+		0x6d, 0x07, 0x0a, 0x2e, 0x41, 0xbf, 0x27, 0x2d, 0xde, 0xb2, 0x1c, 0x55, 0x62, 0x79, 0x64, 0xcb,
+		0x14, 0x2e, 0xc6, 0x1a, 0x36, 0xed, 0x46, 0x8b, 0xf2, 0x43, 0x10, 0x10, 0x54, 0xea, 0x56, 0x40,
+		0x67, 0xa1, 0xbc, 0x20, 0x6e, 0x6a, 0x1d, 0x52, 0x7a, 0x03, 0x0a, 0x71, 0xf3, 0xc8, 0xf3, 0x30,
+		0xe1, 0xf9, 0x9a, 0xeb, 0x53, 0x2f, 0x9c, 0x50, 0x58, 0x41, 0x96, 0x20, 0x45, 0xac, 0x16, 0x9d,
+		0xe5, 0x26, 0x14, 0xfc, 0x29, 0xff, 0xa9, 0xb0, 0xc1, 0x29, 0xda, 0xe0, 0x47, 0xfb, 0x7b, 0x34,
+		0x86, 0xdc, 0xdb, 0xee, 0xd2, 0x05, 0x98, 0x8e, 0x35, 0x60, 0xdc, 0x47, 0x97, 0x7f, 0x0c, 0xee,
+		0x1b, 0x08, 0x2d, 0xbf, 0x02, 0xf3, 0x5d, 0xcb, 0xb0, 0x7c, 0xe2, 0x3a, 0x2e, 0x41, 0x8f, 0x65,
+		0x8f, 0x2a, 0xfe, 0xd7, 0xc9, 0x21, 0x3e, 0xb7, 0x13, 0xe5, 0x66, 0x28, 0xca, 0x5c, 0xb7, 0x9f,
+		0xf8, 0x44, 0x2e, 0xfb, 0xdd, 0x49, 0xe9, 0xcd, 0x37, 0xdf, 0x7c, 0x33, 0x59, 0xfe, 0x67, 0x19,
+		0x98, 0x1f, 0x34, 0x66, 0x06, 0x0e, 0xdf, 0xe3, 0x90, 0xb1, 0xba, 0x9d, 0x5d, 0xe2, 0x52, 0x23,
+		0x4d, 0x28, 0xbc, 0x24, 0x57, 0x61, 0xc2, 0xd4, 0x76, 0x89, 0x59, 0x4c, 0x2f, 0x25, 0x4e, 0x17,
+		0xce, 0x7e, 0x60, 0xac, 0x51, 0xb9, 0xbc, 0x8e, 0x22, 0x0a, 0x93, 0x94, 0x3f, 0x0c, 0x69, 0x3e,
+		0x45, 0x23, 0xc2, 0x13, 0xe3, 0x21, 0xe0, 0x58, 0x52, 0xa8, 0x9c, 0x7c, 0x0a, 0x72, 0xf8, 0x97,
+		0xf9, 0x46, 0x86, 0xea, 0x9c, 0x45, 0x02, 0xfa, 0x85, 0x5c, 0x82, 0x2c, 0x1d, 0x26, 0x2d, 0x22,
+		0x96, 0xb6, 0xa0, 0x8c, 0x8e, 0xd5, 0x22, 0x7b, 0x5a, 0xd7, 0xf4, 0xd5, 0x9b, 0x9a, 0xd9, 0x25,
+		0xd4, 0xe1, 0x73, 0x4a, 0x9e, 0x13, 0xaf, 0x23, 0x4d, 0x5e, 0x84, 0x29, 0x36, 0xaa, 0x0c, 0xab,
+		0x45, 0x6e, 0xd3, 0xd9, 0x73, 0x42, 0x61, 0x03, 0x6d, 0x0d, 0x29, 0xf8, 0xf8, 0xd7, 0x3d, 0xdb,
+		0x12, 0xae, 0x49, 0x1f, 0x81, 0x04, 0xfa, 0xf8, 0x0b, 0xbd, 0x13, 0xf7, 0x03, 0x83, 0x9b, 0xd7,
+		0x37, 0x96, 0x1e, 0x83, 0x19, 0xca, 0xf1, 0x2c, 0xef, 0x7a, 0xcd, 0x2c, 0xce, 0x2e, 0x25, 0x4e,
+		0x67, 0x95, 0x02, 0x23, 0x6f, 0x71, 0x6a, 0xf9, 0x6b, 0x49, 0x48, 0xd3, 0x89, 0x65, 0x06, 0xa6,
+		0xb6, 0x5f, 0x6d, 0xd4, 0xd5, 0xda, 0xd6, 0xce, 0xca, 0x7a, 0x5d, 0x4a, 0xc8, 0x05, 0x00, 0x4a,
+		0xb8, 0xbc, 0xbe, 0x55, 0xdd, 0x96, 0x92, 0x41, 0x79, 0x6d, 0x73, 0xfb, 0xfc, 0x73, 0x52, 0x2a,
+		0x10, 0xd8, 0x61, 0x84, 0x74, 0x94, 0xe1, 0xd9, 0xb3, 0xd2, 0x84, 0x2c, 0x41, 0x9e, 0x01, 0xac,
+		0xbd, 0x52, 0xaf, 0x9d, 0x7f, 0x4e, 0xca, 0xc4, 0x29, 0xcf, 0x9e, 0x95, 0x26, 0xe5, 0x69, 0xc8,
+		0x51, 0xca, 0xca, 0xd6, 0xd6, 0xba, 0x94, 0x0d, 0x30, 0x9b, 0xdb, 0xca, 0xda, 0xe6, 0x15, 0x29,
+		0x17, 0x60, 0x5e, 0x51, 0xb6, 0x76, 0x1a, 0x12, 0x04, 0x08, 0x1b, 0xf5, 0x66, 0xb3, 0x7a, 0xa5,
+		0x2e, 0x4d, 0x05, 0x1c, 0x2b, 0xaf, 0x6e, 0xd7, 0x9b, 0x52, 0x3e, 0xa6, 0xd6, 0xb3, 0x67, 0xa5,
+		0xe9, 0xe0, 0x11, 0xf5, 0xcd, 0x9d, 0x0d, 0xa9, 0x20, 0xcf, 0xc2, 0x34, 0x7b, 0x84, 0x50, 0x62,
+		0xa6, 0x87, 0x74, 0xfe, 0x39, 0x49, 0x0a, 0x15, 0x61, 0x28, 0xb3, 0x31, 0xc2, 0xf9, 0xe7, 0x24,
+		0xb9, 0xbc, 0x0a, 0x13, 0xd4, 0x0d, 0x65, 0x19, 0x0a, 0xeb, 0xd5, 0x95, 0xfa, 0xba, 0xba, 0xd5,
+		0xd8, 0x5e, 0xdb, 0xda, 0xac, 0xae, 0x4b, 0x89, 0x90, 0xa6, 0xd4, 0x5f, 0xda, 0x59, 0x53, 0xea,
+		0x35, 0x29, 0x19, 0xa5, 0x35, 0xea, 0xd5, 0xed, 0x7a, 0x4d, 0x4a, 0x95, 0x75, 0x98, 0x1f, 0x34,
+		0xa1, 0x0e, 0x1c, 0x42, 0x11, 0x5f, 0x48, 0x0e, 0xf1, 0x05, 0x8a, 0xd5, 0xeb, 0x0b, 0xe5, 0x6f,
+		0x27, 0x61, 0x6e, 0xc0, 0xa2, 0x32, 0xf0, 0x21, 0x2f, 0xc0, 0x04, 0xf3, 0x65, 0xb6, 0xcc, 0x3e,
+		0x3e, 0x70, 0x75, 0xa2, 0x9e, 0xdd, 0xb7, 0xd4, 0x52, 0xb9, 0x68, 0xa8, 0x91, 0x1a, 0x12, 0x6a,
+		0x20, 0x44, 0x9f, 0xc3, 0xfe, 0x68, 0xdf, 0xe4, 0xcf, 0xd6, 0xc7, 0xf3, 0xe3, 0xac, 0x8f, 0x94,
+		0x76, 0xb4, 0x45, 0x60, 0x62, 0xc0, 0x22, 0x70, 0x09, 0x66, 0xfb, 0x80, 0xc6, 0x9e, 0x8c, 0x3f,
+		0x96, 0x80, 0xe2, 0x30, 0xe3, 0x8c, 0x98, 0x12, 0x93, 0xb1, 0x29, 0xf1, 0x52, 0xaf, 0x05, 0x1f,
+		0x1c, 0xde, 0x09, 0x7d, 0x7d, 0xfd, 0x85, 0x04, 0x1c, 0x1f, 0x1c, 0x52, 0x0e, 0xd4, 0xe1, 0xc3,
+		0x90, 0xe9, 0x10, 0x7f, 0xdf, 0x16, 0x61, 0xd5, 0xa3, 0x03, 0x16, 0x6b, 0xac, 0xee, 0xed, 0x6c,
+		0x2e, 0x25, 0x5f, 0xec, 0xd5, 0x75, 0x71, 0x58, 0x80, 0xdb, 0xa7, 0xe9, 0xc7, 0x93, 0x70, 0xdf,
+		0x40, 0xf0, 0x81, 0x8a, 0x3e, 0x00, 0x60, 0x58, 0x4e, 0xd7, 0x67, 0xa1, 0x13, 0x9b, 0x89, 0x73,
+		0x94, 0x42, 0x27, 0x2f, 0x9c, 0x65, 0xbb, 0x7e, 0x50, 0x9f, 0xa2, 0xf5, 0xc0, 0x48, 0x94, 0xe1,
+		0xf9, 0x50, 0xd1, 0x34, 0x55, 0x74, 0x61, 0x48, 0x4b, 0xfb, 0x1c, 0xf3, 0x69, 0x90, 0x74, 0xd3,
+		0x20, 0x96, 0xaf, 0x7a, 0xbe, 0x4b, 0xb4, 0x8e, 0x61, 0xb5, 0xe9, 0x52, 0x93, 0xad, 0x4c, 0xec,
+		0x69, 0xa6, 0x47, 0x94, 0x19, 0x56, 0xdd, 0x14, 0xb5, 0x28, 0x41, 0x1d, 0xc8, 0x8d, 0x48, 0x64,
+		0x62, 0x12, 0xac, 0x3a, 0x90, 0x28, 0xff, 0x74, 0x0e, 0xa6, 0x22, 0x01, 0xb8, 0xfc, 0x20, 0xe4,
+		0x5f, 0xd7, 0x6e, 0x6a, 0xaa, 0xd8, 0x54, 0x31, 0x4b, 0x4c, 0x21, 0xad, 0xc1, 0x48, 0xf2, 0xd3,
+		0x30, 0x4f, 0x59, 0xec, 0xae, 0x4f, 0x5c, 0x55, 0x37, 0x35, 0xcf, 0xa3, 0x46, 0xcb, 0x52, 0x56,
+		0x19, 0xeb, 0xb6, 0xb0, 0x6a, 0x55, 0xd4, 0xc8, 0xe7, 0x60, 0x8e, 0x4a, 0x74, 0xba, 0xa6, 0x6f,
+		0x38, 0x26, 0x51, 0x71, 0x9b, 0xe7, 0x15, 0x21, 0xaa, 0xd9, 0x2c, 0x72, 0x6c, 0x70, 0x06, 0xd4,
+		0xc8, 0x93, 0x6b, 0xf0, 0x00, 0x15, 0x6b, 0x13, 0x8b, 0xb8, 0x9a, 0x4f, 0x54, 0xf2, 0x91, 0xae,
+		0x66, 0x7a, 0xaa, 0x66, 0xb5, 0xd4, 0x7d, 0xcd, 0xdb, 0x2f, 0xce, 0x23, 0xc0, 0x4a, 0xb2, 0x98,
+		0x50, 0x4e, 0x22, 0xe3, 0x15, 0xce, 0x57, 0xa7, 0x6c, 0x55, 0xab, 0x75, 0x55, 0xf3, 0xf6, 0xe5,
+		0x0a, 0x1c, 0xa7, 0x28, 0x9e, 0xef, 0x1a, 0x56, 0x5b, 0xd5, 0xf7, 0x89, 0x7e, 0x43, 0xed, 0xfa,
+		0x7b, 0xcf, 0x17, 0x4f, 0x45, 0x9f, 0x4f, 0x35, 0x6c, 0x52, 0x9e, 0x55, 0x64, 0xd9, 0xf1, 0xf7,
+		0x9e, 0x97, 0x9b, 0x90, 0xc7, 0xce, 0xe8, 0x18, 0x6f, 0x10, 0x75, 0xcf, 0x76, 0xe9, 0x1a, 0x5a,
+		0x18, 0x30, 0x35, 0x45, 0x2c, 0xb8, 0xbc, 0xc5, 0x05, 0x36, 0xec, 0x16, 0xa9, 0x4c, 0x34, 0x1b,
+		0xf5, 0x7a, 0x4d, 0x99, 0x12, 0x28, 0x97, 0x6d, 0x17, 0x1d, 0xaa, 0x6d, 0x07, 0x06, 0x9e, 0x62,
+		0x0e, 0xd5, 0xb6, 0x85, 0x79, 0xcf, 0xc1, 0x9c, 0xae, 0xb3, 0x36, 0x1b, 0xba, 0xca, 0x37, 0x63,
+		0x5e, 0x51, 0x8a, 0x19, 0x4b, 0xd7, 0xaf, 0x30, 0x06, 0xee, 0xe3, 0x9e, 0x7c, 0x11, 0xee, 0x0b,
+		0x8d, 0x15, 0x15, 0x9c, 0xed, 0x6b, 0x65, 0xaf, 0xe8, 0x39, 0x98, 0x73, 0x0e, 0xfa, 0x05, 0xe5,
+		0xd8, 0x13, 0x9d, 0x83, 0x5e, 0xb1, 0x0b, 0x30, 0xef, 0xec, 0x3b, 0xfd, 0x72, 0x4f, 0x44, 0xe5,
+		0x64, 0x67, 0xdf, 0xe9, 0x15, 0x7c, 0x84, 0xee, 0xcc, 0x5d, 0xa2, 0x6b, 0x3e, 0x69, 0x15, 0x4f,
+		0x44, 0xd9, 0x23, 0x15, 0xf2, 0x32, 0x48, 0xba, 0xae, 0x12, 0x4b, 0xdb, 0x35, 0x89, 0xaa, 0xb9,
+		0xc4, 0xd2, 0xbc, 0xe2, 0x22, 0x65, 0x4e, 0xfb, 0x6e, 0x97, 0x28, 0x05, 0x5d, 0xaf, 0xd3, 0xca,
+		0x2a, 0xad, 0x93, 0x9f, 0x80, 0x59, 0x7b, 0xf7, 0x75, 0x9d, 0x79, 0xa4, 0xea, 0xb8, 0x64, 0xcf,
+		0xb8, 0x5d, 0x7c, 0x98, 0x9a, 0x77, 0x06, 0x2b, 0xa8, 0x3f, 0x36, 0x28, 0x59, 0x7e, 0x1c, 0x24,
+		0xdd, 0xdb, 0xd7, 0x5c, 0x87, 0x4e, 0xc9, 0x9e, 0xa3, 0xe9, 0xa4, 0xf8, 0x08, 0x63, 0x65, 0xf4,
+		0x4d, 0x41, 0xc6, 0x11, 0xe1, 0xdd, 0x32, 0xf6, 0x7c, 0x81, 0xf8, 0x18, 0x1b, 0x11, 0x94, 0xc6,
+		0xd1, 0x4e, 0x83, 0x84, 0x96, 0x88, 0x3d, 0xf8, 0x34, 0x65, 0x2b, 0x38, 0xfb, 0x4e, 0xf4, 0xb9,
+		0x0f, 0xc1, 0xb4, 0xb3, 0x1f, 0x7d, 0xe8, 0xe3, 0x2c, 0x70, 0x73, 0xf6, 0x23, 0x4f, 0x7c, 0x0e,
+		0x8e, 0x23, 0x53, 0x87, 0xf8, 0x5a, 0x4b, 0xf3, 0xb5, 0x08, 0xf7, 0x93, 0x94, 0x1b, 0xcd, 0xbe,
+		0xc1, 0x2b, 0x63, 0x7a, 0xba, 0xdd, 0xdd, 0x83, 0xc0, 0xb1, 0x9e, 0x62, 0x7a, 0x22, 0x4d, 0xb8,
+		0xd6, 0x7b, 0x16, 0x9c, 0x97, 0x2b, 0x90, 0x8f, 0xfa, 0xbd, 0x9c, 0x03, 0xe6, 0xf9, 0x52, 0x02,
+		0x83, 0xa0, 0xd5, 0xad, 0x1a, 0x86, 0x2f, 0xaf, 0xd5, 0xa5, 0x24, 0x86, 0x51, 0xeb, 0x6b, 0xdb,
+		0x75, 0x55, 0xd9, 0xd9, 0xdc, 0x5e, 0xdb, 0xa8, 0x4b, 0xa9, 0x48, 0x60, 0x7f, 0x2d, 0x9d, 0x7d,
+		0x54, 0x7a, 0x0c, 0xa3, 0x86, 0x42, 0x7c, 0xa7, 0x26, 0x7f, 0x10, 0x4e, 0x88, 0xb4, 0x8a, 0x47,
+		0x7c, 0xf5, 0x96, 0xe1, 0xd2, 0x01, 0xd9, 0xd1, 0xd8, 0xe2, 0x18, 0xf8, 0xcf, 0x3c, 0xe7, 0x6a,
+		0x12, 0xff, 0x65, 0xc3, 0xc5, 0xe1, 0xd6, 0xd1, 0x7c, 0x79, 0x1d, 0x16, 0x2d, 0x5b, 0xf5, 0x7c,
+		0xcd, 0x6a, 0x69, 0x6e, 0x4b, 0x0d, 0x13, 0x5a, 0xaa, 0xa6, 0xeb, 0xc4, 0xf3, 0x6c, 0xb6, 0x10,
+		0x06, 0x28, 0xf7, 0x5b, 0x76, 0x93, 0x33, 0x87, 0x2b, 0x44, 0x95, 0xb3, 0xf6, 0xb8, 0x6f, 0x6a,
+		0x98, 0xfb, 0x9e, 0x82, 0x5c, 0x47, 0x73, 0x54, 0x62, 0xf9, 0xee, 0x01, 0x8d, 0xcf, 0xb3, 0x4a,
+		0xb6, 0xa3, 0x39, 0x75, 0x2c, 0xbf, 0x2f, 0xdb, 0xa4, 0x6b, 0xe9, 0x6c, 0x5a, 0x9a, 0xb8, 0x96,
+		0xce, 0x4e, 0x48, 0x99, 0x6b, 0xe9, 0x6c, 0x46, 0x9a, 0xbc, 0x96, 0xce, 0x66, 0xa5, 0xdc, 0xb5,
+		0x74, 0x36, 0x27, 0x41, 0xf9, 0x67, 0xd2, 0x90, 0x8f, 0x46, 0xf0, 0xb8, 0x21, 0xd2, 0xe9, 0x1a,
+		0x96, 0xa0, 0xb3, 0xdc, 0x43, 0x87, 0xc6, 0xfb, 0xcb, 0xab, 0xb8, 0xb8, 0x55, 0x32, 0x2c, 0x5c,
+		0x56, 0x98, 0x24, 0x06, 0x16, 0xe8, 0x7e, 0x84, 0x85, 0x27, 0x59, 0x85, 0x97, 0xe4, 0x2b, 0x90,
+		0x79, 0xdd, 0xa3, 0xd8, 0x19, 0x8a, 0xfd, 0xf0, 0xe1, 0xd8, 0xd7, 0x9a, 0x14, 0x3c, 0x77, 0xad,
+		0xa9, 0x6e, 0x6e, 0x29, 0x1b, 0xd5, 0x75, 0x85, 0x8b, 0xcb, 0x27, 0x21, 0x6d, 0x6a, 0x6f, 0x1c,
+		0xc4, 0x97, 0x41, 0x4a, 0x92, 0x97, 0x61, 0xa6, 0x6b, 0xdd, 0x24, 0xae, 0xb1, 0x67, 0x90, 0x96,
+		0x4a, 0xb9, 0x66, 0xa2, 0x5c, 0x85, 0xb0, 0x76, 0x1d, 0xf9, 0xc7, 0xec, 0xc6, 0x93, 0x90, 0xc6,
+		0x14, 0x5f, 0x7c, 0xb1, 0xa2, 0xa4, 0xf7, 0x70, 0x38, 0x9d, 0x81, 0x09, 0x6a, 0x5f, 0x19, 0x80,
+		0x5b, 0x58, 0x3a, 0x26, 0x67, 0x21, 0xbd, 0xba, 0xa5, 0xe0, 0x90, 0x92, 0x20, 0xcf, 0xa8, 0x6a,
+		0x63, 0xad, 0xbe, 0x5a, 0x97, 0x92, 0xe5, 0x73, 0x90, 0x61, 0x46, 0xc3, 0xe1, 0x16, 0x98, 0x4d,
+		0x3a, 0xc6, 0x8b, 0x1c, 0x23, 0x21, 0x6a, 0x77, 0x36, 0x56, 0xea, 0x8a, 0x94, 0xec, 0x73, 0x96,
+		0xb2, 0x07, 0xf9, 0x68, 0x24, 0xff, 0xfe, 0x6c, 0xe7, 0x7f, 0x23, 0x01, 0x53, 0x91, 0xc8, 0x1c,
+		0x43, 0x2a, 0xcd, 0x34, 0xed, 0x5b, 0xaa, 0x66, 0x1a, 0x9a, 0xc7, 0x5d, 0x09, 0x28, 0xa9, 0x8a,
+		0x94, 0x71, 0xbb, 0xee, 0x7d, 0x1a, 0x64, 0x13, 0x52, 0xa6, 0xfc, 0x99, 0x04, 0x48, 0xbd, 0xa1,
+		0x71, 0x8f, 0x9a, 0x89, 0x3f, 0x4a, 0x35, 0xcb, 0x9f, 0x4e, 0x40, 0x21, 0x1e, 0x0f, 0xf7, 0xa8,
+		0xf7, 0xe0, 0x1f, 0xa9, 0x7a, 0xbf, 0x9b, 0x84, 0xe9, 0x58, 0x14, 0x3c, 0xae, 0x76, 0x1f, 0x81,
+		0x59, 0xa3, 0x45, 0x3a, 0x8e, 0xed, 0x63, 0xfa, 0x5d, 0x35, 0xc9, 0x4d, 0x62, 0x16, 0xcb, 0x74,
+		0x92, 0x39, 0x73, 0x78, 0x9c, 0xbd, 0xbc, 0x16, 0xca, 0xad, 0xa3, 0x58, 0x65, 0x6e, 0xad, 0x56,
+		0xdf, 0x68, 0x6c, 0x6d, 0xd7, 0x37, 0x57, 0x5f, 0x55, 0x77, 0x36, 0x5f, 0xdc, 0xdc, 0x7a, 0x79,
+		0x53, 0x91, 0x8c, 0x1e, 0xb6, 0xf7, 0x70, 0xd8, 0x37, 0x40, 0xea, 0x55, 0x4a, 0x3e, 0x01, 0x83,
+		0xd4, 0x92, 0x8e, 0xc9, 0x73, 0x30, 0xb3, 0xb9, 0xa5, 0x36, 0xd7, 0x6a, 0x75, 0xb5, 0x7e, 0xf9,
+		0x72, 0x7d, 0x75, 0xbb, 0xc9, 0x32, 0x27, 0x01, 0xf7, 0x76, 0x6c, 0x80, 0x97, 0x3f, 0x95, 0x82,
+		0xb9, 0x01, 0x9a, 0xc8, 0x55, 0xbe, 0xe7, 0x61, 0xdb, 0xb0, 0xa7, 0xc6, 0xd1, 0x7e, 0x19, 0xa3,
+		0x8e, 0x86, 0xe6, 0xfa, 0x7c, 0x8b, 0xf4, 0x38, 0xa0, 0x95, 0x2c, 0x1f, 0x27, 0x57, 0x97, 0x67,
+		0xa4, 0xd8, 0x46, 0x68, 0x26, 0xa4, 0xb3, 0xa4, 0xd4, 0x93, 0x20, 0x3b, 0xb6, 0x67, 0xf8, 0xc6,
+		0x4d, 0x4c, 0xea, 0x8b, 0xf4, 0x15, 0x6e, 0x8c, 0xd2, 0x8a, 0x24, 0x6a, 0xd6, 0x2c, 0x3f, 0xe0,
+		0xb6, 0x48, 0x5b, 0xeb, 0xe1, 0xc6, 0xc9, 0x3f, 0xa5, 0x48, 0xa2, 0x26, 0xe0, 0x7e, 0x10, 0xf2,
+		0x2d, 0xbb, 0x8b, 0xd1, 0x22, 0xe3, 0xc3, 0xb5, 0x26, 0xa1, 0x4c, 0x31, 0x5a, 0xc0, 0xc2, 0xf7,
+		0x01, 0x61, 0xde, 0x2c, 0xaf, 0x4c, 0x31, 0x1a, 0x63, 0x79, 0x0c, 0x66, 0xb4, 0x76, 0xdb, 0x45,
+		0x70, 0x01, 0xc4, 0x76, 0x36, 0x85, 0x80, 0x4c, 0x19, 0x4b, 0xd7, 0x20, 0x2b, 0xec, 0x80, 0x8b,
+		0x3d, 0x5a, 0x42, 0x75, 0xd8, 0x76, 0x3d, 0x89, 0xa9, 0x34, 0x4b, 0x54, 0x3e, 0x08, 0x79, 0xc3,
+		0x53, 0xc3, 0x63, 0x80, 0xe4, 0x52, 0xf2, 0x74, 0x56, 0x99, 0x32, 0xbc, 0x20, 0x85, 0x5a, 0xfe,
+		0x42, 0x12, 0x0a, 0xf1, 0x63, 0x0c, 0xb9, 0x06, 0x59, 0xd3, 0xd6, 0x35, 0xea, 0x5a, 0xec, 0x0c,
+		0xed, 0xf4, 0x88, 0x93, 0x8f, 0xe5, 0x75, 0xce, 0xaf, 0x04, 0x92, 0xa5, 0x7f, 0x93, 0x80, 0xac,
+		0x20, 0xcb, 0xc7, 0x21, 0xed, 0x68, 0xfe, 0x3e, 0x85, 0x9b, 0x58, 0x49, 0x4a, 0x09, 0x85, 0x96,
+		0x91, 0xee, 0x39, 0x9a, 0x55, 0x4c, 0x86, 0x74, 0x2c, 0x63, 0xbf, 0x9a, 0x44, 0x6b, 0xd1, 0x6d,
+		0x93, 0xdd, 0xe9, 0x10, 0xcb, 0xf7, 0x44, 0xbf, 0x72, 0xfa, 0x2a, 0x27, 0xe3, 0x69, 0x9a, 0xef,
+		0x6a, 0x86, 0x19, 0xe3, 0x4d, 0x53, 0x5e, 0x49, 0x54, 0x04, 0xcc, 0x15, 0x38, 0x29, 0x70, 0x5b,
+		0xc4, 0xd7, 0xf4, 0x7d, 0xd2, 0x0a, 0x85, 0x32, 0x34, 0x3d, 0x72, 0x82, 0x33, 0xd4, 0x78, 0xbd,
+		0x90, 0x2d, 0xff, 0x66, 0x02, 0x66, 0xc5, 0x46, 0xaf, 0x15, 0x18, 0x6b, 0x03, 0x40, 0xb3, 0x2c,
+		0xdb, 0x8f, 0x9a, 0xab, 0xdf, 0x95, 0xfb, 0xe4, 0x96, 0xab, 0x81, 0x90, 0x12, 0x01, 0x28, 0x75,
+		0x00, 0xc2, 0x9a, 0xa1, 0x66, 0x5b, 0x84, 0x29, 0x7e, 0x46, 0x45, 0x0f, 0x3a, 0x59, 0x6a, 0x00,
+		0x18, 0x09, 0x77, 0x84, 0x98, 0xc0, 0xd9, 0x25, 0x6d, 0xc3, 0xe2, 0x99, 0x67, 0x56, 0x10, 0x09,
+		0x9c, 0x74, 0x90, 0xc0, 0x59, 0xf9, 0x73, 0x30, 0xa7, 0xdb, 0x9d, 0x5e, 0x75, 0x57, 0xa4, 0x9e,
+		0xf4, 0x84, 0x77, 0x35, 0xf1, 0xda, 0x53, 0x9c, 0xa9, 0x6d, 0x9b, 0x9a, 0xd5, 0x5e, 0xb6, 0xdd,
+		0x76, 0x78, 0x50, 0x8b, 0x11, 0x92, 0x17, 0x39, 0xae, 0x75, 0x76, 0xff, 0x4f, 0x22, 0xf1, 0x8b,
+		0xc9, 0xd4, 0x95, 0xc6, 0xca, 0x17, 0x93, 0xa5, 0x2b, 0x4c, 0xb0, 0x21, 0x8c, 0xa1, 0x90, 0x3d,
+		0x93, 0xe8, 0xd8, 0x40, 0xf8, 0xde, 0x07, 0x60, 0xbe, 0x6d, 0xb7, 0x6d, 0x8a, 0x74, 0x06, 0x7f,
+		0xf1, 0x93, 0xde, 0x5c, 0x40, 0x2d, 0x8d, 0x3c, 0x16, 0xae, 0x6c, 0xc2, 0x1c, 0x67, 0x56, 0xe9,
+		0x51, 0x13, 0xdb, 0x08, 0xc9, 0x87, 0x66, 0xe1, 0x8a, 0x5f, 0xfe, 0x0e, 0x5d, 0xbe, 0x95, 0x59,
+		0x2e, 0x8a, 0x75, 0x6c, 0xaf, 0x54, 0x51, 0xe0, 0xbe, 0x18, 0x1e, 0x1b, 0xa4, 0xc4, 0x1d, 0x81,
+		0xf8, 0x2f, 0x38, 0xe2, 0x5c, 0x04, 0xb1, 0xc9, 0x45, 0x2b, 0xab, 0x30, 0x7d, 0x14, 0xac, 0x7f,
+		0xc9, 0xb1, 0xf2, 0x24, 0x0a, 0x72, 0x05, 0x66, 0x28, 0x88, 0xde, 0xf5, 0x7c, 0xbb, 0x43, 0x67,
+		0xc0, 0xc3, 0x61, 0xfe, 0xd5, 0x77, 0xd8, 0xa8, 0x29, 0xa0, 0xd8, 0x6a, 0x20, 0x55, 0xa9, 0x00,
+		0x3d, 0x5d, 0xc3, 0x53, 0xaf, 0x11, 0x08, 0xdf, 0xe0, 0x8a, 0x04, 0xfc, 0x95, 0xeb, 0x30, 0x8f,
+		0xbf, 0xe9, 0x04, 0x15, 0xd5, 0x64, 0x74, 0xca, 0xae, 0xf8, 0x9b, 0x1f, 0x63, 0x03, 0x73, 0x2e,
+		0x00, 0x88, 0xe8, 0x14, 0xe9, 0xc5, 0x36, 0xf1, 0x7d, 0xe2, 0x7a, 0xaa, 0x66, 0x0e, 0x52, 0x2f,
+		0x92, 0xf3, 0x28, 0xfe, 0xfc, 0xf7, 0xe3, 0xbd, 0x78, 0x85, 0x49, 0x56, 0x4d, 0xb3, 0xb2, 0x03,
+		0x27, 0x06, 0x78, 0xc5, 0x18, 0x98, 0x9f, 0xe2, 0x98, 0xf3, 0x7d, 0x9e, 0x81, 0xb0, 0x0d, 0x10,
+		0xf4, 0xa0, 0x2f, 0xc7, 0xc0, 0xfc, 0x05, 0x8e, 0x29, 0x73, 0x59, 0xd1, 0xa5, 0x88, 0x78, 0x0d,
+		0x66, 0x6f, 0x12, 0x77, 0xd7, 0xf6, 0x78, 0x9e, 0x69, 0x0c, 0xb8, 0x4f, 0x73, 0xb8, 0x19, 0x2e,
+		0x48, 0x13, 0x4f, 0x88, 0x75, 0x11, 0xb2, 0x7b, 0x9a, 0x4e, 0xc6, 0x80, 0xb8, 0xcb, 0x21, 0x26,
+		0x91, 0x1f, 0x45, 0xab, 0x90, 0x6f, 0xdb, 0x7c, 0x8d, 0x1a, 0x2d, 0xfe, 0x19, 0x2e, 0x3e, 0x25,
+		0x64, 0x38, 0x84, 0x63, 0x3b, 0x5d, 0x13, 0x17, 0xb0, 0xd1, 0x10, 0x7f, 0x5d, 0x40, 0x08, 0x19,
+		0x0e, 0x71, 0x04, 0xb3, 0x7e, 0x56, 0x40, 0x78, 0x11, 0x7b, 0xbe, 0x80, 0xc7, 0x4f, 0xe6, 0x81,
+		0x6d, 0x8d, 0xa3, 0xc4, 0xe7, 0x38, 0x02, 0x70, 0x11, 0x04, 0xb8, 0x04, 0xb9, 0x71, 0x3b, 0xe2,
+		0x97, 0xbf, 0x2f, 0x86, 0x87, 0xe8, 0x81, 0x2b, 0x30, 0x23, 0x26, 0x28, 0x3c, 0xae, 0x1e, 0x0d,
+		0xf1, 0x37, 0x38, 0x44, 0x21, 0x22, 0xc6, 0x9b, 0xe1, 0x13, 0xcf, 0x6f, 0x93, 0x71, 0x40, 0xbe,
+		0x20, 0x9a, 0xc1, 0x45, 0xb8, 0x29, 0x77, 0x89, 0xa5, 0xef, 0x8f, 0x87, 0xf0, 0x2b, 0xc2, 0x94,
+		0x42, 0x06, 0x21, 0x56, 0x61, 0xba, 0xa3, 0xb9, 0xde, 0xbe, 0x66, 0x8e, 0xd5, 0x1d, 0x7f, 0x93,
+		0x63, 0xe4, 0x03, 0x21, 0x6e, 0x91, 0xae, 0x75, 0x14, 0x98, 0x2f, 0x0a, 0x8b, 0x74, 0xad, 0x18,
+		0x50, 0x03, 0xe6, 0x3d, 0x9f, 0x26, 0xe5, 0x8e, 0x82, 0xf6, 0xb7, 0xc4, 0xd0, 0x63, 0xb2, 0x1b,
+		0x51, 0xc4, 0x4b, 0x90, 0xf3, 0x8c, 0x37, 0xc6, 0x82, 0xf9, 0x92, 0xe8, 0x69, 0x2a, 0x80, 0xc2,
+		0xaf, 0xc2, 0xc9, 0x81, 0xcb, 0xc4, 0x18, 0x60, 0x7f, 0x9b, 0x83, 0x1d, 0x1f, 0xb0, 0x54, 0xf0,
+		0x29, 0xe1, 0xa8, 0x90, 0x7f, 0x47, 0x4c, 0x09, 0xa4, 0x07, 0xab, 0x81, 0xbb, 0x06, 0x4f, 0xdb,
+		0x3b, 0x9a, 0xd5, 0xfe, 0xae, 0xb0, 0x1a, 0x93, 0x8d, 0x59, 0x6d, 0x1b, 0x8e, 0x73, 0xc4, 0xa3,
+		0xf5, 0xeb, 0xdf, 0x13, 0x13, 0x2b, 0x93, 0xde, 0x89, 0xf7, 0xee, 0x8f, 0x40, 0x29, 0x30, 0xa7,
+		0x08, 0x4f, 0x3d, 0x15, 0x33, 0x59, 0xa3, 0x91, 0xbf, 0xcc, 0x91, 0xc5, 0x8c, 0x1f, 0xc4, 0xb7,
+		0xde, 0x86, 0xe6, 0x20, 0xf8, 0x2b, 0x50, 0x14, 0xe0, 0x5d, 0xcb, 0x25, 0xba, 0xdd, 0xb6, 0x8c,
+		0x37, 0x48, 0x6b, 0x0c, 0xe8, 0x5f, 0xed, 0xe9, 0xaa, 0x9d, 0x88, 0x38, 0x22, 0xaf, 0x81, 0x14,
+		0xc4, 0x2a, 0xaa, 0xd1, 0x71, 0x6c, 0xd7, 0x1f, 0x81, 0xf8, 0x15, 0xd1, 0x53, 0x81, 0xdc, 0x1a,
+		0x15, 0xab, 0xd4, 0x81, 0x9d, 0x54, 0x8f, 0xeb, 0x92, 0x5f, 0xe5, 0x40, 0xd3, 0xa1, 0x14, 0x9f,
+		0x38, 0x74, 0xbb, 0xe3, 0x68, 0xee, 0x38, 0xf3, 0xdf, 0xdf, 0x17, 0x13, 0x07, 0x17, 0xe1, 0x13,
+		0x07, 0x46, 0x74, 0xb8, 0xda, 0x8f, 0x81, 0xf0, 0x35, 0x31, 0x71, 0x08, 0x19, 0x0e, 0x21, 0x02,
+		0x86, 0x31, 0x20, 0xfe, 0x81, 0x80, 0x10, 0x32, 0x08, 0xf1, 0x52, 0xb8, 0xd0, 0xba, 0xa4, 0x6d,
+		0x78, 0xbe, 0xcb, 0x82, 0xe2, 0xc3, 0xa1, 0xfe, 0xe1, 0xf7, 0xe3, 0x41, 0x98, 0x12, 0x11, 0xc5,
+		0x99, 0x88, 0xa7, 0x69, 0xe9, 0x9e, 0x69, 0xb4, 0x62, 0x5f, 0x17, 0x33, 0x51, 0x44, 0x0c, 0x75,
+		0x8b, 0x44, 0x88, 0x68, 0x76, 0x1d, 0x77, 0x0a, 0x63, 0xc0, 0xfd, 0x5a, 0x8f, 0x72, 0x4d, 0x21,
+		0x8b, 0x98, 0x91, 0xf8, 0xa7, 0x6b, 0xdd, 0x20, 0x07, 0x63, 0x79, 0xe7, 0xaf, 0xf7, 0xc4, 0x3f,
+		0x3b, 0x4c, 0x92, 0xcd, 0x21, 0x33, 0x3d, 0xf1, 0x94, 0x3c, 0xea, 0x5e, 0x52, 0xf1, 0xa3, 0xef,
+		0xf0, 0xf6, 0xc6, 0xc3, 0xa9, 0xca, 0x3a, 0x48, 0x9c, 0x12, 0x06, 0xb0, 0x23, 0xc1, 0x3e, 0xf6,
+		0x4e, 0xe0, 0xe7, 0xb1, 0x98, 0xa7, 0x72, 0x19, 0xa6, 0x63, 0x01, 0xcf, 0x68, 0xa8, 0xbf, 0xc0,
+		0xa1, 0xf2, 0xd1, 0x78, 0xa7, 0x72, 0x0e, 0xd2, 0x18, 0xbc, 0x8c, 0x16, 0xff, 0x8b, 0x5c, 0x9c,
+		0xb2, 0x57, 0x3e, 0x04, 0x59, 0x11, 0xb4, 0x8c, 0x16, 0xfd, 0x4b, 0x5c, 0x34, 0x10, 0x41, 0x71,
+		0x11, 0xb0, 0x8c, 0x16, 0xff, 0x71, 0x21, 0x2e, 0x44, 0x50, 0x7c, 0x7c, 0x13, 0xfe, 0xc6, 0x5f,
+		0x4e, 0x33, 0x71, 0x21, 0x52, 0xc1, 0x93, 0x72, 0x16, 0xa9, 0x8c, 0x96, 0xfe, 0x38, 0x7f, 0xb8,
+		0x90, 0xa8, 0x5c, 0x80, 0x89, 0x31, 0x0d, 0xfe, 0x93, 0x5c, 0x94, 0xf1, 0x57, 0x56, 0x61, 0x2a,
+		0x12, 0x9d, 0x8c, 0x16, 0xff, 0x29, 0x2e, 0x1e, 0x95, 0x42, 0xd5, 0x79, 0x74, 0x32, 0x1a, 0xe0,
+		0xaf, 0x08, 0xd5, 0xb9, 0x04, 0x9a, 0x4d, 0x04, 0x26, 0xa3, 0xa5, 0x3f, 0x21, 0xac, 0x2e, 0x44,
+		0x2a, 0x2f, 0x40, 0x2e, 0x58, 0x6c, 0x46, 0xcb, 0xff, 0x34, 0x97, 0x0f, 0x65, 0xd0, 0x02, 0x5d,
+		0xeb, 0x08, 0x10, 0x3f, 0x23, 0x2c, 0x10, 0x91, 0xc2, 0x61, 0xd4, 0x1b, 0xc0, 0x8c, 0x46, 0xfa,
+		0x59, 0x31, 0x8c, 0x7a, 0xe2, 0x17, 0xec, 0x4d, 0x3a, 0xe7, 0x8f, 0x86, 0xf8, 0xab, 0xa2, 0x37,
+		0x29, 0x3f, 0xaa, 0xd1, 0x1b, 0x11, 0x8c, 0xc6, 0xf8, 0x39, 0xa1, 0x46, 0x4f, 0x40, 0x50, 0x69,
+		0x80, 0xdc, 0x1f, 0x0d, 0x8c, 0xc6, 0xfb, 0x24, 0xc7, 0x9b, 0xed, 0x0b, 0x06, 0x2a, 0x2f, 0xc3,
+		0xf1, 0xc1, 0x91, 0xc0, 0x68, 0xd4, 0x9f, 0x7f, 0xa7, 0x67, 0xef, 0x16, 0x0d, 0x04, 0x2a, 0xdb,
+		0x30, 0x3f, 0x28, 0x0a, 0x18, 0x0d, 0xfb, 0xa9, 0x77, 0xe2, 0x13, 0x77, 0x34, 0x08, 0xa8, 0x54,
+		0x01, 0xc2, 0x05, 0x78, 0x34, 0xd6, 0xa7, 0x39, 0x56, 0x44, 0x08, 0x87, 0x06, 0x5f, 0x7f, 0x47,
+		0xcb, 0xdf, 0x15, 0x43, 0x83, 0x4b, 0xe0, 0xd0, 0x10, 0x4b, 0xef, 0x68, 0xe9, 0xcf, 0x88, 0xa1,
+		0x21, 0x44, 0xd0, 0xb3, 0x23, 0xab, 0xdb, 0x68, 0x84, 0xcf, 0x09, 0xcf, 0x8e, 0x48, 0x55, 0x36,
+		0x61, 0xb6, 0x6f, 0x41, 0x1c, 0x0d, 0xf5, 0x8b, 0x1c, 0x4a, 0xea, 0x5d, 0x0f, 0xa3, 0x8b, 0x17,
+		0x5f, 0x0c, 0x47, 0xa3, 0x7d, 0xbe, 0x67, 0xf1, 0xe2, 0x6b, 0x61, 0xe5, 0x12, 0x64, 0xad, 0xae,
+		0x69, 0xe2, 0xe0, 0x91, 0x0f, 0xbf, 0x4b, 0x58, 0xfc, 0x6f, 0x3f, 0xe0, 0xd6, 0x11, 0x02, 0x95,
+		0x73, 0x30, 0x41, 0x3a, 0xbb, 0xa4, 0x35, 0x4a, 0xf2, 0x7b, 0x3f, 0x10, 0x13, 0x26, 0x72, 0x57,
+		0x5e, 0x00, 0x60, 0xa9, 0x11, 0x7a, 0x78, 0x38, 0x42, 0xf6, 0xbf, 0xff, 0x80, 0x5f, 0xde, 0x09,
+		0x45, 0x42, 0x00, 0x76, 0x15, 0xe8, 0x70, 0x80, 0xef, 0xc7, 0x01, 0x68, 0x8f, 0x5c, 0x84, 0x49,
+		0xbc, 0x52, 0xe9, 0x6b, 0xed, 0x51, 0xd2, 0xff, 0x83, 0x4b, 0x0b, 0x7e, 0x34, 0x58, 0xc7, 0x76,
+		0x89, 0xaf, 0xb5, 0xbd, 0x51, 0xb2, 0xff, 0x93, 0xcb, 0x06, 0x02, 0x28, 0xac, 0x6b, 0x9e, 0x3f,
+		0x4e, 0xbb, 0x7f, 0x4f, 0x08, 0x0b, 0x01, 0x54, 0x1a, 0x7f, 0xdf, 0x20, 0x07, 0xa3, 0x64, 0x7f,
+		0x5f, 0x28, 0xcd, 0xf9, 0x2b, 0x1f, 0x82, 0x1c, 0xfe, 0x64, 0x37, 0xf2, 0x46, 0x08, 0xff, 0x2f,
+		0x2e, 0x1c, 0x4a, 0xe0, 0x93, 0x3d, 0xbf, 0xe5, 0x1b, 0xa3, 0x8d, 0xfd, 0x07, 0xbc, 0xa7, 0x05,
+		0x7f, 0xa5, 0x0a, 0x53, 0x9e, 0xdf, 0x6a, 0x75, 0x79, 0x7c, 0x3a, 0x42, 0xfc, 0x0f, 0x7f, 0x10,
+		0xa4, 0x2c, 0x02, 0x19, 0xec, 0xed, 0x5b, 0x37, 0x7c, 0xc7, 0xa6, 0x07, 0x1e, 0xa3, 0x10, 0xde,
+		0xe1, 0x08, 0x11, 0x91, 0xca, 0x2a, 0xe4, 0xb1, 0x2d, 0x2e, 0x71, 0x08, 0x3d, 0x9d, 0x1a, 0x01,
+		0xf1, 0xbf, 0xb9, 0x01, 0x62, 0x42, 0x2b, 0x3f, 0xfa, 0x8d, 0xb7, 0x17, 0x12, 0xdf, 0x7a, 0x7b,
+		0x21, 0xf1, 0xbb, 0x6f, 0x2f, 0x24, 0x3e, 0xf1, 0xed, 0x85, 0x63, 0xdf, 0xfa, 0xf6, 0xc2, 0xb1,
+		0xdf, 0xf9, 0xf6, 0xc2, 0xb1, 0xc1, 0x59, 0x62, 0xb8, 0x62, 0x5f, 0xb1, 0x59, 0x7e, 0xf8, 0xb5,
+		0x72, 0xdb, 0xf0, 0xf7, 0xbb, 0xbb, 0xcb, 0xba, 0xdd, 0xa1, 0x69, 0xdc, 0x30, 0x5b, 0x1b, 0x6c,
+		0x72, 0xe0, 0x87, 0x09, 0x38, 0xc9, 0x30, 0xc2, 0x5a, 0xcd, 0x3a, 0x18, 0xf6, 0x6e, 0xcf, 0x79,
+		0x48, 0x55, 0xad, 0x03, 0xf9, 0x24, 0x9b, 0xdd, 0xd4, 0xae, 0x6b, 0xf2, 0x3b, 0x61, 0x93, 0x58,
+		0xde, 0x71, 0x4d, 0xcc, 0x72, 0x8b, 0x8b, 0x9b, 0x78, 0x98, 0xc2, 0x0a, 0x2b, 0x3f, 0x95, 0x38,
+		0x5a, 0x33, 0xb2, 0x55, 0xeb, 0x80, 0xb6, 0xa2, 0x91, 0x78, 0xed, 0xc9, 0x91, 0x49, 0xee, 0x1b,
+		0x96, 0x7d, 0xcb, 0x42, 0xb5, 0x9d, 0x5d, 0x91, 0xe0, 0x5e, 0xe8, 0x4d, 0x70, 0xbf, 0x4c, 0x4c,
+		0xf3, 0x45, 0xe4, 0xc3, 0x73, 0x71, 0x6f, 0x37, 0xc3, 0xae, 0x1f, 0xc3, 0xcf, 0x26, 0x61, 0xa1,
+		0x2f, 0x97, 0xcd, 0x3d, 0x60, 0x98, 0x11, 0x2a, 0x90, 0xad, 0x09, 0xc7, 0x2a, 0xe2, 0x9b, 0x35,
+		0xba, 0x6d, 0xb5, 0x3c, 0x6a, 0x88, 0x94, 0x22, 0x8a, 0x68, 0x08, 0x4b, 0xb3, 0x6c, 0x8f, 0xdf,
+		0xaa, 0x64, 0x85, 0x95, 0x5f, 0x38, 0xa2, 0x21, 0xa6, 0xc5, 0x93, 0x84, 0x35, 0x9e, 0x19, 0xd3,
+		0x1a, 0xa2, 0x11, 0xb1, 0xb4, 0xff, 0xb8, 0x56, 0xf9, 0xb9, 0x24, 0x2c, 0xf6, 0x5a, 0x05, 0x87,
+		0x95, 0xe7, 0x6b, 0x1d, 0x67, 0x98, 0x59, 0x2e, 0x41, 0x6e, 0x5b, 0xf0, 0x1c, 0xd9, 0x2e, 0x77,
+		0x8f, 0x68, 0x97, 0x42, 0xf0, 0x28, 0x61, 0x98, 0xb3, 0x63, 0x1a, 0x26, 0x68, 0xc7, 0xbb, 0xb2,
+		0xcc, 0xff, 0xcd, 0xc0, 0x49, 0xdd, 0xf6, 0x3a, 0xb6, 0xa7, 0xb2, 0xf3, 0x11, 0x56, 0xe0, 0x36,
+		0xc9, 0x47, 0xab, 0x46, 0x1f, 0x92, 0x94, 0x5f, 0x84, 0xb9, 0x35, 0x9c, 0x2a, 0x70, 0x0b, 0x14,
+		0x1e, 0xef, 0x0c, 0xbc, 0x78, 0xba, 0x14, 0x8b, 0xf6, 0xf9, 0xf1, 0x52, 0x94, 0x54, 0xfe, 0x68,
+		0x02, 0xa4, 0xa6, 0xae, 0x99, 0x9a, 0xfb, 0xff, 0x0b, 0x25, 0x5f, 0x00, 0xa0, 0x2f, 0x2c, 0x85,
+		0x6f, 0x18, 0x15, 0xce, 0x16, 0x97, 0xa3, 0x8d, 0x5b, 0x66, 0x4f, 0xa2, 0xaf, 0x2f, 0xe4, 0x28,
+		0x2f, 0xfe, 0x7c, 0xe2, 0x15, 0x80, 0xb0, 0x42, 0x3e, 0x05, 0x27, 0x9a, 0xab, 0xd5, 0xf5, 0xaa,
+		0xa2, 0xb2, 0x9b, 0xf0, 0x9b, 0xcd, 0x46, 0x7d, 0x75, 0xed, 0xf2, 0x5a, 0xbd, 0x26, 0x1d, 0x93,
+		0x8f, 0x83, 0x1c, 0xad, 0x0c, 0x2e, 0xa5, 0xdc, 0x07, 0xb3, 0x51, 0x3a, 0xbb, 0x4e, 0x9f, 0xc4,
+		0x30, 0xd1, 0xe8, 0x38, 0x26, 0xa1, 0xe7, 0x7e, 0xaa, 0x21, 0xac, 0x36, 0x3a, 0x02, 0xf9, 0xd7,
+		0xff, 0x96, 0x5d, 0xb1, 0x9e, 0x0b, 0xc5, 0x03, 0x9b, 0x57, 0xd6, 0x61, 0x16, 0x2f, 0x7d, 0x39,
+		0x31, 0xc8, 0x11, 0xf3, 0x34, 0x02, 0xd2, 0x93, 0x4c, 0x2e, 0x19, 0xa2, 0x5d, 0x80, 0x8c, 0x47,
+		0x5b, 0x3f, 0x0a, 0xe2, 0x9b, 0x1c, 0x82, 0xb3, 0x57, 0x2c, 0x98, 0xc5, 0xb0, 0x0f, 0xb3, 0x43,
+		0xa1, 0x1a, 0x87, 0x27, 0x19, 0xfe, 0xf1, 0x57, 0x9e, 0xa6, 0xe7, 0x9a, 0x0f, 0xc6, 0xbb, 0x65,
+		0x80, 0x3b, 0x29, 0x12, 0xc7, 0x0e, 0x15, 0x25, 0x50, 0x10, 0xcf, 0xe3, 0x0a, 0x1f, 0xfe, 0xb0,
+		0x7f, 0xc2, 0x1f, 0xb6, 0x30, 0xc8, 0x07, 0x22, 0x4f, 0x9a, 0xe6, 0xa8, 0xac, 0x62, 0xa5, 0x3e,
+		0x6c, 0x4c, 0xbf, 0xf6, 0x81, 0xc8, 0xd2, 0xc4, 0x20, 0xf9, 0x9f, 0xa7, 0x28, 0xf2, 0xa5, 0xe8,
+		0x63, 0x82, 0xb1, 0xf7, 0xdb, 0x29, 0x58, 0xe0, 0xcc, 0xbb, 0x9a, 0x47, 0xce, 0xdc, 0x7c, 0x66,
+		0x97, 0xf8, 0xda, 0x33, 0x67, 0x74, 0xdb, 0x10, 0x73, 0xf5, 0x1c, 0x1f, 0x8e, 0x58, 0xbf, 0xcc,
+		0xeb, 0x4b, 0x03, 0x4f, 0x33, 0x4b, 0xc3, 0x87, 0x71, 0x79, 0x07, 0xd2, 0xab, 0xb6, 0x61, 0xe1,
+		0x54, 0xd5, 0x22, 0x96, 0xdd, 0xe1, 0xa3, 0x87, 0x15, 0xe4, 0x67, 0x20, 0xa3, 0x75, 0xec, 0xae,
+		0xe5, 0xb3, 0x91, 0xb3, 0x72, 0xf2, 0x1b, 0x6f, 0x2d, 0x1e, 0xfb, 0xf7, 0x6f, 0x2d, 0xa6, 0xd6,
+		0x2c, 0xff, 0xb7, 0xbe, 0xfa, 0x14, 0x70, 0xa8, 0x35, 0xcb, 0x57, 0x38, 0x63, 0x25, 0xfd, 0xdd,
+		0xcf, 0x2e, 0x26, 0xca, 0xaf, 0xc0, 0x64, 0x8d, 0xe8, 0xef, 0x06, 0xb9, 0x46, 0xf4, 0x08, 0x72,
+		0x8d, 0xe8, 0x3d, 0xc8, 0x17, 0x20, 0xbb, 0x66, 0xf9, 0xec, 0xd6, 0xfa, 0x07, 0x20, 0x65, 0x58,
+		0xec, 0x22, 0xe4, 0xa1, 0xba, 0x21, 0x17, 0x0a, 0xd6, 0x88, 0x1e, 0x08, 0xb6, 0x88, 0x5e, 0x4c,
+		0x8c, 0x7a, 0x34, 0x72, 0xad, 0xd4, 0x7e, 0xe7, 0x3f, 0x2f, 0x1c, 0x7b, 0xf3, 0xed, 0x85, 0x63,
+		0x43, 0xbb, 0xb8, 0x3c, 0xb4, 0x8b, 0xbd, 0xd6, 0x0d, 0x36, 0x23, 0x07, 0x3d, 0xfb, 0xc5, 0x34,
+		0x3c, 0x40, 0x5f, 0x66, 0x72, 0x3b, 0x86, 0xe5, 0x9f, 0xd1, 0xdd, 0x03, 0xc7, 0xa7, 0xe1, 0x8a,
+		0xbd, 0xc7, 0x3b, 0x76, 0x36, 0xac, 0x5e, 0x66, 0xd5, 0x83, 0xbb, 0xb5, 0xbc, 0x07, 0x13, 0x0d,
+		0x94, 0x43, 0x13, 0xfb, 0xb6, 0xaf, 0x99, 0x7c, 0xfd, 0x61, 0x05, 0xa4, 0xb2, 0x17, 0xa0, 0x92,
+		0x8c, 0x6a, 0x88, 0x77, 0x9f, 0x4c, 0xa2, 0xed, 0xb1, 0x7b, 0xe4, 0x29, 0x1a, 0xb8, 0x64, 0x91,
+		0x40, 0xaf, 0x8c, 0xcf, 0xc3, 0x84, 0xd6, 0x65, 0x17, 0x18, 0x52, 0x18, 0xd1, 0xd0, 0x42, 0xf9,
+		0x45, 0x98, 0xe4, 0xc7, 0xa8, 0x78, 0x84, 0x7f, 0x83, 0x1c, 0xd0, 0xe7, 0xe4, 0x15, 0xfc, 0x29,
+		0x2f, 0xc3, 0x04, 0x55, 0x9e, 0xbf, 0x20, 0x53, 0x5c, 0xee, 0xd3, 0x7e, 0x99, 0x2a, 0xa9, 0x30,
+		0xb6, 0xf2, 0x35, 0xc8, 0xd6, 0xec, 0x8e, 0x61, 0xd9, 0x71, 0xb4, 0x1c, 0x43, 0xa3, 0x3a, 0x3b,
+		0x5d, 0xee, 0x15, 0x0a, 0x2b, 0xe0, 0xed, 0x4a, 0xf6, 0x5e, 0x01, 0xbf, 0x84, 0xc1, 0x4b, 0xe5,
+		0x55, 0x98, 0xa4, 0xd8, 0x5b, 0x0e, 0x4e, 0xfe, 0xc1, 0x15, 0xce, 0x1c, 0x7f, 0xcb, 0x8c, 0xc3,
+		0x27, 0x43, 0x65, 0x65, 0x48, 0xb7, 0x34, 0x5f, 0xe3, 0xed, 0xa6, 0xbf, 0xcb, 0x1f, 0x86, 0x2c,
+		0x07, 0xf1, 0xe4, 0xb3, 0x90, 0xb2, 0x1d, 0x8f, 0x5f, 0xa3, 0x28, 0x0d, 0x6b, 0xca, 0x96, 0xb3,
+		0x92, 0x46, 0x9f, 0x51, 0x90, 0x79, 0x45, 0x19, 0xea, 0x16, 0xcf, 0x47, 0xdc, 0x22, 0xd2, 0xe5,
+		0x91, 0x9f, 0xac, 0x4b, 0xfb, 0xdc, 0x21, 0x70, 0x96, 0xcf, 0x25, 0x61, 0x21, 0x52, 0x7b, 0x93,
+		0xb8, 0x9e, 0x61, 0x5b, 0xcc, 0xa3, 0xb8, 0xb7, 0xc8, 0x11, 0x25, 0x79, 0xfd, 0x10, 0x77, 0xf9,
+		0x10, 0xa4, 0xaa, 0x8e, 0x83, 0xaf, 0xd7, 0xd1, 0xb2, 0x6e, 0x33, 0x7f, 0x49, 0x2b, 0x41, 0x19,
+		0xeb, 0x3c, 0x7b, 0xcf, 0xbf, 0xa5, 0xb9, 0xc1, 0xab, 0x77, 0xa2, 0x5c, 0xbe, 0x08, 0xb9, 0x55,
+		0xdb, 0xf2, 0x88, 0xe5, 0x75, 0x69, 0x64, 0xb3, 0x6b, 0xda, 0xfa, 0x0d, 0x8e, 0xc0, 0x0a, 0x68,
+		0x70, 0xcd, 0x71, 0xa8, 0x64, 0x5a, 0xc1, 0x9f, 0x6c, 0xcc, 0xae, 0x34, 0x87, 0x9a, 0xe8, 0xe2,
+		0xd1, 0x4d, 0xc4, 0x1b, 0x19, 0xd8, 0xe8, 0x87, 0x09, 0xb8, 0xbf, 0x7f, 0x40, 0xdd, 0x20, 0x07,
+		0xde, 0x51, 0xc7, 0xd3, 0x2b, 0x90, 0x6b, 0xd0, 0xf7, 0xdf, 0x5f, 0x24, 0x07, 0x72, 0x09, 0x26,
+		0x49, 0xeb, 0xec, 0xb9, 0x73, 0xcf, 0x5c, 0x64, 0xde, 0x7e, 0xf5, 0x98, 0x22, 0x08, 0xf2, 0x02,
+		0xe4, 0x3c, 0xa2, 0x3b, 0x67, 0xcf, 0x9d, 0xbf, 0xf1, 0x0c, 0x73, 0xaf, 0xab, 0xc7, 0x94, 0x90,
+		0x54, 0xc9, 0x62, 0xab, 0xbf, 0xfb, 0xb9, 0xc5, 0xc4, 0xca, 0x04, 0xa4, 0xbc, 0x6e, 0xe7, 0x3d,
+		0xf5, 0x91, 0x4f, 0x4d, 0xc0, 0x52, 0x54, 0x92, 0xc6, 0x7f, 0x37, 0x35, 0xd3, 0x68, 0x69, 0xe1,
+		0x97, 0x0b, 0xa4, 0x88, 0x0d, 0x28, 0xc7, 0x90, 0x95, 0xe2, 0x50, 0x4b, 0x96, 0x7f, 0x35, 0x01,
+		0xf9, 0xeb, 0x02, 0x19, 0x3f, 0x75, 0x70, 0x09, 0x20, 0x78, 0x92, 0x18, 0x36, 0xa7, 0x96, 0x7b,
+		0x9f, 0xb5, 0x1c, 0xc8, 0x28, 0x11, 0x76, 0xf9, 0x02, 0x75, 0x44, 0xc7, 0xf6, 0xf8, 0xeb, 0x58,
+		0x23, 0x44, 0x03, 0x66, 0xbc, 0x1c, 0x47, 0x67, 0x38, 0xf5, 0xa6, 0xed, 0xe3, 0x6d, 0x01, 0xc7,
+		0xbe, 0xc5, 0x5f, 0x72, 0x4d, 0x29, 0x12, 0xad, 0xb9, 0x4e, 0x2b, 0x1a, 0x48, 0x47, 0xa5, 0x73,
+		0x01, 0x0a, 0x06, 0xeb, 0x5a, 0xab, 0xe5, 0x12, 0xcf, 0xe3, 0x93, 0x98, 0x28, 0xe2, 0x3b, 0x60,
+		0x4e, 0x77, 0x57, 0x15, 0x33, 0x06, 0xbe, 0x45, 0x37, 0x60, 0xfc, 0x0b, 0xff, 0xe0, 0x33, 0x40,
+		0xc6, 0xe9, 0xee, 0xa2, 0xb7, 0x3c, 0x08, 0xf9, 0x01, 0xca, 0x4c, 0xdd, 0x0c, 0xf5, 0xa0, 0x9f,
+		0x5d, 0xe0, 0x2d, 0x50, 0x1d, 0xd7, 0xb0, 0x5d, 0xc3, 0x3f, 0xa0, 0x77, 0xa1, 0x52, 0x8a, 0x24,
+		0x2a, 0x1a, 0x9c, 0x5e, 0xbe, 0x01, 0x33, 0x4d, 0x1a, 0xc4, 0x85, 0x9a, 0x9f, 0x0b, 0xf5, 0x4b,
+		0x8c, 0xd6, 0x6f, 0xa8, 0x66, 0xc9, 0x3e, 0xcd, 0x56, 0x5e, 0x1a, 0xea, 0x9d, 0x17, 0x8e, 0xee,
+		0x9d, 0xf1, 0xd5, 0xee, 0xf7, 0x4e, 0xc2, 0xfd, 0xbd, 0x95, 0xb1, 0xe9, 0x6b, 0x5c, 0xc7, 0x1c,
+		0xb5, 0x47, 0x2b, 0x1d, 0xbe, 0xa8, 0x96, 0x46, 0x4c, 0xa3, 0xa5, 0x91, 0x43, 0xa8, 0x7c, 0x11,
+		0xa6, 0xf1, 0x52, 0x63, 0x93, 0xf8, 0x57, 0x89, 0xd6, 0x22, 0x6e, 0x7c, 0xd5, 0x9d, 0x16, 0xab,
+		0xae, 0x0c, 0x69, 0xba, 0xb4, 0xb2, 0x55, 0x87, 0xfe, 0x2e, 0xef, 0x43, 0x1a, 0x45, 0xc3, 0x15,
+		0x99, 0x4b, 0xd0, 0x02, 0x52, 0x77, 0x0f, 0x7c, 0xe2, 0x89, 0x34, 0x02, 0x2d, 0xc8, 0xcf, 0x89,
+		0x75, 0x35, 0x75, 0xf8, 0xba, 0xca, 0x1d, 0x91, 0xaf, 0xae, 0x26, 0x4c, 0xae, 0xe0, 0x54, 0xbc,
+		0x56, 0x0b, 0x14, 0x49, 0x84, 0x8a, 0xc8, 0x1b, 0x30, 0xe3, 0x68, 0xae, 0x4f, 0x5f, 0x25, 0xd9,
+		0xa7, 0xad, 0xe0, 0xbe, 0xbe, 0xd8, 0x3f, 0xf2, 0x62, 0x8d, 0xe5, 0x4f, 0x99, 0x76, 0xa2, 0xc4,
+		0xf2, 0x7f, 0x49, 0x43, 0x86, 0x1b, 0xe3, 0x43, 0x30, 0xc9, 0xcd, 0xca, 0xbd, 0xf3, 0x81, 0xe5,
+		0xfe, 0x85, 0x69, 0x39, 0x58, 0x40, 0x38, 0x9e, 0x90, 0x91, 0x1f, 0x85, 0xac, 0xbe, 0xaf, 0x19,
+		0x96, 0x6a, 0xb4, 0x78, 0x40, 0x38, 0xf5, 0xf6, 0x5b, 0x8b, 0x93, 0xab, 0x48, 0x5b, 0xab, 0x29,
+		0x93, 0xb4, 0x72, 0xad, 0x85, 0x91, 0xc0, 0x3e, 0x31, 0xda, 0xfb, 0x3e, 0x1f, 0x61, 0xbc, 0x84,
+		0xdf, 0x5c, 0x41, 0x87, 0xe0, 0x2f, 0x1a, 0x96, 0xfa, 0x22, 0xfc, 0x60, 0x0b, 0xbd, 0x92, 0xc5,
+		0x07, 0x7f, 0xe2, 0x3f, 0x2d, 0x26, 0x14, 0x2a, 0x21, 0xaf, 0xc2, 0xb4, 0xa9, 0x79, 0xbe, 0x4a,
+		0x57, 0x30, 0x7c, 0xfc, 0x04, 0x85, 0x38, 0xd9, 0x6f, 0x10, 0x6e, 0x58, 0xae, 0xfa, 0x14, 0x4a,
+		0x31, 0x52, 0x0b, 0xdf, 0x83, 0xa2, 0x20, 0x78, 0x97, 0xd3, 0xf0, 0x59, 0x6c, 0x95, 0xa1, 0x76,
+		0x2f, 0x20, 0x7d, 0x95, 0x92, 0x69, 0x84, 0x75, 0x0a, 0x72, 0xf4, 0xd5, 0x26, 0xca, 0xc2, 0x2e,
+		0xe1, 0x66, 0x91, 0x40, 0x2b, 0x1f, 0x83, 0x99, 0x70, 0x7e, 0x64, 0x2c, 0x59, 0x86, 0x12, 0x92,
+		0x29, 0xe3, 0xd3, 0x30, 0x6f, 0x91, 0xdb, 0xbe, 0x1a, 0x92, 0x19, 0x77, 0x8e, 0x72, 0xcb, 0x58,
+		0x77, 0x3d, 0x2e, 0xf1, 0x08, 0x14, 0x74, 0x61, 0x7c, 0xc6, 0x0b, 0x94, 0x77, 0x3a, 0xa0, 0x52,
+		0xb6, 0x93, 0x90, 0xd5, 0x1c, 0x87, 0x31, 0x4c, 0xf1, 0xf9, 0xd1, 0x71, 0x68, 0xd5, 0x13, 0x30,
+		0x4b, 0xdb, 0xe8, 0x12, 0xaf, 0x6b, 0xfa, 0x1c, 0x24, 0x4f, 0x79, 0x66, 0xb0, 0x42, 0x61, 0x74,
+		0xca, 0xfb, 0x10, 0x4c, 0x93, 0x9b, 0x46, 0x8b, 0x58, 0x3a, 0x61, 0x7c, 0xd3, 0x94, 0x2f, 0x2f,
+		0x88, 0x94, 0xe9, 0x71, 0x08, 0xe6, 0x3d, 0x55, 0xcc, 0xc9, 0x05, 0x86, 0x27, 0xe8, 0x55, 0x46,
+		0x2e, 0x17, 0x21, 0x5d, 0xd3, 0x7c, 0x0d, 0x03, 0x0c, 0xff, 0x36, 0x5b, 0x68, 0xf2, 0x0a, 0xfe,
+		0x2c, 0x7f, 0x37, 0x09, 0xe9, 0xeb, 0xb6, 0x4f, 0xe4, 0x67, 0x23, 0x01, 0x60, 0x61, 0x90, 0x3f,
+		0x37, 0x8d, 0xb6, 0x45, 0x5a, 0x1b, 0x5e, 0x3b, 0xf2, 0x1d, 0x82, 0xd0, 0x9d, 0x92, 0x31, 0x77,
+		0x9a, 0x87, 0x09, 0xd7, 0xee, 0x5a, 0x2d, 0x71, 0x7f, 0x95, 0x16, 0xe4, 0x3a, 0x64, 0x03, 0x2f,
+		0x49, 0x8f, 0xf2, 0x92, 0x19, 0xf4, 0x12, 0xf4, 0x61, 0x4e, 0x50, 0x26, 0x77, 0xb9, 0xb3, 0xac,
+		0x40, 0x2e, 0x98, 0xbc, 0x8a, 0x13, 0x47, 0x70, 0xd8, 0x50, 0x0c, 0x17, 0x93, 0xa0, 0xef, 0x03,
+		0xe3, 0x31, 0x8f, 0x93, 0x82, 0x0a, 0x6e, 0xbd, 0x98, 0x5b, 0xf1, 0x6f, 0x22, 0x4c, 0xd2, 0x76,
+		0x85, 0x6e, 0xc5, 0xbe, 0x8b, 0x70, 0x3f, 0x5e, 0x47, 0x6a, 0x5b, 0x9a, 0xdf, 0x75, 0x09, 0xf7,
+		0xbc, 0x90, 0x80, 0x6f, 0xab, 0x64, 0x98, 0x27, 0x47, 0xec, 0x96, 0x18, 0x6c, 0xb7, 0xe4, 0x30,
+		0xbb, 0xa5, 0xde, 0xbd, 0xdd, 0xaa, 0x00, 0x81, 0x32, 0x1e, 0x7f, 0x55, 0x7d, 0x40, 0xc4, 0xc0,
+		0x54, 0x6c, 0x1a, 0x6d, 0x3e, 0x50, 0x23, 0x42, 0xe5, 0xff, 0x98, 0x80, 0x5c, 0x50, 0x2f, 0x57,
+		0x61, 0x5a, 0xe8, 0xa5, 0xee, 0x99, 0x5a, 0x9b, 0xfb, 0xce, 0x03, 0x43, 0x95, 0xbb, 0x6c, 0x6a,
+		0x6d, 0x65, 0x8a, 0xeb, 0x83, 0x85, 0xc1, 0xfd, 0x90, 0x1c, 0xd2, 0x0f, 0xb1, 0x8e, 0x4f, 0xbd,
+		0xbb, 0x8e, 0x8f, 0x75, 0x51, 0xba, 0xb7, 0x8b, 0xbe, 0x92, 0xa4, 0x9b, 0x19, 0xc7, 0xf6, 0x34,
+		0xf3, 0xfd, 0x18, 0x11, 0xa7, 0x20, 0xe7, 0xd8, 0xa6, 0xca, 0x6a, 0xd8, 0xbd, 0xee, 0xac, 0x63,
+		0x9b, 0x4a, 0x5f, 0xb7, 0x4f, 0xdc, 0xa3, 0xe1, 0x92, 0xb9, 0x07, 0x56, 0x9b, 0xec, 0xb5, 0x9a,
+		0x0b, 0x79, 0x66, 0x0a, 0xbe, 0x96, 0x3d, 0x8d, 0x36, 0xc0, 0x5f, 0xc5, 0x44, 0xff, 0xda, 0xcb,
+		0xd4, 0x66, 0x9c, 0x4a, 0x66, 0x3f, 0x90, 0x60, 0x53, 0x7f, 0x31, 0x39, 0x4c, 0x82, 0xb9, 0x9d,
+		0xc2, 0xf9, 0xca, 0x7f, 0x2d, 0x01, 0xb0, 0x8e, 0x96, 0xa5, 0xed, 0xc5, 0x55, 0xc8, 0xa3, 0x2a,
+		0xa8, 0xb1, 0x27, 0x2f, 0x0c, 0xeb, 0x34, 0xfe, 0xfc, 0xbc, 0x17, 0xd5, 0x7b, 0x15, 0xa6, 0x43,
+		0x67, 0xf4, 0x88, 0x50, 0x66, 0xe1, 0x90, 0xa8, 0xba, 0x49, 0x7c, 0x25, 0x7f, 0x33, 0x52, 0x2a,
+		0xff, 0xf3, 0x04, 0xe4, 0xa8, 0x4e, 0xf8, 0xa2, 0x6d, 0xac, 0x0f, 0x13, 0xef, 0xbe, 0x0f, 0x1f,
+		0x00, 0x60, 0x30, 0x78, 0x38, 0xcb, 0x3d, 0x2b, 0x47, 0x29, 0x78, 0xe4, 0x2a, 0x9f, 0x0f, 0x0c,
+		0x9e, 0x3a, 0xdc, 0xe0, 0x22, 0xea, 0xe6, 0x66, 0x3f, 0x01, 0x93, 0xf4, 0xd3, 0x4e, 0xb7, 0x3d,
+		0x1e, 0x48, 0xe3, 0xf7, 0x1c, 0xb6, 0x6f, 0x7b, 0xe5, 0xd7, 0x61, 0x72, 0xfb, 0x36, 0xcb, 0x8d,
+		0x9c, 0x82, 0x9c, 0x6b, 0xdb, 0x7c, 0x4d, 0x66, 0xb1, 0x50, 0x16, 0x09, 0x74, 0x09, 0x12, 0xf9,
+		0x80, 0x64, 0x98, 0x0f, 0x08, 0x13, 0x1a, 0xa9, 0xb1, 0x12, 0x1a, 0x4f, 0xfc, 0x76, 0x02, 0xa6,
+		0x22, 0xf3, 0x83, 0xfc, 0x0c, 0xdc, 0xb7, 0xb2, 0xbe, 0xb5, 0xfa, 0xa2, 0xba, 0x56, 0x53, 0x2f,
+		0xaf, 0x57, 0xaf, 0x84, 0x6f, 0x2e, 0x95, 0x8e, 0xdf, 0xb9, 0xbb, 0x24, 0x47, 0x78, 0x77, 0x2c,
+		0x9a, 0xa7, 0x97, 0xcf, 0xc0, 0x7c, 0x5c, 0xa4, 0xba, 0xd2, 0xc4, 0xd7, 0x98, 0x12, 0xa5, 0xfb,
+		0xee, 0xdc, 0x5d, 0x9a, 0x8d, 0x48, 0x54, 0x77, 0x3d, 0x62, 0xf9, 0xfd, 0x02, 0xab, 0x5b, 0x1b,
+		0x1b, 0x6b, 0xdb, 0x52, 0xb2, 0x4f, 0x80, 0x4f, 0xd8, 0x8f, 0xc3, 0x6c, 0x5c, 0x60, 0x73, 0x6d,
+		0x5d, 0x4a, 0x95, 0xe4, 0x3b, 0x77, 0x97, 0x0a, 0x11, 0xee, 0x4d, 0xc3, 0x2c, 0x65, 0x7f, 0xe2,
+		0xf3, 0x0b, 0xc7, 0x7e, 0xe5, 0x97, 0x16, 0x12, 0xd8, 0xb2, 0xe9, 0xd8, 0x1c, 0x21, 0x3f, 0x09,
+		0x27, 0x9a, 0x6b, 0x57, 0x36, 0xeb, 0x35, 0x75, 0xa3, 0x79, 0x45, 0x64, 0xba, 0x45, 0xeb, 0x66,
+		0xee, 0xdc, 0x5d, 0x9a, 0xe2, 0x4d, 0x1a, 0xc6, 0xdd, 0x50, 0xea, 0xd7, 0xb7, 0xb6, 0xeb, 0x52,
+		0x82, 0x71, 0x37, 0x5c, 0x72, 0xd3, 0xf6, 0xd9, 0xb7, 0xdf, 0x9e, 0x86, 0x93, 0x03, 0xb8, 0x83,
+		0x86, 0xcd, 0xde, 0xb9, 0xbb, 0x34, 0xdd, 0x70, 0x09, 0x1b, 0x3f, 0x54, 0x62, 0x19, 0x8a, 0xfd,
+		0x12, 0x5b, 0x8d, 0xad, 0x66, 0x75, 0x5d, 0x5a, 0x2a, 0x49, 0x77, 0xee, 0x2e, 0xe5, 0xc5, 0x64,
+		0x88, 0xfc, 0x61, 0xcb, 0xde, 0xcb, 0x1d, 0xcf, 0x8f, 0x5f, 0x80, 0x87, 0x79, 0x0e, 0xd0, 0xf3,
+		0xb5, 0x1b, 0x86, 0xd5, 0x0e, 0x92, 0xb7, 0xbc, 0xcc, 0x77, 0x3e, 0xc7, 0x19, 0xd7, 0xb2, 0xa0,
+		0x8e, 0x48, 0xe1, 0x0e, 0x3d, 0xb9, 0x2c, 0x8d, 0x38, 0xd4, 0x1b, 0xbd, 0x75, 0x1a, 0x9e, 0x1e,
+		0x2e, 0x8d, 0x48, 0x42, 0x97, 0x0e, 0xdd, 0xdc, 0x95, 0x3f, 0x9e, 0x80, 0xc2, 0x55, 0xc3, 0xf3,
+		0x6d, 0xd7, 0xd0, 0x35, 0x93, 0xbe, 0xaf, 0x74, 0x7e, 0xdc, 0xb9, 0xb5, 0x67, 0xa8, 0xbf, 0x00,
+		0x99, 0x9b, 0x9a, 0xc9, 0x26, 0xb5, 0xe8, 0x59, 0x40, 0xaf, 0xf9, 0xc2, 0xa9, 0x4d, 0x00, 0x30,
+		0xb1, 0x72, 0x0d, 0xe6, 0x83, 0x2a, 0xba, 0xed, 0x55, 0x88, 0x6e, 0xbb, 0xad, 0xc3, 0x42, 0x99,
+		0xe8, 0x86, 0x99, 0x15, 0xca, 0x5f, 0x4a, 0xc2, 0x0c, 0x1d, 0x52, 0x1e, 0xfb, 0x00, 0x18, 0xee,
+		0xd4, 0x1a, 0x90, 0x76, 0x35, 0x9f, 0xa7, 0x1e, 0x57, 0x3e, 0xc8, 0xb3, 0xc9, 0x8f, 0x8e, 0xce,
+		0x09, 0x2f, 0xf7, 0x27, 0x9c, 0x29, 0x92, 0xfc, 0x32, 0x64, 0x3b, 0xda, 0x6d, 0x95, 0xa2, 0x26,
+		0xef, 0x01, 0xea, 0x64, 0x47, 0xbb, 0x8d, 0xba, 0xca, 0x2d, 0x98, 0x41, 0x60, 0x7d, 0x5f, 0xb3,
+		0xda, 0x84, 0xe1, 0xa7, 0xee, 0x01, 0xfe, 0x74, 0x47, 0xbb, 0xbd, 0x4a, 0x31, 0xf1, 0x29, 0x95,
+		0xec, 0x27, 0x3f, 0xbb, 0x78, 0x8c, 0x26, 0xeb, 0x7f, 0x3d, 0x01, 0x10, 0x9a, 0x4b, 0xfe, 0xd3,
+		0x20, 0xe9, 0x41, 0x89, 0x3e, 0xde, 0xe3, 0x6e, 0xf0, 0xd8, 0xb0, 0xee, 0xec, 0x31, 0x36, 0x5b,
+		0xde, 0xbf, 0xf5, 0xd6, 0x62, 0x42, 0x99, 0xd1, 0x7b, 0xfa, 0xa1, 0x0e, 0x53, 0x5d, 0xa7, 0xa5,
+		0xf9, 0x44, 0xa5, 0x5b, 0xc1, 0xe4, 0x11, 0x42, 0x05, 0x60, 0x82, 0x58, 0x15, 0xd1, 0xfe, 0x4b,
+		0x09, 0x98, 0xaa, 0x45, 0x8e, 0x0a, 0x8b, 0x30, 0xd9, 0xb1, 0x2d, 0xe3, 0x06, 0x77, 0xde, 0x9c,
+		0x22, 0x8a, 0x98, 0x37, 0x65, 0xef, 0x7b, 0xfa, 0x07, 0x22, 0x6f, 0x2a, 0xca, 0x28, 0x75, 0x8b,
+		0xec, 0x7a, 0x86, 0xb0, 0xb5, 0x22, 0x8a, 0xb8, 0x01, 0xf2, 0x88, 0xde, 0xc5, 0x84, 0x8f, 0xaa,
+		0xdb, 0x96, 0xaf, 0xe9, 0x3e, 0x7f, 0x73, 0x70, 0x46, 0xd0, 0x57, 0x19, 0x19, 0x41, 0x5a, 0xc4,
+		0xd7, 0x0c, 0xd3, 0x2b, 0xb2, 0xe3, 0x34, 0x51, 0x8c, 0xa8, 0xfb, 0xd1, 0x5c, 0x34, 0xd1, 0xb5,
+		0x0a, 0x92, 0xed, 0x10, 0x37, 0x16, 0x98, 0x32, 0x0f, 0x2d, 0xfe, 0xd6, 0x57, 0x9f, 0x9a, 0xe7,
+		0xe6, 0xe6, 0xa1, 0x29, 0xbb, 0x1a, 0xab, 0xcc, 0x08, 0x09, 0x4e, 0x96, 0x5f, 0x05, 0x29, 0xd8,
+		0x1f, 0xaa, 0x4e, 0x77, 0x37, 0x4c, 0x8e, 0xcd, 0xf7, 0xd9, 0xb5, 0x6a, 0x1d, 0xac, 0x14, 0xbf,
+		0x19, 0x42, 0x87, 0x19, 0x29, 0x4c, 0x47, 0xcd, 0x04, 0x38, 0x0d, 0x0a, 0x83, 0xe3, 0xee, 0x75,
+		0xcd, 0x30, 0xc5, 0x6b, 0xec, 0x0a, 0x2f, 0xc9, 0x15, 0xc8, 0x78, 0xbe, 0xe6, 0x77, 0x3d, 0xfe,
+		0x79, 0xba, 0xf2, 0x30, 0xcf, 0x58, 0xb1, 0xad, 0x56, 0x93, 0x72, 0x2a, 0x5c, 0x42, 0xde, 0x86,
+		0x8c, 0x6f, 0xdf, 0x20, 0x16, 0x37, 0xd2, 0x91, 0xbc, 0x7a, 0xc0, 0x89, 0x16, 0xc3, 0x92, 0xdb,
+		0x20, 0xb5, 0x88, 0x49, 0xda, 0x2c, 0xac, 0xda, 0xd7, 0x70, 0xf7, 0x91, 0xb9, 0x07, 0xa3, 0x66,
+		0x26, 0x40, 0x6d, 0x52, 0x50, 0xf9, 0xc5, 0xf8, 0x61, 0x35, 0xfb, 0x96, 0xe3, 0x43, 0xc3, 0xda,
+		0x1f, 0xf1, 0x4c, 0x91, 0x92, 0x88, 0x48, 0xa3, 0x73, 0x75, 0xad, 0x5d, 0xdb, 0xa2, 0x2f, 0x9b,
+		0xf2, 0x19, 0x2e, 0x4b, 0xa7, 0xb2, 0x99, 0x80, 0x7e, 0x95, 0x92, 0xe5, 0x17, 0xa1, 0x10, 0xb2,
+		0xd2, 0xb1, 0x93, 0x3b, 0xc2, 0xd8, 0x99, 0x0e, 0x64, 0xb1, 0x56, 0xbe, 0x0a, 0x10, 0x0e, 0x4c,
+		0x9a, 0x64, 0x98, 0x3a, 0x5b, 0x1e, 0x3d, 0xba, 0xc5, 0x66, 0x2d, 0x94, 0x95, 0x4d, 0x98, 0xeb,
+		0x18, 0x96, 0xea, 0x11, 0x73, 0x4f, 0xe5, 0xa6, 0x42, 0xc8, 0xa9, 0x7b, 0xd0, 0xb5, 0xb3, 0x1d,
+		0xc3, 0x6a, 0x12, 0x73, 0xaf, 0x16, 0xc0, 0xca, 0x0e, 0xdc, 0x17, 0x06, 0xcf, 0xd8, 0x20, 0xd1,
+		0xd5, 0xf9, 0x7b, 0xd0, 0xd5, 0x73, 0x01, 0x34, 0xf5, 0x5a, 0xd6, 0xdd, 0x1a, 0x4c, 0x9b, 0xc6,
+		0x47, 0xba, 0x46, 0xf0, 0xa4, 0xe9, 0x7b, 0xf0, 0xa4, 0x3c, 0x83, 0xe4, 0x8f, 0xf8, 0x20, 0x9c,
+		0x0a, 0x7b, 0xd6, 0xb6, 0xd4, 0x7d, 0xdb, 0x6c, 0xa9, 0x2e, 0xd9, 0x53, 0x75, 0x7a, 0xf4, 0x5a,
+		0xa0, 0xfe, 0x70, 0x22, 0x60, 0xd9, 0xb2, 0xae, 0xda, 0x66, 0x4b, 0x21, 0x7b, 0xab, 0x58, 0x8d,
+		0x59, 0x9c, 0x50, 0xda, 0x68, 0x79, 0xc5, 0x99, 0xa5, 0xd4, 0xe9, 0xb4, 0x92, 0x0f, 0x88, 0x6b,
+		0x2d, 0xaf, 0x92, 0xff, 0x89, 0xcf, 0x2e, 0x1e, 0xe3, 0x73, 0xd0, 0xb1, 0x72, 0x83, 0x1e, 0x10,
+		0xf0, 0xe9, 0x83, 0x78, 0xf2, 0x79, 0xc8, 0x69, 0xa2, 0x40, 0xd3, 0x36, 0x87, 0x4d, 0x3f, 0x21,
+		0x2b, 0x9b, 0xd5, 0xde, 0xfc, 0x0f, 0x4b, 0x89, 0xf2, 0x2f, 0x25, 0x20, 0x53, 0xbb, 0xde, 0xd0,
+		0x0c, 0x57, 0xae, 0xe3, 0xd5, 0x01, 0x31, 0x10, 0xc7, 0x9d, 0xd3, 0xc2, 0xb1, 0xcb, 0xe9, 0x08,
+		0x33, 0x78, 0xcf, 0x7e, 0x28, 0x4c, 0xef, 0x6e, 0xbe, 0xa7, 0xe1, 0x75, 0x98, 0x64, 0x5a, 0xe2,
+		0x4b, 0xde, 0x13, 0x0e, 0xfe, 0x28, 0x26, 0x62, 0x17, 0x09, 0xfa, 0x07, 0x30, 0xe5, 0x0f, 0xf2,
+		0xb7, 0x28, 0x52, 0xfe, 0x61, 0x02, 0xa0, 0x76, 0xfd, 0xfa, 0xb6, 0x6b, 0x38, 0x26, 0xf1, 0xef,
+		0x55, 0x8b, 0xd7, 0xa3, 0xbe, 0xed, 0xb9, 0xfa, 0xd8, 0xad, 0x0e, 0xfd, 0xb6, 0xe9, 0xea, 0x03,
+		0xd1, 0x5a, 0x9e, 0x1f, 0xa0, 0xa5, 0xc6, 0x46, 0xab, 0x79, 0xfe, 0x60, 0x33, 0x36, 0x61, 0x2a,
+		0x6c, 0x3e, 0x7e, 0x08, 0x2d, 0xeb, 0xf3, 0xdf, 0xdc, 0x9a, 0xe5, 0xe1, 0xd6, 0x14, 0x62, 0xdc,
+		0xa2, 0x81, 0x64, 0xf9, 0x97, 0x93, 0x00, 0x91, 0x91, 0xfe, 0xc7, 0xca, 0x8d, 0x70, 0xcd, 0xe2,
+		0xc3, 0xff, 0x5e, 0x44, 0x62, 0x1c, 0x0b, 0xd3, 0xbd, 0xf1, 0xd9, 0xac, 0xc8, 0x5e, 0xe4, 0x98,
+		0x8e, 0x4d, 0x44, 0x3d, 0xc6, 0xff, 0x58, 0x12, 0x3f, 0x94, 0xc1, 0xc7, 0xf6, 0x1f, 0x5b, 0x83,
+		0x35, 0x60, 0x92, 0x58, 0xbe, 0x6b, 0x50, 0x8b, 0xa1, 0x4b, 0x3c, 0x3d, 0xcc, 0x25, 0x06, 0xb4,
+		0x85, 0x7e, 0x84, 0x4a, 0x1c, 0x3e, 0x70, 0x98, 0x1e, 0x2b, 0xfc, 0xa3, 0x14, 0x14, 0x87, 0x49,
+		0x62, 0x2a, 0x55, 0x77, 0x09, 0x25, 0xa8, 0xb1, 0x6d, 0x43, 0x41, 0x90, 0xf9, 0x9a, 0xba, 0x01,
+		0x18, 0x9f, 0xa2, 0xff, 0x21, 0xeb, 0x91, 0x03, 0xd2, 0x42, 0x28, 0x8c, 0xd5, 0x32, 0x81, 0x19,
+		0xc3, 0x32, 0x7c, 0x43, 0x33, 0xd5, 0x5d, 0xcd, 0xd4, 0x2c, 0xfd, 0xdd, 0x04, 0xee, 0xfd, 0xeb,
+		0x60, 0x81, 0x83, 0xae, 0x30, 0x4c, 0xf9, 0x3a, 0x4c, 0x0a, 0xf8, 0xf4, 0x3d, 0x80, 0x17, 0x60,
+		0x78, 0x08, 0x19, 0x5d, 0x49, 0x68, 0x78, 0x96, 0x56, 0xa6, 0x02, 0xda, 0x5a, 0x6b, 0xd4, 0x52,
+		0x95, 0x39, 0x74, 0xa9, 0x8a, 0x44, 0xc1, 0xbf, 0x96, 0x82, 0x59, 0x85, 0xb4, 0xfe, 0x64, 0xf5,
+		0xdb, 0x8f, 0x00, 0xb0, 0x81, 0x8f, 0xf3, 0x71, 0x31, 0x7d, 0x0f, 0x26, 0x92, 0x1c, 0xc3, 0xab,
+		0x79, 0xfe, 0xfb, 0xd9, 0x79, 0xdf, 0x4c, 0x42, 0x3e, 0xda, 0x79, 0x7f, 0x02, 0x16, 0x40, 0x79,
+		0x2d, 0x9c, 0xcf, 0xd2, 0xfc, 0x03, 0xc0, 0x43, 0xe6, 0xb3, 0x3e, 0xb7, 0x3e, 0x7c, 0x22, 0xfb,
+		0xfa, 0x04, 0x64, 0x1a, 0x9a, 0xab, 0x75, 0x3c, 0xf9, 0x5a, 0x5f, 0x84, 0x2f, 0x92, 0xb9, 0x7d,
+		0x9f, 0x79, 0xe7, 0xb9, 0x23, 0xe6, 0xd3, 0x9f, 0x1c, 0x10, 0xe0, 0x3f, 0x02, 0x05, 0xcc, 0x21,
+		0x44, 0xee, 0x7d, 0x24, 0xe9, 0x69, 0x36, 0x26, 0x01, 0xc2, 0x43, 0x47, 0xfc, 0x46, 0x0c, 0xb2,
+		0x85, 0x53, 0x35, 0xf2, 0x40, 0x47, 0xbb, 0x5d, 0x67, 0x14, 0xf9, 0x29, 0x90, 0xf7, 0x83, 0xdc,
+		0x90, 0x1a, 0x9a, 0x00, 0xf9, 0x66, 0xc3, 0x1a, 0xc1, 0x8e, 0x29, 0x64, 0x8c, 0xca, 0xd9, 0x5d,
+		0x42, 0xb6, 0x09, 0xce, 0x21, 0xa5, 0x86, 0x04, 0xf9, 0xc7, 0xd8, 0x66, 0xa1, 0x27, 0xbd, 0xc0,
+		0xf7, 0x69, 0xeb, 0x47, 0x1b, 0x0a, 0x7f, 0xf0, 0xd6, 0x62, 0xe9, 0x40, 0xeb, 0x98, 0x95, 0xf2,
+		0x00, 0xc8, 0x32, 0xdd, 0x3c, 0xc4, 0xd3, 0x12, 0xf2, 0x9f, 0x4f, 0xf4, 0xed, 0x1e, 0xf6, 0x34,
+		0xdd, 0xb7, 0x5d, 0xf6, 0x7d, 0xf2, 0x95, 0xcd, 0x23, 0x2b, 0x70, 0x3f, 0x53, 0x60, 0x20, 0x68,
+		0xb9, 0x67, 0x3f, 0x71, 0x99, 0x52, 0xe5, 0x9f, 0xc4, 0xf7, 0x1b, 0x4c, 0x7b, 0x57, 0x33, 0x55,
+		0xb1, 0xaf, 0x60, 0x0e, 0xa4, 0xea, 0x9a, 0xc3, 0x3e, 0xe5, 0xb4, 0xa2, 0x1c, 0x59, 0x91, 0x25,
+		0xa6, 0xc8, 0x50, 0xe0, 0xb2, 0x72, 0x9c, 0xd5, 0xad, 0xb3, 0x8d, 0x07, 0xab, 0x59, 0xd5, 0x1c,
+		0x3c, 0xa4, 0xa6, 0x49, 0x33, 0x95, 0x75, 0xe6, 0x01, 0x3b, 0x62, 0xf7, 0xe8, 0xe6, 0x32, 0xad,
+		0xc8, 0xb4, 0x8e, 0x65, 0x07, 0x0f, 0x68, 0x0a, 0x3a, 0x9a, 0xcb, 0xf8, 0x7c, 0x02, 0xe4, 0x70,
+		0xed, 0x55, 0x88, 0xe7, 0xd8, 0x96, 0x47, 0x37, 0x97, 0x91, 0x9d, 0x60, 0xe2, 0xf0, 0xcd, 0x65,
+		0x28, 0x2f, 0x36, 0x97, 0xa1, 0x2c, 0x7e, 0x45, 0x59, 0x4c, 0xc8, 0x49, 0x3e, 0x14, 0x06, 0xdc,
+		0xa7, 0x5d, 0xc6, 0x1b, 0xac, 0x62, 0x94, 0x71, 0xfe, 0x40, 0xcb, 0x63, 0xe5, 0x7f, 0x97, 0x80,
+		0x93, 0x7d, 0x83, 0x32, 0x50, 0xf6, 0xcf, 0x80, 0xec, 0x46, 0x2a, 0xf9, 0x07, 0x31, 0x99, 0xd2,
+		0x47, 0x1e, 0xe3, 0xb3, 0x6e, 0x6f, 0xc5, 0x7b, 0xb5, 0x58, 0xf3, 0x7b, 0xb6, 0xff, 0x34, 0x01,
+		0xf3, 0x51, 0x65, 0x82, 0x66, 0x6d, 0x42, 0x3e, 0xaa, 0x0b, 0x6f, 0xd0, 0xc3, 0xe3, 0x34, 0x88,
+		0xb7, 0x25, 0x26, 0x2f, 0xbf, 0x14, 0xce, 0x7f, 0x2c, 0xb5, 0xfb, 0xcc, 0xd8, 0xb6, 0x11, 0x3a,
+		0xf5, 0xce, 0x83, 0x69, 0x11, 0xce, 0xa6, 0x1b, 0xb6, 0x6d, 0xca, 0x7f, 0x16, 0x66, 0x2d, 0xdb,
+		0xa7, 0x23, 0x88, 0xb4, 0x54, 0x9e, 0x21, 0x62, 0x8b, 0xc8, 0x4b, 0x47, 0x33, 0xd9, 0xf7, 0xde,
+		0x5a, 0xec, 0x87, 0xea, 0xb1, 0xe3, 0x8c, 0x65, 0xfb, 0x2b, 0xb4, 0x7e, 0x9b, 0x56, 0xcb, 0x2e,
+		0x4c, 0xc7, 0x1f, 0xcd, 0x16, 0x9d, 0x8d, 0x23, 0x3f, 0x7a, 0xfa, 0xb0, 0xc7, 0xe6, 0x77, 0x23,
+		0xcf, 0x64, 0x37, 0x10, 0x7f, 0x9f, 0xc6, 0x43, 0x09, 0x98, 0xa3, 0x44, 0xe3, 0x0d, 0x42, 0xb3,
+		0x02, 0x3c, 0xef, 0x5d, 0x80, 0x24, 0x3f, 0xd3, 0x4b, 0x2b, 0x49, 0x03, 0x3f, 0x3a, 0x3c, 0x61,
+		0xdf, 0xb2, 0x78, 0xbe, 0xfb, 0xb0, 0x45, 0x8c, 0xb1, 0xd1, 0x65, 0xc0, 0x6e, 0x75, 0x4d, 0x82,
+		0x9f, 0x92, 0xa5, 0xab, 0x3c, 0xcb, 0x6e, 0x4e, 0x33, 0x6a, 0x95, 0x11, 0x31, 0x01, 0x10, 0xcc,
+		0x55, 0xc5, 0xf4, 0x08, 0xe8, 0x90, 0x95, 0x39, 0xe1, 0x13, 0x5f, 0x4b, 0x00, 0x84, 0x79, 0x3e,
+		0x3c, 0x50, 0x5a, 0xd9, 0xda, 0xac, 0xa9, 0xcd, 0xed, 0xea, 0xf6, 0x4e, 0x33, 0xfe, 0x92, 0x85,
+		0x38, 0x7e, 0xf2, 0x1c, 0xa2, 0xd3, 0x6f, 0x9d, 0xca, 0x8f, 0xc2, 0x7c, 0x9c, 0x1b, 0x4b, 0xf8,
+		0x65, 0xde, 0x52, 0xfe, 0xce, 0xdd, 0xa5, 0x2c, 0x8b, 0xf1, 0x09, 0x5e, 0xde, 0xb9, 0xaf, 0x9f,
+		0x0f, 0x5f, 0xd0, 0x48, 0x96, 0xa6, 0xef, 0xdc, 0x5d, 0xca, 0x05, 0x9b, 0x01, 0xb9, 0x0c, 0x72,
+		0x94, 0x93, 0xe3, 0xa5, 0x4a, 0x70, 0xe7, 0xee, 0x52, 0x86, 0xf5, 0x79, 0x29, 0x8d, 0x87, 0x4c,
+		0x4f, 0x7c, 0x39, 0x09, 0xd3, 0x81, 0x1c, 0x3d, 0xac, 0xba, 0x04, 0xa5, 0x00, 0x79, 0xc0, 0x3b,
+		0x22, 0xa5, 0x53, 0x77, 0xee, 0x2e, 0x9d, 0x88, 0x89, 0xa8, 0x3b, 0x56, 0x8b, 0xec, 0x19, 0x16,
+		0x69, 0xc9, 0x9b, 0xf0, 0x50, 0x9f, 0xb0, 0x28, 0xd6, 0xea, 0xeb, 0xf5, 0x2b, 0x55, 0xfc, 0x37,
+		0x08, 0x52, 0xa2, 0xf4, 0xc8, 0x9d, 0xbb, 0x4b, 0x0f, 0xf6, 0xa2, 0xf4, 0xef, 0xe8, 0x5e, 0x80,
+		0x53, 0x3d, 0x78, 0x4a, 0x3d, 0x82, 0x93, 0x2c, 0x2d, 0xdc, 0xb9, 0xbb, 0x54, 0x8a, 0xe3, 0xc4,
+		0xe2, 0xb2, 0x75, 0x28, 0xf7, 0x00, 0x5c, 0xaf, 0xae, 0xaf, 0xd5, 0xaa, 0xdb, 0x5b, 0x4a, 0xc4,
+		0x80, 0xa9, 0xd2, 0xc3, 0x77, 0xee, 0x2e, 0x2d, 0xc5, 0x71, 0x82, 0x18, 0x21, 0x20, 0x33, 0x9b,
+		0xad, 0x5c, 0x1e, 0x7a, 0x28, 0xf7, 0xe4, 0xa1, 0x43, 0xe4, 0x76, 0x70, 0xd0, 0x16, 0x3b, 0x89,
+		0xfb, 0x7f, 0x03, 0x00, 0x66, 0xeb, 0x42, 0x0c, 0xd3, 0x6b, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -1865,6 +2185,12 @@ func (this *UnbondingDelegationEntry) Equal(that interface{}) bool {
 	if !this.Balance.Equal(that1.Balance) {
 		return false
 	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntry) Equal(that interface{}) bool {
@@ -1898,6 +2224,12 @@ func (this *RedelegationEntry) Equal(that interface{}) bool {
 	if !this.SharesDst.Equal(that1.SharesDst) {
 		return false
 	}
+	if this.UnbondingId != that1.UnbondingId {
+		return false
+	}
+	if this.UnbondingOnHoldRefCount != that1.UnbondingOnHoldRefCount {
+		return false
+	}
 	return true
 }
 func (this *Params) Equal(that interface{}) bool {
@@ -1937,6 +2269,15 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if !this.ValidatorBondFactor.Equal(that1.ValidatorBondFactor) {
+		return false
+	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if this.PowerHistoryBlocks != that1.PowerHistoryBlocks {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -1993,6 +2334,39 @@ func (this *Pool) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *TokenizeShareRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenizeShareRecord)
+	if !ok {
+		that2, ok := that.(TokenizeShareRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.ModuleAccount != that1.ModuleAccount {
+		return false
+	}
+	if this.Validator != that1.Validator {
+		return false
+	}
+	return true
+}
 func (m *HistoricalInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2040,6 +2414,39 @@ func (m *HistoricalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorPowerRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPowerRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPowerRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommissionRates) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2212,6 +2619,49 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnbondingIds) > 0 {
+		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
+		var j4 int
+		for _, num := range m.UnbondingIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintStaking(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.LiquidShares.Size()
+		i -= size
+		if _, err := m.LiquidShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ValidatorBondShares.Size()
+		i -= size
+		if _, err := m.ValidatorBondShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
 	{
 		size := m.MinSelfDelegation.Size()
 		i -= size
@@ -2232,12 +2682,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
-	if err5 != nil {
-		return 0, err5
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UnbondingTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n5
-	i = encodeVarintStaking(dAtA, i, uint64(n5))
+	i -= n7
+	i = encodeVarintStaking(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2519,6 +2969,16 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ValidatorBond {
+		i--
+		if m.ValidatorBond {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.Shares.Size()
 		i -= size
@@ -2617,6 +3077,16 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size := m.Balance.Size()
 		i -= size
@@ -2637,12 +3107,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err8 != nil {
-		return 0, err8
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n8
-	i = encodeVarintStaking(dAtA, i, uint64(n8))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2673,7 +3143,17 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
+	if m.UnbondingOnHoldRefCount != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingOnHoldRefCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UnbondingId != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
 		size := m.SharesDst.Size()
 		i -= size
 		if _, err := m.SharesDst.MarshalTo(dAtA[i:]); err != nil {
@@ -2693,12 +3173,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
-	if err9 != nil {
-		return 0, err9
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -2787,6 +3267,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PowerHistoryBlocks != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PowerHistoryBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ValidatorBondFactor.Size()
+		i -= size
+		if _, err := m.ValidatorBondFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -2819,12 +3324,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3006,6 +3511,55 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -3034,6 +3588,21 @@ func (m *HistoricalInfo) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorPowerRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
 func (m *CommissionRates) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3126,6 +3695,20 @@ func (m *Validator) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.MinSelfDelegation.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.LiquidShares.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
+	if len(m.UnbondingIds) > 0 {
+		l = 0
+		for _, e := range m.UnbondingIds {
+			l += sovStaking(uint64(e))
+		}
+		n += 1 + sovStaking(uint64(l)) + l
+	}
 	return n
 }
 
@@ -3228,6 +3811,9 @@ func (m *Delegation) Size() (n int) {
 	}
 	l = m.Shares.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.ValidatorBond {
+		n += 2
+	}
 	return n
 }
 
@@ -3269,6 +3855,12 @@ func (m *UnbondingDelegationEntry) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.Balance.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
@@ -3287,6 +3879,12 @@ func (m *RedelegationEntry) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.SharesDst.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if m.UnbondingId != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingId))
+	}
+	if m.UnbondingOnHoldRefCount != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingOnHoldRefCount))
+	}
 	return n
 }
 
@@ -3340,6 +3938,13 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorBondFactor.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.PowerHistoryBlocks != 0 {
+		n += 1 + sovStaking(uint64(m.PowerHistoryBlocks))
+	}
 	return n
 }
 
@@ -3399,6 +4004,30 @@ func (m *Pool) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3540,6 +4169,94 @@ func (m *HistoricalInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorPowerRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPowerRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPowerRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CommissionRates) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4374,59 +5091,43 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondShares", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStaking
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ValidatorBondShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4454,16 +5155,195 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStaking(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LiquidShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStaking
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbondingIds = append(m.UnbondingIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStaking
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStaking
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStaking
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbondingIds) == 0 {
+					m.UnbondingIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStaking
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbondingIds = append(m.UnbondingIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -5032,6 +5912,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBond", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatorBond = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5350,6 +6250,44 @@ func (m *UnbondingDelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5520,6 +6458,44 @@ func (m *RedelegationEntry) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingId", wireType)
+			}
+			m.UnbondingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingOnHoldRefCount", wireType)
+			}
+			m.UnbondingOnHoldRefCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingOnHoldRefCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5906,6 +6882,93 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorBondFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorBondFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerHistoryBlocks", wireType)
+			}
+			m.PowerHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6395,6 +7458,171 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module
+// accounts of tokenize share records.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new TokenizeShareRecord instance.
+//nolint:interfacer
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the
+// delegation of the record.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return r.Validator + "/" + strconv.FormatUint(r.Id, 10)
+}
+
+// Validate performs a stateless validation of the record.
+func (r TokenizeShareRecord) Validate() error {
+	if r.Id == 0 {
+		return fmt.Errorf("tokenize share record id cannot be 0")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner of tokenize share record %d: %w", r.Id, err)
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Validator); err != nil {
+		return fmt.Errorf("invalid validator of tokenize share record %d: %w", r.Id, err)
+	}
+	if r.ModuleAccount != fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, r.Id) {
+		return fmt.Errorf("invalid module account of tokenize share record %d: %s", r.Id, r.ModuleAccount)
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	tokenfactoryQueryCmd := &cobra.Command{
+		Use:                        tokenfactory.ModuleName,
+		Short:                      "Querying commands for the tokenfactory module",
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryDenomAuthorityMetadata(),
+		GetCmdQueryDenomsFromCreator(),
+	)
+	return tokenfactoryQueryCmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "query the parameters of the tokenfactory module",
+		Example: fmt.Sprintf(`$ %s query %s params`, version.AppName, tokenfactory.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := tokenfactory.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &tokenfactory.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomAuthorityMetadata implements the query denom authority metadata command.
+func GetCmdQueryDenomAuthorityMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-authority-metadata [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query the authority metadata, such as the admin, of a factory denom",
+		Example: fmt.Sprintf(`$ %s query %s denom-authority-metadata factory/<creator>/<subdenom>`, version.AppName, tokenfactory.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := tokenfactory.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomAuthorityMetadata(cmd.Context(), &tokenfactory.QueryDenomAuthorityMetadataRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomsFromCreator implements the query denoms from creator command.
+func GetCmdQueryDenomsFromCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denoms-from-creator [creator]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "query all the factory denoms created by an account",
+		Example: fmt.Sprintf(`$ %s query %s denoms-from-creator <creator>`, version.AppName, tokenfactory.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := tokenfactory.NewQueryClient(clientCtx)
+			res, err := queryClient.DenomsFromCreator(cmd.Context(), &tokenfactory.QueryDenomsFromCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denoms")
+	return cmd
+}
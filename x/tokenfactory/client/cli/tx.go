@@ -0,0 +1,212 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	tokenfactoryTxCmd := &cobra.Command{
+		Use:                        tokenfactory.ModuleName,
+		Short:                      "tokenfactory transactions subcommands",
+		Long:                       "Create factory/{creator}/{subdenom} denoms and administer them",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	tokenfactoryTxCmd.AddCommand(
+		NewCmdCreateDenom(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdChangeAdmin(),
+		NewCmdSetDenomMetadata(),
+	)
+
+	return tokenfactoryTxCmd
+}
+
+func NewCmdCreateDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-denom [subdenom] --from [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new factory/{creator}/{subdenom} denom administered by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`Create a new factory/{creator}/{subdenom} denom administered by the sender.
+The sender pays the denom creation fee, which goes to the community pool.
+
+Example:
+$ %s tx %s create-denom <subdenom> --from <creator> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgCreateDenom{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Subdenom: args[0],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [amount] [mint-to-address] --from [admin]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "mint coins of a factory denom, to the admin or the given account",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint 1000factory/<creator>/<subdenom> [mint-to-address] --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgMint{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			if len(args) > 1 {
+				msg.MintToAddress = args[1]
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "burn coins of a factory denom held by the admin",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn 1000factory/<creator>/<subdenom> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgBurn{
+				Sender: clientCtx.GetFromAddress().String(),
+				Amount: amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdChangeAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-admin [denom] [new-admin] --from [admin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "hand the administration of a factory denom over to another account",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s change-admin factory/<creator>/<subdenom> <new-admin> --from <admin> --chain-id <chain-id>`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgChangeAdmin{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Denom:    args[0],
+				NewAdmin: args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdSetDenomMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file] --from [admin]",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the bank metadata of a factory denom from a JSON file",
+		Long: strings.TrimSpace(fmt.Sprintf(`Set the bank metadata of a factory denom. The metadata base must be the factory denom,
+and the other denom units must be named {base}/{unit}.
+
+Example:
+$ %s tx %s set-denom-metadata metadata.json --from <admin> --chain-id <chain-id>
+
+Where metadata.json contains:
+{
+  "description": "The native token of my app",
+  "denom_units": [
+    {"denom": "factory/<creator>/utoken", "exponent": 0},
+    {"denom": "factory/<creator>/utoken/token", "exponent": 6}
+  ],
+  "base": "factory/<creator>/utoken",
+  "display": "factory/<creator>/utoken/token",
+  "name": "Token",
+  "symbol": "TKN"
+}`, version.AppName, tokenfactory.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var metadata banktypes.Metadata
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &metadata); err != nil {
+				return err
+			}
+
+			msg := tokenfactory.MsgSetDenomMetadata{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Metadata: metadata,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,80 @@
+package tokenfactory
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of every denom created by the tokenfactory module.
+	ModuleDenomPrefix = "factory"
+
+	// MaxSubdenomLength is the largest subdenom a creator can choose.
+	MaxSubdenomLength = 44
+)
+
+// GetTokenDenom returns the factory/{creator}/{subdenom} denom and checks that
+// it is a valid sdk denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", sdkerrors.Wrapf(ErrInvalidSubdenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", sdkerrors.Wrapf(ErrInvalidCreator, "creator %s contains a slash", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructDenom splits a factory/{creator}/{subdenom} denom into its
+// creator and subdenom. The subdenom may itself contain slashes.
+func DeconstructDenom(denom string) (creator string, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", sdkerrors.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) < 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "denom %s is not of the form %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidDenom, "invalid creator address (%s)", err)
+	}
+
+	subdenom = parts[2]
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", sdkerrors.Wrapf(ErrInvalidSubdenom, "subdenom is longer than %d characters", MaxSubdenomLength)
+	}
+	return creator, subdenom, nil
+}
+
+// ValidateDenomUnits checks that the denom units of the metadata of a factory
+// denom, and their aliases, are the base denom or named after it as
+// {base}/{unit}, so that a factory denom can't take the display name of
+// another denom.
+func ValidateDenomUnits(base string, units []*banktypes.DenomUnit) error {
+	for _, unit := range units {
+		if !isDerivedDenom(base, unit.Denom) {
+			return sdkerrors.Wrapf(ErrInvalidMetadata, "denom unit %s is not derived from the base denom %s", unit.Denom, base)
+		}
+		for _, alias := range unit.Aliases {
+			if !isDerivedDenom(base, alias) {
+				return sdkerrors.Wrapf(ErrInvalidMetadata, "denom unit alias %s is not derived from the base denom %s", alias, base)
+			}
+		}
+	}
+	return nil
+}
+
+func isDerivedDenom(base, denom string) bool {
+	return denom == base || strings.HasPrefix(denom, base+"/")
+}
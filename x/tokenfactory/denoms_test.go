@@ -0,0 +1,75 @@
+package tokenfactory_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func TestGetTokenDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name     string
+		creator  string
+		subdenom string
+		expDenom string
+		expErr   error
+	}{
+		{"valid", creator, "bitcoin", "factory/" + creator + "/bitcoin", nil},
+		{"empty subdenom", creator, "", "factory/" + creator + "/", nil},
+		{"subdenom with slashes", creator, "bitcoin/sat", "factory/" + creator + "/bitcoin/sat", nil},
+		{"subdenom too long", creator, strings.Repeat("a", tokenfactory.MaxSubdenomLength+1), "", tokenfactory.ErrInvalidSubdenom},
+		{"creator with a slash", "a/b", "bitcoin", "", tokenfactory.ErrInvalidCreator},
+		{"invalid characters", creator, "bit coin", "", tokenfactory.ErrInvalidDenom},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := tokenfactory.GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+
+	testCases := []struct {
+		name        string
+		denom       string
+		expCreator  string
+		expSubdenom string
+		expErr      error
+	}{
+		{"valid", "factory/" + creator + "/bitcoin", creator, "bitcoin", nil},
+		{"subdenom with slashes", "factory/" + creator + "/bitcoin/sat", creator, "bitcoin/sat", nil},
+		{"not a factory denom", "stake", "", "", tokenfactory.ErrInvalidDenom},
+		{"wrong prefix", "ibc/" + creator + "/bitcoin", "", "", tokenfactory.ErrInvalidDenom},
+		{"missing subdenom", "factory/" + creator, "", "", tokenfactory.ErrInvalidDenom},
+		{"invalid creator", "factory/creator/bitcoin", "", "", tokenfactory.ErrInvalidDenom},
+		{"subdenom too long", "factory/" + creator + "/" + strings.Repeat("a", tokenfactory.MaxSubdenomLength+1), "", "", tokenfactory.ErrInvalidSubdenom},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			creator, subdenom, err := tokenfactory.DeconstructDenom(tc.denom)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCreator, creator)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package tokenfactory
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/tokenfactory module sentinel errors
+var (
+	ErrInvalidDenom    = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	ErrDenomExists     = sdkerrors.Register(ModuleName, 3, "denom already exists")
+	ErrDenomNotExists  = sdkerrors.Register(ModuleName, 4, "denom does not exist")
+	ErrInvalidSubdenom = sdkerrors.Register(ModuleName, 5, "invalid subdenom")
+	ErrInvalidCreator  = sdkerrors.Register(ModuleName, 6, "invalid creator")
+	ErrUnauthorized    = sdkerrors.Register(ModuleName, 7, "unauthorized account")
+	ErrInvalidMetadata = sdkerrors.Register(ModuleName, 8, "invalid denom metadata")
+	ErrInvalidGenesis  = sdkerrors.Register(ModuleName, 9, "invalid genesis")
+)
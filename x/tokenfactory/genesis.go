@@ -0,0 +1,45 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(data.FactoryDenoms))
+	for _, denom := range data.FactoryDenoms {
+		if seen[denom.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidGenesis, "duplicate denom: %s", denom.Denom)
+		}
+		seen[denom.Denom] = true
+
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+
+		if denom.AuthorityMetadata.Admin != "" {
+			if _, err := sdk.AccAddressFromBech32(denom.AuthorityMetadata.Admin); err != nil {
+				return sdkerrors.Wrapf(ErrInvalidGenesis, "invalid admin address (%s)", err)
+			}
+		}
+	}
+	return nil
+}
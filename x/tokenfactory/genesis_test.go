@@ -0,0 +1,65 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	creator := sdk.AccAddress("creator_____________").String()
+	admin := sdk.AccAddress("admin_______________").String()
+	denom := "factory/" + creator + "/bitcoin"
+
+	testCases := []struct {
+		name    string
+		genesis tokenfactory.GenesisState
+		expErr  bool
+	}{
+		{"default", *tokenfactory.DefaultGenesisState(), false},
+		{
+			"valid denoms",
+			*tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: admin}},
+				{Denom: denom + "/sat"},
+			}),
+			false,
+		},
+		{
+			"invalid params",
+			*tokenfactory.NewGenesisState(tokenfactory.Params{DenomCreationFee: sdk.Coins{sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)}}}, nil),
+			true,
+		},
+		{
+			"duplicate denom",
+			*tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{{Denom: denom}, {Denom: denom}}),
+			true,
+		},
+		{
+			"not a factory denom",
+			*tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{{Denom: "stake"}}),
+			true,
+		},
+		{
+			"invalid admin",
+			*tokenfactory.NewGenesisState(tokenfactory.DefaultParams(), []tokenfactory.GenesisDenom{
+				{Denom: denom, AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: "admin"}},
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tokenfactory.ValidateGenesis(tc.genesis)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, charging the
+// creator the denom creation fee. The creator becomes the admin of the denom.
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	denom, err := tokenfactory.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if k.HasDenom(ctx, denom) {
+		return "", sdkerrors.Wrap(tokenfactory.ErrDenomExists, denom)
+	}
+
+	// a denom with the same name may already be known to the bank module, for
+	// instance through genesis, it can't be taken over
+	if _, found := k.bk.GetDenomMetaData(ctx, denom); found {
+		return "", sdkerrors.Wrap(tokenfactory.ErrDenomExists, denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.ck.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", sdkerrors.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	k.createDenom(ctx, denom, creator, tokenfactory.DenomAuthorityMetadata{Admin: creator.String()})
+
+	// the metadata must be valid for the bank genesis to be exported, the admin
+	// can replace it with MsgSetDenomMetadata
+	k.bk.SetDenomMetaData(ctx, banktypes.Metadata{
+		Base: denom,
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    denom,
+			Exponent: 0,
+		}},
+		Display: denom,
+		Name:    denom,
+		Symbol:  denom,
+	})
+	return denom, nil
+}
+
+// createDenom stores the authority metadata of denom and indexes it under its creator.
+func (k Keeper) createDenom(ctx sdk.Context, denom string, creator sdk.AccAddress, metadata tokenfactory.DenomAuthorityMetadata) {
+	k.setAuthorityMetadata(ctx, denom, metadata)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(denomsByCreatorStoreKey(creator, denom), DenomsByCreatorValue)
+}
+
+// HasDenom checks whether denom has been created by the tokenfactory module.
+func (k Keeper) HasDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(denomAuthorityStoreKey(denom))
+}
+
+// GetAuthorityMetadata returns the authority metadata of a factory denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactory.DenomAuthorityMetadata, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(denomAuthorityStoreKey(denom))
+	if bz == nil {
+		return tokenfactory.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata tokenfactory.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata tokenfactory.DenomAuthorityMetadata) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(denomAuthorityStoreKey(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// GetDenomsFromCreator returns all the denoms created by creator.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator sdk.AccAddress) (denoms []string) {
+	store := ctx.KVStore(k.storeKey)
+	creatorStore := prefix.NewStore(store, prefixDenomsByCreatorStoreKey(creator))
+	iterator := creatorStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// IterateDenoms iterates over all the factory denoms along with their
+// authority metadata, and stops when cb returns true.
+func (k Keeper) IterateDenoms(ctx sdk.Context, cb func(denom string, metadata tokenfactory.DenomAuthorityMetadata) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DenomAuthorityKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata tokenfactory.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		if cb(string(iterator.Key()[len(DenomAuthorityKey):]), metadata) {
+			break
+		}
+	}
+}
+
+// assertIsAdmin returns an error unless sender is the admin of denom.
+func (k Keeper) assertIsAdmin(ctx sdk.Context, denom string, sender sdk.AccAddress) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return sdkerrors.Wrap(tokenfactory.ErrDenomNotExists, denom)
+	}
+
+	if metadata.Admin != sender.String() {
+		return sdkerrors.Wrapf(tokenfactory.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+	return nil
+}
+
+// Mint mints amount of a factory denom to mintTo. Only the denom admin can mint.
+func (k Keeper) Mint(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin, mintTo sdk.AccAddress) error {
+	if err := k.assertIsAdmin(ctx, amount.Denom, sender); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	if err := k.bk.MintCoins(ctx, tokenfactory.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bk.SendCoinsFromModuleToAccount(ctx, tokenfactory.ModuleName, mintTo, coins)
+}
+
+// Burn burns amount of a factory denom held by the admin. Only the denom admin can burn.
+func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	if err := k.assertIsAdmin(ctx, amount.Denom, sender); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(amount)
+	// moving the coins to the module account is only a step of burning them,
+	// it isn't subject to the bank send restrictions
+	if err := k.bk.SendCoinsFromAccountToModule(banktypes.WithBypass(ctx), sender, tokenfactory.ModuleName, coins); err != nil {
+		return err
+	}
+	return k.bk.BurnCoins(ctx, tokenfactory.ModuleName, coins)
+}
+
+// ChangeAdmin hands the administration of denom over to newAdmin. Only the
+// denom admin can change it.
+func (k Keeper) ChangeAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string, newAdmin sdk.AccAddress) error {
+	if err := k.assertIsAdmin(ctx, denom, sender); err != nil {
+		return err
+	}
+
+	k.setAuthorityMetadata(ctx, denom, tokenfactory.DenomAuthorityMetadata{Admin: newAdmin.String()})
+	return nil
+}
+
+// SetDenomMetadata sets the bank metadata of the factory denom metadata.Base.
+// Only the denom admin can set it.
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, sender sdk.AccAddress, metadata banktypes.Metadata) error {
+	if err := k.assertIsAdmin(ctx, metadata.Base, sender); err != nil {
+		return err
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(tokenfactory.ErrInvalidMetadata, err.Error())
+	}
+
+	// the display denom is one of the denom units, so it is restricted as well
+	if err := tokenfactory.ValidateDenomUnits(metadata.Base, metadata.DenomUnits); err != nil {
+		return err
+	}
+
+	k.bk.SetDenomMetaData(ctx, metadata)
+	return nil
+}
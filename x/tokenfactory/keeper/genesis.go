@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// InitGenesis new tokenfactory genesis
+func (k Keeper) InitGenesis(ctx sdk.Context, data *tokenfactory.GenesisState) {
+	// ensure the module account is created, it holds the coins while they are minted and burned
+	k.ak.GetModuleAccount(ctx, tokenfactory.ModuleName)
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, denom := range data.FactoryDenoms {
+		creator, _, err := tokenfactory.DeconstructDenom(denom.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		k.createDenom(ctx, denom.Denom, sdk.MustAccAddressFromBech32(creator), denom.AuthorityMetadata)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *tokenfactory.GenesisState {
+	denoms := []tokenfactory.GenesisDenom{}
+	k.IterateDenoms(ctx, func(denom string, metadata tokenfactory.DenomAuthorityMetadata) bool {
+		denoms = append(denoms, tokenfactory.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: metadata,
+		})
+		return false
+	})
+
+	return tokenfactory.NewGenesisState(k.GetParams(ctx), denoms)
+}
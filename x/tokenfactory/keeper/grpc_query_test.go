@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	gocontext "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func (s *TestSuite) TestQueryParams() {
+	res, err := s.queryClient.Params(gocontext.Background(), &tokenfactory.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(tokenfactory.DefaultParams(), res.Params)
+}
+
+func (s *TestSuite) TestQueryDenomAuthorityMetadata() {
+	denom := s.createDenom(s.addrs[0])
+
+	testCases := []struct {
+		msg      string
+		req      *tokenfactory.QueryDenomAuthorityMetadataRequest
+		expError string
+		expAdmin string
+	}{
+		{
+			"fail not a factory denom",
+			&tokenfactory.QueryDenomAuthorityMetadataRequest{Denom: sdk.DefaultBondDenom},
+			"invalid denom",
+			"",
+		},
+		{
+			"fail unknown denom",
+			&tokenfactory.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + s.addrs[1].String() + "/" + testSubdenom},
+			"denom does not exist",
+			"",
+		},
+		{
+			"success",
+			&tokenfactory.QueryDenomAuthorityMetadataRequest{Denom: denom},
+			"",
+			s.addrs[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.queryClient.DenomAuthorityMetadata(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expAdmin, res.AuthorityMetadata.Admin)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryDenomsFromCreator() {
+	s.Require().NoError(s.tokenFactoryKeeper.SetParams(s.ctx, tokenfactory.NewParams(sdk.NewCoins())))
+
+	creator := s.addrs[0]
+	var denoms []string
+	for _, subdenom := range []string{"a", "b", "c"} {
+		denom, err := s.tokenFactoryKeeper.CreateDenom(s.ctx, creator, subdenom)
+		s.Require().NoError(err)
+		denoms = append(denoms, denom)
+	}
+	s.createDenom(s.addrs[1])
+
+	testCases := []struct {
+		msg       string
+		req       *tokenfactory.QueryDenomsFromCreatorRequest
+		expError  string
+		expDenoms []string
+	}{
+		{
+			"fail invalid creator",
+			&tokenfactory.QueryDenomsFromCreatorRequest{Creator: "creator"},
+			"decoding bech32 failed",
+			nil,
+		},
+		{
+			"success no denoms",
+			&tokenfactory.QueryDenomsFromCreatorRequest{Creator: s.addrs[2].String()},
+			"",
+			nil,
+		},
+		{
+			"success",
+			&tokenfactory.QueryDenomsFromCreatorRequest{Creator: creator.String()},
+			"",
+			denoms,
+		},
+		{
+			"success with pagination",
+			&tokenfactory.QueryDenomsFromCreatorRequest{Creator: creator.String(), Pagination: &query.PageRequest{Offset: 1, Limit: 1}},
+			"",
+			denoms[1:2],
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			res, err := s.queryClient.DenomsFromCreator(gocontext.Background(), tc.req)
+			if tc.expError != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expDenoms, res.Denoms)
+		})
+	}
+}
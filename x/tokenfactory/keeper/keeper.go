@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// Keeper of the tokenfactory store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	ak       tokenfactory.AccountKeeper
+	bk       tokenfactory.BankKeeper
+	ck       tokenfactory.CommunityPoolKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new tokenfactory Keeper instance
+func NewKeeper(key storetypes.StoreKey,
+	cdc codec.BinaryCodec, ak tokenfactory.AccountKeeper, bk tokenfactory.BankKeeper,
+	ck tokenfactory.CommunityPoolKeeper, authority string,
+) Keeper {
+	// ensure tokenfactory module account is set
+	if addr := ak.GetModuleAddress(tokenfactory.ModuleName); addr == nil {
+		panic("the tokenfactory module account has not been set")
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		ak:        ak,
+		bk:        bk,
+		ck:        ck,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the x/tokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
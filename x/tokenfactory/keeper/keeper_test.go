@@ -0,0 +1,264 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+)
+
+const testSubdenom = "bitcoin"
+
+type TestSuite struct {
+	suite.Suite
+
+	ctx                sdk.Context
+	addrs              []sdk.AccAddress
+	queryClient        tokenfactory.QueryClient
+	tokenFactoryKeeper keeper.Keeper
+	bankKeeper         bankkeeper.Keeper
+	distrKeeper        distrkeeper.Keeper
+}
+
+func (s *TestSuite) SetupTest() {
+	var (
+		interfaceRegistry  codectypes.InterfaceRegistry
+		bankKeeper         bankkeeper.Keeper
+		distrKeeper        distrkeeper.Keeper
+		stakingKeeper      *stakingkeeper.Keeper
+		tokenFactoryKeeper keeper.Keeper
+	)
+
+	app, err := simtestutil.Setup(
+		testutil.AppConfig,
+		&interfaceRegistry,
+		&tokenFactoryKeeper,
+		&bankKeeper,
+		&distrKeeper,
+		&stakingKeeper,
+	)
+	s.Require().NoError(err)
+
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, interfaceRegistry)
+	tokenfactory.RegisterQueryServer(queryHelper, tokenFactoryKeeper)
+
+	s.ctx = ctx
+	s.queryClient = tokenfactory.NewQueryClient(queryHelper)
+	s.addrs = simtestutil.AddTestAddrsIncremental(bankKeeper, stakingKeeper, ctx, 3, sdk.NewInt(30000000))
+	s.tokenFactoryKeeper = tokenFactoryKeeper
+	s.bankKeeper = bankKeeper
+	s.distrKeeper = distrKeeper
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+// createDenom creates testSubdenom with creator as its admin and returns the new denom.
+func (s *TestSuite) createDenom(creator sdk.AccAddress) string {
+	denom, err := s.tokenFactoryKeeper.CreateDenom(s.ctx, creator, testSubdenom)
+	s.Require().NoError(err)
+	return denom
+}
+
+func (s *TestSuite) TestCreateDenom() {
+	creator := s.addrs[0]
+	balanceBefore := s.bankKeeper.GetBalance(s.ctx, creator, sdk.DefaultBondDenom)
+	poolBefore := s.distrKeeper.GetFeePoolCommunityCoins(s.ctx)
+
+	denom := s.createDenom(creator)
+	s.Require().Equal("factory/"+creator.String()+"/"+testSubdenom, denom)
+
+	// the creation fee is paid to the community pool
+	fee := tokenfactory.DefaultDenomCreationFee
+	balanceAfter := s.bankKeeper.GetBalance(s.ctx, creator, sdk.DefaultBondDenom)
+	s.Require().Equal(balanceBefore.Sub(fee[0]), balanceAfter)
+	s.Require().Equal(poolBefore.Add(sdk.NewDecCoinsFromCoins(fee...)...), s.distrKeeper.GetFeePoolCommunityCoins(s.ctx))
+
+	metadata, found := s.tokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(creator.String(), metadata.Admin)
+
+	bankMetadata, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(denom, bankMetadata.Base)
+	s.Require().NoError(bankMetadata.Validate())
+
+	s.Require().Equal([]string{denom}, s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, creator))
+
+	// the same subdenom can't be created twice by the same creator
+	_, err := s.tokenFactoryKeeper.CreateDenom(s.ctx, creator, testSubdenom)
+	s.Require().ErrorIs(err, tokenfactory.ErrDenomExists)
+
+	// but it can by another one
+	otherDenom := s.createDenom(s.addrs[1])
+	s.Require().NotEqual(denom, otherDenom)
+}
+
+func (s *TestSuite) TestCreateDenomFee() {
+	creator := s.addrs[0]
+
+	// a fee the creator can't afford
+	balance := s.bankKeeper.GetBalance(s.ctx, creator, sdk.DefaultBondDenom)
+	s.Require().NoError(s.tokenFactoryKeeper.SetParams(s.ctx, tokenfactory.NewParams(sdk.NewCoins(balance.AddAmount(sdk.OneInt())))))
+	_, err := s.tokenFactoryKeeper.CreateDenom(s.ctx, creator, testSubdenom)
+	s.Require().Error(err)
+	s.Require().False(s.tokenFactoryKeeper.HasDenom(s.ctx, "factory/"+creator.String()+"/"+testSubdenom))
+
+	// no fee at all
+	s.Require().NoError(s.tokenFactoryKeeper.SetParams(s.ctx, tokenfactory.NewParams(sdk.NewCoins())))
+	s.createDenom(creator)
+	s.Require().Equal(balance, s.bankKeeper.GetBalance(s.ctx, creator, sdk.DefaultBondDenom))
+}
+
+func (s *TestSuite) TestCreateDenomWithBankMetadata() {
+	creator := s.addrs[0]
+	denom, err := tokenfactory.GetTokenDenom(creator.String(), testSubdenom)
+	s.Require().NoError(err)
+
+	// denoms already known to the bank module can't be taken over
+	s.bankKeeper.SetDenomMetaData(s.ctx, banktypes.Metadata{
+		Base:       denom,
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+	})
+	_, err = s.tokenFactoryKeeper.CreateDenom(s.ctx, creator, testSubdenom)
+	s.Require().ErrorIs(err, tokenfactory.ErrDenomExists)
+}
+
+func (s *TestSuite) TestMint() {
+	admin, other := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin)
+	amount := sdk.NewInt64Coin(denom, 100)
+
+	s.Require().ErrorIs(s.tokenFactoryKeeper.Mint(s.ctx, other, amount, other), tokenfactory.ErrUnauthorized)
+	s.Require().ErrorIs(s.tokenFactoryKeeper.Mint(s.ctx, admin, sdk.NewInt64Coin("factory/"+admin.String()+"/unknown", 100), admin), tokenfactory.ErrDenomNotExists)
+
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, admin, amount, other))
+	s.Require().Equal(amount, s.bankKeeper.GetBalance(s.ctx, other, denom))
+	s.Require().Equal(amount, s.bankKeeper.GetSupply(s.ctx, denom))
+}
+
+func (s *TestSuite) TestBurn() {
+	admin, other := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin)
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, admin, sdk.NewInt64Coin(denom, 100), admin))
+
+	s.Require().ErrorIs(s.tokenFactoryKeeper.Burn(s.ctx, other, sdk.NewInt64Coin(denom, 10)), tokenfactory.ErrUnauthorized)
+	s.Require().Error(s.tokenFactoryKeeper.Burn(s.ctx, admin, sdk.NewInt64Coin(denom, 101)))
+
+	s.Require().NoError(s.tokenFactoryKeeper.Burn(s.ctx, admin, sdk.NewInt64Coin(denom, 40)))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 60), s.bankKeeper.GetBalance(s.ctx, admin, denom))
+	s.Require().Equal(sdk.NewInt64Coin(denom, 60), s.bankKeeper.GetSupply(s.ctx, denom))
+}
+
+func (s *TestSuite) TestBurnIgnoresSendRestrictions() {
+	admin := s.addrs[0]
+	denom := s.createDenom(admin)
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, admin, sdk.NewInt64Coin(denom, 100), admin))
+
+	s.bankKeeper.AppendSendRestriction(func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, banktypes.ErrSendDisabled
+	})
+	defer s.bankKeeper.ClearSendRestriction()
+
+	s.Require().NoError(s.tokenFactoryKeeper.Burn(s.ctx, admin, sdk.NewInt64Coin(denom, 100)))
+	s.Require().True(s.bankKeeper.GetSupply(s.ctx, denom).IsZero())
+}
+
+func (s *TestSuite) TestChangeAdmin() {
+	admin, newAdmin := s.addrs[0], s.addrs[1]
+	denom := s.createDenom(admin)
+
+	s.Require().ErrorIs(s.tokenFactoryKeeper.ChangeAdmin(s.ctx, newAdmin, denom, newAdmin), tokenfactory.ErrUnauthorized)
+
+	s.Require().NoError(s.tokenFactoryKeeper.ChangeAdmin(s.ctx, admin, denom, newAdmin))
+	metadata, found := s.tokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(newAdmin.String(), metadata.Admin)
+
+	// the previous admin lost its rights, the denom stays indexed under its creator
+	s.Require().ErrorIs(s.tokenFactoryKeeper.Mint(s.ctx, admin, sdk.NewInt64Coin(denom, 1), admin), tokenfactory.ErrUnauthorized)
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, newAdmin, sdk.NewInt64Coin(denom, 1), newAdmin))
+	s.Require().Equal([]string{denom}, s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, admin))
+}
+
+func (s *TestSuite) TestSetDenomMetadata() {
+	admin := s.addrs[0]
+	denom := s.createDenom(admin)
+	metadata := banktypes.Metadata{
+		Description: "a factory bitcoin",
+		Base:        denom,
+		Display:     denom + "/bitcoin",
+		Name:        "Bitcoin",
+		Symbol:      "BTC",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: denom + "/bitcoin", Exponent: 8},
+		},
+	}
+
+	s.Require().ErrorIs(s.tokenFactoryKeeper.SetDenomMetadata(s.ctx, s.addrs[1], metadata), tokenfactory.ErrUnauthorized)
+
+	invalid := metadata
+	invalid.Display = "satoshi"
+	s.Require().ErrorIs(s.tokenFactoryKeeper.SetDenomMetadata(s.ctx, admin, invalid), tokenfactory.ErrInvalidMetadata)
+
+	// the display and denom units can't take the names of other denoms
+	invalid.Display = "bitcoin"
+	invalid.DenomUnits = []*banktypes.DenomUnit{
+		{Denom: denom, Exponent: 0},
+		{Denom: "bitcoin", Exponent: 8},
+	}
+	s.Require().ErrorIs(s.tokenFactoryKeeper.SetDenomMetadata(s.ctx, admin, invalid), tokenfactory.ErrInvalidMetadata)
+
+	s.Require().NoError(s.tokenFactoryKeeper.SetDenomMetadata(s.ctx, admin, metadata))
+	bankMetadata, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(metadata, bankMetadata)
+}
+
+func (s *TestSuite) TestExportGenesis() {
+	denom1 := s.createDenom(s.addrs[0])
+	denom2 := s.createDenom(s.addrs[1])
+	s.Require().NoError(s.tokenFactoryKeeper.ChangeAdmin(s.ctx, s.addrs[1], denom2, s.addrs[2]))
+
+	genesis := s.tokenFactoryKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(tokenfactory.DefaultParams(), genesis.Params)
+	s.Require().ElementsMatch([]tokenfactory.GenesisDenom{
+		{Denom: denom1, AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: s.addrs[0].String()}},
+		{Denom: denom2, AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: s.addrs[2].String()}},
+	}, genesis.FactoryDenoms)
+
+	// the bank metadata of the factory denoms is exported as valid genesis
+	s.Require().NoError(s.bankKeeper.ExportGenesis(s.ctx).Validate())
+}
+
+func (s *TestSuite) TestInitGenesis() {
+	denom, err := tokenfactory.GetTokenDenom(s.addrs[0].String(), testSubdenom)
+	s.Require().NoError(err)
+
+	expGenesis := tokenfactory.NewGenesisState(
+		tokenfactory.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5))),
+		[]tokenfactory.GenesisDenom{{
+			Denom:             denom,
+			AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: s.addrs[1].String()},
+		}},
+	)
+	s.tokenFactoryKeeper.InitGenesis(s.ctx, expGenesis)
+
+	s.Require().Equal([]string{denom}, s.tokenFactoryKeeper.GetDenomsFromCreator(s.ctx, s.addrs[0]))
+	s.Require().Equal(expGenesis, s.tokenFactoryKeeper.ExportGenesis(s.ctx))
+}
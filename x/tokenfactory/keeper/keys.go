@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/internal/conv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+var (
+	ParamsKey            = []byte{0x01}
+	DenomAuthorityKey    = []byte{0x02}
+	DenomsByCreatorKey   = []byte{0x03}
+	DenomsByCreatorValue = []byte{0x01}
+)
+
+// StoreKey is the store key string for tokenfactory
+const StoreKey = tokenfactory.ModuleName
+
+// denomAuthorityStoreKey returns the byte representation of the denom authority key
+// Items are stored with the following key: values
+// 0x02<denom>
+func denomAuthorityStoreKey(denom string) []byte {
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(DenomAuthorityKey)+len(denomBz))
+	copy(key, DenomAuthorityKey)
+	copy(key[len(DenomAuthorityKey):], denomBz)
+	return key
+}
+
+// denomsByCreatorStoreKey returns the byte representation of the creator index key
+// Items are stored with the following key: values
+// 0x03<creator_len (1 Byte)><creator><denom>
+func denomsByCreatorStoreKey(creator sdk.AccAddress, denom string) []byte {
+	prefix := prefixDenomsByCreatorStoreKey(creator)
+	denomBz := conv.UnsafeStrToBytes(denom)
+
+	key := make([]byte, len(prefix)+len(denomBz))
+	copy(key, prefix)
+	copy(key[len(prefix):], denomBz)
+	return key
+}
+
+// prefixDenomsByCreatorStoreKey returns the prefix of the result of the method denomsByCreatorStoreKey
+// Items are stored with the following key: values
+// 0x03<creator_len (1 Byte)><creator>
+func prefixDenomsByCreatorStoreKey(creator sdk.AccAddress) []byte {
+	creator = address.MustLengthPrefix(creator)
+
+	key := make([]byte, len(DenomsByCreatorKey)+len(creator))
+	copy(key, DenomsByCreatorKey)
+	copy(key[len(DenomsByCreatorKey):], creator)
+	return key
+}
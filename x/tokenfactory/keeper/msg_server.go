@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+var _ tokenfactory.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the tokenfactory MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) tokenfactory.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// CreateDenom implement Msg/CreateDenom
+func (k msgServer) CreateDenom(goCtx context.Context, msg *tokenfactory.MsgCreateDenom) (*tokenfactory.MsgCreateDenomResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denom, err := k.Keeper.CreateDenom(ctx, sender, msg.Subdenom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&tokenfactory.EventCreateDenom{
+		Denom:   denom,
+		Creator: msg.Sender,
+	})
+	return &tokenfactory.MsgCreateDenomResponse{NewTokenDenom: denom}, nil
+}
+
+// Mint implement Msg/Mint
+func (k msgServer) Mint(goCtx context.Context, msg *tokenfactory.MsgMint) (*tokenfactory.MsgMintResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	mintTo := sender
+	if msg.MintToAddress != "" {
+		mintTo, err = sdk.AccAddressFromBech32(msg.MintToAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.Mint(ctx, sender, msg.Amount, mintTo); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&tokenfactory.EventMint{
+		Denom:         msg.Amount.Denom,
+		Amount:        msg.Amount.Amount.String(),
+		MintToAddress: mintTo.String(),
+	})
+	return &tokenfactory.MsgMintResponse{}, nil
+}
+
+// Burn implement Msg/Burn
+func (k msgServer) Burn(goCtx context.Context, msg *tokenfactory.MsgBurn) (*tokenfactory.MsgBurnResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.Burn(ctx, sender, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&tokenfactory.EventBurn{
+		Denom:           msg.Amount.Denom,
+		Amount:          msg.Amount.Amount.String(),
+		BurnFromAddress: msg.Sender,
+	})
+	return &tokenfactory.MsgBurnResponse{}, nil
+}
+
+// ChangeAdmin implement Msg/ChangeAdmin
+func (k msgServer) ChangeAdmin(goCtx context.Context, msg *tokenfactory.MsgChangeAdmin) (*tokenfactory.MsgChangeAdminResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	newAdmin, err := sdk.AccAddressFromBech32(msg.NewAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ChangeAdmin(ctx, sender, msg.Denom, newAdmin); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&tokenfactory.EventChangeAdmin{
+		Denom:    msg.Denom,
+		NewAdmin: msg.NewAdmin,
+	})
+	return &tokenfactory.MsgChangeAdminResponse{}, nil
+}
+
+// SetDenomMetadata implement Msg/SetDenomMetadata
+func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *tokenfactory.MsgSetDenomMetadata) (*tokenfactory.MsgSetDenomMetadataResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.SetDenomMetadata(ctx, sender, msg.Metadata); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&tokenfactory.EventSetDenomMetadata{
+		Denom: msg.Metadata.Base,
+	})
+	return &tokenfactory.MsgSetDenomMetadataResponse{}, nil
+}
+
+// UpdateParams implement Msg/UpdateParams
+func (k msgServer) UpdateParams(goCtx context.Context, msg *tokenfactory.MsgUpdateParams) (*tokenfactory.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &tokenfactory.MsgUpdateParamsResponse{}, nil
+}
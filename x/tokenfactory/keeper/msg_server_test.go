@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+)
+
+func (s *TestSuite) TestMsgCreateDenom() {
+	msgServer := keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	res, err := msgServer.CreateDenom(goCtx, &tokenfactory.MsgCreateDenom{Sender: s.addrs[0].String(), Subdenom: testSubdenom})
+	s.Require().NoError(err)
+	s.Require().Equal("factory/"+s.addrs[0].String()+"/"+testSubdenom, res.NewTokenDenom)
+	s.Require().True(s.tokenFactoryKeeper.HasDenom(s.ctx, res.NewTokenDenom))
+
+	_, err = msgServer.CreateDenom(goCtx, &tokenfactory.MsgCreateDenom{Sender: s.addrs[0].String(), Subdenom: testSubdenom})
+	s.Require().ErrorIs(err, tokenfactory.ErrDenomExists)
+}
+
+func (s *TestSuite) TestMsgMint() {
+	msgServer := keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(s.addrs[0])
+
+	testCases := []struct {
+		msg    string
+		req    *tokenfactory.MsgMint
+		expTo  sdk.AccAddress
+		expErr bool
+	}{
+		{
+			"not the admin",
+			&tokenfactory.MsgMint{Sender: s.addrs[1].String(), Amount: sdk.NewInt64Coin(denom, 10)},
+			nil,
+			true,
+		},
+		{
+			"defaults to minting to the sender",
+			&tokenfactory.MsgMint{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 10)},
+			s.addrs[0],
+			false,
+		},
+		{
+			"mint to another account",
+			&tokenfactory.MsgMint{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 10), MintToAddress: s.addrs[2].String()},
+			s.addrs[2],
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			_, err := msgServer.Mint(goCtx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.req.Amount, s.bankKeeper.GetBalance(s.ctx, tc.expTo, denom))
+		})
+	}
+}
+
+func (s *TestSuite) TestMsgBurn() {
+	msgServer := keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(s.addrs[0])
+	s.Require().NoError(s.tokenFactoryKeeper.Mint(s.ctx, s.addrs[0], sdk.NewInt64Coin(denom, 10), s.addrs[0]))
+
+	_, err := msgServer.Burn(goCtx, &tokenfactory.MsgBurn{Sender: s.addrs[1].String(), Amount: sdk.NewInt64Coin(denom, 10)})
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = msgServer.Burn(goCtx, &tokenfactory.MsgBurn{Sender: s.addrs[0].String(), Amount: sdk.NewInt64Coin(denom, 10)})
+	s.Require().NoError(err)
+	s.Require().True(s.bankKeeper.GetBalance(s.ctx, s.addrs[0], denom).IsZero())
+}
+
+func (s *TestSuite) TestMsgChangeAdmin() {
+	msgServer := keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(s.addrs[0])
+
+	_, err := msgServer.ChangeAdmin(goCtx, &tokenfactory.MsgChangeAdmin{Sender: s.addrs[1].String(), Denom: denom, NewAdmin: s.addrs[1].String()})
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = msgServer.ChangeAdmin(goCtx, &tokenfactory.MsgChangeAdmin{Sender: s.addrs[0].String(), Denom: denom, NewAdmin: s.addrs[1].String()})
+	s.Require().NoError(err)
+
+	metadata, found := s.tokenFactoryKeeper.GetAuthorityMetadata(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(s.addrs[1].String(), metadata.Admin)
+}
+
+func (s *TestSuite) TestMsgSetDenomMetadata() {
+	msgServer := keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	denom := s.createDenom(s.addrs[0])
+	metadata := banktypes.Metadata{
+		Base:       denom,
+		Display:    denom,
+		Name:       "Bitcoin",
+		Symbol:     "BTC",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+	}
+
+	_, err := msgServer.SetDenomMetadata(goCtx, &tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[1].String(), Metadata: metadata})
+	s.Require().ErrorIs(err, tokenfactory.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomMetadata(goCtx, &tokenfactory.MsgSetDenomMetadata{Sender: s.addrs[0].String(), Metadata: metadata})
+	s.Require().NoError(err)
+
+	bankMetadata, found := s.bankKeeper.GetDenomMetaData(s.ctx, denom)
+	s.Require().True(found)
+	s.Require().Equal(metadata, bankMetadata)
+}
+
+func (s *TestSuite) TestMsgUpdateParams() {
+	msgServer := keeper.NewMsgServerImpl(s.tokenFactoryKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	params := tokenfactory.NewParams(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	_, err := msgServer.UpdateParams(goCtx, &tokenfactory.MsgUpdateParams{Authority: s.addrs[0].String(), Params: params})
+	s.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = msgServer.UpdateParams(goCtx, &tokenfactory.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().Equal(params, s.tokenFactoryKeeper.GetParams(s.ctx))
+}
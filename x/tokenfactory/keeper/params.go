@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// GetParams returns the total set of tokenfactory parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params tokenfactory.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of tokenfactory parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params tokenfactory.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -0,0 +1,12 @@
+package tokenfactory
+
+const (
+	// ModuleName module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey is the default store key for tokenfactory
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for tokenfactory
+	RouterKey = ModuleName
+)
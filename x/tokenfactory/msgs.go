@@ -0,0 +1,145 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// tokenfactory message types
+	TypeMsgCreateDenom      = "create_denom"
+	TypeMsgMint             = "mint"
+	TypeMsgBurn             = "burn"
+	TypeMsgChangeAdmin      = "change_admin"
+	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgUpdateParams     = "update_params"
+)
+
+var (
+	_ sdk.Msg = &MsgCreateDenom{}
+	_ sdk.Msg = &MsgMint{}
+	_ sdk.Msg = &MsgBurn{}
+	_ sdk.Msg = &MsgChangeAdmin{}
+	_ sdk.Msg = &MsgSetDenomMetadata{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	_, err := GetTokenDenom(m.Sender, m.Subdenom)
+	return err
+}
+
+// GetSigners implements Msg
+func (m MsgCreateDenom) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if m.MintToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.MintToAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid mint to address (%s)", m.MintToAddress)
+		}
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// GetSigners implements Msg
+func (m MsgMint) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	return validateFactoryCoin(m.Amount)
+}
+
+// GetSigners implements Msg
+func (m MsgBurn) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgChangeAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewAdmin); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new admin address (%s)", m.NewAdmin)
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners implements Msg
+func (m MsgChangeAdmin) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", m.Sender)
+	}
+
+	if err := m.Metadata.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidMetadata, err.Error())
+	}
+
+	if _, _, err := DeconstructDenom(m.Metadata.Base); err != nil {
+		return err
+	}
+
+	return ValidateDenomUnits(m.Metadata.Base, m.Metadata.DenomUnits)
+}
+
+// GetSigners implements Msg
+func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid authority address (%s)", m.Authority)
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{signer}
+}
+
+// validateFactoryCoin checks that coin is a positive amount of a factory denom.
+func validateFactoryCoin(coin sdk.Coin) error {
+	if !coin.IsValid() || coin.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, coin.String())
+	}
+
+	_, _, err := DeconstructDenom(coin.Denom)
+	return err
+}
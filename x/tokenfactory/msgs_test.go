@@ -0,0 +1,72 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress("sender______________").String()
+	other := sdk.AccAddress("other_______________").String()
+	denom := "factory/" + sender + "/bitcoin"
+	metadata := banktypes.Metadata{
+		Base:       denom,
+		Display:    denom,
+		Name:       "Bitcoin",
+		Symbol:     "BTC",
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+	}
+
+	displayMetadata := metadata
+	displayMetadata.Display = denom + "/btc"
+	displayMetadata.DenomUnits = []*banktypes.DenomUnit{{Denom: denom}, {Denom: denom + "/btc", Exponent: 8}}
+	foreignMetadata := metadata
+	foreignMetadata.Display = "atom"
+	foreignMetadata.DenomUnits = []*banktypes.DenomUnit{{Denom: denom}, {Denom: "atom", Exponent: 6}}
+	aliasMetadata := metadata
+	aliasMetadata.DenomUnits = []*banktypes.DenomUnit{{Denom: denom, Aliases: []string{"uatom"}}}
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"create denom", &tokenfactory.MsgCreateDenom{Sender: sender, Subdenom: "bitcoin"}, false},
+		{"create denom invalid sender", &tokenfactory.MsgCreateDenom{Sender: "sender", Subdenom: "bitcoin"}, true},
+		{"create denom invalid subdenom", &tokenfactory.MsgCreateDenom{Sender: sender, Subdenom: "bit coin"}, true},
+		{"mint", &tokenfactory.MsgMint{Sender: sender, Amount: sdk.NewInt64Coin(denom, 1)}, false},
+		{"mint to address", &tokenfactory.MsgMint{Sender: sender, Amount: sdk.NewInt64Coin(denom, 1), MintToAddress: other}, false},
+		{"mint invalid mint to address", &tokenfactory.MsgMint{Sender: sender, Amount: sdk.NewInt64Coin(denom, 1), MintToAddress: "other"}, true},
+		{"mint zero", &tokenfactory.MsgMint{Sender: sender, Amount: sdk.NewInt64Coin(denom, 0)}, true},
+		{"mint not a factory denom", &tokenfactory.MsgMint{Sender: sender, Amount: sdk.NewInt64Coin("stake", 1)}, true},
+		{"burn", &tokenfactory.MsgBurn{Sender: sender, Amount: sdk.NewInt64Coin(denom, 1)}, false},
+		{"burn invalid sender", &tokenfactory.MsgBurn{Sender: "sender", Amount: sdk.NewInt64Coin(denom, 1)}, true},
+		{"burn not a factory denom", &tokenfactory.MsgBurn{Sender: sender, Amount: sdk.NewInt64Coin("stake", 1)}, true},
+		{"change admin", &tokenfactory.MsgChangeAdmin{Sender: sender, Denom: denom, NewAdmin: other}, false},
+		{"change admin invalid new admin", &tokenfactory.MsgChangeAdmin{Sender: sender, Denom: denom, NewAdmin: "other"}, true},
+		{"change admin not a factory denom", &tokenfactory.MsgChangeAdmin{Sender: sender, Denom: "stake", NewAdmin: other}, true},
+		{"set denom metadata", &tokenfactory.MsgSetDenomMetadata{Sender: sender, Metadata: metadata}, false},
+		{"set denom metadata invalid metadata", &tokenfactory.MsgSetDenomMetadata{Sender: sender, Metadata: banktypes.Metadata{Base: denom}}, true},
+		{"set denom metadata derived display", &tokenfactory.MsgSetDenomMetadata{Sender: sender, Metadata: displayMetadata}, false},
+		{"set denom metadata foreign display", &tokenfactory.MsgSetDenomMetadata{Sender: sender, Metadata: foreignMetadata}, true},
+		{"set denom metadata foreign alias", &tokenfactory.MsgSetDenomMetadata{Sender: sender, Metadata: aliasMetadata}, true},
+		{"update params", &tokenfactory.MsgUpdateParams{Authority: sender, Params: tokenfactory.DefaultParams()}, false},
+		{"update params invalid authority", &tokenfactory.MsgUpdateParams{Authority: "authority", Params: tokenfactory.DefaultParams()}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
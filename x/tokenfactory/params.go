@@ -0,0 +1,25 @@
+package tokenfactory
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDenomCreationFee is the denom creation fee of DefaultParams.
+var DefaultDenomCreationFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000))
+
+// NewParams creates a new parameter configuration for the tokenfactory module
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the tokenfactory module
+func DefaultParams() Params {
+	return NewParams(DefaultDenomCreationFee)
+}
+
+// Validate all tokenfactory module parameters
+func (p Params) Validate() error {
+	return p.DenomCreationFee.Validate()
+}
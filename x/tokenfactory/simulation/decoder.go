@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that umarshals the KVPair's
+// Value to the corresponding tokenfactory type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.ParamsKey):
+			var paramsA, paramsB tokenfactory.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+		case bytes.Equal(kvA.Key[:1], keeper.DenomAuthorityKey):
+			var metadataA, metadataB tokenfactory.DenomAuthorityMetadata
+			cdc.MustUnmarshal(kvA.Value, &metadataA)
+			cdc.MustUnmarshal(kvB.Value, &metadataB)
+			return fmt.Sprintf("%v\n%v", metadataA, metadataB)
+		case bytes.Equal(kvA.Key[:1], keeper.DenomsByCreatorKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+		default:
+			panic(fmt.Sprintf("invalid tokenfactory key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/depinject"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+)
+
+func TestDecodeStore(t *testing.T) {
+	var cdc codec.Codec
+	depinject.Inject(testutil.AppConfig, &cdc)
+	dec := simulation.NewDecodeStore(cdc)
+
+	params := tokenfactory.DefaultParams()
+	paramsBz, err := cdc.Marshal(&params)
+	require.NoError(t, err)
+
+	metadata := tokenfactory.DenomAuthorityMetadata{Admin: "admin"}
+	metadataBz, err := cdc.Marshal(&metadata)
+	require.NoError(t, err)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: keeper.ParamsKey, Value: paramsBz},
+			{Key: keeper.DenomAuthorityKey, Value: metadataBz},
+			{Key: keeper.DenomsByCreatorKey, Value: keeper.DenomsByCreatorValue},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectErr   bool
+		expectedLog string
+	}{
+		{"Params", false, fmt.Sprintf("%v\n%v", params, params)},
+		{"DenomAuthority", false, fmt.Sprintf("%v\n%v", metadata, metadata)},
+		{"DenomsByCreator", false, fmt.Sprintf("%v\n%v", keeper.DenomsByCreatorValue, keeper.DenomsByCreatorValue)},
+		{"other", true, ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.expectErr {
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			} else {
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
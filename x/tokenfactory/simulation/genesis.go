@@ -0,0 +1,65 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+)
+
+// Simulation parameter constants
+const (
+	DenomCreationFee = "denom_creation_fee"
+	FactoryDenoms    = "factory_denoms"
+)
+
+// genDenomCreationFee returns a random denom creation fee, which can be free.
+func genDenomCreationFee(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(r.Intn(1000))))
+}
+
+// genFactoryDenoms returns a factory denom for some of the accounts, each
+// administered by its creator.
+func genFactoryDenoms(r *rand.Rand, accounts []simtypes.Account) []tokenfactory.GenesisDenom {
+	denoms := []tokenfactory.GenesisDenom{}
+	for _, acc := range accounts {
+		if r.Intn(3) != 0 {
+			continue
+		}
+
+		denom, err := tokenfactory.GetTokenDenom(acc.Address.String(), genSubdenom(r))
+		if err != nil {
+			panic(err)
+		}
+		denoms = append(denoms, tokenfactory.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: tokenfactory.DenomAuthorityMetadata{Admin: acc.Address.String()},
+		})
+	}
+	return denoms
+}
+
+// genSubdenom returns a random subdenom.
+func genSubdenom(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 11))
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory.
+func RandomizedGenState(simState *module.SimulationState) {
+	var fee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationFee, &fee, simState.Rand,
+		func(r *rand.Rand) { fee = genDenomCreationFee(r) },
+	)
+
+	var denoms []tokenfactory.GenesisDenom
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FactoryDenoms, &denoms, simState.Rand,
+		func(r *rand.Rand) { denoms = genFactoryDenoms(r, simState.Accounts) },
+	)
+
+	tokenfactoryGenesis := tokenfactory.NewGenesisState(tokenfactory.NewParams(fee), denoms)
+	simState.GenState[tokenfactory.ModuleName] = simState.Cdc.MustMarshalJSON(tokenfactoryGenesis)
+}
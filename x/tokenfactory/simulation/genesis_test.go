@@ -0,0 +1,48 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/depinject"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	var cdc codec.Codec
+	depinject.Inject(testutil.AppConfig, &cdc)
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          cdc,
+		Rand:         r,
+		NumBonded:    3,
+		Accounts:     simtypes.RandomAccounts(r, 10),
+		InitialStake: sdkmath.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+	}
+
+	simulation.RandomizedGenState(&simState)
+	var tokenfactoryGenesis tokenfactory.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[tokenfactory.ModuleName], &tokenfactoryGenesis)
+
+	require.NoError(t, tokenfactory.ValidateGenesis(tokenfactoryGenesis))
+	require.NotEmpty(t, tokenfactoryGenesis.FactoryDenoms)
+	for _, denom := range tokenfactoryGenesis.FactoryDenoms {
+		creator, _, err := tokenfactory.DeconstructDenom(denom.Denom)
+		require.NoError(t, err)
+		require.Equal(t, creator, denom.AuthorityMetadata.Admin)
+	}
+}
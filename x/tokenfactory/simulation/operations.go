@@ -0,0 +1,332 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+)
+
+//nolint:gosec // these are not hardcoded credentials.
+const (
+	// OpWeightMsgCreateDenom Simulation operation weights constants
+	OpWeightMsgCreateDenom      = "op_weight_msg_create_denom"
+	OpWeightMsgMint             = "op_weight_msg_mint"
+	OpWeightMsgBurn             = "op_weight_msg_burn"
+	OpWeightMsgChangeAdmin      = "op_weight_msg_change_admin"
+	OpWeightMsgSetDenomMetadata = "op_weight_msg_set_denom_metadata"
+)
+
+const (
+	// WeightCreateDenom tokenfactory operations weights
+	WeightCreateDenom      = 20
+	WeightMint             = 50
+	WeightBurn             = 30
+	WeightChangeAdmin      = 10
+	WeightSetDenomMetadata = 10
+)
+
+var (
+	TypeMsgCreateDenom      = sdk.MsgTypeURL(&tokenfactory.MsgCreateDenom{})
+	TypeMsgMint             = sdk.MsgTypeURL(&tokenfactory.MsgMint{})
+	TypeMsgBurn             = sdk.MsgTypeURL(&tokenfactory.MsgBurn{})
+	TypeMsgChangeAdmin      = sdk.MsgTypeURL(&tokenfactory.MsgChangeAdmin{})
+	TypeMsgSetDenomMetadata = sdk.MsgTypeURL(&tokenfactory.MsgSetDenomMetadata{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDenom      int
+		weightMsgMint             int
+		weightMsgBurn             int
+		weightMsgChangeAdmin      int
+		weightMsgSetDenomMetadata int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDenom = WeightCreateDenom
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) {
+			weightMsgMint = WeightMint
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurn = WeightBurn
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgChangeAdmin, &weightMsgChangeAdmin, nil,
+		func(_ *rand.Rand) {
+			weightMsgChangeAdmin = WeightChangeAdmin
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDenomMetadata, &weightMsgSetDenomMetadata, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetDenomMetadata = WeightSetDenomMetadata
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateDenom,
+			SimulateMsgCreateDenom(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMint,
+			SimulateMsgMint(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurn,
+			SimulateMsgBurn(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgChangeAdmin,
+			SimulateMsgChangeAdmin(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetDenomMetadata,
+			SimulateMsgSetDenomMetadata(protoCdc, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateDenom generates a MsgCreateDenom with random values.
+func SimulateMsgCreateDenom(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+
+		fee := k.GetParams(ctx).DenomCreationFee
+		if !bk.SpendableCoins(ctx, creator.Address).IsAllGTE(fee) {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgCreateDenom, "insufficient funds to pay the creation fee"), nil, nil
+		}
+
+		subdenom := genSubdenom(r)
+		denom, err := tokenfactory.GetTokenDenom(creator.Address.String(), subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgCreateDenom, err.Error()), nil, err
+		}
+		if k.HasDenom(ctx, denom) {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgCreateDenom, "denom already exists"), nil, nil
+		}
+
+		msg := &tokenfactory.MsgCreateDenom{
+			Sender:   creator.Address.String(),
+			Subdenom: subdenom,
+		}
+		return deliverMsg(r, app, ctx, cdc, ak, bk, creator, msg, TypeMsgCreateDenom, fee)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint with random values.
+func SimulateMsgMint(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs, nil)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgMint, "no denom administered by a simulation account"), nil, nil
+		}
+
+		// the admin mints to itself half of the time, so that it has coins to burn
+		receiver := admin
+		if r.Intn(2) == 0 {
+			receiver, _ = simtypes.RandomAcc(r, accs)
+		}
+		msg := &tokenfactory.MsgMint{
+			Sender:        admin.Address.String(),
+			Amount:        sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1_000_000))),
+			MintToAddress: receiver.Address.String(),
+		}
+		return deliverMsg(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgMint, sdk.NewCoins())
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn with random values.
+func SimulateMsgBurn(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs, func(denom string, admin sdk.AccAddress) bool {
+			return bk.SpendableCoins(ctx, admin).AmountOf(denom).IsPositive()
+		})
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgBurn, "no simulation account holds coins of a denom it administers"), nil, nil
+		}
+
+		balance := bk.SpendableCoins(ctx, admin.Address).AmountOf(denom)
+
+		amount, err := simtypes.RandPositiveInt(r, balance)
+		if err != nil {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgBurn, err.Error()), nil, err
+		}
+
+		msg := &tokenfactory.MsgBurn{
+			Sender: admin.Address.String(),
+			Amount: sdk.NewCoin(denom, amount),
+		}
+		return deliverMsg(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgBurn, sdk.NewCoins(msg.Amount))
+	}
+}
+
+// SimulateMsgChangeAdmin generates a MsgChangeAdmin with random values.
+func SimulateMsgChangeAdmin(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs, nil)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgChangeAdmin, "no denom administered by a simulation account"), nil, nil
+		}
+
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+		msg := &tokenfactory.MsgChangeAdmin{
+			Sender:   admin.Address.String(),
+			Denom:    denom,
+			NewAdmin: newAdmin.Address.String(),
+		}
+		return deliverMsg(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgChangeAdmin, sdk.NewCoins())
+	}
+}
+
+// SimulateMsgSetDenomMetadata generates a MsgSetDenomMetadata with random values.
+func SimulateMsgSetDenomMetadata(
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randAdministeredDenom(ctx, r, k, accs, nil)
+		if !found {
+			return simtypes.NoOpMsg(tokenfactory.ModuleName, TypeMsgSetDenomMetadata, "no denom administered by a simulation account"), nil, nil
+		}
+
+		display := denom + "/u" + simtypes.RandStringOfLength(r, 5)
+		msg := &tokenfactory.MsgSetDenomMetadata{
+			Sender: admin.Address.String(),
+			Metadata: banktypes.Metadata{
+				Description: simtypes.RandStringOfLength(r, 10),
+				DenomUnits: []*banktypes.DenomUnit{
+					{Denom: denom, Exponent: 0},
+					{Denom: display, Exponent: uint32(simtypes.RandIntBetween(r, 1, 19))},
+				},
+				Base:    denom,
+				Display: display,
+				Name:    simtypes.RandStringOfLength(r, 10),
+				Symbol:  simtypes.RandStringOfLength(r, 5),
+			},
+		}
+		return deliverMsg(r, app, ctx, cdc, ak, bk, admin, msg, TypeMsgSetDenomMetadata, sdk.NewCoins())
+	}
+}
+
+func deliverMsg(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	cdc *codec.ProtoCodec,
+	ak tokenfactory.AccountKeeper,
+	bk tokenfactory.BankKeeper,
+	account simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+	coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           tx.NewTxConfig(cdc, tx.DefaultSignModes),
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      tokenfactory.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
+
+// randAdministeredDenom returns a random factory denom administered by one of
+// the simulation accounts, along with that account. If filter is not nil, only
+// the denoms for which it returns true are considered.
+func randAdministeredDenom(
+	ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account, filter func(denom string, admin sdk.AccAddress) bool,
+) (string, simtypes.Account, bool) {
+	var (
+		denoms []string
+		admins []simtypes.Account
+	)
+	k.IterateDenoms(ctx, func(denom string, metadata tokenfactory.DenomAuthorityMetadata) bool {
+		admin, err := sdk.AccAddressFromBech32(metadata.Admin)
+		if err != nil {
+			return false
+		}
+		if filter != nil && !filter(denom, admin) {
+			return false
+		}
+		if acc, found := simtypes.FindAccount(accs, admin); found {
+			denoms = append(denoms, denom)
+			admins = append(admins, acc)
+		}
+		return false
+	})
+
+	if len(denoms) == 0 {
+		return "", simtypes.Account{}, false
+	}
+	i := r.Intn(len(denoms))
+	return denoms[i], admins[i], true
+}
@@ -0,0 +1,234 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/cosmos-sdk/x/tokenfactory/keeper"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/simulation"
+	"github.com/cosmos/cosmos-sdk/x/tokenfactory/testutil"
+)
+
+type SimTestSuite struct {
+	suite.Suite
+
+	ctx sdk.Context
+
+	app                *runtime.App
+	codec              codec.Codec
+	interfaceRegistry  codectypes.InterfaceRegistry
+	accountKeeper      authkeeper.AccountKeeper
+	bankKeeper         bankkeeper.Keeper
+	stakingKeeper      *stakingkeeper.Keeper
+	tokenFactoryKeeper tokenfactorykeeper.Keeper
+}
+
+func (suite *SimTestSuite) SetupTest() {
+	app, err := simtestutil.Setup(
+		testutil.AppConfig,
+		&suite.codec,
+		&suite.interfaceRegistry,
+		&suite.accountKeeper,
+		&suite.bankKeeper,
+		&suite.stakingKeeper,
+		&suite.tokenFactoryKeeper,
+	)
+	suite.Require().NoError(err)
+
+	suite.app = app
+	suite.ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+}
+
+func (suite *SimTestSuite) TestWeightedOperations() {
+	weightedOps := simulation.WeightedOperations(
+		suite.interfaceRegistry,
+		make(simtypes.AppParams),
+		suite.codec,
+		suite.accountKeeper,
+		suite.bankKeeper,
+		suite.tokenFactoryKeeper,
+	)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accs := suite.getTestingAccounts(r, 3)
+
+	expected := []struct {
+		weight    int
+		opMsgName string
+	}{
+		{simulation.WeightCreateDenom, simulation.TypeMsgCreateDenom},
+		{simulation.WeightMint, simulation.TypeMsgMint},
+		{simulation.WeightBurn, simulation.TypeMsgBurn},
+		{simulation.WeightChangeAdmin, simulation.TypeMsgChangeAdmin},
+		{simulation.WeightSetDenomMetadata, simulation.TypeMsgSetDenomMetadata},
+	}
+
+	for i, w := range weightedOps {
+		operationMsg, _, err := w.Op()(r, suite.app.BaseApp, suite.ctx, accs, "")
+		suite.Require().NoError(err)
+
+		// the following checks are very much dependent from the ordering of the output given
+		// by WeightedOperations. if the ordering in WeightedOperations changes some tests
+		// will fail
+		suite.Require().Equal(expected[i].weight, w.Weight(), "weight should be the same")
+		suite.Require().Equal(expected[i].opMsgName, operationMsg.Name, "operation Msg name should be the same")
+	}
+}
+
+func (suite *SimTestSuite) getTestingAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accounts := simtypes.RandomAccounts(r, n)
+
+	initAmt := suite.stakingKeeper.TokensFromConsensusPower(suite.ctx, 200000)
+	initCoins := sdk.NewCoins(sdk.NewCoin("stake", initAmt))
+
+	// add coins to the accounts
+	for _, account := range accounts {
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, account.Address)
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		suite.Require().NoError(banktestutil.FundAccount(suite.bankKeeper, suite.ctx, account.Address, initCoins))
+	}
+
+	return accounts
+}
+
+func (suite *SimTestSuite) beginBlock() {
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+}
+
+func (suite *SimTestSuite) TestSimulateMsgCreateDenom() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgCreateDenom(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.tokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgCreateDenom
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	denom, err := tokenfactory.GetTokenDenom(msg.Sender, msg.Subdenom)
+	suite.Require().NoError(err)
+	metadata, found := suite.tokenFactoryKeeper.GetAuthorityMetadata(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Sender, metadata.Admin)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMint() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	denom, err := suite.tokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address, "bitcoin")
+	suite.Require().NoError(err)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgMint(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.tokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgMint
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(accounts[0].Address.String(), msg.Sender)
+	suite.Require().Equal(msg.Amount, suite.bankKeeper.GetSupply(suite.ctx, denom))
+}
+
+func (suite *SimTestSuite) TestSimulateMsgBurn() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	denom, err := suite.tokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address, "bitcoin")
+	suite.Require().NoError(err)
+	minted := sdk.NewInt64Coin(denom, 1000)
+	suite.Require().NoError(suite.tokenFactoryKeeper.Mint(suite.ctx, accounts[0].Address, minted, accounts[0].Address))
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgBurn(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.tokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgBurn
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal(minted.Sub(msg.Amount), suite.bankKeeper.GetSupply(suite.ctx, denom))
+}
+
+func (suite *SimTestSuite) TestSimulateMsgChangeAdmin() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	denom, err := suite.tokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address, "bitcoin")
+	suite.Require().NoError(err)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgChangeAdmin(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.tokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgChangeAdmin
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	metadata, found := suite.tokenFactoryKeeper.GetAuthorityMetadata(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.NewAdmin, metadata.Admin)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgSetDenomMetadata() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	denom, err := suite.tokenFactoryKeeper.CreateDenom(suite.ctx, accounts[0].Address, "bitcoin")
+	suite.Require().NoError(err)
+	suite.beginBlock()
+
+	op := simulation.SimulateMsgSetDenomMetadata(codec.NewProtoCodec(suite.interfaceRegistry), suite.accountKeeper, suite.bankKeeper, suite.tokenFactoryKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg tokenfactory.MsgSetDenomMetadata
+	suite.codec.UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Metadata.Display, metadata.Display)
+}
+
+func TestSimTestSuite(t *testing.T) {
+	suite.Run(t, new(SimTestSuite))
+}
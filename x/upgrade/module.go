@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	modulev1 "cosmossdk.io/api/cosmos/upgrade/module/v1"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
@@ -183,6 +184,7 @@ type upgradeOutputs struct {
 
 	UpgradeKeeper keeper.Keeper
 	Module        runtime.AppModuleWrapper
+	GovHandler    govv1beta1.HandlerRoute
 }
 
 func provideModule(in upgradeInputs) upgradeOutputs {
@@ -202,6 +204,7 @@ func provideModule(in upgradeInputs) upgradeOutputs {
 	// set the governance module account as the authority for conducting upgrades
 	k := keeper.NewKeeper(skipUpgradeHeights, in.Key, in.Cdc, homePath, nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	m := NewAppModule(k)
+	gh := govv1beta1.NewHandlerRoute(types.RouterKey, NewSoftwareUpgradeProposalHandler(k))
 
-	return upgradeOutputs{UpgradeKeeper: k, Module: runtime.WrapAppModule(m)}
+	return upgradeOutputs{UpgradeKeeper: k, Module: runtime.WrapAppModule(m), GovHandler: gh}
 }